@@ -0,0 +1,51 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package mux
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// TestNewMuxServerProviderSchema checks that the SDKv2 and framework providers declare the same provider schema,
+// as the muxed provider refuses to start otherwise
+func TestNewMuxServerProviderSchema(t *testing.T) {
+	ctx := context.Background()
+	server, err := NewMuxServer(ctx)
+	if err != nil {
+		t.Fatalf("NewMuxServer() error = %v", err)
+	}
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+}
+
+// TestNewMuxServerEphemeralResources checks that the ephemeral resources of the framework provider are served
+func TestNewMuxServerEphemeralResources(t *testing.T) {
+	ctx := context.Background()
+	server, err := NewMuxServer(ctx)
+	if err != nil {
+		t.Fatalf("NewMuxServer() error = %v", err)
+	}
+	resp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+	for _, name := range []string{"vcfa_session_token", "vcfa_kubeconfig"} {
+		if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+			t.Errorf("expected ephemeral resource %s to be served", name)
+		}
+	}
+}
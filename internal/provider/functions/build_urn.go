@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &BuildUrnFunction{}
+
+// BuildUrnFunction builds a VCFA URN from an entity type and a UUID
+type BuildUrnFunction struct{}
+
+func NewBuildUrnFunction() function.Function {
+	return &BuildUrnFunction{}
+}
+
+func (f *BuildUrnFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_urn"
+}
+
+func (f *BuildUrnFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a URN from an entity type and a UUID",
+		MarkdownDescription: "Returns the VCFA URN `urn:vcloud:<type>:<uuid>` of the given entity type and UUID. " +
+			"The UUID can also be a URN, in which case its UUID is used.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "type",
+				MarkdownDescription: "Entity type of the URN, like `org`, `region` or `supervisor`",
+			},
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "UUID or URN of the entity",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *BuildUrnFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var entityType, id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &entityType, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	urn, err := buildUrn(entityType, id)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, urn))
+}
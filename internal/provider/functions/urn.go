@@ -0,0 +1,48 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// urnPrefix is the common prefix of the URNs that VCFA uses as entity IDs, like urn:vcloud:org:<uuid>
+const urnPrefix = "urn:vcloud:"
+
+var (
+	uuidRegex    = regexp.MustCompile(`^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$`)
+	urnTypeRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`)
+)
+
+// urnId returns the bare UUID of the given URN. A bare UUID is returned as is, so configurations can use the function
+// on IDs that come in either form
+func urnId(id string) (string, error) {
+	if uuidRegex.MatchString(id) {
+		return strings.ToLower(id), nil
+	}
+	if !strings.HasPrefix(id, urnPrefix) {
+		return "", fmt.Errorf("'%s' is not a URN like '%s<type>:<uuid>' nor a UUID", id, urnPrefix)
+	}
+	parts := strings.Split(strings.TrimPrefix(id, urnPrefix), ":")
+	if len(parts) != 2 || !urnTypeRegex.MatchString(parts[0]) || !uuidRegex.MatchString(parts[1]) {
+		return "", fmt.Errorf("'%s' is not a URN like '%s<type>:<uuid>'", id, urnPrefix)
+	}
+	return strings.ToLower(parts[1]), nil
+}
+
+// buildUrn returns the URN of the given entity type with the given UUID. The UUID can also be a URN, in which case its
+// UUID is used, so IDs of the same entity can be converted from either form
+func buildUrn(entityType, id string) (string, error) {
+	if !urnTypeRegex.MatchString(entityType) {
+		return "", fmt.Errorf("'%s' is not a valid URN entity type, like 'org' or 'supervisor'", entityType)
+	}
+	uuid, err := urnId(id)
+	if err != nil {
+		return "", err
+	}
+	return urnPrefix + entityType + ":" + uuid, nil
+}
@@ -0,0 +1,55 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var _ function.Function = &UrnIdFunction{}
+
+// UrnIdFunction extracts the bare UUID of a VCFA URN
+type UrnIdFunction struct{}
+
+func NewUrnIdFunction() function.Function {
+	return &UrnIdFunction{}
+}
+
+func (f *UrnIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "urn_id"
+}
+
+func (f *UrnIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Extracts the UUID of a URN",
+		MarkdownDescription: "Returns the bare UUID of a VCFA URN, like `urn:vcloud:org:<uuid>`. " +
+			"A bare UUID is returned as is, so the function accepts IDs in either form.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "URN or UUID of a VCFA entity",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *UrnIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id))
+	if resp.Error != nil {
+		return
+	}
+
+	uuid, err := urnId(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, uuid))
+}
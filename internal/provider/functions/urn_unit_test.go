@@ -0,0 +1,84 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testUuid = "6127c856-7315-46b8-b774-f2b8f1686c80"
+
+func TestUrnIdFunction(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{name: "Urn", id: "urn:vcloud:org:" + testUuid, want: testUuid},
+		{name: "Uuid", id: testUuid, want: testUuid},
+		{name: "UpperCase", id: "urn:vcloud:org:6127C856-7315-46B8-B774-F2B8F1686C80", want: testUuid},
+		{name: "Empty", id: "", wantErr: true},
+		{name: "NotVcloud", id: "urn:other:org:" + testUuid, wantErr: true},
+		{name: "NoType", id: "urn:vcloud:" + testUuid, wantErr: true},
+		{name: "InvalidUuid", id: "urn:vcloud:org:not-a-uuid", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runStringFunction(NewUrnIdFunction(), types.StringValue(tt.id))
+			checkStringFunctionResult(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestBuildUrnFunction(t *testing.T) {
+	tests := []struct {
+		name       string
+		entityType string
+		id         string
+		want       string
+		wantErr    bool
+	}{
+		{name: "Uuid", entityType: "org", id: testUuid, want: "urn:vcloud:org:" + testUuid},
+		{name: "Urn", entityType: "org", id: "urn:vcloud:org:" + testUuid, want: "urn:vcloud:org:" + testUuid},
+		{name: "OtherType", entityType: "supervisor", id: "urn:vcloud:org:" + testUuid, want: "urn:vcloud:supervisor:" + testUuid},
+		{name: "EmptyType", entityType: "", id: testUuid, wantErr: true},
+		{name: "InvalidType", entityType: "org:x", id: testUuid, wantErr: true},
+		{name: "InvalidUuid", entityType: "org", id: "12345", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := runStringFunction(NewBuildUrnFunction(), types.StringValue(tt.entityType), types.StringValue(tt.id))
+			checkStringFunctionResult(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// runStringFunction runs a function that returns a string with the given arguments
+func runStringFunction(f function.Function, arguments ...attr.Value) (string, *function.FuncError) {
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+	f.Run(context.Background(), function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func checkStringFunctionResult(t *testing.T, got string, err *function.FuncError, want string, wantErr bool) {
+	t.Helper()
+	if (err != nil) != wantErr {
+		t.Fatalf("error = %v, wantErr %t", err, wantErr)
+	}
+	if got != want {
+		t.Errorf("got '%s', want '%s'", got, want)
+	}
+}
@@ -5,6 +5,7 @@
 package helpers
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/vmware/terraform-provider-vcfa/vcfa"
@@ -23,3 +24,45 @@ func GetTmClientFromProviderData(providerData any) (*vcfa.VCDClient, error) {
 	}
 	return container.GetTMClient(), nil
 }
+
+// IsDryRunFromProviderData returns whether the provider is configured with 'dry_run'.
+// It is designed to be called from a resource's Configure method.
+func IsDryRunFromProviderData(providerData any) bool {
+	sdkv2Meta, ok := providerData.(func() any)
+	if !ok {
+		return false
+	}
+	container, ok := sdkv2Meta().(vcfa.ClientContainer)
+	return ok && container.IsDryRun()
+}
+
+// IsReadOnlyFromProviderData returns whether the provider is configured with 'read_only'.
+// It is designed to be called from a resource's Configure method.
+func IsReadOnlyFromProviderData(providerData any) bool {
+	sdkv2Meta, ok := providerData.(func() any)
+	if !ok {
+		return false
+	}
+	container, ok := sdkv2Meta().(vcfa.ClientContainer)
+	return ok && container.IsReadOnly()
+}
+
+// GetOperationSlotAcquirerFromProviderData returns the function that waits for a free slot of the provider
+// 'max_concurrent_operations' and returns the function that releases it.
+// It is designed to be called from a resource's Configure method.
+func GetOperationSlotAcquirerFromProviderData(providerData any) func(context.Context) (func(), error) {
+	sdkv2Meta, ok := providerData.(func() any)
+	if !ok {
+		return noOperationSlotLimit
+	}
+	container, ok := sdkv2Meta().(vcfa.ClientContainer)
+	if !ok {
+		return noOperationSlotLimit
+	}
+	return container.AcquireOperationSlot
+}
+
+// noOperationSlotLimit is used when the provider data is not available, so operations are not limited
+func noOperationSlotLimit(context.Context) (func(), error) {
+	return func() {}, nil
+}
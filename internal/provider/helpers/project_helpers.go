@@ -5,6 +5,7 @@
 package helpers
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/vmware/go-vcloud-director/v3/ccitypes"
@@ -13,8 +14,9 @@ import (
 	"github.com/vmware/terraform-provider-vcfa/vcfa"
 )
 
-func GetProject(tmClient *vcfa.VCDClient, projectName string) (ccitypes.Project, error) {
+func GetProject(ctx context.Context, tmClient *vcfa.VCDClient, projectName string) (ccitypes.Project, error) {
 	var project ccitypes.Project
+	tmClient = tmClient.WithContext(ctx)
 
 	projectURL, err := tmClient.VCDClient.Client.GetEntityUrl(fmt.Sprintf("%s/%s", ccitypes.ProjectsURL, projectName))
 	if err != nil {
@@ -0,0 +1,79 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+// mintedSessionTokenKey is the key of the private data of ephemeral resources that holds their minted session token
+const mintedSessionTokenKey = "minted_session_token"
+
+// mintedSessionTokenData is the private data that ephemeral resources keep between Open and Close, so the minted
+// session token can be revoked
+type mintedSessionTokenData struct {
+	Token      string `json:"token"`
+	ApiTokenId string `json:"api_token_id"`
+}
+
+// privateDataSetter is the private data of the response of an ephemeral resource Open
+type privateDataSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// privateDataGetter is the private data of the request of an ephemeral resource Close
+type privateDataGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// MintSessionToken mints a new session token for an ephemeral resource, and keeps it in its private data so
+// RevokeSessionToken can revoke it when the ephemeral resource is closed
+func MintSessionToken(ctx context.Context, tmClient *vcfa.VCDClient, typeName string, private privateDataSetter) (*vcfa.MintedSessionToken, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	tokenName := fmt.Sprintf("terraform-%s-%d", typeName, time.Now().UnixNano())
+	sessionToken, err := vcfa.MintSessionToken(ctx, tmClient, tokenName)
+	if err != nil {
+		diags.AddError("error minting session token", err.Error())
+		return nil, diags
+	}
+
+	data, err := json.Marshal(mintedSessionTokenData{Token: sessionToken.Token, ApiTokenId: sessionToken.ApiTokenId})
+	if err == nil {
+		diags.Append(private.SetKey(ctx, mintedSessionTokenKey, data)...)
+	} else {
+		diags.AddError("error saving minted session token", err.Error())
+	}
+	if diags.HasError() {
+		if err := vcfa.RevokeSessionToken(ctx, tmClient, sessionToken.Token, sessionToken.ApiTokenId); err != nil {
+			diags.AddError("error revoking session token", err.Error())
+		}
+		return nil, diags
+	}
+	return sessionToken, diags
+}
+
+// RevokeSessionToken revokes the session token minted by MintSessionToken for an ephemeral resource
+func RevokeSessionToken(ctx context.Context, tmClient *vcfa.VCDClient, private privateDataGetter) diag.Diagnostics {
+	rawData, diags := private.GetKey(ctx, mintedSessionTokenKey)
+	if diags.HasError() || rawData == nil {
+		return diags
+	}
+	var data mintedSessionTokenData
+	if err := json.Unmarshal(rawData, &data); err != nil {
+		diags.AddError("error reading minted session token", err.Error())
+		return diags
+	}
+	if err := vcfa.RevokeSessionToken(ctx, tmClient, data.Token, data.ApiTokenId); err != nil {
+		diags.AddError("error revoking session token", err.Error())
+	}
+	return diags
+}
@@ -5,6 +5,7 @@
 package helpers
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strings"
@@ -15,8 +16,9 @@ import (
 	"github.com/vmware/terraform-provider-vcfa/vcfa"
 )
 
-func GetSupervisorNamespaceEndpointURL(tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (string, error) {
-	if _, err := GetProject(tmClient, projectName); err != nil {
+func GetSupervisorNamespaceEndpointURL(ctx context.Context, tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (string, error) {
+	tmClient = tmClient.WithContext(ctx)
+	if _, err := GetProject(ctx, tmClient, projectName); err != nil {
 		return "", fmt.Errorf("error getting project %s: %s", projectName, err)
 	}
 
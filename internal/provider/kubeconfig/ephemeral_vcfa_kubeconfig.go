@@ -0,0 +1,89 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = (*vcfaKubeconfigEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*vcfaKubeconfigEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithClose     = (*vcfaKubeconfigEphemeralResource)(nil)
+)
+
+type vcfaKubeconfigEphemeralResource struct {
+	tmClient *vcfa.VCDClient
+	// changesAllowed is false when the provider is configured with 'read_only' or 'dry_run', which don't allow
+	// minting tokens
+	changesAllowed bool
+}
+
+func NewVcfaKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &vcfaKubeconfigEphemeralResource{}
+}
+
+func (e *vcfaKubeconfigEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubeconfig"
+}
+
+func (e *vcfaKubeconfigEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting TM client", err.Error())
+		return
+	}
+	e.tmClient = tmClient
+	e.changesAllowed = !helpers.IsReadOnlyFromProviderData(req.ProviderData) && !helpers.IsDryRunFromProviderData(req.ProviderData)
+}
+
+func (e *vcfaKubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data vcfaKubeconfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !e.changesAllowed {
+		resp.Diagnostics.AddError("error building kubeconfig", "a new session can't be opened for its token when the "+
+			"provider is configured with 'read_only' or 'dry_run'")
+		return
+	}
+	sessionToken, diags := helpers.MintSessionToken(ctx, e.tmClient, "kubeconfig", resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeconfig, err := vcfa.BuildKubeconfig(ctx, e.tmClient, &sessionToken.SessionToken, data.ProjectName.ValueString(), data.SupervisorNamespaceName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error building kubeconfig", err.Error())
+		resp.Diagnostics.Append(helpers.RevokeSessionToken(ctx, e.tmClient, resp.Private)...)
+		return
+	}
+
+	data.Host = types.StringValue(kubeconfig.Host)
+	data.InsecureSkipTLSVerify = types.BoolValue(kubeconfig.InsecureSkipTLSVerify)
+	data.Token = types.StringValue(kubeconfig.Token)
+	data.User = types.StringValue(kubeconfig.User)
+	data.ContextName = types.StringValue(kubeconfig.ContextName)
+	data.KubeConfigRaw = types.StringValue(kubeconfig.Raw)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *vcfaKubeconfigEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	resp.Diagnostics.Append(helpers.RevokeSessionToken(ctx, e.tmClient, req.Private)...)
+}
@@ -0,0 +1,21 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vcfaKubeconfigModel struct {
+	ProjectName             types.String `tfsdk:"project_name"`
+	SupervisorNamespaceName types.String `tfsdk:"supervisor_namespace_name"`
+
+	Host                  types.String `tfsdk:"host"`
+	InsecureSkipTLSVerify types.Bool   `tfsdk:"insecure_skip_tls_verify"`
+	Token                 types.String `tfsdk:"token"`
+	User                  types.String `tfsdk:"user"`
+	ContextName           types.String `tfsdk:"context_name"`
+	KubeConfigRaw         types.String `tfsdk:"kube_config_raw"`
+}
@@ -0,0 +1,63 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+func (e *vcfaKubeconfigEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Ephemeral resource that provides a kubeconfig for the CCI Kubernetes endpoint or a Supervisor Namespace, without storing it in the state",
+		Attributes: map[string]schema.Attribute{
+			"project_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Project where the Supervisor Namespace belongs to",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("supervisor_namespace_name")),
+				},
+			},
+			"supervisor_namespace_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the Supervisor Namespace to generate the kubeconfig for",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("project_name")),
+				},
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Hostname of the Kubernetes cluster",
+			},
+			"insecure_skip_tls_verify": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether to skip TLS verification when connecting to the Kubernetes cluster",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token for authentication to the Kubernetes cluster, of a new session that is revoked when Terraform closes the ephemeral resource",
+			},
+			"user": schema.StringAttribute{
+				Computed:    true,
+				Description: "Bearer token username",
+			},
+			"context_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the generated context",
+			},
+			"kube_config_raw": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Raw kubeconfig",
+			},
+		},
+	}
+}
@@ -40,14 +40,16 @@ type Client struct {
 	warnings      *warningCollector
 }
 
-func NewClient(tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (*Client, error) {
-	restConfig, err := getKubernetesRestConfig(tmClient, projectName, supervisorNamespaceName)
+func NewClient(ctx context.Context, tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (*Client, error) {
+	restConfig, err := getKubernetesRestConfig(ctx, tmClient, projectName, supervisorNamespaceName)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Kubernetes rest config: %w", err)
 	}
 
-	restConfig.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
-		return &kubernetesloggingRoundTripper{wrapped: rt}
+	if vcfa.IsKubernetesApiLoggingEnabled() {
+		restConfig.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+			return &kubernetesloggingRoundTripper{wrapped: rt}
+		}
 	}
 
 	warnCollector := &warningCollector{}
@@ -247,12 +249,12 @@ func (k *Client) ReadSecret(ctx context.Context, namespace string, name string)
 	return secret, nil
 }
 
-func getKubernetesRestConfig(tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (*rest.Config, error) {
+func getKubernetesRestConfig(ctx context.Context, tmClient *vcfa.VCDClient, projectName string, supervisorNamespaceName string) (*rest.Config, error) {
 	// Get Supervisor Namespace URL
 	clusterName := fmt.Sprintf("%s:%s@%s", tmClient.Org, supervisorNamespaceName, tmClient.Client.VCDHREF.Host)
 	contextName := fmt.Sprintf("%s:%s:%s", tmClient.Org, supervisorNamespaceName, projectName)
 
-	supervisorNamespaceEndpointURL, err := helpers.GetSupervisorNamespaceEndpointURL(tmClient, projectName, supervisorNamespaceName)
+	supervisorNamespaceEndpointURL, err := helpers.GetSupervisorNamespaceEndpointURL(ctx, tmClient, projectName, supervisorNamespaceName)
 	if err != nil {
 		return nil, err
 	}
@@ -305,6 +307,11 @@ func getKubernetesRestConfig(tmClient *vcfa.VCDClient, projectName string, super
 		CurrentContext: contextName,
 	}
 
+	// Kubernetes clients reject a CA together with the insecure flag
+	if tmClient.CaCertificate != "" && !tmClient.InsecureFlag {
+		kubeconfig.Clusters[0].Cluster.CertificateAuthorityData = []byte(tmClient.CaCertificate)
+	}
+
 	// Convert to rest.Config using clientcmd API
 	configBytes, err := json.Marshal(kubeconfig)
 	if err != nil {
@@ -315,6 +322,7 @@ func getKubernetesRestConfig(tmClient *vcfa.VCDClient, projectName string, super
 	if err != nil {
 		return nil, fmt.Errorf("error building rest config: %w", err)
 	}
+	restConfig.Proxy = tmClient.Proxy
 
 	return restConfig, nil
 }
@@ -9,11 +9,17 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/functions"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/kubeconfig"
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/sessiontoken"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/vkscluster"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/vksclusterclass"
 	"github.com/vmware/terraform-provider-vcfa/internal/provider/vksclusterkubeconfig"
@@ -22,7 +28,9 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces
 var (
-	_ provider.Provider = &VcfaFrameworkProvider{}
+	_ provider.Provider                       = &VcfaFrameworkProvider{}
+	_ provider.ProviderWithFunctions          = &VcfaFrameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &VcfaFrameworkProvider{}
 )
 
 type VcfaFrameworkProvider struct {
@@ -82,6 +90,10 @@ func (p *VcfaFrameworkProvider) Schema(_ context.Context, _ provider.SchemaReque
 				Optional:    true,
 				Description: "Set this to true if you understand the security risks of using Service Account token files and would like to suppress the warnings",
 			},
+			"token_cache_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, the session token is cached in this file and reused by next Terraform runs while it is valid, instead of logging in every time",
+			},
 			"sysorg": schema.StringAttribute{
 				Optional:    true,
 				Description: "The VCFA Org for user authentication",
@@ -98,6 +110,23 @@ func (p *VcfaFrameworkProvider) Schema(_ context.Context, _ provider.SchemaReque
 				Optional:    true,
 				Description: "If set, VCFAClient will permit unverifiable SSL certificates.",
 			},
+			"allow_insecure": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Host names (or 'host:port' pairs) of the endpoints for which unverifiable SSL certificates are permitted, such as the VCFA host or content library download servers",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM bundle of additional CA certificates to trust, for endpoints signed by an internal CA",
+			},
+			"http_proxy": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy for HTTP requests. If not set, the HTTP_PROXY environment variable is used",
+			},
+			"https_proxy": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy for HTTPS requests. If not set, the HTTPS_PROXY environment variable is used",
+			},
 			"logging": schema.BoolAttribute{
 				Optional:    true,
 				Description: "If set, it will enable logging of API requests and responses",
@@ -110,6 +139,71 @@ func (p *VcfaFrameworkProvider) Schema(_ context.Context, _ provider.SchemaReque
 				Optional:    true,
 				Description: "Defines the import separation string to be used with 'terraform import'",
 			},
+			"default_operation_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Defines a minimum timeout (e.g. '45m') for long running operations of all resources, that extends the ones of their 'timeouts' blocks",
+			},
+			"deletion_grace_period": schema.StringAttribute{
+				Optional:    true,
+				Description: "Defines how long (e.g. '10m') deletions wait for a regular deletion to succeed before forcing it or re-issuing it",
+			},
+			"max_clock_skew": schema.StringAttribute{
+				Optional:    true,
+				Description: "Defines the tolerated clock difference (e.g. '2m') between this machine and VCFA, which is checked when the provider is configured. Defaults to '5m', and '0' disables the check",
+			},
+			"probe_endpoints": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set, the API version and CCI endpoints are probed before authenticating, reporting reachability and version problems in a single diagnostic",
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set, changes to resources that support it are sent as server-side dry runs, which are validated but not persisted, and any other resource refuses to apply changes",
+			},
+			"org_scoped_sessions": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set, when the provider is logged in to the System Organization, the tenant resources like Supervisor Namespaces and VPCs are managed as the tenant of 'org', unless they set their own 'org'",
+			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set, any create, update or delete operation is refused, so the configuration can only be used to plan and refresh",
+			},
+			"metrics_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "If set, the operation durations, retry counts and upload throughput of the provider are written to this file in Prometheus text format, which is rewritten after every operation",
+			},
+			"site_name": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the VCFA site of this provider configuration (e.g. 'primary' or 'dr'), exported by the 'vcfa_site' data source",
+			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of resource and data source operations that the provider runs at the same time, regardless of the Terraform parallelism. Defaults to 0, which means no limit",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"api_logging": schema.ListNestedBlock{
+				Description: "Settings of the logging of API requests and responses. They take precedence over 'logging' and 'logging_file'",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled": schema.BoolAttribute{
+							Optional:    true,
+							Description: "If set, API requests and responses are logged",
+						},
+						"file": schema.StringAttribute{
+							Optional:    true,
+							Description: "Full name of the logging file for API calls. Defaults to 'logging_file'",
+						},
+						"redact_secrets": schema.BoolAttribute{
+							Optional:    true,
+							Description: "If set, passwords, tokens and Authorization headers are masked in the log",
+						},
+						"include_kubernetes_calls": schema.BoolAttribute{
+							Optional:    true,
+							Description: "If set, the CCI Kubernetes API calls are logged too",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -118,6 +212,7 @@ func (p *VcfaFrameworkProvider) Configure(_ context.Context, req provider.Config
 	// Re-use the SDKv2 configuration until all datasources and resources have been migrated to the framework provider
 	resp.ResourceData = p.SDKv2Meta
 	resp.DataSourceData = p.SDKv2Meta
+	resp.EphemeralResourceData = p.SDKv2Meta
 }
 
 // Resources returns the list of framework-based resources.
@@ -136,3 +231,19 @@ func (p *VcfaFrameworkProvider) DataSources(_ context.Context) []func() datasour
 		vksclusterkubeconfig.NewVcfaVksClusterKubeconfigDataSource,
 	}
 }
+
+// EphemeralResources returns the list of framework-based ephemeral resources.
+func (p *VcfaFrameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		sessiontoken.NewVcfaSessionTokenEphemeralResource,
+		kubeconfig.NewVcfaKubeconfigEphemeralResource,
+	}
+}
+
+// Functions returns the list of provider-defined functions.
+func (p *VcfaFrameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewUrnIdFunction,
+		functions.NewBuildUrnFunction,
+	}
+}
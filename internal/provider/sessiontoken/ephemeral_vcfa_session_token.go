@@ -0,0 +1,87 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessiontoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/provider/helpers"
+	"github.com/vmware/terraform-provider-vcfa/vcfa"
+)
+
+const labelSessionToken = "Session Token"
+
+var (
+	_ ephemeral.EphemeralResource              = (*vcfaSessionTokenEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*vcfaSessionTokenEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithClose     = (*vcfaSessionTokenEphemeralResource)(nil)
+)
+
+type vcfaSessionTokenEphemeralResource struct {
+	tmClient *vcfa.VCDClient
+	// changesAllowed is false when the provider is configured with 'read_only' or 'dry_run', which don't allow
+	// minting tokens
+	changesAllowed bool
+}
+
+func NewVcfaSessionTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &vcfaSessionTokenEphemeralResource{}
+}
+
+func (e *vcfaSessionTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_token"
+}
+
+func (e *vcfaSessionTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	tmClient, err := helpers.GetTmClientFromProviderData(req.ProviderData)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting TM client", err.Error())
+		return
+	}
+	e.tmClient = tmClient
+	e.changesAllowed = !helpers.IsReadOnlyFromProviderData(req.ProviderData) && !helpers.IsDryRunFromProviderData(req.ProviderData)
+}
+
+func (e *vcfaSessionTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data vcfaSessionTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !e.changesAllowed {
+		resp.Diagnostics.AddError("error minting "+labelSessionToken, "a new session can't be opened when the provider "+
+			"is configured with 'read_only' or 'dry_run'")
+		return
+	}
+	sessionToken, diags := helpers.MintSessionToken(ctx, e.tmClient, "session-token", resp.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Token = types.StringValue(sessionToken.Token)
+	data.User = types.StringValue(sessionToken.Username)
+	data.Org = types.StringValue(e.tmClient.Org)
+	data.Url = types.StringValue(e.tmClient.Client.VCDHREF.String())
+	data.ExpiresAt = types.StringValue("")
+	if !sessionToken.ExpiresAt.IsZero() {
+		data.ExpiresAt = types.StringValue(sessionToken.ExpiresAt.UTC().Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (e *vcfaSessionTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	resp.Diagnostics.Append(helpers.RevokeSessionToken(ctx, e.tmClient, req.Private)...)
+}
@@ -0,0 +1,17 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessiontoken
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type vcfaSessionTokenModel struct {
+	Token     types.String `tfsdk:"token"`
+	User      types.String `tfsdk:"user"`
+	Org       types.String `tfsdk:"org"`
+	Url       types.String `tfsdk:"url"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
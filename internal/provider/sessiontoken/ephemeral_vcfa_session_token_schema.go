@@ -0,0 +1,42 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package sessiontoken
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+)
+
+func (e *vcfaSessionTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Ephemeral resource that mints a %s of a new session of the provider user, without storing it in the state", labelSessionToken),
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token of a new session of the provider user, which is revoked when Terraform closes the ephemeral resource",
+			},
+			"user": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the user of the session, the same as the provider one",
+			},
+			"org": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the Organization of the session",
+			},
+			"url": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the VCFA API that accepts the token",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "Expiration time of the token, in RFC 3339 format. Empty if the token does not expire",
+			},
+		},
+	}
+}
@@ -64,7 +64,7 @@ func (d *vcfaVksClusterDataSource) Read(ctx context.Context, req datasource.Read
 	namespace := vcfContext.Namespace.ValueString()
 	name := data.Name.ValueString()
 
-	kubernetesClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	kubernetesClient, err := kubernetes.NewClient(ctx, d.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVksCluster, name),
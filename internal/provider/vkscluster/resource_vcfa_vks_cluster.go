@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -49,6 +48,12 @@ var (
 
 type vcfaVksClusterResource struct {
 	tmClient *vcfa.VCDClient
+	// dryRun sends the changes as server-side dry runs, when the provider is configured with 'dry_run'
+	dryRun bool
+	// readOnly refuses any change, when the provider is configured with 'read_only'
+	readOnly bool
+	// acquireOperationSlot waits for a free slot, when the provider is configured with 'max_concurrent_operations'
+	acquireOperationSlot func(context.Context) (func(), error)
 }
 
 func NewVcfaVksClusterResource() resource.Resource {
@@ -70,9 +75,23 @@ func (r *vcfaVksClusterResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 	r.tmClient = tmClient
+	r.dryRun = helpers.IsDryRunFromProviderData(req.ProviderData)
+	r.readOnly = helpers.IsReadOnlyFromProviderData(req.ProviderData)
+	r.acquireOperationSlot = helpers.GetOperationSlotAcquirerFromProviderData(req.ProviderData)
 }
 
 func (r *vcfaVksClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.readOnly {
+		addReadOnlyError(&resp.Diagnostics, "created")
+		return
+	}
+
+	release, ok := r.waitForOperationSlot(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+
 	var plan vcfaVksClusterResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -100,7 +119,7 @@ func (r *vcfaVksClusterResource) Create(ctx context.Context, req resource.Create
 	namespace := vcfContext.Namespace.ValueString()
 	name := plan.Name.ValueString()
 
-	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	k8sClient, err := kubernetes.NewClient(ctx, r.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error creating %s %s", vcfatypes.LabelVksCluster, name),
@@ -116,13 +135,17 @@ func (r *vcfaVksClusterResource) Create(ctx context.Context, req resource.Create
 	}
 
 	var created vcfatypes.VksCluster
-	if err := k8sClient.CreateNamespaceScopedResource(ctx, vcfatypes.GetVksClusterGVR(), namespace, clusterObj, &created, false); err != nil {
+	if err := k8sClient.CreateNamespaceScopedResource(ctx, vcfatypes.GetVksClusterGVR(), namespace, clusterObj, &created, r.dryRun); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error creating %s %s", vcfatypes.LabelVksCluster, name),
 			fmt.Sprintf("could not create %s %s in VCF context %s/%s: %s", vcfatypes.LabelVksCluster, name, project, namespace, err.Error()),
 		)
 		return
 	}
+	if r.dryRun {
+		addDryRunError(&resp.Diagnostics, name, "created")
+		return
+	}
 
 	plan.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", project, namespace, name))
 
@@ -140,6 +163,12 @@ func (r *vcfaVksClusterResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *vcfaVksClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	release, ok := r.waitForOperationSlot(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+
 	var state vcfaVksClusterResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -155,7 +184,7 @@ func (r *vcfaVksClusterResource) Read(ctx context.Context, req resource.ReadRequ
 	namespace := vcfContext.Namespace.ValueString()
 	name := state.Name.ValueString()
 
-	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	k8sClient, err := kubernetes.NewClient(ctx, r.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVksCluster, name),
@@ -196,6 +225,17 @@ func (r *vcfaVksClusterResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.readOnly {
+		addReadOnlyError(&resp.Diagnostics, "updated")
+		return
+	}
+
+	release, ok := r.waitForOperationSlot(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+
 	var state vcfaVksClusterResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -229,7 +269,7 @@ func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.Update
 	namespace := vcfContext.Namespace.ValueString()
 	name := plan.Name.ValueString()
 
-	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	k8sClient, err := kubernetes.NewClient(ctx, r.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error updating %s %s", vcfatypes.LabelVksCluster, name),
@@ -291,7 +331,7 @@ func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.Update
 			}
 
 			// Apply the patch
-			patchErr = k8sClient.PatchNamespaceScopedResource(ctx, vcfatypes.GetVksClusterGVR(), namespace, name, k8stypes.MergePatchType, finalPatch, &updatedCluster, false)
+			patchErr = k8sClient.PatchNamespaceScopedResource(ctx, vcfatypes.GetVksClusterGVR(), namespace, name, k8stypes.MergePatchType, finalPatch, &updatedCluster, r.dryRun)
 			if patchErr == nil || !apierrors.IsConflict(patchErr) {
 				break
 			}
@@ -316,6 +356,10 @@ func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.Update
 			)
 			return
 		}
+		if r.dryRun {
+			addDryRunError(&resp.Diagnostics, name, "updated")
+			return
+		}
 
 		// Capture planned values before mapping overwrites plan with the API response.
 		// The provider stores what the user supplied so the post-apply state matches
@@ -399,6 +443,17 @@ func (r *vcfaVksClusterResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.readOnly {
+		addReadOnlyError(&resp.Diagnostics, "deleted")
+		return
+	}
+
+	release, ok := r.waitForOperationSlot(ctx, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	defer release()
+
 	var state vcfaVksClusterResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
@@ -426,7 +481,7 @@ func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.Delete
 	namespace := vcfContext.Namespace.ValueString()
 	name := state.Name.ValueString()
 
-	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	k8sClient, err := kubernetes.NewClient(ctx, r.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error deleting %s %s", vcfatypes.LabelVksCluster, name),
@@ -436,7 +491,7 @@ func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.Delete
 	}
 	defer func() { resp.Diagnostics.Append(k8sClient.FlushWarnings()...) }()
 
-	if err := k8sClient.DeleteNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVksClusterGVR(), false); err != nil {
+	if err := k8sClient.DeleteNamespaceScopedResource(ctx, namespace, name, vcfatypes.GetVksClusterGVR(), r.dryRun); err != nil {
 		if apierrors.IsNotFound(err) {
 			return
 		}
@@ -446,6 +501,10 @@ func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.Delete
 		)
 		return
 	}
+	if r.dryRun {
+		addDryRunError(&resp.Diagnostics, name, "deleted")
+		return
+	}
 
 	if waitDeleted {
 		if err := r.waitForClusterDeleted(ctx, k8sClient, project, namespace, name, deleteTimeout); err != nil {
@@ -458,7 +517,7 @@ func (r *vcfaVksClusterResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *vcfaVksClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, vcfa.ImportSeparator, 4)
+	parts := vcfa.SplitImportId(req.ID, 4)
 	if len(parts) != 3 {
 		resp.Diagnostics.AddError(
 			"invalid import ID format",
@@ -537,7 +596,7 @@ func (r *vcfaVksClusterResource) ModifyPlan(ctx context.Context, req resource.Mo
 	namespace := vcfContext.Namespace.ValueString()
 	name := plan.Name.ValueString()
 
-	k8sClient, err := kubernetes.NewClient(r.tmClient, project, namespace)
+	k8sClient, err := kubernetes.NewClient(ctx, r.tmClient, project, namespace)
 	if err != nil {
 		// Report as a warning so that a transient connectivity issue does not fail the plan.
 		resp.Diagnostics.AddWarning(
@@ -832,3 +891,34 @@ func (r *vcfaVksClusterResource) waitForClusterDeleted(ctx context.Context, k8sC
 
 	return nil
 }
+
+// waitForOperationSlot waits until the provider runs fewer operations than 'max_concurrent_operations'. It returns
+// the function that releases the slot, or false after adding an error if the wait was cancelled
+func (r *vcfaVksClusterResource) waitForOperationSlot(ctx context.Context, diags *diag.Diagnostics) (func(), bool) {
+	if r.acquireOperationSlot == nil {
+		return func() {}, true
+	}
+	release, err := r.acquireOperationSlot(ctx)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("error waiting to operate %s", vcfatypes.LabelVksCluster), err.Error())
+		return nil, false
+	}
+	return release, true
+}
+
+// addReadOnlyError reports that a change was refused because the provider is configured with 'read_only'
+func addReadOnlyError(diags *diag.Diagnostics, operation string) {
+	diags.AddError(
+		fmt.Sprintf("[read only] %s was not %s", vcfatypes.LabelVksCluster, operation),
+		"The provider is configured with 'read_only', so no resource can be created, updated or deleted. Remove 'read_only' from the provider configuration to apply the changes.",
+	)
+}
+
+// addDryRunError reports that a dry run succeeded. It is an error, so Terraform doesn't change the state for an
+// operation that was never persisted
+func addDryRunError(diags *diag.Diagnostics, name, operation string) {
+	diags.AddError(
+		fmt.Sprintf("[dry run] %s %s passed the server-side validation and was not %s", vcfatypes.LabelVksCluster, name, operation),
+		"The provider is configured with 'dry_run', so changes are only validated by VCFA. Remove 'dry_run' from the provider configuration to apply them.",
+	)
+}
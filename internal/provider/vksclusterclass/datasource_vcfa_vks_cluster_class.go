@@ -65,7 +65,7 @@ func (d *vcfaVksClusterClassDataSource) Read(ctx context.Context, req datasource
 	name := data.Name.ValueString()
 	system := data.System.ValueBool()
 
-	kubernetesClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	kubernetesClient, err := kubernetes.NewClient(ctx, d.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVksClusterClass, name),
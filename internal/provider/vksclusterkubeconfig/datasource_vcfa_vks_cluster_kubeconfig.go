@@ -64,7 +64,7 @@ func (d *vcfaVksClusterKubeconfigDataSource) Read(ctx context.Context, req datas
 	namespace := vcfContext.Namespace.ValueString()
 	clusterName := data.Name.ValueString()
 
-	kubernetesClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	kubernetesClient, err := kubernetes.NewClient(ctx, d.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error reading %s for %s %s", vcfatypes.LabelVksClusterKubeconfig, vcfatypes.LabelVksCluster, clusterName),
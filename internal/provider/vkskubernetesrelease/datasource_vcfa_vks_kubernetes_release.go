@@ -64,7 +64,7 @@ func (d *vcfaVksKubernetesReleaseDataSource) Read(ctx context.Context, req datas
 	namespace := vcfContext.Namespace.ValueString()
 	name := data.Name.ValueString()
 
-	k8sClient, err := kubernetes.NewClient(d.tmClient, project, namespace)
+	k8sClient, err := kubernetes.NewClient(ctx, d.tmClient, project, namespace)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("error reading %s %s", vcfatypes.LabelVksKubernetesRelease, name),
@@ -66,6 +66,25 @@ func (c *TestCachedFieldValue) TestCheckCachedResourceFieldValue(res, field stri
 	}
 }
 
+// TestCheckCachedResourceFieldValueChanged verifies that the current field value is different from the
+// previously cached value, for example when a resource is replaced.
+func (c *TestCachedFieldValue) TestCheckCachedResourceFieldValueChanged(res, field string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[res]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", res)
+		}
+		value, exists := rs.Primary.Attributes[field]
+		if !exists {
+			return fmt.Errorf("field %s in resource %s does not exist", field, res)
+		}
+		if value == c.fieldValue {
+			return fmt.Errorf("got '%s - %s' field value %s, expected a different value", res, field, value)
+		}
+		return nil
+	}
+}
+
 // TestCheckCachedResourceFieldValuePattern verifies that the current field value equals the
 // pattern formatted with the previously cached value.
 func (c *TestCachedFieldValue) TestCheckCachedResourceFieldValuePattern(res, field, pattern string) resource.TestCheckFunc {
@@ -52,6 +52,7 @@ type TestConfig struct {
 		Region           string `json:"region"`
 		Vpc              string `json:"vpc"`
 		StoragePolicy    string `json:"storagePolicy"`
+		StoragePolicy2   string `json:"storagePolicy2"`
 		SupervisorZone   string `json:"supervisorZone"`
 		ContentLibrary   string `json:"contentLibrary"`
 		InfraPolicyName  string `json:"infraPolicyName"`
@@ -97,8 +98,11 @@ type TestConfig struct {
 		VcenterDatacenter     string `json:"vcenterDatacenter"`
 		VcenterDatastore      string `json:"vcenterDatastore"`
 		VcenterStorageProfile string `json:"vcenterStorageProfile"`
-		VcenterSupervisor     string `json:"vcenterSupervisor"`
-		VcenterSupervisorZone string `json:"vcenterSupervisorZone"`
+		// VcenterStorageProfile2 is an additional storage policy that is not in the Region, used to test
+		// vcfa_region_storage_policy
+		VcenterStorageProfile2 string `json:"vcenterStorageProfile2,omitempty"`
+		VcenterSupervisor      string `json:"vcenterSupervisor"`
+		VcenterSupervisorZone  string `json:"vcenterSupervisorZone"`
 
 		OidcServer struct {
 			Url               string `json:"url,omitempty"`
@@ -120,6 +124,7 @@ type TestConfig struct {
 		Password              string `json:"password"`
 		BaseDistinguishedName string `json:"baseDistinguishedName"`
 		Type                  string `json:"type"`
+		GroupName             string `json:"groupName,omitempty"` // An existing LDAP group, used to test Organization groups
 	} `json:"ldap,omitempty"`
 	Logging struct {
 		Enabled         bool   `json:"enabled,omitempty"`
@@ -0,0 +1,299 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package testutils
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+const (
+	// ovfDescriptorName is the name of the OVF descriptor of the generated fixtures
+	ovfDescriptorName = "descriptor.ovf"
+	// ovfManifestName is the name of the manifest of the generated OVA fixtures
+	ovfManifestName = "descriptor.mf"
+
+	vmdkSectorSize   = 512
+	vmdkGrainSectors = 128
+	vmdkGTEsPerGT    = 512
+	// vmdkOverheadSectors is where the metadata that follows the header and the descriptor starts
+	vmdkOverheadSectors = 128
+	// vmdkGdAtEnd tells that the grain directory offset is in the footer, as streamOptimized disks do
+	vmdkGdAtEnd = ^uint64(0)
+	// vmdkFlags are the flags of a streamOptimized disk: valid new line detection, compressed grains and markers
+	vmdkFlags = 0x30001
+
+	vmdkMarkerEndOfStream = 0
+	vmdkMarkerGrainDir    = 2
+	vmdkMarkerFooter      = 3
+)
+
+// vmdkSparseExtentHeader is the header of a sparse VMDK, as described in the Virtual Disk Format 5.0 specification
+type vmdkSparseExtentHeader struct {
+	MagicNumber        uint32
+	Version            uint32
+	Flags              uint32
+	Capacity           uint64
+	GrainSize          uint64
+	DescriptorOffset   uint64
+	DescriptorSize     uint64
+	NumGTEsPerGT       uint32
+	RgdOffset          uint64
+	GdOffset           uint64
+	OverHead           uint64
+	UncleanShutdown    uint8
+	SingleEndLineChar  byte
+	NonEndLineChar     byte
+	DoubleEndLineChar1 byte
+	DoubleEndLineChar2 byte
+	CompressAlgorithm  uint16
+	Pad                [433]byte
+}
+
+// vmdkMarker is the metadata marker of a streamOptimized VMDK
+type vmdkMarker struct {
+	Value uint64
+	Size  uint32
+	Type  uint32
+	Pad   [496]byte
+}
+
+// CreateOvaFixture generates an OVA with a single virtual machine that has one empty disk for every given size,
+// in MiB, and returns its absolute path. The disks are streamOptimized VMDKs without any data, so the OVA
+// stays a few KiB in size regardless of the disk capacities. The OVA is removed when the test finishes
+func CreateOvaFixture(t *testing.T, name string, diskSizesMiB ...int64) string {
+	t.Helper()
+	files, err := generateOvfFiles(name, diskSizesMiB)
+	if err != nil {
+		t.Fatalf("error generating OVA fixture '%s': %s", name, err)
+	}
+
+	var manifest strings.Builder
+	for _, file := range files {
+		fmt.Fprintf(&manifest, "SHA256(%s)= %x\n", file.name, sha256.Sum256(file.contents))
+	}
+	// The descriptor must be the first entry of the OVA, followed by the manifest
+	entries := append([]ovfFile{files[0], {name: ovfManifestName, contents: []byte(manifest.String())}}, files[1:]...)
+
+	var ova bytes.Buffer
+	writer := tar.NewWriter(&ova)
+	for _, entry := range entries {
+		err = writer.WriteHeader(&tar.Header{Name: entry.name, Mode: 0644, Size: int64(len(entry.contents)), Format: tar.FormatUSTAR})
+		if err == nil {
+			_, err = writer.Write(entry.contents)
+		}
+		if err != nil {
+			t.Fatalf("error writing '%s' into OVA fixture '%s': %s", entry.name, name, err)
+		}
+	}
+	if err = writer.Close(); err != nil {
+		t.Fatalf("error closing OVA fixture '%s': %s", name, err)
+	}
+
+	ovaPath := filepath.Join(t.TempDir(), name+".ova")
+	if err = os.WriteFile(ovaPath, ova.Bytes(), 0600); err != nil {
+		t.Fatalf("error saving OVA fixture '%s': %s", ovaPath, err)
+	}
+	return ovaPath
+}
+
+// CreateOvfFixture generates the same virtual machine as CreateOvaFixture, but as separate files. It returns the
+// absolute paths of the OVF descriptor, first, and of the disks. The files are removed when the test finishes
+func CreateOvfFixture(t *testing.T, name string, diskSizesMiB ...int64) []string {
+	t.Helper()
+	files, err := generateOvfFiles(name, diskSizesMiB)
+	if err != nil {
+		t.Fatalf("error generating OVF fixture '%s': %s", name, err)
+	}
+
+	directory := t.TempDir()
+	paths := make([]string, len(files))
+	for i, file := range files {
+		paths[i] = filepath.Join(directory, file.name)
+		if err = os.WriteFile(paths[i], file.contents, 0600); err != nil {
+			t.Fatalf("error saving OVF fixture file '%s': %s", paths[i], err)
+		}
+	}
+	return paths
+}
+
+// ovfFile is a file of an OVF package
+type ovfFile struct {
+	name     string
+	contents []byte
+}
+
+// ovfDisk is the information of a disk that the OVF descriptor needs
+type ovfDisk struct {
+	FileName   string
+	FileSize   int
+	SizeMiB    int64
+	InstanceID int
+}
+
+// generateOvfFiles returns the OVF descriptor followed by the disks of a virtual machine with the given disks
+func generateOvfFiles(name string, diskSizesMiB []int64) ([]ovfFile, error) {
+	if len(diskSizesMiB) == 0 {
+		return nil, fmt.Errorf("at least one disk is required")
+	}
+
+	disks := make([]ovfDisk, len(diskSizesMiB))
+	files := make([]ovfFile, len(diskSizesMiB)+1)
+	for i, sizeMiB := range diskSizesMiB {
+		if sizeMiB <= 0 {
+			return nil, fmt.Errorf("disk %d must have a positive size, got %d MiB", i+1, sizeMiB)
+		}
+		fileName := fmt.Sprintf("%s-disk%d.vmdk", name, i+1)
+		contents, err := generateStreamOptimizedVmdk(fileName, sizeMiB)
+		if err != nil {
+			return nil, fmt.Errorf("error generating disk %d: %s", i+1, err)
+		}
+		// The first instance IDs are taken by the CPU, the memory and the SCSI controller
+		disks[i] = ovfDisk{FileName: fileName, FileSize: len(contents), SizeMiB: sizeMiB, InstanceID: i + 4}
+		files[i+1] = ovfFile{name: fileName, contents: contents}
+	}
+
+	var descriptor bytes.Buffer
+	err := ovfDescriptorTemplate.Execute(&descriptor, map[string]interface{}{"Name": name, "Disks": disks})
+	if err != nil {
+		return nil, fmt.Errorf("error generating OVF descriptor: %s", err)
+	}
+	files[0] = ovfFile{name: ovfDescriptorName, contents: descriptor.Bytes()}
+	return files, nil
+}
+
+// generateStreamOptimizedVmdk returns a streamOptimized VMDK of the given capacity that contains no data: the
+// header, the embedded descriptor, an empty grain directory, the footer and the end-of-stream marker
+func generateStreamOptimizedVmdk(fileName string, sizeMiB int64) ([]byte, error) {
+	capacity := uint64(sizeMiB) * 1024 * 1024 / vmdkSectorSize
+	cylinders := max(capacity/(255*63), 1)
+	descriptor := fmt.Sprintf("# Disk DescriptorFile\nversion=1\nCID=fffffffe\nparentCID=ffffffff\ncreateType=\"streamOptimized\"\n\n"+
+		"# Extent description\nRDONLY %d SPARSE \"%s\"\n\n"+
+		"# The Disk Data Base\n#DDB\n\nddb.adapterType = \"lsilogic\"\nddb.geometry.cylinders = \"%d\"\n"+
+		"ddb.geometry.heads = \"255\"\nddb.geometry.sectors = \"63\"\nddb.virtualHWVersion = \"4\"\n", capacity, fileName, cylinders)
+	descriptorSectors := uint64(len(descriptor)+vmdkSectorSize-1) / vmdkSectorSize
+	if 1+descriptorSectors > vmdkOverheadSectors {
+		return nil, fmt.Errorf("the disk descriptor is too large")
+	}
+
+	// With no grain tables, every grain directory entry is zero
+	gdEntries := (capacity + vmdkGrainSectors*vmdkGTEsPerGT - 1) / (vmdkGrainSectors * vmdkGTEsPerGT)
+	gdSectors := (gdEntries*4 + vmdkSectorSize - 1) / vmdkSectorSize
+	gdOffset := uint64(vmdkOverheadSectors + 1)
+
+	header := vmdkSparseExtentHeader{
+		MagicNumber:        0x564d444b, // "KDMV"
+		Version:            3,
+		Flags:              vmdkFlags,
+		Capacity:           capacity,
+		GrainSize:          vmdkGrainSectors,
+		DescriptorOffset:   1,
+		DescriptorSize:     descriptorSectors,
+		NumGTEsPerGT:       vmdkGTEsPerGT,
+		GdOffset:           vmdkGdAtEnd,
+		OverHead:           vmdkOverheadSectors,
+		SingleEndLineChar:  '\n',
+		NonEndLineChar:     ' ',
+		DoubleEndLineChar1: '\r',
+		DoubleEndLineChar2: '\n',
+		CompressAlgorithm:  1, // Deflate
+	}
+
+	var vmdk bytes.Buffer
+	write := func(data interface{}) {
+		// Writing into a bytes.Buffer does not fail
+		_ = binary.Write(&vmdk, binary.LittleEndian, data)
+	}
+	write(header)
+	vmdk.WriteString(descriptor)
+	vmdk.Write(make([]byte, vmdkOverheadSectors*vmdkSectorSize-vmdk.Len()))
+
+	write(vmdkMarker{Value: gdSectors, Type: vmdkMarkerGrainDir})
+	vmdk.Write(make([]byte, gdSectors*vmdkSectorSize))
+
+	write(vmdkMarker{Value: 1, Type: vmdkMarkerFooter})
+	footer := header
+	footer.GdOffset = gdOffset
+	write(footer)
+
+	write(vmdkMarker{Type: vmdkMarkerEndOfStream})
+	return vmdk.Bytes(), nil
+}
+
+// ovfDescriptorTemplate is a minimal OVF descriptor of a powered off virtual machine with a SCSI controller and
+// the given disks
+var ovfDescriptorTemplate = template.Must(template.New("ovf").Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<Envelope xmlns="http://schemas.dmtf.org/ovf/envelope/1" xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1" xmlns:rasd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData" xmlns:vssd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData" xmlns:vmw="http://www.vmware.com/schema/ovf">
+  <References>
+{{- range $i, $disk := .Disks}}
+    <File ovf:id="file{{$i}}" ovf:href="{{$disk.FileName}}" ovf:size="{{$disk.FileSize}}"/>
+{{- end}}
+  </References>
+  <DiskSection>
+    <Info>Virtual disk information</Info>
+{{- range $i, $disk := .Disks}}
+    <Disk ovf:diskId="vmdisk{{$i}}" ovf:fileRef="file{{$i}}" ovf:capacity="{{$disk.SizeMiB}}" ovf:capacityAllocationUnits="byte * 2^20" ovf:format="http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"/>
+{{- end}}
+  </DiskSection>
+  <VirtualSystem ovf:id="{{.Name}}">
+    <Info>A virtual machine</Info>
+    <Name>{{.Name}}</Name>
+    <OperatingSystemSection ovf:id="101" vmw:osType="otherLinux64Guest">
+      <Info>The kind of installed guest operating system</Info>
+    </OperatingSystemSection>
+    <VirtualHardwareSection>
+      <Info>Virtual hardware requirements</Info>
+      <System>
+        <vssd:ElementName>Virtual Hardware Family</vssd:ElementName>
+        <vssd:InstanceID>0</vssd:InstanceID>
+        <vssd:VirtualSystemIdentifier>{{.Name}}</vssd:VirtualSystemIdentifier>
+        <vssd:VirtualSystemType>vmx-13</vssd:VirtualSystemType>
+      </System>
+      <Item>
+        <rasd:AllocationUnits>hertz * 10^6</rasd:AllocationUnits>
+        <rasd:Description>Number of Virtual CPUs</rasd:Description>
+        <rasd:ElementName>1 virtual CPU(s)</rasd:ElementName>
+        <rasd:InstanceID>1</rasd:InstanceID>
+        <rasd:ResourceType>3</rasd:ResourceType>
+        <rasd:VirtualQuantity>1</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:AllocationUnits>byte * 2^20</rasd:AllocationUnits>
+        <rasd:Description>Memory Size</rasd:Description>
+        <rasd:ElementName>4MB of memory</rasd:ElementName>
+        <rasd:InstanceID>2</rasd:InstanceID>
+        <rasd:ResourceType>4</rasd:ResourceType>
+        <rasd:VirtualQuantity>4</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:Address>0</rasd:Address>
+        <rasd:Description>SCSI Controller</rasd:Description>
+        <rasd:ElementName>SCSI Controller 0</rasd:ElementName>
+        <rasd:InstanceID>3</rasd:InstanceID>
+        <rasd:ResourceSubType>lsilogic</rasd:ResourceSubType>
+        <rasd:ResourceType>6</rasd:ResourceType>
+      </Item>
+{{- range $i, $disk := .Disks}}
+      <Item>
+        <rasd:AddressOnParent>{{$i}}</rasd:AddressOnParent>
+        <rasd:ElementName>Hard disk {{$i}}</rasd:ElementName>
+        <rasd:HostResource>ovf:/disk/vmdisk{{$i}}</rasd:HostResource>
+        <rasd:InstanceID>{{$disk.InstanceID}}</rasd:InstanceID>
+        <rasd:Parent>3</rasd:Parent>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+{{- end}}
+    </VirtualHardwareSection>
+  </VirtualSystem>
+</Envelope>
+`))
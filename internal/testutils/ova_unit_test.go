@@ -0,0 +1,132 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package testutils
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestCreateOvaFixture checks that the generated OVA has the descriptor first, a manifest with the checksums of
+// all the files, and one VMDK for every requested disk
+func TestCreateOvaFixture(t *testing.T) {
+	ovaPath := CreateOvaFixture(t, "fixture", 40, 2048)
+	if !filepath.IsAbs(ovaPath) {
+		t.Errorf("expected an absolute path, got '%s'", ovaPath)
+	}
+	ova, err := os.ReadFile(ovaPath) // #nosec G304 -- the file is generated by the test
+	if err != nil {
+		t.Fatalf("error reading OVA: %s", err)
+	}
+	if len(ova) > 512*1024 {
+		t.Errorf("expected a tiny OVA, got %d bytes", len(ova))
+	}
+
+	var names []string
+	contents := map[string][]byte{}
+	reader := tar.NewReader(bytes.NewReader(ova))
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("error reading OVA entry: %s", err)
+		}
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("error reading '%s': %s", header.Name, err)
+		}
+		names = append(names, header.Name)
+		contents[header.Name] = data
+	}
+
+	expectedNames := []string{"descriptor.ovf", "descriptor.mf", "fixture-disk1.vmdk", "fixture-disk2.vmdk"}
+	if strings.Join(names, ",") != strings.Join(expectedNames, ",") {
+		t.Fatalf("expected OVA entries %v, got %v", expectedNames, names)
+	}
+	for _, name := range []string{"descriptor.ovf", "fixture-disk1.vmdk", "fixture-disk2.vmdk"} {
+		checksum := fmt.Sprintf("SHA256(%s)= %x", name, sha256.Sum256(contents[name]))
+		if !strings.Contains(string(contents["descriptor.mf"]), checksum) {
+			t.Errorf("expected manifest to contain '%s', got:\n%s", checksum, contents["descriptor.mf"])
+		}
+	}
+	for _, expected := range []string{`ovf:capacity="40"`, `ovf:capacity="2048"`, `ovf:href="fixture-disk2.vmdk"`, `<rasd:InstanceID>5</rasd:InstanceID>`} {
+		if !strings.Contains(string(contents["descriptor.ovf"]), expected) {
+			t.Errorf("expected descriptor to contain '%s'", expected)
+		}
+	}
+}
+
+// TestGenerateStreamOptimizedVmdk checks the header, the footer and the end-of-stream marker of the generated disks
+func TestGenerateStreamOptimizedVmdk(t *testing.T) {
+	tests := []struct {
+		sizeMiB   int64
+		gdSectors uint64
+	}{
+		{sizeMiB: 1, gdSectors: 1},
+		{sizeMiB: 40, gdSectors: 1},
+		// 4096 grain tables of 32MiB fill 32 sectors of grain directory, and one more MiB needs another sector
+		{sizeMiB: 128 * 1024, gdSectors: 32},
+		{sizeMiB: 128*1024 + 1, gdSectors: 33},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%dMiB", tt.sizeMiB), func(t *testing.T) {
+			vmdk, err := generateStreamOptimizedVmdk("disk.vmdk", tt.sizeMiB)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			expectedLen := (vmdkOverheadSectors + 1 + tt.gdSectors + 3) * vmdkSectorSize
+			if uint64(len(vmdk)) != expectedLen {
+				t.Fatalf("expected %d bytes, got %d", expectedLen, len(vmdk))
+			}
+
+			var header, footer vmdkSparseExtentHeader
+			if err := binary.Read(bytes.NewReader(vmdk), binary.LittleEndian, &header); err != nil {
+				t.Fatalf("error reading header: %s", err)
+			}
+			if string(vmdk[:4]) != "KDMV" || header.Flags != vmdkFlags || header.GdOffset != vmdkGdAtEnd {
+				t.Errorf("unexpected header: %+v", header)
+			}
+			if expected := uint64(tt.sizeMiB) * 2048; header.Capacity != expected {
+				t.Errorf("expected capacity of %d sectors, got %d", expected, header.Capacity)
+			}
+			descriptor := string(vmdk[vmdkSectorSize : vmdkSectorSize*(1+header.DescriptorSize)])
+			if !strings.Contains(descriptor, fmt.Sprintf("RDONLY %d SPARSE \"disk.vmdk\"", header.Capacity)) {
+				t.Errorf("unexpected descriptor:\n%s", descriptor)
+			}
+
+			footerOffset := len(vmdk) - 2*vmdkSectorSize
+			if err := binary.Read(bytes.NewReader(vmdk[footerOffset:]), binary.LittleEndian, &footer); err != nil {
+				t.Fatalf("error reading footer: %s", err)
+			}
+			if footer.GdOffset != vmdkOverheadSectors+1 || footer.Capacity != header.Capacity {
+				t.Errorf("unexpected footer: %+v", footer)
+			}
+			if !bytes.Equal(vmdk[len(vmdk)-vmdkSectorSize:], make([]byte, vmdkSectorSize)) {
+				t.Errorf("expected an end-of-stream marker at the end of the disk")
+			}
+		})
+	}
+}
+
+func TestGenerateOvfFilesErrors(t *testing.T) {
+	if _, err := generateOvfFiles("fixture", nil); err == nil {
+		t.Errorf("expected an error without disks")
+	}
+	if _, err := generateOvfFiles("fixture", []int64{40, 0}); err == nil {
+		t.Errorf("expected an error with a disk without size")
+	}
+}
@@ -0,0 +1,34 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import "github.com/vmware/go-vcloud-director/v3/types/v56"
+
+// AuditTrailEndpoint is the OpenAPI endpoint that holds the events of the audit trail
+const AuditTrailEndpoint = types.OpenApiPathVersion1_0_0 + types.OpenApiEndpointAuditTrail
+
+// AuditTrailEvent is an event of the audit trail, recorded when an entity is changed or an operation is performed
+type AuditTrailEvent struct {
+	EventID string `json:"eventId"`
+	// Description is a human-readable summary of the event
+	Description string `json:"description,omitempty"`
+	// OperatingOrg is the Organization in which the operation was performed
+	OperatingOrg *types.OpenApiReference `json:"operatingOrg,omitempty"`
+	// User is the user who performed the operation
+	User *types.OpenApiReference `json:"user,omitempty"`
+	// EventEnvironment is the environment in which the event happened
+	EventEnvironment string `json:"eventEnvironment,omitempty"`
+	// EventType identifies the entity and the operation, like 'com/vmware/vcloud/event/org/modify'
+	EventType string `json:"eventType"`
+	// EventStatus is the outcome of the operation, like 'SUCCESS' or 'FAILURE'
+	EventStatus string `json:"eventStatus,omitempty"`
+	// Timestamp is the time of the event, in ISO-8601 format
+	Timestamp  string `json:"timestamp"`
+	ExternalID string `json:"externalId,omitempty"`
+	// AdditionalProperties are details of the event that depend on its type, like the name of the changed entity
+	AdditionalProperties map[string]string `json:"additionalProperties,omitempty"`
+	// Tenant is the Organization that owns the changed entity
+	Tenant *types.OpenApiReference `json:"tenant,omitempty"`
+}
@@ -0,0 +1,46 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+const (
+	// TenantBrandingEndpoint is the OpenAPI endpoint that holds the portal branding of the Organization with the
+	// given name
+	TenantBrandingEndpoint = "1.0.0/branding/tenant/%s"
+	// TenantBrandingLogoEndpoint is the OpenAPI endpoint that holds the portal logo of the Organization with the
+	// given name. The logo is sent and received as a raw image, not as JSON
+	TenantBrandingLogoEndpoint = "1.0.0/branding/tenant/%s/logo"
+
+	// BrandingThemeTypeBuiltIn is the type of the themes shipped with VCFA
+	BrandingThemeTypeBuiltIn = "BUILT_IN"
+	// BrandingThemeTypeCustom is the type of the themes uploaded by the provider
+	BrandingThemeTypeCustom = "CUSTOM"
+)
+
+// UiBranding defines how the tenant portal of an Organization looks like
+type UiBranding struct {
+	// PortalName is the name shown in the header and the title of the portal
+	PortalName string `json:"portalName,omitempty"`
+	// SelectedTheme is the theme of the portal
+	SelectedTheme *UiBrandingTheme `json:"selectedTheme,omitempty"`
+	// PortalColor is the background color of the header, in '#RRGGBB' format
+	PortalColor string `json:"portalColor,omitempty"`
+	// CustomLinks are the links added to the help and user menus of the portal
+	CustomLinks []UiBrandingMenuItem `json:"customLinks"`
+}
+
+// UiBrandingTheme identifies a theme of the portal
+type UiBrandingTheme struct {
+	ThemeType string `json:"themeType"`
+	Name      string `json:"name"`
+}
+
+// UiBrandingMenuItem is an entry of the help and user menus of the portal
+type UiBrandingMenuItem struct {
+	Name string `json:"name,omitempty"`
+	// MenuItemType is one of 'link', 'section', 'separator' or 'override'
+	MenuItemType string `json:"menuItemType"`
+	// Url is the target of 'link' and 'override' items
+	Url string `json:"url,omitempty"`
+}
@@ -0,0 +1,15 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import "github.com/vmware/go-vcloud-director/v3/types/v56"
+
+// ContentLibrarySyncEndpoint is the OpenAPI endpoint that synchronizes a subscribed Content Library with its publisher.
+// It expects the Content Library ID
+const ContentLibrarySyncEndpoint = types.OpenApiPathVcf + types.OpenApiEndpointContentLibraries + "%s/sync"
+
+// ContentLibraryItemSyncEndpoint is the OpenAPI endpoint that synchronizes an item of a subscribed Content Library
+// with its publisher. It expects the Content Library Item ID
+const ContentLibraryItemSyncEndpoint = types.OpenApiPathVcf + types.OpenApiEndpointContentLibraryItems + "%s/sync"
@@ -0,0 +1,21 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+// GeneralSettingsEndpoint is the OpenAPI endpoint that holds the system-wide general settings
+const GeneralSettingsEndpoint = "1.0.0/site/settings/general"
+
+// GeneralSettings contains the system-wide settings that are relevant to hardening baselines, such as
+// session timeouts and API Explorer availability
+type GeneralSettings struct {
+	// SessionTimeoutMinutes is the idle time, in minutes, after which a session is terminated
+	SessionTimeoutMinutes *int `json:"sessionTimeoutMinutes,omitempty"`
+	// AbsoluteSessionTimeoutMinutes is the maximum lifetime, in minutes, of a session regardless of activity
+	AbsoluteSessionTimeoutMinutes *int `json:"absoluteSessionTimeoutMinutes,omitempty"`
+	// ApiExplorerEnabled defines whether the API Explorer is available to users
+	ApiExplorerEnabled *bool `json:"apiExplorerEnabled,omitempty"`
+	// ShowStackTraces defines whether error responses include stack traces
+	ShowStackTraces *bool `json:"showStackTraces,omitempty"`
+}
@@ -0,0 +1,25 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import "github.com/vmware/go-vcloud-director/v3/types/v56"
+
+// IpSpaceOrgAssignmentsEndpoint is the OpenAPI endpoint that holds the allocations of IP Spaces to Organizations
+const IpSpaceOrgAssignmentsEndpoint = types.OpenApiPathVcf + types.OpenApiEndpointIpSpaceOrgAssignments
+
+// IpSpaceOrgAssignment defines the allocation of an IP Space to an Organization, together with the quota
+// that the Organization can consume from it
+type IpSpaceOrgAssignment struct {
+	ID string `json:"id,omitempty"`
+	// IpSpaceRef is the IP Space that is allocated
+	IpSpaceRef types.OpenApiReference `json:"ipSpaceRef"`
+	// OrgRef is the Organization that receives the allocation
+	OrgRef types.OpenApiReference `json:"orgRef"`
+	// DefaultQuota is the quota inherited from the IP Space. It is read-only
+	DefaultQuota *types.TmIpSpaceQuota `json:"defaultQuota,omitempty"`
+	// CustomQuota overrides the default quota of the IP Space for this Organization. When it is not set,
+	// the default quota of the IP Space applies
+	CustomQuota *types.TmIpSpaceQuota `json:"customQuota,omitempty"`
+}
@@ -0,0 +1,9 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+// EntityMetadataEndpoint is the OpenAPI endpoint that holds the metadata entries of the entity with the given URN,
+// like an Organization or a Region
+const EntityMetadataEndpoint = "1.0.0/entities/%s/metadata"
@@ -0,0 +1,55 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	VpcKind    = "VPC"
+	VpcAPI     = "vpc.nsx.vmware.com"
+	VpcVersion = "v1alpha1"
+	VpcsURL    = "/apis/" + VpcAPI + "/" + VpcVersion + "/namespaces/%s/vpcs"
+)
+
+// Vpc is a tenant Virtual Private Cloud, managed through the CCI Kubernetes API within a Project
+type Vpc struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          VpcSpec   `json:"spec,omitempty"`
+	Status        VpcStatus `json:"status,omitempty"`
+}
+
+// VpcSpec defines the desired state of a VPC
+type VpcSpec struct {
+	// RegionName is the name of the Region where the VPC is created
+	RegionName string `json:"regionName,omitempty"`
+	// Description of the VPC
+	Description string `json:"description,omitempty"`
+	// PrivateIPs is the list of private CIDR blocks that can be used by the VPC Subnets
+	PrivateIPs []string `json:"privateIPs,omitempty"`
+	// ConnectivityProfileName is the name of the VPC Connectivity Profile that defines the external connectivity
+	ConnectivityProfileName string `json:"vpcConnectivityProfileName,omitempty"`
+	// ServiceGateway contains the settings of the VPC Service Gateway, which provides external connectivity and NAT
+	ServiceGateway *VpcServiceGateway `json:"serviceGateway,omitempty"`
+	// DhcpProfileName is the name of the DHCP Profile used by the VPC Subnets
+	DhcpProfileName string `json:"dhcpProfileName,omitempty"`
+}
+
+// VpcServiceGateway defines the external connectivity settings of a VPC
+type VpcServiceGateway struct {
+	// Enabled defines whether the VPC is connected to the outside through the Service Gateway
+	Enabled bool `json:"enabled"`
+	// AutoSnat defines whether a default SNAT rule is created for the private CIDR blocks of the VPC
+	AutoSnat bool `json:"autoSnat"`
+}
+
+// VpcStatus defines the observed state of a VPC
+type VpcStatus struct {
+	Phase      string                                         `json:"phase,omitempty"`
+	Conditions []ccitypes.SupervisorNamespaceStatusConditions `json:"conditions,omitempty"`
+}
@@ -0,0 +1,113 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfatypes
+
+import (
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DhcpProfileKind = "DHCPProfile"
+	DhcpProfilesURL = "/apis/" + VpcAPI + "/" + VpcVersion + "/namespaces/%s/dhcpprofiles"
+	DnsServiceKind  = "DNSService"
+	DnsServicesURL  = "/apis/" + VpcAPI + "/" + VpcVersion + "/namespaces/%s/dnsservices"
+	SubnetKind      = "Subnet"
+	SubnetsURL      = "/apis/" + VpcAPI + "/" + VpcVersion + "/namespaces/%s/subnets"
+)
+
+// DhcpProfile defines how the Subnets of the VPCs that use it obtain their IP configuration, either from the
+// VPC DHCP server or by relaying the requests to external DHCP servers
+type DhcpProfile struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          DhcpProfileSpec         `json:"spec,omitempty"`
+	Status        VpcNetworkServiceStatus `json:"status,omitempty"`
+}
+
+// DhcpProfileSpec defines the desired state of a DHCP Profile
+type DhcpProfileSpec struct {
+	// RegionName is the name of the Region where the DHCP Profile is created
+	RegionName string `json:"regionName,omitempty"`
+	// Description of the DHCP Profile
+	Description string `json:"description,omitempty"`
+	// Mode is either 'SERVER', to serve DHCP from the VPC, or 'RELAY', to relay DHCP requests to external servers
+	Mode string `json:"mode,omitempty"`
+	// ServerAddresses are the external DHCP servers that requests are relayed to. Only used in 'RELAY' mode
+	ServerAddresses []string `json:"serverAddresses,omitempty"`
+	// LeaseTime is the lease time, in seconds, of the served addresses. Only used in 'SERVER' mode
+	LeaseTime int `json:"leaseTime,omitempty"`
+	// DnsServers are the DNS servers that are advertised to the DHCP clients. Only used in 'SERVER' mode
+	DnsServers []string `json:"dnsServers,omitempty"`
+}
+
+// DnsService is the DNS forwarder of a VPC, which resolves the queries of the VPC workloads using upstream servers
+type DnsService struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          DnsServiceSpec   `json:"spec,omitempty"`
+	Status        DnsServiceStatus `json:"status,omitempty"`
+}
+
+// DnsServiceSpec defines the desired state of a DNS Service
+type DnsServiceSpec struct {
+	// VpcName is the name of the VPC that the DNS Service serves
+	VpcName string `json:"vpcName,omitempty"`
+	// Description of the DNS Service
+	Description string `json:"description,omitempty"`
+	// UpstreamServers are the DNS servers that queries are forwarded to by default
+	UpstreamServers []string `json:"upstreamServers,omitempty"`
+	// ConditionalForwarders forward the queries of specific domains to other DNS servers
+	ConditionalForwarders []DnsConditionalForwarder `json:"conditionalForwarders,omitempty"`
+}
+
+// DnsConditionalForwarder forwards the queries of the given domains to the given DNS servers
+type DnsConditionalForwarder struct {
+	DomainNames     []string `json:"domainNames"`
+	UpstreamServers []string `json:"upstreamServers"`
+}
+
+// DnsServiceStatus defines the observed state of a DNS Service
+type DnsServiceStatus struct {
+	VpcNetworkServiceStatus `json:",inline"`
+	// ListenerIP is the IP address where the DNS Service listens for queries
+	ListenerIP string `json:"listenerIP,omitempty"`
+}
+
+// Subnet is a segment of the address space of a VPC, where the workloads attached to the VPC are connected
+type Subnet struct {
+	v1.TypeMeta   `json:",inline"`
+	v1.ObjectMeta `json:"metadata,omitempty"`
+	Spec          SubnetSpec              `json:"spec,omitempty"`
+	Status        VpcNetworkServiceStatus `json:"status,omitempty"`
+}
+
+// SubnetSpec defines the desired state of a Subnet
+type SubnetSpec struct {
+	// VpcName is the name of the VPC that the Subnet belongs to
+	VpcName string `json:"vpcName,omitempty"`
+	// Description of the Subnet
+	Description string `json:"description,omitempty"`
+	// AccessMode is either 'Private', 'Public' or 'PrivateTGW'
+	AccessMode string `json:"accessMode,omitempty"`
+	// IPAddresses contains the CIDR block of the Subnet
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+	// GatewayAddress is the IP address of the Subnet gateway. The first address of the CIDR block is used if not set
+	GatewayAddress string `json:"gatewayAddress,omitempty"`
+	// DhcpRanges are the IP address ranges that DHCP serves to the Subnet workloads
+	DhcpRanges []SubnetDhcpRange `json:"dhcpRanges,omitempty"`
+}
+
+// SubnetDhcpRange is a range of IP addresses, both included
+type SubnetDhcpRange struct {
+	StartAddress string `json:"startAddress"`
+	EndAddress   string `json:"endAddress"`
+}
+
+// VpcNetworkServiceStatus defines the observed state of the VPC network services
+type VpcNetworkServiceStatus struct {
+	Phase      string                                         `json:"phase,omitempty"`
+	Conditions []ccitypes.SupervisorNamespaceStatusConditions `json:"conditions,omitempty"`
+}
@@ -80,11 +80,13 @@ function unit_test {
     then
         echo "go test -tags unit ${TEST} ./vcfa || exit 1"
         echo "go test -tags unit -v -timeout 5m ./vcfa"
+        echo "go test -tags unit ./internal/mux"
     fi
     if [ -z "$DRY_RUN" ]
     then
         go test -tags unit ${TEST} ./vcfa || exit 1
-        go test -tags unit -v -timeout 5m ./vcfa
+        go test -tags unit -v -timeout 5m ./vcfa || exit 1
+        go test -tags unit ./internal/mux
     fi
 }
 
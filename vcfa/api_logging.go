@@ -0,0 +1,67 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+// logKubernetesCalls defines whether the requests and responses of the Kubernetes clients, used by the framework
+// resources, are written to the API log. Set with 'include_kubernetes_calls' in the 'api_logging' block
+var logKubernetesCalls = true
+
+// apiLoggingSettings are the settings of the logging of API requests and responses
+type apiLoggingSettings struct {
+	enabled                bool
+	file                   string
+	redactSecrets          bool
+	includeKubernetesCalls bool
+}
+
+// getApiLoggingSettings returns the logging settings of the provider. The 'api_logging' block takes precedence over
+// the 'logging' and 'logging_file' arguments, which are kept as a shorthand
+func getApiLoggingSettings(d *schema.ResourceData) apiLoggingSettings {
+	settings := apiLoggingSettings{
+		enabled:                d.Get("logging").(bool),
+		file:                   d.Get("logging_file").(string),
+		redactSecrets:          true,
+		includeKubernetesCalls: true,
+	}
+
+	blocks := d.Get("api_logging").([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return settings
+	}
+	block := blocks[0].(map[string]interface{})
+	settings.enabled = block["enabled"].(bool)
+	if file := block["file"].(string); file != "" {
+		settings.file = file
+	}
+	settings.redactSecrets = block["redact_secrets"].(bool)
+	settings.includeKubernetesCalls = block["include_kubernetes_calls"].(bool)
+	return settings
+}
+
+// configureApiLogging activates the logging of API requests and responses of the upstream go-vcloud-director library,
+// which also receives the debug messages of this provider and, optionally, the Kubernetes API calls
+func configureApiLogging(settings apiLoggingSettings) {
+	logKubernetesCalls = settings.includeKubernetesCalls
+	// Logging is disabled by default.
+	// If enabled, we set the log file name and invoke the upstream logging set-up
+	if !settings.enabled || settings.file == "" {
+		return
+	}
+	util.EnableLogging = true
+	util.ApiLogFileName = settings.file
+	// Passwords, tokens and Authorization headers are masked unless the redaction is explicitly disabled
+	util.LogPasswords = !settings.redactSecrets
+	util.InitLogging()
+}
+
+// IsKubernetesApiLoggingEnabled returns whether the Kubernetes API calls must be written to the API log
+func IsKubernetesApiLoggingEnabled() bool {
+	return util.EnableLogging && logKubernetesCalls
+}
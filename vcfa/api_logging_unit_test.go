@@ -0,0 +1,94 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+// TestGetApiLoggingSettings checks that the 'api_logging' block takes precedence over 'logging' and 'logging_file'
+func TestGetApiLoggingSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		want apiLoggingSettings
+	}{
+		{
+			name: "shorthand arguments",
+			raw:  map[string]interface{}{"logging": true, "logging_file": "vcfa.log"},
+			want: apiLoggingSettings{enabled: true, file: "vcfa.log", redactSecrets: true, includeKubernetesCalls: true},
+		},
+		{
+			name: "block with defaults",
+			raw: map[string]interface{}{
+				"logging_file": "vcfa.log",
+				"api_logging":  []interface{}{map[string]interface{}{"enabled": true}},
+			},
+			want: apiLoggingSettings{enabled: true, file: "vcfa.log", redactSecrets: true, includeKubernetesCalls: true},
+		},
+		{
+			name: "block overrides arguments",
+			raw: map[string]interface{}{
+				"logging":      true,
+				"logging_file": "vcfa.log",
+				"api_logging": []interface{}{map[string]interface{}{
+					"enabled":                  false,
+					"file":                     "debug.log",
+					"redact_secrets":           false,
+					"include_kubernetes_calls": false,
+				}},
+			},
+			want: apiLoggingSettings{enabled: false, file: "debug.log", redactSecrets: false, includeKubernetesCalls: false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tt.raw)
+			got := getApiLoggingSettings(d)
+			if got != tt.want {
+				t.Errorf("getApiLoggingSettings() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDebugPrintfWithApiLogging checks that the debug messages are written once, to the API log, when it is enabled
+func TestDebugPrintfWithApiLogging(t *testing.T) {
+	previousEnableLogging, previousLogger, previousEnableDebug, previousStdout := util.EnableLogging, util.Logger, enableDebug, os.Stdout
+	defer func() {
+		util.EnableLogging, util.Logger, enableDebug, os.Stdout = previousEnableLogging, previousLogger, previousEnableDebug, previousStdout
+	}()
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("error creating pipe: %s", err)
+	}
+	var apiLog bytes.Buffer
+	util.EnableLogging, util.Logger, enableDebug, os.Stdout = true, log.New(&apiLog, "", 0), true, writer
+
+	debugPrintf("connecting to %s\n", "vcfa.example.com")
+	_ = writer.Close()
+	stdout, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("error reading stdout: %s", err)
+	}
+
+	if got := strings.Count(apiLog.String(), "connecting to vcfa.example.com"); got != 1 {
+		t.Errorf("expected the message once in the API log, got %d times: %q", got, apiLog.String())
+	}
+	if len(stdout) != 0 {
+		t.Errorf("expected nothing in the standard output, got %q", stdout)
+	}
+}
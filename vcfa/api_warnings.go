@@ -0,0 +1,99 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiWarningRegex matches the 'Warning' header format of RFC 7234 (code, agent and quoted text, with an optional date),
+// which is the one used by the CCI Kubernetes API for deprecated API versions
+var apiWarningRegex = regexp.MustCompile(`^\s*\d{3}\s+\S+\s+"((?:[^"\\]|\\.)*)"`)
+
+// apiWarnings collects the warnings and deprecation notices returned by VCFA, until they are reported
+var apiWarnings = &apiWarningCollector{reported: make(map[string]bool)}
+
+// apiWarningCollector accumulates the API warnings. Every distinct warning is reported once per provider run, as the
+// same deprecated endpoint is usually called by many resources
+type apiWarningCollector struct {
+	sync.Mutex
+	pending  []diag.Diagnostic
+	reported map[string]bool
+}
+
+func (c *apiWarningCollector) add(summary, detail string) {
+	c.Lock()
+	defer c.Unlock()
+	if c.reported[summary] {
+		return
+	}
+	c.reported[summary] = true
+	c.pending = append(c.pending, diag.Diagnostic{Severity: diag.Warning, Summary: summary, Detail: detail})
+}
+
+// drain returns the warnings that were not reported yet
+func (c *apiWarningCollector) drain() diag.Diagnostics {
+	c.Lock()
+	defer c.Unlock()
+	pending := c.pending
+	c.pending = nil
+	return pending
+}
+
+// apiWarningTransport records the 'Warning', 'Deprecation' and 'Sunset' headers of the API responses
+type apiWarningTransport struct {
+	wrapped   http.RoundTripper
+	collector *apiWarningCollector
+}
+
+func (t *apiWarningTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	response, err := t.wrapped.RoundTrip(request)
+	if err != nil || response == nil {
+		return response, err
+	}
+	endpoint := fmt.Sprintf("Returned by %s %s", request.Method, request.URL.Path)
+	for _, warning := range response.Header.Values("Warning") {
+		text := warning
+		if matches := apiWarningRegex.FindStringSubmatch(warning); matches != nil {
+			text = matches[1]
+		}
+		t.collector.add("VCFA API warning: "+text, endpoint)
+	}
+	if deprecation := response.Header.Get("Deprecation"); deprecation != "" {
+		summary := fmt.Sprintf("VCFA API deprecation: %s is deprecated", request.URL.Path)
+		if sunset := response.Header.Get("Sunset"); sunset != "" {
+			summary += fmt.Sprintf(" and will be removed on %s", sunset)
+		}
+		t.collector.add(summary, fmt.Sprintf("%s. Upgrade the provider to a version that uses a supported API before it is removed", endpoint))
+	}
+	return response, nil
+}
+
+// reportApiWarnings makes every operation of a resource or data source report the API warnings received so far
+func reportApiWarnings(resource *schema.Resource) {
+	withWarnings := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return append(f(ctx, d, meta), apiWarnings.drain()...)
+		}
+	}
+	resource.CreateContext = withWarnings(resource.CreateContext)
+	resource.ReadContext = withWarnings(resource.ReadContext)
+	resource.UpdateContext = withWarnings(resource.UpdateContext)
+	resource.DeleteContext = withWarnings(resource.DeleteContext)
+	resource.CreateWithoutTimeout = withWarnings(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = withWarnings(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = withWarnings(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = withWarnings(resource.DeleteWithoutTimeout)
+}
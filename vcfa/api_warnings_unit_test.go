@@ -0,0 +1,76 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestApiWarningTransport checks that the warning and deprecation headers are collected, and reported only once
+func TestApiWarningTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Warning", `299 - "infrastructure.cci.vmware.com/v1alpha1 SupervisorNamespace is deprecated; use v1alpha2"`)
+		w.Header().Add("Warning", "plain text warning")
+		if r.URL.Path == "/cloudapi/1.0.0/old" {
+			w.Header().Set("Deprecation", "true")
+			w.Header().Set("Sunset", "Wed, 01 Dec 2027 00:00:00 GMT")
+		}
+	}))
+	defer server.Close()
+
+	collector := &apiWarningCollector{reported: make(map[string]bool)}
+	client := &http.Client{Transport: &apiWarningTransport{wrapped: http.DefaultTransport, collector: collector}}
+	for _, path := range []string{"/cloudapi/1.0.0/old", "/cloudapi/1.0.0/old"} {
+		response, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		_ = response.Body.Close()
+	}
+
+	expected := []string{
+		"VCFA API warning: infrastructure.cci.vmware.com/v1alpha1 SupervisorNamespace is deprecated; use v1alpha2",
+		"VCFA API warning: plain text warning",
+		"VCFA API deprecation: /cloudapi/1.0.0/old is deprecated and will be removed on Wed, 01 Dec 2027 00:00:00 GMT",
+	}
+	diags := collector.drain()
+	if len(diags) != len(expected) {
+		t.Fatalf("expected %d warnings, got %d: %v", len(expected), len(diags), diags)
+	}
+	for i, summary := range expected {
+		if diags[i].Severity != diag.Warning || diags[i].Summary != summary {
+			t.Errorf("expected warning %q, got %q", summary, diags[i].Summary)
+		}
+	}
+	if diags := collector.drain(); len(diags) != 0 {
+		t.Errorf("expected warnings to be drained, got %v", diags)
+	}
+}
+
+// TestReportApiWarnings checks that the pending API warnings are added to the diagnostics of the operations
+func TestReportApiWarnings(t *testing.T) {
+	resource := &schema.Resource{
+		ReadContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			apiWarnings.add("VCFA API warning: test warning", "")
+			return nil
+		},
+	}
+	reportApiWarnings(resource)
+	if resource.CreateContext != nil {
+		t.Fatalf("expected undefined operations to remain undefined")
+	}
+	diags := resource.ReadContext(context.Background(), nil, nil)
+	if len(diags) != 1 || diags[0].Summary != "VCFA API warning: test warning" {
+		t.Errorf("expected the API warning to be reported, got %v", diags)
+	}
+}
@@ -0,0 +1,113 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The audit trail is kept in the metadata of the managed objects, so platform operators can tell which objects are
+// managed by Terraform, and by whom. Only the flag to select them is a label, as label values can't contain the ':' of
+// the timestamps nor the '@' of the user names
+const (
+	// auditTrailManagedLabel marks the objects that record an audit trail
+	auditTrailManagedLabel = "terraform.vcfa.vmware.com/managed"
+	// auditTrailAnnotationPrefix is the prefix of all the audit trail annotations
+	auditTrailAnnotationPrefix = "terraform.vcfa.vmware.com/last-"
+	// auditTrailOperationAnnotation is the last operation done by Terraform: 'create' or 'update'
+	auditTrailOperationAnnotation = auditTrailAnnotationPrefix + "operation"
+	// auditTrailTimeAnnotation is the RFC 3339 time of the last operation
+	auditTrailTimeAnnotation = auditTrailAnnotationPrefix + "operation-time"
+	// auditTrailUserAnnotation is the session user that did the last operation
+	auditTrailUserAnnotation = auditTrailAnnotationPrefix + "operation-user"
+	// auditTrailProviderVersionAnnotation is the version of the provider that did the last operation
+	auditTrailProviderVersionAnnotation = auditTrailAnnotationPrefix + "provider-version"
+	// auditTrailWorkspaceAnnotation is the Terraform workspace of the last operation, when it is given
+	auditTrailWorkspaceAnnotation = auditTrailAnnotationPrefix + "workspace"
+)
+
+// auditTrail contains the metadata of the last operation done on a managed object
+type auditTrail struct {
+	operation string
+	time      time.Time
+	user      string
+	workspace string
+}
+
+// newAuditTrail returns the audit trail of an operation done now with the session of the given client
+func newAuditTrail(tmClient *VCDClient, operation, workspace string) auditTrail {
+	return auditTrail{
+		operation: operation,
+		time:      time.Now(),
+		user:      getSessionUserName(tmClient),
+		workspace: workspace,
+	}
+}
+
+// setAuditTrail records the given audit trail in the labels and annotations of an object, or removes it if it is not
+// enabled. The rest of the labels and annotations are kept
+func setAuditTrail(objectMeta *v1.ObjectMeta, enabled bool, trail auditTrail) {
+	if !enabled {
+		delete(objectMeta.Labels, auditTrailManagedLabel)
+		for key := range objectMeta.Annotations {
+			if strings.HasPrefix(key, auditTrailAnnotationPrefix) {
+				delete(objectMeta.Annotations, key)
+			}
+		}
+		return
+	}
+
+	if objectMeta.Labels == nil {
+		objectMeta.Labels = map[string]string{}
+	}
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = map[string]string{}
+	}
+	objectMeta.Labels[auditTrailManagedLabel] = "true"
+	objectMeta.Annotations[auditTrailOperationAnnotation] = trail.operation
+	objectMeta.Annotations[auditTrailTimeAnnotation] = trail.time.UTC().Format(time.RFC3339)
+	objectMeta.Annotations[auditTrailUserAnnotation] = trail.user
+	objectMeta.Annotations[auditTrailProviderVersionAnnotation] = BuildVersion
+	if trail.workspace != "" {
+		objectMeta.Annotations[auditTrailWorkspaceAnnotation] = trail.workspace
+	} else {
+		delete(objectMeta.Annotations, auditTrailWorkspaceAnnotation)
+	}
+}
+
+// getAuditTrailAnnotations returns the audit trail annotations of an object
+func getAuditTrailAnnotations(annotations map[string]string) map[string]string {
+	auditAnnotations := map[string]string{}
+	for key, value := range annotations {
+		if strings.HasPrefix(key, auditTrailAnnotationPrefix) {
+			auditAnnotations[key] = value
+		}
+	}
+	return auditAnnotations
+}
+
+// getSessionUserName returns the user of the session, as '<org>:<user>', from the claims of its token. When the token
+// can't be parsed, the Organization of the session is returned
+func getSessionUserName(tmClient *VCDClient) string {
+	// The token is only read to know the user, so its signature is not verified
+	token, _, err := new(jwt.Parser).ParseUnverified(tmClient.Client.VCDToken, jwt.MapClaims{})
+	if err != nil {
+		return tmClient.Org
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return tmClient.Org
+	}
+	preferredUsername, ok := claims["preferred_username"].(string)
+	if !ok || preferredUsername == "" {
+		return tmClient.Org
+	}
+	return fmt.Sprintf("%s:%s", tmClient.Org, preferredUsername)
+}
@@ -0,0 +1,88 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestSetAuditTrail checks that the audit trail is recorded and removed without touching the rest of the metadata
+func TestSetAuditTrail(t *testing.T) {
+	objectMeta := v1.ObjectMeta{
+		Labels:      map[string]string{"app": "web"},
+		Annotations: map[string]string{"owner": "team1"},
+	}
+	trail := auditTrail{
+		operation: "create",
+		time:      time.Date(2026, 10, 16, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+		user:      "org1:user1",
+		workspace: "production",
+	}
+
+	setAuditTrail(&objectMeta, true, trail)
+	expectedLabels := map[string]string{"app": "web", auditTrailManagedLabel: "true"}
+	if !reflect.DeepEqual(objectMeta.Labels, expectedLabels) {
+		t.Errorf("expected labels %v, got %v", expectedLabels, objectMeta.Labels)
+	}
+	expectedAnnotations := map[string]string{
+		auditTrailOperationAnnotation:       "create",
+		auditTrailTimeAnnotation:            "2026-10-16T10:00:00Z",
+		auditTrailUserAnnotation:            "org1:user1",
+		auditTrailProviderVersionAnnotation: BuildVersion,
+		auditTrailWorkspaceAnnotation:       "production",
+	}
+	if got := getAuditTrailAnnotations(objectMeta.Annotations); !reflect.DeepEqual(got, expectedAnnotations) {
+		t.Errorf("expected audit annotations %v, got %v", expectedAnnotations, got)
+	}
+	if objectMeta.Annotations["owner"] != "team1" {
+		t.Errorf("expected the other annotations to be kept, got %v", objectMeta.Annotations)
+	}
+
+	trail.operation = "update"
+	trail.workspace = ""
+	setAuditTrail(&objectMeta, true, trail)
+	if objectMeta.Annotations[auditTrailOperationAnnotation] != "update" {
+		t.Errorf("expected the operation to be updated, got %v", objectMeta.Annotations)
+	}
+	if _, ok := objectMeta.Annotations[auditTrailWorkspaceAnnotation]; ok {
+		t.Errorf("expected the workspace to be removed, got %v", objectMeta.Annotations)
+	}
+
+	setAuditTrail(&objectMeta, false, trail)
+	if !reflect.DeepEqual(objectMeta.Labels, map[string]string{"app": "web"}) || !reflect.DeepEqual(objectMeta.Annotations, map[string]string{"owner": "team1"}) {
+		t.Errorf("expected only the audit trail to be removed, got labels %v and annotations %v", objectMeta.Labels, objectMeta.Annotations)
+	}
+
+	// Objects without metadata are not modified when the audit trail is disabled
+	empty := v1.ObjectMeta{}
+	setAuditTrail(&empty, false, trail)
+	if empty.Labels != nil || empty.Annotations != nil {
+		t.Errorf("expected no metadata, got %+v", empty)
+	}
+}
+
+func TestGetSessionUserName(t *testing.T) {
+	newClient := func(token string) *VCDClient {
+		return &VCDClient{VCDClient: &govcd.VCDClient{Client: govcd.Client{VCDToken: token}}, Org: "org1"}
+	}
+	signedToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"preferred_username": "user1"}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatalf("error signing token: %s", err)
+	}
+	if got := getSessionUserName(newClient(signedToken)); got != "org1:user1" {
+		t.Errorf("expected 'org1:user1', got '%s'", got)
+	}
+	if got := getSessionUserName(newClient("not-a-jwt")); got != "org1" {
+		t.Errorf("expected 'org1' for an opaque token, got '%s'", got)
+	}
+}
@@ -0,0 +1,62 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// cciListPageSize is the number of items requested in every page of a CCI list
+const cciListPageSize = 100
+
+// cciListPage is a page of a Kubernetes-style list returned by the CCI API. When there are more items, its metadata
+// contains the token to request the next page
+type cciListPage[T any] struct {
+	Metadata struct {
+		Continue string `json:"continue,omitempty"`
+	} `json:"metadata"`
+	Items []T `json:"items"`
+}
+
+// getAllCciItems retrieves all the items of the CCI list at the given URL, requesting the pages one after the other,
+// so lists that are larger than a page are never truncated
+func getAllCciItems[T any](tmClient *VCDClient, listURL *url.URL) ([]T, error) {
+	return getAllCciPages(func(continueToken string) (cciListPage[T], error) {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(cciListPageSize))
+		if continueToken != "" {
+			params.Set("continue", continueToken)
+		}
+		var page cciListPage[T]
+		err := tmClient.VCDClient.Client.GetEntity(listURL, params, &page, nil)
+		return page, err
+	})
+}
+
+// getAllCciPages collects the items of all the pages returned by getPage, which is called with the token of the
+// previous page until a page without a token is returned
+func getAllCciPages[T any](getPage func(continueToken string) (cciListPage[T], error)) ([]T, error) {
+	var items []T
+	seenTokens := map[string]bool{}
+	continueToken := ""
+	for {
+		page, err := getPage(continueToken)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page.Items...)
+		continueToken = page.Metadata.Continue
+		if continueToken == "" {
+			return items, nil
+		}
+		// A token that was already used would make this loop endless
+		if seenTokens[continueToken] {
+			return nil, fmt.Errorf("the list returned the same continue token twice after %d items", len(items))
+		}
+		seenTokens[continueToken] = true
+	}
+}
@@ -0,0 +1,94 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// fakeCciListPages returns a page getter that serves the given number of items in pages of 'pageSize' items, using
+// the index of the next item as continue token
+func fakeCciListPages(total, pageSize int, requestedTokens *[]string) func(string) (cciListPage[int], error) {
+	return func(continueToken string) (cciListPage[int], error) {
+		*requestedTokens = append(*requestedTokens, continueToken)
+		start := 0
+		if continueToken != "" {
+			var err error
+			if start, err = strconv.Atoi(continueToken); err != nil {
+				return cciListPage[int]{}, fmt.Errorf("invalid token %s", continueToken)
+			}
+		}
+		var page cciListPage[int]
+		for i := start; i < total && i < start+pageSize; i++ {
+			page.Items = append(page.Items, i)
+		}
+		if start+pageSize < total {
+			page.Metadata.Continue = strconv.Itoa(start + pageSize)
+		}
+		return page, nil
+	}
+}
+
+// TestGetAllCciPages checks that the items of all the pages are collected
+func TestGetAllCciPages(t *testing.T) {
+	tests := []struct {
+		name           string
+		total          int
+		expectedTokens []string
+	}{
+		{name: "Empty", total: 0, expectedTokens: []string{""}},
+		{name: "SinglePage", total: 100, expectedTokens: []string{""}},
+		{name: "SeveralPages", total: 250, expectedTokens: []string{"", "100", "200"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestedTokens []string
+			items, err := getAllCciPages(fakeCciListPages(tt.total, cciListPageSize, &requestedTokens))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(items) != tt.total {
+				t.Errorf("expected %d items, got %d", tt.total, len(items))
+			}
+			for i, item := range items {
+				if item != i {
+					t.Fatalf("expected item %d at position %d, got %d", i, i, item)
+				}
+			}
+			if !reflect.DeepEqual(requestedTokens, tt.expectedTokens) {
+				t.Errorf("expected tokens %v, got %v", tt.expectedTokens, requestedTokens)
+			}
+		})
+	}
+}
+
+// TestGetAllCciPagesErrors checks that errors and repeated tokens stop the retrieval
+func TestGetAllCciPagesErrors(t *testing.T) {
+	_, err := getAllCciPages(func(continueToken string) (cciListPage[int], error) {
+		if continueToken != "" {
+			return cciListPage[int]{}, fmt.Errorf("page error")
+		}
+		page := cciListPage[int]{Items: []int{1}}
+		page.Metadata.Continue = "next"
+		return page, nil
+	})
+	if err == nil || err.Error() != "page error" {
+		t.Errorf("expected the error of the second page, got %v", err)
+	}
+
+	_, err = getAllCciPages(func(continueToken string) (cciListPage[int], error) {
+		page := cciListPage[int]{Items: []int{1}}
+		page.Metadata.Continue = "same"
+		return page, nil
+	})
+	if err == nil {
+		t.Errorf("expected an error for a repeated continue token")
+	}
+}
@@ -0,0 +1,165 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+)
+
+// cciProjectEntity defines a CCI Kubernetes object that lives within a Project, such as the VPC network services.
+// 'urlTemplate' must contain a single '%s' placeholder for the Project name
+type cciProjectEntity struct {
+	label       string
+	urlTemplate string
+}
+
+func (e cciProjectEntity) buildURL(tmClient *VCDClient, projectName, name string) (*url.URL, error) {
+	rawURL := fmt.Sprintf(e.urlTemplate, projectName)
+	if name != "" {
+		rawURL = rawURL + "/" + name
+	}
+	return tmClient.VCDClient.Client.GetEntityUrl(rawURL)
+}
+
+func createCciProjectEntity[T any](tmClient *VCDClient, e cciProjectEntity, projectName string, entity *T, params url.Values) error {
+	entityURL, err := e.buildURL(tmClient, projectName, "")
+	if err != nil {
+		return fmt.Errorf("error building %s URL: %s", e.label, err)
+	}
+	var entityOut T
+	if err := tmClient.VCDClient.Client.PostEntity(entityURL, params, entity, &entityOut, nil); err != nil {
+		return fmt.Errorf("error creating %s in Project %s: %s", e.label, projectName, err)
+	}
+	return nil
+}
+
+func updateCciProjectEntity[T any](tmClient *VCDClient, e cciProjectEntity, projectName, name string, entity *T, params url.Values) error {
+	entityURL, err := e.buildURL(tmClient, projectName, name)
+	if err != nil {
+		return fmt.Errorf("error building %s URL: %s", e.label, err)
+	}
+	var entityOut T
+	if err := tmClient.VCDClient.Client.PutEntity(entityURL, params, entity, &entityOut, nil); err != nil {
+		return fmt.Errorf("error updating %s %s in Project %s: %s", e.label, name, projectName, err)
+	}
+	return nil
+}
+
+func readCciProjectEntity[T any](tmClient *VCDClient, e cciProjectEntity, projectName, name string) (T, error) {
+	var entity T
+	entityURL, err := e.buildURL(tmClient, projectName, name)
+	if err != nil {
+		return entity, fmt.Errorf("error building %s URL: %s", e.label, err)
+	}
+	if err := tmClient.VCDClient.Client.GetEntity(entityURL, nil, &entity, nil); err != nil {
+		return entity, fmt.Errorf("error reading %s %s in Project %s: %s", e.label, name, projectName, err)
+	}
+	return entity, nil
+}
+
+func deleteCciProjectEntity(tmClient *VCDClient, e cciProjectEntity, projectName, name string, params url.Values) error {
+	entityURL, err := e.buildURL(tmClient, projectName, name)
+	if err != nil {
+		return fmt.Errorf("error building %s URL: %s", e.label, err)
+	}
+	if err := tmClient.VCDClient.Client.DeleteEntity(entityURL, params, nil); err != nil {
+		return fmt.Errorf("error deleting %s %s in Project %s: %s", e.label, name, projectName, err)
+	}
+	return nil
+}
+
+// waitForCciProjectEntityReady waits until the 'status' function, which returns the phase and conditions of the
+// object, reports a 'Ready' condition with status 'True'
+func waitForCciProjectEntityReady(ctx context.Context, e cciProjectEntity, projectName, name string, timeout time.Duration,
+	status func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error)) error {
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"WAITING"},
+		Target:  []string{"READY"},
+		Refresh: func() (any, string, error) {
+			phase, conditions, err := status()
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] %s %s current phase is %s", e.label, name, phase)
+			if strings.ToUpper(phase) == "ERROR" {
+				return nil, "", fmt.Errorf("%s %s is in an ERROR state", e.label, name)
+			}
+			if isCciConditionReady(conditions) {
+				return phase, "READY", nil
+			}
+			return phase, "WAITING", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in Project %s to be ready: %s", e.label, name, projectName, err)
+	}
+	return nil
+}
+
+// waitForCciProjectEntityDeleted waits until the object can't be found anymore
+func waitForCciProjectEntityDeleted(ctx context.Context, e cciProjectEntity, projectName, name string, timeout time.Duration,
+	status func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error)) error {
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (any, string, error) {
+			phase, _, err := status()
+			if err != nil {
+				if strings.Contains(err.Error(), "not found") {
+					return "", "DELETED", nil
+				}
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] %s %s current phase is %s", e.label, name, phase)
+			return phase, "DELETING", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in Project %s to be deleted: %s", e.label, name, projectName, err)
+	}
+	return nil
+}
+
+// isCciConditionReady returns true if the given conditions contain a 'Ready' condition with status 'True'
+func isCciConditionReady(conditions []ccitypes.SupervisorNamespaceStatusConditions) bool {
+	for _, condition := range conditions {
+		if strings.EqualFold(condition.Type, "Ready") {
+			return strings.EqualFold(condition.Status, "True")
+		}
+	}
+	return false
+}
+
+// flattenCciConditions converts the given conditions to the format of 'supervisorNamespaceConditionsSchema'
+func flattenCciConditions(conditions []ccitypes.SupervisorNamespaceStatusConditions) []interface{} {
+	result := make([]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		result = append(result, map[string]interface{}{
+			"last_transition_time": condition.LastTransitionTime,
+			"message":              condition.Message,
+			"reason":               condition.Reason,
+			"severity":             condition.Severity,
+			"status":               condition.Status,
+			"type":                 condition.Type,
+		})
+	}
+	return result
+}
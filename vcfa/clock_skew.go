@@ -0,0 +1,142 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultMaxClockSkew is the clock difference with VCFA that is tolerated when 'max_clock_skew' is not set
+const defaultMaxClockSkew = 5 * time.Minute
+
+// parseMaxClockSkew parses the 'max_clock_skew' value. An empty value means the default one, and zero disables the
+// clock skew checks
+func parseMaxClockSkew(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultMaxClockSkew, nil
+	}
+	maxSkew, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid 'max_clock_skew' value '%s': %s", value, err)
+	}
+	if maxSkew < 0 {
+		return 0, fmt.Errorf("invalid 'max_clock_skew' value '%s': must not be negative", value)
+	}
+	return maxSkew, nil
+}
+
+// serverClockTransport measures the difference between the local clock and the one of VCFA, using the 'Date' header
+// of the responses
+type serverClockTransport struct {
+	wrapped http.RoundTripper
+	now     func() time.Time
+
+	sync.Mutex
+	skew     time.Duration
+	measured bool
+}
+
+func (t *serverClockTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	sent := t.now()
+	response, err := t.wrapped.RoundTrip(request)
+	if err != nil || response == nil {
+		return response, err
+	}
+	serverTime, dateErr := http.ParseTime(response.Header.Get("Date"))
+	if dateErr != nil {
+		return response, nil
+	}
+	received := t.now()
+	// The server time is compared with the middle of the round trip, to not count the latency as skew
+	localTime := sent.Add(received.Sub(sent) / 2)
+
+	t.Lock()
+	defer t.Unlock()
+	t.skew = serverTime.Sub(localTime)
+	t.measured = true
+	return response, nil
+}
+
+// serverTime returns the current time according to the last measured VCFA clock
+func (t *serverClockTransport) serverTime() (time.Time, bool) {
+	t.Lock()
+	defer t.Unlock()
+	return t.now().Add(t.skew), t.measured
+}
+
+// checkClockSkew returns an error if the measured clock difference with VCFA is greater than 'maxSkew'. The 'Date'
+// header only has a precision of one second, which is always tolerated
+func (t *serverClockTransport) checkClockSkew(href string, maxSkew time.Duration) error {
+	t.Lock()
+	skew, measured := t.skew, t.measured
+	t.Unlock()
+	if maxSkew == 0 || !measured {
+		return nil
+	}
+	absoluteSkew := skew
+	if absoluteSkew < 0 {
+		absoluteSkew = -absoluteSkew
+	}
+	if absoluteSkew <= maxSkew+time.Second {
+		return nil
+	}
+	direction := "ahead of"
+	if skew > 0 {
+		direction = "behind"
+	}
+	return fmt.Errorf("the clock of this machine is %s %s the clock of VCFA at '%s', which is more than the "+
+		"tolerated 'max_clock_skew' of %s. Session tokens would be rejected by VCFA during long operations, failing with "+
+		"'401 Unauthorized' errors. Synchronize the clock of this machine (for example, with NTP) or increase 'max_clock_skew'",
+		absoluteSkew.Round(time.Second), direction, href, maxSkew)
+}
+
+// checkTokenExpiry returns an error if the given session token is a JWT that expired according to the VCFA clock,
+// which happens when the token was issued for a skewed clock or was cached for too long
+func (t *serverClockTransport) checkTokenExpiry(token string) error {
+	expiry, ok := getJwtExpiry(token)
+	if !ok {
+		return nil
+	}
+	serverTime, measured := t.serverTime()
+	if !measured {
+		serverTime = t.now()
+	}
+	if !expiry.After(serverTime) {
+		return fmt.Errorf("the session token expired at %s, before the current VCFA time %s. Provide a new token, or "+
+			"remove the cached one with 'token_cache_file' to authenticate again",
+			expiry.UTC().Format(time.RFC3339), serverTime.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// getJwtExpiry returns the expiration time of a JWT, given by its 'exp' claim. It returns false if the token is not
+// a JWT or has no expiration
+func getJwtExpiry(token string) (time.Time, bool) {
+	parts := strings.Split(strings.TrimSpace(token), ".")
+	if len(parts) != 3 {
+		return time.Time{}, false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, false
+	}
+	var claims struct {
+		Expiry *json.Number `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Expiry == nil {
+		return time.Time{}, false
+	}
+	seconds, err := claims.Expiry.Int64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
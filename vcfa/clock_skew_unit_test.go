@@ -0,0 +1,110 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestServerClockTransport checks that the clock difference with VCFA is measured from the 'Date' header, and that
+// only the differences above the tolerated one are reported
+func TestServerClockTransport(t *testing.T) {
+	serverTime := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", serverTime.Format(http.TimeFormat))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		localTime time.Time
+		maxSkew   time.Duration
+		wantErr   string
+	}{
+		{name: "InSync", localTime: serverTime, maxSkew: defaultMaxClockSkew},
+		{name: "WithinTolerance", localTime: serverTime.Add(4 * time.Minute), maxSkew: defaultMaxClockSkew},
+		{name: "Ahead", localTime: serverTime.Add(10 * time.Minute), maxSkew: defaultMaxClockSkew, wantErr: "is 10m0s ahead of"},
+		{name: "Behind", localTime: serverTime.Add(-time.Hour), maxSkew: defaultMaxClockSkew, wantErr: "is 1h0m0s behind"},
+		{name: "Disabled", localTime: serverTime.Add(-time.Hour), maxSkew: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &serverClockTransport{wrapped: http.DefaultTransport, now: func() time.Time { return tt.localTime }}
+			if err := clock.checkClockSkew(server.URL, tt.maxSkew); err != nil {
+				t.Fatalf("expected no error before any response, got: %s", err)
+			}
+			response, err := (&http.Client{Transport: clock}).Get(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			_ = response.Body.Close()
+
+			err = clock.checkClockSkew(server.URL, tt.maxSkew)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("expected no error, got: %s", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("expected an error containing '%s', got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCheckTokenExpiry(t *testing.T) {
+	serverTime := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	jwt := func(payload string) string {
+		return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".c2lnbmF0dXJl"
+	}
+	// The local clock is one hour behind VCFA
+	clock := &serverClockTransport{now: func() time.Time { return serverTime.Add(-time.Hour) }, skew: time.Hour, measured: true}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "NotJwt", token: "e0b4d6a2c1f84c6b9b7f2a3d4e5f6a7b"},
+		{name: "NoExpiry", token: jwt(`{"sub":"admin"}`)},
+		{name: "Valid", token: jwt(fmt.Sprintf(`{"exp":%d}`, serverTime.Add(time.Hour).Unix()))},
+		// Still valid for the local clock, but not for VCFA
+		{name: "ExpiredForServer", token: jwt(fmt.Sprintf(`{"exp":%d}`, serverTime.Add(-time.Minute).Unix())), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := clock.checkTokenExpiry(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkTokenExpiry() error = %v, wantErr %t", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseMaxClockSkew(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: defaultMaxClockSkew},
+		{value: "0", want: 0},
+		{value: "90s", want: 90 * time.Second},
+		{value: "-1m", wantErr: true},
+		{value: "five minutes", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := parseMaxClockSkew(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("parseMaxClockSkew(%q) = %s, %v, want %s, wantErr %t", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -5,8 +5,10 @@
 package vcfa
 
 import (
+	"context"
 	"crypto/sha256"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -18,6 +20,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/util"
 )
 
 func init() {
@@ -38,17 +41,41 @@ type Config struct {
 	AllowApiTokenFile       bool   // Setting to suppress API Token File security warnings
 	ServiceAccountTokenFile string // File containing the Service Account API token
 	AllowSATokenFile        bool   // Setting to suppress Service Account Token File security warnings
+	TokenCacheFile          string // File where session tokens are cached across Terraform runs
 	SysOrg                  string // Org used for authentication
 	Org                     string // Default Org used for API operations
 	Href                    string
 	InsecureFlag            bool
+	InsecureHosts           []string      // Hosts for which unverifiable SSL certificates are permitted
+	HttpProxy               string        // Proxy for HTTP requests, instead of the HTTP_PROXY environment variable
+	HttpsProxy              string        // Proxy for HTTPS requests, instead of the HTTPS_PROXY environment variable
+	CaCertificate           string        // PEM bundle of additional trusted CA certificates
+	MaxClockSkew            time.Duration // Tolerated clock difference with VCFA. Zero disables the check
 }
 
 type VCDClient struct {
 	*govcd.VCDClient
 	SysOrg       string
 	Org          string // name of default Org
-	InsecureFlag bool
+	InsecureFlag bool   // whether unverifiable SSL certificates are permitted for the VCFA host
+	// CaCertificate is the PEM bundle of additional trusted CA certificates, set with 'ca_certificate'
+	CaCertificate string
+	// Proxy returns the proxy for a given request, according to 'http_proxy', 'https_proxy' and the environment
+	Proxy func(*http.Request) (*url.URL, error)
+	// BaseTransport is the HTTP transport with the proxy, CA certificate and insecure settings of the provider, but
+	// without recording API warnings, metrics or the clock of VCFA. It is used for requests to other hosts
+	BaseTransport http.RoundTripper
+
+	// unbound is the client that this one was copied from by WithContext, and boundContext the context that its
+	// requests are bound to. Both are nil when the client is not a copy
+	unbound      *VCDClient
+	boundContext context.Context
+
+	// orgScoped is true for the sessions that act as the tenant of 'Org', opened by orgScopedClient
+	orgScoped bool
+	// orgSessions are the org-scoped sessions opened from this one, by Organization name
+	orgSessions     map[string]*VCDClient
+	orgSessionsLock sync.Mutex
 }
 
 // StringMap type is used to simplify reading resource definitions
@@ -109,7 +136,12 @@ func debugPrintf(format string, args ...interface{}) {
 	if enableTrace {
 		format = fmt.Sprintf("[%s] %s", filepath.Base(callFuncName()), format)
 	}
-	// The formatted message passed to this function is displayed only when GOVCD_DEBUG is enabled.
+	// When the API log is enabled, the message is written only there, with the same masking of secrets as API payloads
+	if util.EnableLogging {
+		util.Logger.Print(util.HideSensitive(fmt.Sprintf(format, args...), false))
+		return
+	}
+	// Otherwise, the formatted message passed to this function is displayed only when GOVCD_DEBUG is enabled.
 	if enableDebug {
 		fmt.Printf(format, args...)
 	}
@@ -119,7 +151,7 @@ func debugPrintf(format string, args ...interface{}) {
 func ProviderAuthenticate(client *govcd.VCDClient, user, password, token, org, apiToken, apiTokenFile, saTokenFile string) error {
 	var err error
 	if saTokenFile != "" {
-		return client.SetServiceAccountApiToken(org, saTokenFile)
+		return authenticateWithServiceAccountToken(client, org, saTokenFile)
 	}
 	if apiTokenFile != "" {
 		_, err := client.SetApiTokenFromFile(org, apiTokenFile)
@@ -154,7 +186,11 @@ func (c *Config) Client() (*VCDClient, error) {
 		c.ApiTokenFile + "#" +
 		c.ServiceAccountTokenFile + "#" +
 		c.SysOrg + "#" +
-		c.Href
+		c.Href + "#" +
+		strings.Join(c.InsecureHosts, ",") + "#" +
+		c.HttpProxy + "#" +
+		c.HttpsProxy + "#" +
+		c.CaCertificate
 	checksum := fmt.Sprintf("%x", sha256.Sum256([]byte(rawData)))
 
 	// The cached connection is served only if the variable VCFA_CACHE is set
@@ -182,21 +218,53 @@ func (c *Config) Client() (*VCDClient, error) {
 		return nil, fmt.Errorf("something went wrong while retrieving URL: %s", err)
 	}
 
+	baseTransport, err := c.newBaseHttpTransport()
+	if err != nil {
+		return nil, err
+	}
+	clock := &serverClockTransport{wrapped: wrapApiTransport(baseTransport), now: time.Now}
+	proxy, err := buildProxyFunc(c.HttpProxy, c.HttpsProxy)
+	if err != nil {
+		return nil, err
+	}
+
 	userAgent := buildUserAgent(BuildVersion, c.SysOrg)
 
 	tmClient := &VCDClient{
 		VCDClient: govcd.NewVCDClient(*authUrl, c.InsecureFlag,
 			govcd.WithHttpUserAgent(userAgent),
 			govcd.WithAPIVersion(minVcfaApiVersion),
+			withHttpTransport(clock),
 		),
-		SysOrg:       c.SysOrg,
-		Org:          c.Org,
-		InsecureFlag: c.InsecureFlag}
+		SysOrg:        c.SysOrg,
+		Org:           c.Org,
+		InsecureFlag:  c.isInsecureHost(authUrl),
+		CaCertificate: c.CaCertificate,
+		Proxy:         proxy,
+		BaseTransport: baseTransport,
+	}
 
-	err = ProviderAuthenticate(tmClient.VCDClient, c.User, c.Password, c.Token, c.SysOrg, c.ApiToken, c.ApiTokenFile, c.ServiceAccountTokenFile)
+	authenticate := func() error {
+		return ProviderAuthenticate(tmClient.VCDClient, c.User, c.Password, c.Token, c.SysOrg, c.ApiToken, c.ApiTokenFile, c.ServiceAccountTokenFile)
+	}
+	// A token given in the configuration is already reusable, so it is not cached
+	if c.TokenCacheFile != "" && c.Token == "" {
+		err = authenticateWithTokenCache(tmClient.VCDClient, c.TokenCacheFile, tokenCacheKey(c), c.SysOrg, authenticate)
+	} else {
+		err = authenticate()
+	}
+	// A skewed clock is the usual cause of tokens rejected with '401 Unauthorized', so it is reported in the first place
+	if skewErr := clock.checkClockSkew(c.Href, c.MaxClockSkew); skewErr != nil {
+		return nil, skewErr
+	}
 	if err != nil {
 		return nil, fmt.Errorf("something went wrong during authentication: %s", err)
 	}
+	if c.MaxClockSkew != 0 {
+		if err := clock.checkTokenExpiry(tmClient.Client.VCDToken); err != nil {
+			return nil, err
+		}
+	}
 
 	cachedVCDClients.Lock()
 	cachedVCDClients.conMap[checksum] = cachedConnection{initTime: time.Now(), connection: tmClient}
@@ -0,0 +1,118 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+// downloadContentLibraryItemSource downloads, with the given HTTP client, the OVA or ISO file referenced by the given
+// HTTP(S) URL into a temporary directory, sending the given headers. If a SHA-256 checksum is given, the downloaded contents are verified against it.
+// It returns the path of the downloaded file and a function that removes it, which must be called once it is not needed anymore.
+func downloadContentLibraryItemSource(ctx context.Context, httpClient *http.Client, sourceUrl string, headers map[string]string, checksum string) (string, func(), error) {
+	cleanup := func() {}
+
+	parsedUrl, err := url.Parse(sourceUrl)
+	if err != nil {
+		return "", cleanup, fmt.Errorf("error parsing URL '%s': %s", sourceUrl, err)
+	}
+	if parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https" {
+		return "", cleanup, fmt.Errorf("URL '%s' must use the 'http' or 'https' scheme", sourceUrl)
+	}
+	fileName := path.Base(parsedUrl.Path)
+	if ext := strings.ToLower(path.Ext(fileName)); ext != ".ova" && ext != ".iso" {
+		return "", cleanup, fmt.Errorf("URL '%s' must point to an OVA or ISO file", sourceUrl)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceUrl, nil)
+	if err != nil {
+		return "", cleanup, fmt.Errorf("error creating request for '%s': %s", sourceUrl, err)
+	}
+	for k, v := range headers {
+		request.Header.Set(k, v)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return "", cleanup, fmt.Errorf("error downloading '%s': %s", sourceUrl, err)
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			util.Logger.Printf("[DEBUG] could not close response body of '%s': %s", sourceUrl, err)
+		}
+	}()
+	if response.StatusCode != http.StatusOK {
+		return "", cleanup, fmt.Errorf("error downloading '%s': unexpected status '%s'", sourceUrl, response.Status)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "vcfa-cli-")
+	if err != nil {
+		return "", cleanup, fmt.Errorf("error creating temporary directory to download '%s': %s", sourceUrl, err)
+	}
+	cleanup = func() {
+		if err := os.RemoveAll(tmpDir); err != nil {
+			util.Logger.Printf("[DEBUG] could not remove temporary directory '%s': %s", tmpDir, err)
+		}
+	}
+
+	// The extension is lowered, as the upload relies on it to detect the type of file
+	filePath := filepath.Join(tmpDir, strings.TrimSuffix(fileName, path.Ext(fileName))+strings.ToLower(path.Ext(fileName)))
+	file, err := os.Create(filepath.Clean(filePath))
+	if err != nil {
+		return "", cleanup, fmt.Errorf("error creating file '%s': %s", filePath, err)
+	}
+
+	hash := sha256.New()
+	written, err := io.Copy(io.MultiWriter(file, hash), response.Body)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", cleanup, fmt.Errorf("error downloading '%s' to '%s': %s", sourceUrl, filePath, err)
+	}
+	util.Logger.Printf("[DEBUG] downloaded %d bytes from '%s' to '%s'", written, sourceUrl, filePath)
+
+	if checksum != "" {
+		if actual := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actual, checksum) {
+			return "", cleanup, fmt.Errorf("SHA-256 checksum of '%s' is '%s', but '%s' was expected", sourceUrl, actual, checksum)
+		}
+	}
+
+	return filePath, cleanup, nil
+}
+
+// verifyFileChecksum returns an error if the SHA-256 checksum of the given file is not the expected one
+func verifyFileChecksum(filePath, checksum string) error {
+	file, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return fmt.Errorf("error opening file '%s': %s", filePath, err)
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			util.Logger.Printf("[DEBUG] could not close file '%s': %s", filePath, err)
+		}
+	}()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return fmt.Errorf("error reading file '%s': %s", filePath, err)
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); !strings.EqualFold(actual, checksum) {
+		return fmt.Errorf("SHA-256 checksum of '%s' is '%s', but '%s' was expected", filePath, actual, checksum)
+	}
+	return nil
+}
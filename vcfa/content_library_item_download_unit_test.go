@@ -0,0 +1,131 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDownloadContentLibraryItemSource checks the download of Content Library Item files from a URL
+func TestDownloadContentLibraryItemSource(t *testing.T) {
+	content := []byte("fake ISO contents")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		url      string
+		headers  map[string]string
+		checksum string
+		wantErr  bool
+	}{
+		{
+			name:     "Valid",
+			url:      server.URL + "/images/linux.ISO",
+			headers:  map[string]string{"Authorization": "Bearer token"},
+			checksum: checksum,
+		},
+		{
+			name:    "NoChecksum",
+			url:     server.URL + "/images/appliance.ova",
+			headers: map[string]string{"Authorization": "Bearer token"},
+		},
+		{
+			name:     "WrongChecksum",
+			url:      server.URL + "/images/linux.iso",
+			headers:  map[string]string{"Authorization": "Bearer token"},
+			checksum: "0000000000000000000000000000000000000000000000000000000000000000",
+			wantErr:  true,
+		},
+		{
+			name:    "MissingHeaders",
+			url:     server.URL + "/images/linux.iso",
+			wantErr: true,
+		},
+		{
+			name:    "UnsupportedExtension",
+			url:     server.URL + "/images/descriptor.ovf",
+			headers: map[string]string{"Authorization": "Bearer token"},
+			wantErr: true,
+		},
+		{
+			name:    "UnsupportedScheme",
+			url:     "ftp://example.com/linux.iso",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filePath, cleanup, err := downloadContentLibraryItemSource(context.Background(), http.DefaultClient, tt.url, tt.headers, tt.checksum)
+			defer cleanup()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("downloadContentLibraryItemSource() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if ext := filepath.Ext(filePath); ext != ".iso" && ext != ".ova" {
+				t.Errorf("downloadContentLibraryItemSource() returned a file with unexpected extension: %s", filePath)
+			}
+			downloaded, err := os.ReadFile(filepath.Clean(filePath))
+			if err != nil {
+				t.Fatalf("could not read downloaded file: %s", err)
+			}
+			if string(downloaded) != string(content) {
+				t.Errorf("downloadContentLibraryItemSource() downloaded '%s', expected '%s'", downloaded, content)
+			}
+		})
+	}
+}
+
+// TestVerifyFileChecksum checks the verification of the SHA-256 checksum of local files
+func TestVerifyFileChecksum(t *testing.T) {
+	content := []byte("fake OVA contents")
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	filePath := filepath.Join(t.TempDir(), "appliance.ova")
+	if err := os.WriteFile(filePath, content, 0600); err != nil {
+		t.Fatalf("could not write test file: %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		filePath string
+		checksum string
+		wantErr  bool
+	}{
+		{name: "Valid", filePath: filePath, checksum: checksum},
+		{name: "ValidUpperCase", filePath: filePath, checksum: strings.ToUpper(checksum)},
+		{name: "Mismatch", filePath: filePath, checksum: "0000000000000000000000000000000000000000000000000000000000000000", wantErr: true},
+		{name: "MissingFile", filePath: filePath + ".missing", checksum: checksum, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verifyFileChecksum(tt.filePath, tt.checksum); (err != nil) != tt.wantErr {
+				t.Errorf("verifyFileChecksum() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+// contentLibraryQueues counts, by Content Library ID, the operations that hold or wait for the lock of the library, so
+// the position of every operation in the queue can be logged
+var contentLibraryQueues = struct {
+	sync.Mutex
+	length map[string]int
+}{length: make(map[string]int)}
+
+// lockContentLibrary serializes the operations that change the items of the given Content Library, as VCFA can reject
+// concurrent ones with busy entity errors. It blocks until the lock is acquired, and returns the function that releases it.
+// The given operation is only used for logging purposes
+func lockContentLibrary(contentLibraryId, operation string) func() {
+	contentLibraryQueues.Lock()
+	ahead := contentLibraryQueues.length[contentLibraryId]
+	contentLibraryQueues.length[contentLibraryId]++
+	contentLibraryQueues.Unlock()
+
+	if ahead > 0 {
+		log.Printf("[INFO] %s is waiting for %d other operations in %s '%s'", operation, ahead, labelVcfaContentLibrary, contentLibraryId)
+	}
+	start := time.Now()
+	vcfa.kvLock(contentLibraryLockKey(contentLibraryId))
+	if ahead > 0 {
+		log.Printf("[INFO] %s acquired the lock of %s '%s' after waiting %s", operation, labelVcfaContentLibrary, contentLibraryId, time.Since(start).Round(time.Second))
+	}
+
+	return func() {
+		vcfa.kvUnlock(contentLibraryLockKey(contentLibraryId))
+		contentLibraryQueues.Lock()
+		contentLibraryQueues.length[contentLibraryId]--
+		if contentLibraryQueues.length[contentLibraryId] == 0 {
+			delete(contentLibraryQueues.length, contentLibraryId)
+		}
+		contentLibraryQueues.Unlock()
+	}
+}
+
+// contentLibraryLockKey returns the key of the lock of a Content Library in the global mutexKV
+func contentLibraryLockKey(contentLibraryId string) string {
+	return "content-library:" + contentLibraryId
+}
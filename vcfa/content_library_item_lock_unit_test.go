@@ -0,0 +1,60 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestLockContentLibrary checks that the operations in the same Content Library are serialized, while the ones in
+// different libraries are not
+func TestLockContentLibrary(t *testing.T) {
+	const operations = 10
+	running := map[string]*atomic.Int32{"library-a": {}, "library-b": {}}
+	maxRunning := map[string]*atomic.Int32{"library-a": {}, "library-b": {}}
+	var bothRunning atomic.Bool
+
+	var wg sync.WaitGroup
+	for i := 0; i < operations; i++ {
+		for _, libraryId := range []string{"library-a", "library-b"} {
+			wg.Add(1)
+			go func(libraryId string) {
+				defer wg.Done()
+				unlock := lockContentLibrary(libraryId, "test operation")
+				defer unlock()
+
+				current := running[libraryId].Add(1)
+				if current > maxRunning[libraryId].Load() {
+					maxRunning[libraryId].Store(current)
+				}
+				if running["library-a"].Load() > 0 && running["library-b"].Load() > 0 {
+					bothRunning.Store(true)
+				}
+				time.Sleep(5 * time.Millisecond)
+				running[libraryId].Add(-1)
+			}(libraryId)
+		}
+	}
+	wg.Wait()
+
+	for libraryId, maximum := range maxRunning {
+		if maximum.Load() != 1 {
+			t.Errorf("expected the operations in '%s' to run one at a time, got %d at the same time", libraryId, maximum.Load())
+		}
+	}
+	if !bothRunning.Load() {
+		t.Errorf("expected the operations in different libraries to run at the same time")
+	}
+	contentLibraryQueues.Lock()
+	defer contentLibraryQueues.Unlock()
+	if len(contentLibraryQueues.length) != 0 {
+		t.Errorf("expected the queues to be empty after all the operations, got %v", contentLibraryQueues.length)
+	}
+}
@@ -0,0 +1,184 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	// #nosec G505 -- SHA1 is only used to write the digests of OVF manifests that were created with it
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+// ovfPropertyRegex matches the opening tag of a ProductSection <Property> element, with any namespace prefix
+var ovfPropertyRegex = regexp.MustCompile(`<(\w+:)?Property\s[^>]*>`)
+
+// ovfPropertyKeyRegex and ovfPropertyValueRegex capture the key and value attributes of a <Property> element
+var ovfPropertyKeyRegex = regexp.MustCompile(`\s(\w+:)?key="([^"]*)"`)
+var ovfPropertyValueRegex = regexp.MustCompile(`\s(\w+:)?value="[^"]*"`)
+
+// ovfManifestLineRegex matches a line of an OVF manifest, like 'SHA256(disk.vmdk)= <digest>', capturing the algorithm
+// and the file name
+var ovfManifestLineRegex = regexp.MustCompile(`^(\w+)\(([^)]+)\)\s*=\s*[0-9a-fA-F]+\s*$`)
+
+// ovfManifestHashes are the digest algorithms that OVF manifests can use
+var ovfManifestHashes = map[string]func() hash.Hash{
+	"SHA1":   sha1.New,
+	"SHA256": sha256.New,
+	"SHA512": sha512.New,
+}
+
+// overrideOvfProperties sets the value of the ProductSection properties of the given OVF descriptor contents, identified
+// by their key. The descriptor is modified textually so the rest of the document is preserved byte by byte.
+// It fails if any of the given keys is not present in the descriptor.
+func overrideOvfProperties(descriptor string, properties map[string]string) (string, error) {
+	found := make(map[string]bool, len(properties))
+	result := ovfPropertyRegex.ReplaceAllStringFunc(descriptor, func(tag string) string {
+		keyMatch := ovfPropertyKeyRegex.FindStringSubmatch(tag)
+		if keyMatch == nil {
+			return tag
+		}
+		value, ok := properties[html.UnescapeString(keyMatch[2])]
+		if !ok {
+			return tag
+		}
+		found[html.UnescapeString(keyMatch[2])] = true
+
+		valueAttribute := fmt.Sprintf(` %svalue="%s"`, keyMatch[1], html.EscapeString(value))
+		if ovfPropertyValueRegex.MatchString(tag) {
+			return ovfPropertyValueRegex.ReplaceAllLiteralString(tag, valueAttribute)
+		}
+		// The property has no default value, so the attribute is added next to the key
+		return strings.Replace(tag, keyMatch[0], keyMatch[0]+valueAttribute, 1)
+	})
+
+	var missing []string
+	for key := range properties {
+		if !found[key] {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return "", fmt.Errorf("the OVF descriptor does not contain the properties %v", missing)
+	}
+	return result, nil
+}
+
+// applyOvfPropertyOverrides prepares the upload arguments so the OVF descriptor that gets uploaded contains the given
+// property values. OVA files are unpacked, and the patched descriptor is written to a temporary directory.
+// The returned function removes the temporary files and must be called once the upload is finished.
+func applyOvfPropertyOverrides(uploadArgs *govcd.ContentLibraryItemUploadArguments, properties map[string]string) (func(), error) {
+	var tmpDirs []string
+	cleanup := func() {
+		for _, dir := range tmpDirs {
+			if err := os.RemoveAll(dir); err != nil {
+				util.Logger.Printf("[DEBUG] could not remove temporary directory '%s': %s", dir, err)
+			}
+		}
+	}
+
+	if filepath.Ext(uploadArgs.FilePath) == ".ova" {
+		ovaFiles, ovaTmpDir, err := util.Unpack(uploadArgs.FilePath)
+		if err != nil {
+			return cleanup, fmt.Errorf("error unpacking OVA file '%s': %s", uploadArgs.FilePath, err)
+		}
+		tmpDirs = append(tmpDirs, ovaTmpDir)
+		uploadArgs.FilePath = ""
+		uploadArgs.OvfFilesPaths = nil
+		for _, p := range ovaFiles {
+			if filepath.Ext(p) == ".ovf" {
+				uploadArgs.FilePath = p
+			} else {
+				uploadArgs.OvfFilesPaths = append(uploadArgs.OvfFilesPaths, p)
+			}
+		}
+		if uploadArgs.FilePath == "" {
+			return cleanup, fmt.Errorf("could not find an OVF descriptor inside the OVA file")
+		}
+	}
+
+	descriptor, err := os.ReadFile(filepath.Clean(uploadArgs.FilePath))
+	if err != nil {
+		return cleanup, fmt.Errorf("error reading OVF descriptor '%s': %s", uploadArgs.FilePath, err)
+	}
+	patchedDescriptor, err := overrideOvfProperties(string(descriptor), properties)
+	if err != nil {
+		return cleanup, err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "vcfa-ovf-")
+	if err != nil {
+		return cleanup, fmt.Errorf("error creating temporary directory for the OVF descriptor: %s", err)
+	}
+	tmpDirs = append(tmpDirs, tmpDir)
+	patchedPath := filepath.Join(tmpDir, filepath.Base(uploadArgs.FilePath))
+	if err := os.WriteFile(patchedPath, []byte(patchedDescriptor), 0600); err != nil {
+		return cleanup, fmt.Errorf("error writing OVF descriptor '%s': %s", patchedPath, err)
+	}
+	uploadArgs.FilePath = patchedPath
+
+	// The manifest must list the digest of the patched descriptor, and the certificate that signs the original
+	// manifest is not valid anymore, so VCFA would reject the item with any of them unchanged
+	var ovfFilesPaths []string
+	for _, p := range uploadArgs.OvfFilesPaths {
+		switch filepath.Ext(p) {
+		case ".cert":
+			util.Logger.Printf("[DEBUG] not uploading the OVF certificate '%s', as the OVF descriptor was modified", p)
+			continue
+		case ".mf":
+			manifest, err := os.ReadFile(filepath.Clean(p))
+			if err != nil {
+				return cleanup, fmt.Errorf("error reading OVF manifest '%s': %s", p, err)
+			}
+			updatedManifest, err := updateOvfManifest(string(manifest), filepath.Base(patchedPath), []byte(patchedDescriptor))
+			if err != nil {
+				return cleanup, fmt.Errorf("error updating OVF manifest '%s': %s", p, err)
+			}
+			p = filepath.Join(tmpDir, filepath.Base(p))
+			if err := os.WriteFile(p, []byte(updatedManifest), 0600); err != nil {
+				return cleanup, fmt.Errorf("error writing OVF manifest '%s': %s", p, err)
+			}
+		}
+		ovfFilesPaths = append(ovfFilesPaths, p)
+	}
+	uploadArgs.OvfFilesPaths = ovfFilesPaths
+
+	return cleanup, nil
+}
+
+// updateOvfManifest replaces the digest of the given file in the contents of an OVF manifest, computing it with the
+// algorithm of its line. The other lines are kept as they are
+func updateOvfManifest(manifest, fileName string, contents []byte) (string, error) {
+	lines := strings.Split(manifest, "\n")
+	for i, line := range lines {
+		match := ovfManifestLineRegex.FindStringSubmatch(strings.TrimSuffix(line, "\r"))
+		if match == nil || match[2] != fileName {
+			continue
+		}
+		newHash, ok := ovfManifestHashes[strings.ToUpper(match[1])]
+		if !ok {
+			return "", fmt.Errorf("unsupported digest algorithm '%s' for file '%s'", match[1], fileName)
+		}
+		h := newHash()
+		h.Write(contents)
+		lines[i] = fmt.Sprintf("%s(%s)= %s", match[1], fileName, hex.EncodeToString(h.Sum(nil)))
+		if strings.HasSuffix(line, "\r") {
+			lines[i] += "\r"
+		}
+	}
+	return strings.Join(lines, "\n"), nil
+}
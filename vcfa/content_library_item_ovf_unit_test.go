@@ -0,0 +1,160 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+const testOvfDescriptorProductSection = `<ovf:ProductSection ovf:required="false">
+  <ovf:Info>Information about the installed software</ovf:Info>
+  <ovf:Property ovf:key="hostname" ovf:type="string" ovf:userConfigurable="true" ovf:value="localhost">
+    <ovf:Label>Hostname</ovf:Label>
+  </ovf:Property>
+  <ovf:Property ovf:key="dns" ovf:type="string" ovf:userConfigurable="true"/>
+  <Property ovf:key="ntp" ovf:type="string" ovf:value=""/>
+</ovf:ProductSection>`
+
+// TestOverrideOvfProperties checks that OVF ProductSection properties are overridden in the descriptor
+func TestOverrideOvfProperties(t *testing.T) {
+	tests := []struct {
+		name       string
+		properties map[string]string
+		contains   []string
+		wantErr    bool
+	}{
+		{
+			name:       "ReplaceExistingValue",
+			properties: map[string]string{"hostname": "web01"},
+			contains:   []string{`ovf:key="hostname" ovf:type="string" ovf:userConfigurable="true" ovf:value="web01">`},
+		},
+		{
+			name:       "AddMissingValue",
+			properties: map[string]string{"dns": "10.0.0.1"},
+			contains:   []string{`<ovf:Property ovf:key="dns" ovf:value="10.0.0.1" ovf:type="string" ovf:userConfigurable="true"/>`},
+		},
+		{
+			name:       "DefaultNamespace",
+			properties: map[string]string{"ntp": "pool.ntp.org"},
+			contains:   []string{`<Property ovf:key="ntp" ovf:type="string" ovf:value="pool.ntp.org"/>`},
+		},
+		{
+			name:       "EscapedValue",
+			properties: map[string]string{"hostname": `a"b<c`},
+			contains:   []string{`ovf:value="a&#34;b&lt;c"`},
+		},
+		{
+			name:       "MultipleProperties",
+			properties: map[string]string{"hostname": "web01", "dns": "10.0.0.1", "ntp": "pool.ntp.org"},
+			contains:   []string{`ovf:value="web01"`, `ovf:value="10.0.0.1"`, `ovf:value="pool.ntp.org"`},
+		},
+		{
+			name:       "UnknownProperty",
+			properties: map[string]string{"hostname": "web01", "unknown": "value"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := overrideOvfProperties(testOvfDescriptorProductSection, tt.properties)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("overrideOvfProperties() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			for _, expected := range tt.contains {
+				if !strings.Contains(got, expected) {
+					t.Errorf("overrideOvfProperties() result does not contain '%s':\n%s", expected, got)
+				}
+			}
+			if !strings.Contains(got, "<ovf:Label>Hostname</ovf:Label>") {
+				t.Errorf("overrideOvfProperties() modified the rest of the descriptor:\n%s", got)
+			}
+		})
+	}
+}
+
+// TestApplyOvfPropertyOverridesWithManifest checks that the manifest of an OVA lists the digest of the patched OVF
+// descriptor, and that its certificate, which is not valid anymore, is not uploaded
+func TestApplyOvfPropertyOverridesWithManifest(t *testing.T) {
+	descriptor := "<ovf:Envelope>" + testOvfDescriptorProductSection + "</ovf:Envelope>"
+	disk := "disk contents"
+	digest := func(contents string) string {
+		sum := sha256.Sum256([]byte(contents))
+		return hex.EncodeToString(sum[:])
+	}
+	manifest := fmt.Sprintf("SHA256(vm.ovf)= %s\nSHA256(disk.vmdk)= %s\n", digest(descriptor), digest(disk))
+
+	ovaPath := filepath.Join(t.TempDir(), "vm.ova")
+	ovaFile, err := os.Create(ovaPath)
+	if err != nil {
+		t.Fatalf("error creating OVA file: %s", err)
+	}
+	writer := tar.NewWriter(ovaFile)
+	for _, file := range []struct{ name, contents string }{
+		{"vm.ovf", descriptor},
+		{"vm.mf", manifest},
+		{"vm.cert", "certificate"},
+		{"disk.vmdk", disk},
+	} {
+		if err := writer.WriteHeader(&tar.Header{Name: file.name, Mode: 0600, Size: int64(len(file.contents))}); err != nil {
+			t.Fatalf("error writing OVA header: %s", err)
+		}
+		if _, err := writer.Write([]byte(file.contents)); err != nil {
+			t.Fatalf("error writing OVA file: %s", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("error closing OVA: %s", err)
+	}
+	if err := ovaFile.Close(); err != nil {
+		t.Fatalf("error closing OVA file: %s", err)
+	}
+
+	uploadArgs := &govcd.ContentLibraryItemUploadArguments{FilePath: ovaPath}
+	cleanup, err := applyOvfPropertyOverrides(uploadArgs, map[string]string{"hostname": "vm1"})
+	defer cleanup()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	patchedDescriptor, err := os.ReadFile(uploadArgs.FilePath)
+	if err != nil {
+		t.Fatalf("error reading the patched descriptor: %s", err)
+	}
+	if !strings.Contains(string(patchedDescriptor), `ovf:value="vm1"`) {
+		t.Errorf("expected the descriptor to be patched, got %s", patchedDescriptor)
+	}
+
+	var uploadedManifest string
+	for _, p := range uploadArgs.OvfFilesPaths {
+		switch filepath.Base(p) {
+		case "vm.cert":
+			t.Errorf("expected the certificate not to be uploaded")
+		case "vm.mf":
+			contents, err := os.ReadFile(p)
+			if err != nil {
+				t.Fatalf("error reading the manifest: %s", err)
+			}
+			uploadedManifest = string(contents)
+		}
+	}
+	want := fmt.Sprintf("SHA256(vm.ovf)= %s\nSHA256(disk.vmdk)= %s\n", digest(string(patchedDescriptor)), digest(disk))
+	if uploadedManifest != want {
+		t.Errorf("expected manifest %q, got %q", want, uploadedManifest)
+	}
+}
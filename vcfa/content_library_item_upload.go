@@ -0,0 +1,458 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+const (
+	// contentLibraryItemDefaultPieceSize is the size of the pieces when no valid piece size is given, like the SDK does
+	contentLibraryItemDefaultPieceSize int64 = 1024 * 1024
+	// contentLibraryItemPieceRetries is the amount of attempts to upload a single piece of a file before giving up
+	contentLibraryItemPieceRetries = 3
+	// contentLibraryItemFilesPollingRetries is the amount of times the files to upload are polled before giving up
+	contentLibraryItemFilesPollingRetries = 10
+)
+
+// contentLibraryItemPieceRetryDelay is the base delay between the attempts to upload a piece, that grows with every attempt.
+// It is a variable so unit tests can shorten it
+var contentLibraryItemPieceRetryDelay = 2 * time.Second
+
+// createContentLibraryItemWithParallelUpload follows the same steps as govcd.ContentLibrary.CreateContentLibraryItem, but
+// the pieces of every file are uploaded concurrently by 'parallelism' workers, and every piece is retried on failure.
+// Like the SDK, if any step fails the stranded Content Library Item is cleaned up.
+func createContentLibraryItemWithParallelUpload(ctx context.Context, tmClient *VCDClient, cl *govcd.ContentLibrary, config *types.ContentLibraryItem,
+	args govcd.ContentLibraryItemUploadArguments, parallelism int) (*govcd.ContentLibraryItem, error) {
+	// Only OVA files have all the required files packed inside, so they are extracted first
+	if filepath.Ext(args.FilePath) == ".ova" {
+		ovaFiles, tmpDir, err := util.Unpack(args.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("error unpacking OVA file '%s': %s", args.FilePath, err)
+		}
+		defer func() {
+			if err := os.RemoveAll(tmpDir); err != nil {
+				log.Printf("[DEBUG] could not clean up temporary directory %s: %s", tmpDir, err)
+			}
+		}()
+		args.OvfFilesPaths = nil
+		for _, p := range ovaFiles {
+			if filepath.Ext(p) == ".ovf" {
+				args.FilePath = p
+			} else {
+				args.OvfFilesPaths = append(args.OvfFilesPaths, p)
+			}
+		}
+	}
+
+	fileExt := filepath.Ext(args.FilePath)
+	if fileExt != ".iso" && fileExt != ".ovf" {
+		return nil, fmt.Errorf("%s is not a valid ISO/OVF file", args.FilePath)
+	}
+
+	config.ContentLibrary = types.OpenApiReference{ID: cl.ContentLibrary.ID, Name: cl.ContentLibrary.Name}
+	config.ItemType = "TEMPLATE"
+	if fileExt == ".iso" {
+		fileInfo, err := os.Stat(args.FilePath)
+		if err != nil {
+			return nil, err
+		}
+		config.ItemType = "ISO"
+		config.FileUploadSizeBytes = fileInfo.Size()
+	}
+
+	// Create the "skeleton" of the Content Library Item. The files are sent afterward
+	client := &tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf, types.OpenApiEndpointContentLibraryItems)
+	if err != nil {
+		return nil, err
+	}
+	// Only the creation locks the library, so the files of several items can be uploaded at the same time
+	created := &types.ContentLibraryItem{}
+	unlock := lockContentLibrary(cl.ContentLibrary.ID, fmt.Sprintf("creation of %s '%s'", labelVcfaContentLibraryItem, config.Name))
+	err = client.OpenApiPostItem(minVcfaApiVersion, urlRef, nil, config, created, getContentLibraryTenantHeader(cl))
+	unlock()
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s '%s': %s", labelVcfaContentLibraryItem, config.Name, err)
+	}
+
+	uploadErr := uploadContentLibraryItemFiles(ctx, client, created, args, parallelism)
+	if uploadErr != nil {
+		return nil, cleanupContentLibraryItemAfterUploadError(tmClient, created, uploadErr)
+	}
+	cli, err := cl.GetContentLibraryItemById(created.ID)
+	if err != nil {
+		return nil, cleanupContentLibraryItemAfterUploadError(tmClient, created, err)
+	}
+	return cli, nil
+}
+
+// uploadContentLibraryItemFiles uploads the ISO file or the OVF descriptor first and, for templates, the files referenced
+// by the descriptor afterward. Then it waits for the upload task to finish
+func uploadContentLibraryItemFiles(ctx context.Context, client *govcd.Client, cli *types.ContentLibraryItem, args govcd.ContentLibraryItemUploadArguments, parallelism int) error {
+	// This always returns a single file, either the ISO file or the "descriptor.ovf"
+	files, err := getContentLibraryItemPendingFiles(ctx, client, cli, 1)
+	if err != nil {
+		return err
+	}
+	if len(files) != 1 {
+		return fmt.Errorf("expected 1 %s file to upload, got %d", labelVcfaContentLibraryItem, len(files))
+	}
+	if err := uploadContentLibraryItemFileInParallel(ctx, client, files[0], args.FilePath, args.UploadPieceSize, parallelism); err != nil {
+		return err
+	}
+
+	if cli.ItemType == "TEMPLATE" {
+		// Once the descriptor is uploaded, the remaining files are added to the list
+		files, err = getContentLibraryItemPendingFiles(ctx, client, cli, 2)
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			if file.BytesTransferred != 0 && file.ExpectedSizeBytes == file.BytesTransferred {
+				continue
+			}
+			filePath, err := findContentLibraryItemFilePath(file.Name, args.OvfFilesPaths)
+			if err != nil {
+				return err
+			}
+			if err := uploadContentLibraryItemFileInParallel(ctx, client, file, filePath, args.UploadPieceSize, parallelism); err != nil {
+				return err
+			}
+		}
+	}
+
+	task, err := getContentLibraryItemUploadTask(client, cli)
+	if err != nil {
+		return err
+	}
+	if task == nil {
+		// The task does not exist, so the upload has finished already
+		return nil
+	}
+	return waitForTaskCompletion(ctx, task)
+}
+
+// getContentLibraryItemPendingFiles polls the files of the given Content Library Item until at least 'expectedAtLeast'
+// files are returned, as VCFA adds the files to upload next during the upload process
+func getContentLibraryItemPendingFiles(ctx context.Context, client *govcd.Client, cli *types.ContentLibraryItem, expectedAtLeast int) ([]*types.ContentLibraryItemFile, error) {
+	for i := 0; i < contentLibraryItemFilesPollingRetries; i++ {
+		files, err := getContentLibraryItemFiles(client, cli)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) >= expectedAtLeast {
+			return files, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Second):
+		}
+	}
+	return nil, fmt.Errorf("was expecting at least %d files to upload for %s '%s' in %d retries, but failed",
+		expectedAtLeast, labelVcfaContentLibraryItem, cli.Name, contentLibraryItemFilesPollingRetries)
+}
+
+// getContentLibraryItemFiles retrieves the files of the given Content Library Item, with their transfer progress
+func getContentLibraryItemFiles(client *govcd.Client, cli *types.ContentLibraryItem) ([]*types.ContentLibraryItemFile, error) {
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf, fmt.Sprintf(types.OpenApiEndpointContentLibraryItemFiles, cli.ID))
+	if err != nil {
+		return nil, err
+	}
+	var files []*types.ContentLibraryItemFile
+	if err := client.OpenApiGetAllItems(minVcfaApiVersion, urlRef, nil, &files, nil); err != nil {
+		return nil, fmt.Errorf("error retrieving the files of %s '%s': %s", labelVcfaContentLibraryItem, cli.Name, err)
+	}
+	return files, nil
+}
+
+// isContentLibraryItemUploadIncomplete returns true if any of the given files was not fully transferred, or if there are
+// no files at all, which happens when the upload was interrupted right after creating the Content Library Item
+func isContentLibraryItemUploadIncomplete(files []*types.ContentLibraryItemFile) bool {
+	if len(files) == 0 {
+		return true
+	}
+	for _, file := range files {
+		if file.ExpectedSizeBytes > 0 && file.BytesTransferred != file.ExpectedSizeBytes {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanupIncompleteContentLibraryItem removes the Content Library Item with the given name if a previous upload did not finish,
+// for example because Terraform was interrupted, as it would make the creation fail with a name conflict. Items whose files
+// were fully uploaded are never removed. It returns true if an incomplete Content Library Item was removed.
+func cleanupIncompleteContentLibraryItem(ctx context.Context, tmClient *VCDClient, cl *govcd.ContentLibrary, name string, timeout time.Duration) (bool, error) {
+	existing, err := cl.GetContentLibraryItemByName(name)
+	if govcd.ContainsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	cli := existing.ContentLibraryItem
+	if cli.IsSubscribed {
+		// Files of subscribed items are transferred on demand, so they are never incomplete uploads
+		return false, nil
+	}
+	files, err := getContentLibraryItemFiles(&tmClient.VCDClient.Client, cli)
+	if err != nil {
+		return false, err
+	}
+	if !isContentLibraryItemUploadIncomplete(files) {
+		return false, nil
+	}
+
+	log.Printf("[WARN] %s '%s' has an incomplete upload from a previous run, removing it before uploading it again", labelVcfaContentLibraryItem, name)
+	cleanupErr := fmt.Errorf("incomplete upload of %s '%s'", labelVcfaContentLibraryItem, name)
+	if err := cleanupContentLibraryItemAfterUploadError(tmClient, cli, cleanupErr); err != cleanupErr {
+		return false, err
+	}
+
+	// Cancelling the upload task makes VCFA remove the Content Library Item asynchronously
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (any, string, error) {
+			_, err := cl.GetContentLibraryItemById(cli.ID)
+			if govcd.ContainsNotFound(err) {
+				return "", "DELETED", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+			return cli, "DELETING", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return false, fmt.Errorf("error waiting for incomplete %s '%s' to be removed: %s", labelVcfaContentLibraryItem, name, err)
+	}
+	return true, nil
+}
+
+// findContentLibraryItemFilePath returns the local path of the file that VCFA requests with the given name
+func findContentLibraryItemFilePath(name string, filePaths []string) (string, error) {
+	for _, filePath := range filePaths {
+		if filepath.Base(filePath) == name {
+			return filePath, nil
+		}
+	}
+	return "", fmt.Errorf("'%s' not found among the local file paths: %v", name, filePaths)
+}
+
+// uploadContentLibraryItemFileInParallel splits the local file in pieces of 'pieceSize' bytes and sends them to the
+// transfer URL of the given Content Library Item file with 'parallelism' concurrent requests. Every request carries a
+// 'Content-Range' header, so the pieces can arrive in any order. Each piece is retried up to 'contentLibraryItemPieceRetries'
+// times, and the progress is logged as pieces finish.
+func uploadContentLibraryItemFileInParallel(ctx context.Context, client *govcd.Client, file *types.ContentLibraryItemFile, filePath string, pieceSize int64, parallelism int) error {
+	f, err := os.Open(filepath.Clean(filePath))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("[DEBUG] could not close file %s: %s", filePath, err)
+		}
+	}()
+	fileInfo, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	fileSize := file.ExpectedSizeBytes
+	if fileSize <= 0 {
+		fileSize = fileInfo.Size()
+	}
+	if fileSize != fileInfo.Size() {
+		log.Printf("[WARN] file '%s' has %d bytes, but VCFA expects %d bytes for '%s'. The upload may not finish", filePath, fileInfo.Size(), fileSize, file.Name)
+	}
+	if pieceSize <= 1024 {
+		pieceSize = contentLibraryItemDefaultPieceSize
+	}
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	transferUrl, err := url.ParseRequestURI(file.TransferUrl)
+	if err != nil {
+		return fmt.Errorf("error parsing transfer URL of file '%s': %s", file.Name, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	start := time.Now()
+	offsets := make(chan int64)
+	go func() {
+		defer close(offsets)
+		for offset := int64(0); offset < fileSize; offset += pieceSize {
+			select {
+			case offsets <- offset:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var uploadedBytes atomic.Int64
+	var firstErr error
+	var errOnce sync.Once
+	var wg sync.WaitGroup
+	for i := 0; i < parallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			part := make([]byte, pieceSize)
+			for offset := range offsets {
+				size := min(pieceSize, fileSize-offset)
+				if _, err := f.ReadAt(part[:size], offset); err != nil && err != io.EOF {
+					errOnce.Do(func() { firstErr = fmt.Errorf("error reading file '%s': %s", filePath, err) })
+					cancel()
+					return
+				}
+				if err := uploadContentLibraryItemPiece(ctx, client, *transferUrl, part[:size], offset, fileSize); err != nil {
+					errOnce.Do(func() {
+						firstErr = fmt.Errorf("error uploading file '%s' of %s: %s", file.Name, labelVcfaContentLibraryItem, err)
+					})
+					cancel()
+					return
+				}
+				uploaded := uploadedBytes.Add(size)
+				log.Printf("[DEBUG] Uploaded %s file '%s': %d/%d bytes (%d%%)", labelVcfaContentLibraryItem, file.Name, uploaded, fileSize, uploaded*100/fileSize)
+			}
+		}()
+	}
+	wg.Wait()
+	// Partial uploads are recorded too, so the throughput reflects the slow networks that make uploads fail
+	providerMetrics.observeUpload(uploadedBytes.Load(), time.Since(start))
+	return firstErr
+}
+
+// uploadContentLibraryItemPiece sends a single piece of a file, retrying it if the request fails
+func uploadContentLibraryItemPiece(ctx context.Context, client *govcd.Client, transferUrl url.URL, part []byte, offset, fileSize int64) error {
+	var err error
+	for attempt := 1; attempt <= contentLibraryItemPieceRetries; attempt++ {
+		if err = sendContentLibraryItemPiece(ctx, client, transferUrl, part, offset, fileSize); err == nil {
+			providerMetrics.addRetries("upload_piece", attempt-1)
+			return nil
+		}
+		log.Printf("[DEBUG] attempt %d of %d to upload bytes %d-%d failed: %s", attempt, contentLibraryItemPieceRetries, offset, offset+int64(len(part))-1, err)
+		if attempt == contentLibraryItemPieceRetries {
+			providerMetrics.addRetries("upload_piece", attempt-1)
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt) * contentLibraryItemPieceRetryDelay):
+		}
+	}
+	return err
+}
+
+func sendContentLibraryItemPiece(ctx context.Context, client *govcd.Client, transferUrl url.URL, part []byte, offset, fileSize int64) error {
+	request := client.NewRequestWitNotEncodedParams(nil, nil, http.MethodPut, transferUrl, bytes.NewReader(part)).WithContext(ctx)
+	request.ContentLength = int64(len(part))
+	request.Header.Set("Content-Length", strconv.FormatInt(request.ContentLength, 10))
+	request.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+int64(len(part))-1, fileSize))
+
+	response, err := client.Http.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := response.Body.Close(); err != nil {
+			log.Printf("[DEBUG] could not close response body: %s", err)
+		}
+	}()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		body, _ := io.ReadAll(response.Body)
+		return fmt.Errorf("unexpected status %s: %s", response.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// getContentLibraryItemUploadTask returns the running upload task of the given Content Library Item, or nil if there is none
+func getContentLibraryItemUploadTask(client *govcd.Client, cli *types.ContentLibraryItem) (*govcd.Task, error) {
+	taskRecords, err := client.QueryTaskList(map[string]string{
+		"name":       "contentLibraryItemUpload",
+		"status":     "running,preRunning,postRunning,queued,error",
+		"objectType": "contentLibraryItem",
+		"objectName": cli.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	uuid := cli.ID[strings.LastIndex(cli.ID, ":")+1:]
+	for _, tr := range taskRecords {
+		if strings.Contains(tr.Object, uuid) {
+			task, err := client.GetTaskByHREF(tr.HREF)
+			if err != nil && !govcd.ContainsNotFound(err) {
+				return nil, err
+			}
+			return task, nil
+		}
+	}
+	return nil, nil
+}
+
+// cleanupContentLibraryItemAfterUploadError prevents leaving stranded Content Library Items when the upload fails. If the
+// upload task is still running it is cancelled, so VCFA removes the item, otherwise the item is deleted. The requests are
+// not bound to the context of the operation, so the cleanup also runs when the upload failed because it was cancelled
+func cleanupContentLibraryItemAfterUploadError(tmClient *VCDClient, cli *types.ContentLibraryItem, originalErr error) error {
+	unbound := tmClient.withoutContext()
+	task, err := getContentLibraryItemUploadTask(&unbound.VCDClient.Client, cli)
+	if err == nil && task != nil {
+		err = task.CancelTask()
+	} else if err == nil {
+		var item *govcd.ContentLibraryItem
+		item, err = unbound.GetContentLibraryItemById(cli.ID)
+		if govcd.ContainsNotFound(err) {
+			return originalErr
+		}
+		if err == nil {
+			err = item.Delete()
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("the %s creation failed with error: %s\nCleanup of stranded %s also failed: %s",
+			labelVcfaContentLibraryItem, originalErr, labelVcfaContentLibraryItem, err)
+	}
+	return originalErr
+}
+
+// getContentLibraryTenantHeader returns the tenant context headers required to create items in Tenant Content Libraries
+func getContentLibraryTenantHeader(cl *govcd.ContentLibrary) map[string]string {
+	org := cl.ContentLibrary.Org
+	if org == nil || org.Name == "" || strings.EqualFold(org.Name, "system") {
+		return nil
+	}
+	return map[string]string{
+		types.HeaderTenantContext: org.ID[strings.LastIndex(org.ID, ":")+1:],
+		types.HeaderAuthContext:   org.Name,
+	}
+}
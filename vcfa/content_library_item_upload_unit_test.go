@@ -0,0 +1,131 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// TestUploadContentLibraryItemFileInParallel checks that the pieces of a Content Library Item file are uploaded
+// concurrently with their 'Content-Range' headers, and that failed pieces are retried
+func TestUploadContentLibraryItemFileInParallel(t *testing.T) {
+	defer func(delay time.Duration) { contentLibraryItemPieceRetryDelay = delay }(contentLibraryItemPieceRetryDelay)
+	contentLibraryItemPieceRetryDelay = 0
+
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	filePath := filepath.Join(t.TempDir(), "disk.vmdk")
+	if err := os.WriteFile(filePath, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		parallelism int
+		failures    int // Amount of failures of the first piece before it succeeds
+		wantErr     bool
+	}{
+		{name: "Serial", parallelism: 1},
+		{name: "Parallel", parallelism: 4},
+		{name: "RetriedPiece", parallelism: 4, failures: 2},
+		{name: "FailedPiece", parallelism: 4, failures: contentLibraryItemPieceRetries, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			received := make([]byte, len(content))
+			failures := tt.failures
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var start, end, total int
+				if _, err := fmt.Sscanf(r.Header.Get("Content-Range"), "bytes %d-%d/%d", &start, &end, &total); err != nil || total != len(content) {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				body, err := io.ReadAll(r.Body)
+				if err != nil || len(body) != end-start+1 {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				if start == 0 && failures > 0 {
+					failures--
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				copy(received[start:], body)
+			}))
+			defer server.Close()
+
+			file := &types.ContentLibraryItemFile{
+				Name:              "disk.vmdk",
+				ExpectedSizeBytes: int64(len(content)),
+				TransferUrl:       server.URL + "/transfer/disk.vmdk",
+			}
+			client := &govcd.Client{Http: *server.Client()}
+			// The piece size is the minimum allowed, so the file is split in 10 pieces
+			err := uploadContentLibraryItemFileInParallel(context.Background(), client, file, filePath, 1025, tt.parallelism)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("uploadContentLibraryItemFileInParallel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !bytes.Equal(received, content) {
+				t.Errorf("uploaded contents do not match the local file")
+			}
+		})
+	}
+}
+
+// TestFindContentLibraryItemFilePath checks that the files requested by VCFA are matched with the local paths
+func TestFindContentLibraryItemFilePath(t *testing.T) {
+	paths := []string{"/tmp/ovf/descriptor.ovf", "/tmp/ovf/disk1.vmdk"}
+	got, err := findContentLibraryItemFilePath("disk1.vmdk", paths)
+	if err != nil || got != "/tmp/ovf/disk1.vmdk" {
+		t.Errorf("findContentLibraryItemFilePath() = %s, %v, want /tmp/ovf/disk1.vmdk", got, err)
+	}
+	if _, err := findContentLibraryItemFilePath("disk2.vmdk", paths); err == nil {
+		t.Errorf("findContentLibraryItemFilePath() expected an error for a missing file")
+	}
+}
+
+// TestIsContentLibraryItemUploadIncomplete checks the detection of Content Library Items whose upload was interrupted
+func TestIsContentLibraryItemUploadIncomplete(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []*types.ContentLibraryItemFile
+		want  bool
+	}{
+		{name: "NoFiles", want: true},
+		{name: "Complete", files: []*types.ContentLibraryItemFile{
+			{Name: "descriptor.ovf", ExpectedSizeBytes: 100, BytesTransferred: 100},
+			{Name: "disk.vmdk", ExpectedSizeBytes: 1000, BytesTransferred: 1000},
+		}},
+		{name: "UnknownSize", files: []*types.ContentLibraryItemFile{{Name: "descriptor.ovf", ExpectedSizeBytes: -1}}},
+		{name: "Partial", want: true, files: []*types.ContentLibraryItemFile{
+			{Name: "descriptor.ovf", ExpectedSizeBytes: 100, BytesTransferred: 100},
+			{Name: "disk.vmdk", ExpectedSizeBytes: 1000, BytesTransferred: 400},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isContentLibraryItemUploadIncomplete(tt.files); got != tt.want {
+				t.Errorf("isContentLibraryItemUploadIncomplete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
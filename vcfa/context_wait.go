@@ -0,0 +1,140 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// sleepWithContext waits for the given duration, or until the context is cancelled, in which case the context error
+// is returned. It replaces time.Sleep in the polling loops, so Ctrl-C stops them straight away
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// contextTransport binds every request to the given context, so it is cancelled with it. The requests of the SDK are
+// built without a context, so this is how they are cancelled
+type contextTransport struct {
+	ctx     context.Context
+	wrapped http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	wrapped := t.wrapped
+	if wrapped == nil {
+		wrapped = http.DefaultTransport
+	}
+	return wrapped.RoundTrip(request.WithContext(t.ctx))
+}
+
+// WithContext returns a copy of the client whose HTTP requests are bound to the given context, so they are cancelled
+// with it, like with Ctrl-C or at the end of the timeout of the operation. The objects retrieved with the copy send
+// their requests with it too, so they must not be kept after the context ends
+func (cli *VCDClient) WithContext(ctx context.Context) *VCDClient {
+	unbound := cli.withoutContext()
+	vcdClient := *unbound.VCDClient
+	vcdClient.Client.Http.Transport = &contextTransport{ctx: ctx, wrapped: vcdClient.Client.Http.Transport}
+	return &VCDClient{
+		VCDClient:     &vcdClient,
+		SysOrg:        unbound.SysOrg,
+		Org:           unbound.Org,
+		InsecureFlag:  unbound.InsecureFlag,
+		CaCertificate: unbound.CaCertificate,
+		Proxy:         unbound.Proxy,
+		BaseTransport: unbound.BaseTransport,
+		orgScoped:     unbound.orgScoped,
+		unbound:       unbound,
+		boundContext:  ctx,
+	}
+}
+
+// withoutContext returns the client that WithContext copied this one from, whose requests are not bound to any
+// context, or this client if it is not a copy
+func (cli *VCDClient) withoutContext() *VCDClient {
+	if cli.unbound != nil {
+		return cli.unbound
+	}
+	return cli
+}
+
+// bindOperationContext makes every operation of a resource or data source send its requests with a client bound to
+// the context of the operation, so that cancelling it, like with Ctrl-C, cancels the HTTP requests in flight too
+func bindOperationContext(resource *schema.Resource) {
+	bind := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, withOperationContext(ctx, meta))
+		}
+	}
+	resource.CreateContext = bind(resource.CreateContext)
+	resource.ReadContext = bind(resource.ReadContext)
+	resource.UpdateContext = bind(resource.UpdateContext)
+	resource.DeleteContext = bind(resource.DeleteContext)
+	resource.CreateWithoutTimeout = bind(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = bind(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = bind(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = bind(resource.DeleteWithoutTimeout)
+
+	if resource.Importer != nil && resource.Importer.StateContext != nil {
+		importState := resource.Importer.StateContext
+		resource.Importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return importState(ctx, d, withOperationContext(ctx, meta))
+		}
+	}
+}
+
+// withOperationContext returns a copy of the provider meta whose client is bound to the given context
+func withOperationContext(ctx context.Context, meta interface{}) interface{} {
+	container, ok := meta.(ClientContainer)
+	if !ok || container.tmClient == nil {
+		return meta
+	}
+	container.tmClient = container.tmClient.WithContext(ctx)
+	return container
+}
+
+// isContextCancellation returns true if the given error was caused by the cancellation of the operation, like
+// Ctrl-C, or by the end of its timeout
+func isContextCancellation(err error) bool {
+	if err == nil {
+		return false
+	}
+	// Most errors are wrapped with '%s', so the message is checked too
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) ||
+		strings.Contains(err.Error(), context.Canceled.Error()) || strings.Contains(err.Error(), context.DeadlineExceeded.Error())
+}
+
+// interruptedCreationDiagnostics returns the error of a creation that was interrupted after the object was created
+// in VCFA. The resource ID must be set before returning it, so Terraform saves the object as tainted instead of
+// forgetting it, and the next apply replaces it
+func interruptedCreationDiagnostics(label, name string, err error) diag.Diagnostics {
+	reason := "failed"
+	if isContextCancellation(err) {
+		reason = "was interrupted"
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("%s %s was created, but waiting for it to be ready %s: %s", label, name, reason, err),
+		Detail: fmt.Sprintf("Terraform saved %s %s in the state as tainted, so the next apply replaces it. If it "+
+			"becomes ready in the meantime, run 'terraform untaint' to keep it.", label, name),
+	}}
+}
@@ -0,0 +1,120 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// TestSleepWithContext checks that sleeping stops when the context is cancelled
+func TestSleepWithContext(t *testing.T) {
+	if err := sleepWithContext(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	if err := sleepWithContext(ctx, time.Hour); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("expected the sleep to stop straight away")
+	}
+}
+
+// TestWithContext checks that the requests of a client bound to a context are cancelled with it, and that the client
+// it was copied from is not affected
+func TestWithContext(t *testing.T) {
+	unblock := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-unblock:
+			case <-r.Context().Done():
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(unblock)
+
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("error parsing server URL: %s", err)
+	}
+	tmClient := &VCDClient{VCDClient: govcd.NewVCDClient(*serverUrl, true), Org: "org1"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	bound := tmClient.WithContext(ctx)
+	if bound.Org != "org1" || bound.withoutContext() != tmClient {
+		t.Errorf("expected a copy of the client, got %+v", bound)
+	}
+	if rebound := bound.WithContext(context.Background()); rebound.withoutContext() != tmClient {
+		t.Errorf("expected a copy of a bound client to be bound to the new context only")
+	}
+
+	start := time.Now()
+	_, err = bound.Client.Http.Get(server.URL + "/slow")
+	if !isContextCancellation(err) {
+		t.Errorf("expected the request to be cancelled with the context, got %v", err)
+	}
+	if time.Since(start) > 5*time.Second {
+		t.Errorf("expected the request to be cancelled straight away")
+	}
+
+	response, err := tmClient.Client.Http.Get(server.URL + "/fast")
+	if err != nil {
+		t.Fatalf("expected the original client not to be bound to the context, got %s", err)
+	}
+	_ = response.Body.Close()
+}
+
+// TestBindOperationContext checks that the operations receive a client bound to their context
+func TestBindOperationContext(t *testing.T) {
+	tmClient := &VCDClient{VCDClient: govcd.NewVCDClient(url.URL{Scheme: "https", Host: "vcfa.example.com", Path: "/api"}, true)}
+	var received *VCDClient
+	resource := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			received = meta.(ClientContainer).tmClient
+			return nil
+		},
+	}
+	bindOperationContext(resource)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resource.ReadContext(ctx, nil, ClientContainer{tmClient: tmClient})
+	if received == nil || received == tmClient || received.withoutContext() != tmClient || received.boundContext != ctx {
+		t.Errorf("expected the operation to receive a client bound to its context, got %+v", received)
+	}
+}
+
+// TestInterruptedCreationDiagnostics checks that interruptions are told apart from failures, even when wrapped as text
+func TestInterruptedCreationDiagnostics(t *testing.T) {
+	diags := interruptedCreationDiagnostics(labelSupervisorNamespace, "ns1", fmt.Errorf("error waiting: %s", context.Canceled))
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "was interrupted") || !strings.Contains(diags[0].Detail, "tainted") {
+		t.Errorf("unexpected diagnostics of an interruption: %+v", diags)
+	}
+
+	diags = interruptedCreationDiagnostics(labelSupervisorNamespace, "ns1", fmt.Errorf("Supervisor Namespace ns1 is in an ERROR state"))
+	if !strings.Contains(diags[0].Summary, "failed") {
+		t.Errorf("unexpected diagnostics of a failure: %+v", diags)
+	}
+}
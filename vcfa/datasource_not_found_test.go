@@ -53,6 +53,23 @@ func testSpecificDataSourceNotFound(dataSourceName string, tmClient *VCDClient)
 				dataSourceName: "vcfa_kubeconfig",
 				reason:         "Data source vcfa_kubeconfig always returns data, it is not possible to get ENF",
 			},
+			{
+				dataSourceName: "vcfa_supervisors",
+				reason:         "Data source vcfa_supervisors returns an empty list when nothing matches, it is not possible to get ENF",
+			},
+			{
+				// TODO: TM: Retrieving non-existent Supervisor by ID returns 400 and not ENF
+				dataSourceName: "vcfa_supervisor_zones",
+				reason:         "TODO: TM: Retrieving non-existent Supervisor by ID returns 400 and not ENF",
+			},
+			{
+				dataSourceName: "vcfa_site",
+				reason:         "Data source vcfa_site always describes the site of the provider, it is not possible to get ENF",
+			},
+			{
+				dataSourceName: "vcfa_tls_probe",
+				reason:         "Data source vcfa_tls_probe probes an endpoint, it is not possible to get ENF",
+			},
 			{
 				// TODO: TM: Retrieving non-existent Supervisor by ID returns 400 and not ENF
 				dataSourceName: "vcfa_supervisor_zone",
@@ -66,6 +83,26 @@ func testSpecificDataSourceNotFound(dataSourceName string, tmClient *VCDClient)
 				dataSourceName: "vcfa_supervisor_namespace",
 				reason:         "Data source vcfa_supervisor_namespace requires different auth mechanism",
 			},
+			{
+				dataSourceName: "vcfa_provider_general_settings",
+				reason:         "Data source vcfa_provider_general_settings always returns data, it is not possible to get ENF",
+			},
+			{
+				dataSourceName: "vcfa_tm_inventory",
+				reason:         "Data source vcfa_tm_inventory always returns data, it is not possible to get ENF",
+			},
+			{
+				dataSourceName: "vcfa_edge_clusters",
+				reason:         "Data source vcfa_edge_clusters always returns data, it is not possible to get ENF",
+			},
+			{
+				dataSourceName: "vcfa_cci_api_resources",
+				reason:         "Data source vcfa_cci_api_resources always returns data when no group is given, it is not possible to get ENF",
+			},
+			{
+				dataSourceName: "vcfa_vpc",
+				reason:         "Data source vcfa_vpc requires different auth mechanism",
+			},
 		}
 		for _, skip := range skipAlwaysSlice {
 			if dataSourceName == skip.dataSourceName {
@@ -93,14 +130,21 @@ func testSpecificDataSourceNotFound(dataSourceName string, tmClient *VCDClient)
 			"vcfa_region",
 			"vcfa_supervisor",
 			"vcfa_supervisor_zone",
+			"vcfa_supervisors",
+			"vcfa_supervisor_zones",
 			"vcfa_vcenter",
 			"vcfa_ip_space",
+			"vcfa_ip_space_allocation",
 			"vcfa_region_zone",
 			"vcfa_org_region_quota",
 			"vcfa_region_vm_class",
+			"vcfa_region_vm_classes",
+			"vcfa_region_storage_policies",
 			"vcfa_tier0_gateway",
 			"vcfa_content_library",
 			"vcfa_content_library_item",
+			"vcfa_content_library_items",
+			"vcfa_rights",
 		}
 
 		if contains(dataSourcesRequiringSysAdmin, dataSourceName) && !usingSysAdmin() {
@@ -177,6 +221,8 @@ func addMandatoryParams(dataSourceName string, mandatoryFields []string, t *test
 			templateFields = templateFields + `alias = "non-existent-certificate"` + "\n"
 		case "username": // vcfa_org_local_user
 			templateFields = templateFields + `username = "non-existent-local-user"` + "\n"
+		case "ip_space_id":
+			templateFields = templateFields + `ip_space_id = "urn:vcloud:ipSpace:12345678-1234-1234-1234-123456789012"` + "\n"
 		case "org_regional_networking_id":
 			templateFields = templateFields + `org_regional_networking_id = "urn:vcloud:regionalNetworkingSetting:12345678-1234-1234-1234-123456789012"` + "\n"
 		}
@@ -0,0 +1,277 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+const labelVcfaAuditTrail = "Audit Trail"
+
+// auditTrailTimestampFormat is the format of the timestamps sent in the filters of the audit trail
+const auditTrailTimestampFormat = "2006-01-02T15:04:05.000Z"
+
+var dsAuditTrailEventSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the event",
+		},
+		"timestamp": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time of the event",
+		},
+		"event_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of the event, like 'com/vmware/vcloud/event/org/modify'",
+		},
+		"entity_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Type of the entity of the event, taken from the event type, like 'org'",
+		},
+		"action": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Operation of the event, taken from the event type, like 'modify'",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Outcome of the operation, like 'SUCCESS' or 'FAILURE'",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the event",
+		},
+		"user_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the user who performed the operation",
+		},
+		"user_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the user who performed the operation",
+		},
+		"operating_org_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s in which the operation was performed", labelVcfaOrg),
+		},
+		"tenant_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s that owns the entity of the event", labelVcfaOrg),
+		},
+		"additional_properties": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "Details of the event that depend on its type, like the name of the entity",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	},
+}
+
+func datasourceVcfaAuditTrail() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaAuditTrailRead,
+		Schema: map[string]*schema.Schema{
+			"from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only list the events that happened at this time or later, in RFC 3339 format",
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Only list the events that happened at this time or earlier, in RFC 3339 format",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the events of the operations performed by this user",
+			},
+			"entity_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the events of this type of entity, like 'org' or 'vm', as found in the event types",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SUCCESS", "FAILURE"}, false),
+				Description:  "Only list the events with this outcome. One of 'SUCCESS' or 'FAILURE'",
+			},
+			"events": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Events that match the filters, sorted by time",
+				Elem:        dsAuditTrailEventSchema,
+			},
+		},
+	}
+}
+
+// auditTrailFilter contains the criteria that the listed events must meet. Empty fields match any value
+type auditTrailFilter struct {
+	from       string
+	to         string
+	userName   string
+	entityType string
+	status     string
+}
+
+func datasourceVcfaAuditTrailRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	filter := auditTrailFilter{
+		from:       d.Get("from").(string),
+		to:         d.Get("to").(string),
+		userName:   d.Get("user_name").(string),
+		entityType: d.Get("entity_type").(string),
+		status:     d.Get("status").(string),
+	}
+
+	fiql, err := buildAuditTrailFiql(filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	events, err := getAuditTrailEvents(tmClient, fiql)
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaAuditTrail, err)
+	}
+	events = filterAuditTrailEvents(events, filter.entityType)
+
+	eventList := make([]interface{}, len(events))
+	for i, event := range events {
+		eventList[i] = flattenAuditTrailEvent(event)
+	}
+	if err := d.Set("events", eventList); err != nil {
+		return diag.Errorf("error storing 'events': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("from='%s',to='%s',user_name='%s',entity_type='%s',status='%s'",
+		filter.from, filter.to, filter.userName, filter.entityType, filter.status))
+	return nil
+}
+
+// buildAuditTrailFiql converts the filters that VCFA can apply to a FIQL expression. The entity type is not part of
+// it, as it is a segment of the event type, so it is filtered by the provider
+func buildAuditTrailFiql(filter auditTrailFilter) (string, error) {
+	var conditions []string
+	for _, bound := range []struct {
+		value    string
+		operator string
+	}{{filter.from, "=ge="}, {filter.to, "=le="}} {
+		if bound.value == "" {
+			continue
+		}
+		timestamp, err := time.Parse(time.RFC3339, bound.value)
+		if err != nil {
+			return "", fmt.Errorf("invalid time '%s': %s", bound.value, err)
+		}
+		conditions = append(conditions, "timestamp"+bound.operator+timestamp.UTC().Format(auditTrailTimestampFormat))
+	}
+	if filter.userName != "" {
+		conditions = append(conditions, "user.name=="+filter.userName)
+	}
+	if filter.status != "" {
+		conditions = append(conditions, "eventStatus=="+filter.status)
+	}
+	return strings.Join(conditions, ";"), nil
+}
+
+// getAuditTrailEvents retrieves the events of the audit trail that match the given FIQL expression
+func getAuditTrailEvents(tmClient *VCDClient, fiql string) ([]*vcfatypes.AuditTrailEvent, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.AuditTrailEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("sortAsc", "timestamp")
+	if fiql != "" {
+		queryParams.Add("filter", fiql)
+	}
+	var events []*vcfatypes.AuditTrailEvent
+	if err := client.OpenApiGetAllItems(minVcfaApiVersion, urlRef, queryParams, &events, nil); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// parseAuditTrailEventType returns the entity type and the action of an event type, which are its two last
+// segments, like 'org' and 'modify' for 'com/vmware/vcloud/event/org/modify'
+func parseAuditTrailEventType(eventType string) (string, string) {
+	segments := strings.Split(eventType, "/")
+	if len(segments) < 2 {
+		return "", eventType
+	}
+	return segments[len(segments)-2], segments[len(segments)-1]
+}
+
+// filterAuditTrailEvents returns the events of the given entity type, sorted by time. An empty entity type matches
+// all the events
+func filterAuditTrailEvents(events []*vcfatypes.AuditTrailEvent, entityType string) []*vcfatypes.AuditTrailEvent {
+	var filtered []*vcfatypes.AuditTrailEvent
+	for _, event := range events {
+		eventEntityType, _ := parseAuditTrailEventType(event.EventType)
+		if entityType == "" || strings.EqualFold(eventEntityType, entityType) {
+			filtered = append(filtered, event)
+		}
+	}
+	// Sorting to avoid spurious differences in the list order when several pages are retrieved
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Timestamp < filtered[j].Timestamp
+	})
+	return filtered
+}
+
+// flattenAuditTrailEvent converts an event to the format of 'dsAuditTrailEventSchema'
+func flattenAuditTrailEvent(event *vcfatypes.AuditTrailEvent) map[string]interface{} {
+	entityType, action := parseAuditTrailEventType(event.EventType)
+	var userId, userName, operatingOrgName, tenantName string
+	if event.User != nil {
+		userId, userName = event.User.ID, event.User.Name
+	}
+	if event.OperatingOrg != nil {
+		operatingOrgName = event.OperatingOrg.Name
+	}
+	if event.Tenant != nil {
+		tenantName = event.Tenant.Name
+	}
+	return map[string]interface{}{
+		"id":                    event.EventID,
+		"timestamp":             event.Timestamp,
+		"event_type":            event.EventType,
+		"entity_type":           entityType,
+		"action":                action,
+		"status":                event.EventStatus,
+		"description":           event.Description,
+		"user_id":               userId,
+		"user_name":             userName,
+		"operating_org_name":    operatingOrgName,
+		"tenant_name":           tenantName,
+		"additional_properties": event.AdditionalProperties,
+	}
+}
@@ -0,0 +1,93 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// TestBuildAuditTrailFiql checks the conversion of the filters to a FIQL expression
+func TestBuildAuditTrailFiql(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  auditTrailFilter
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "NoFilters",
+			want: "",
+		},
+		{
+			name:   "TimeRangeInUtc",
+			filter: auditTrailFilter{from: "2025-03-01T10:00:00+02:00", to: "2025-03-01T12:30:00Z"},
+			want:   "timestamp=ge=2025-03-01T08:00:00.000Z;timestamp=le=2025-03-01T12:30:00.000Z",
+		},
+		{
+			name:   "UserAndStatus",
+			filter: auditTrailFilter{userName: "admin", status: "FAILURE", entityType: "org"},
+			want:   "user.name==admin;eventStatus==FAILURE",
+		},
+		{
+			name:    "InvalidTime",
+			filter:  auditTrailFilter{from: "yesterday"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildAuditTrailFiql(tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %t, got %v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expected '%s', got '%s'", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestFilterAuditTrailEvents checks the filter by entity type and the sorting by time
+func TestFilterAuditTrailEvents(t *testing.T) {
+	events := []*vcfatypes.AuditTrailEvent{
+		{EventID: "3", EventType: "com/vmware/vcloud/event/org/modify", Timestamp: "2025-03-01T10:00:03.000Z"},
+		{EventID: "1", EventType: "com/vmware/vcloud/event/org/create", Timestamp: "2025-03-01T10:00:01.000Z"},
+		{EventID: "2", EventType: "com/vmware/vcloud/event/vm/deploy", Timestamp: "2025-03-01T10:00:02.000Z"},
+		{EventID: "4", EventType: "login", Timestamp: "2025-03-01T10:00:00.000Z"},
+	}
+
+	tests := []struct {
+		name       string
+		entityType string
+		wantIds    []string
+	}{
+		{name: "All", wantIds: []string{"4", "1", "2", "3"}},
+		{name: "Org", entityType: "org", wantIds: []string{"1", "3"}},
+		{name: "CaseInsensitive", entityType: "VM", wantIds: []string{"2"}},
+		{name: "NoMatch", entityType: "catalog"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered := filterAuditTrailEvents(events, tt.entityType)
+			if len(filtered) != len(tt.wantIds) {
+				t.Fatalf("expected %d events, got %d", len(tt.wantIds), len(filtered))
+			}
+			for i, event := range filtered {
+				if event.EventID != tt.wantIds[i] {
+					t.Errorf("expected event '%s' at position %d, got '%s'", tt.wantIds[i], i, event.EventID)
+				}
+			}
+		})
+	}
+
+	entityType, action := parseAuditTrailEventType("com/vmware/vcloud/event/org/modify")
+	if entityType != "org" || action != "modify" {
+		t.Errorf("unexpected entity type '%s' and action '%s'", entityType, action)
+	}
+}
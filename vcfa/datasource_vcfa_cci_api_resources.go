@@ -0,0 +1,210 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const labelVcfaCciApiResources = "CCI API Resources"
+
+var dsCciApiGroupSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the API group, like 'vpc.nsx.vmware.com'",
+		},
+		"preferred_version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Version of the API group preferred by the server",
+		},
+		"versions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Versions of the API group served, like 'v1alpha1'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	},
+}
+
+var dsCciApiResourceSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"group": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "API group of the resource",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Version of the API group that serves the resource",
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Kind of the resource, like 'VPC'",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Plural name of the resource, used in its API path, like 'vpcs'",
+		},
+		"namespaced": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the resource lives within a Project namespace",
+		},
+		"verbs": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Operations supported by the resource, like 'get', 'list' or 'create'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	},
+}
+
+func datasourceVcfaCciApiResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaCciApiResourcesRead,
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set, only this API group is discovered. Otherwise, all the API groups served by the CCI endpoint are",
+			},
+			"api_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "API groups served by the CCI endpoint, sorted by name",
+				Elem:        dsCciApiGroupSchema,
+			},
+			"api_resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Resources served by every version of the discovered API groups, sorted by group, version and kind",
+				Elem:        dsCciApiResourceSchema,
+			},
+			"kinds": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Description: "Set of '<group>/<version>/<kind>' strings of all the discovered resources, to check easily " +
+					"whether a resource is served with 'contains()'",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func datasourceVcfaCciApiResourcesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	client := tmClient.VCDClient.Client
+	groupFilter := d.Get("group").(string)
+
+	groupsURL, err := client.GetEntityUrl("/apis")
+	if err != nil {
+		return diag.Errorf("error building %s URL: %s", labelVcfaCciApiResources, err)
+	}
+	var groupList v1.APIGroupList
+	if err := client.GetEntity(groupsURL, nil, &groupList, nil); err != nil {
+		return diag.Errorf("error discovering the API groups of the CCI endpoint: %s", err)
+	}
+
+	groups := filterCciApiGroups(groupList.Groups, groupFilter)
+	if groupFilter != "" && len(groups) == 0 {
+		return diag.Errorf("%s: API group '%s' is not served by the CCI endpoint", govcd.ErrorEntityNotFound, groupFilter)
+	}
+
+	apiGroups := make([]interface{}, 0, len(groups))
+	apiResources := make([]interface{}, 0)
+	kinds := make([]string, 0)
+	for _, group := range groups {
+		versions := make([]string, 0, len(group.Versions))
+		for _, version := range group.Versions {
+			versions = append(versions, version.Version)
+
+			resourcesURL, err := client.GetEntityUrl("/apis/" + version.GroupVersion)
+			if err != nil {
+				return diag.Errorf("error building %s URL: %s", labelVcfaCciApiResources, err)
+			}
+			var resourceList v1.APIResourceList
+			if err := client.GetEntity(resourcesURL, nil, &resourceList, nil); err != nil {
+				// An API group version can be advertised while the service behind it is not available
+				if !isMissingEndpointError(err) {
+					return diag.Errorf("error discovering the resources of API group version '%s': %s", version.GroupVersion, err)
+				}
+				warnOptionalEndpointMissing(fmt.Sprintf("API group version '%s'", version.GroupVersion),
+					fmt.Sprintf("Its resources are not listed in %s. %s", labelVcfaCciApiResources, err))
+			}
+			for _, resource := range flattenCciApiResources(group.Name, version.Version, resourceList.APIResources) {
+				apiResources = append(apiResources, resource)
+				kinds = append(kinds, fmt.Sprintf("%s/%s/%s", group.Name, version.Version, resource["kind"]))
+			}
+		}
+		apiGroups = append(apiGroups, map[string]interface{}{
+			"name":              group.Name,
+			"preferred_version": group.PreferredVersion.Version,
+			"versions":          versions,
+		})
+	}
+
+	dSet(d, "api_groups", apiGroups)
+	dSet(d, "api_resources", apiResources)
+	dSet(d, "kinds", kinds)
+	d.SetId(fmt.Sprintf("%s,group='%s'", client.VCDHREF.Host, groupFilter))
+	return nil
+}
+
+// filterCciApiGroups returns the given API groups sorted by name. If a group name is given, only that group is returned
+func filterCciApiGroups(groups []v1.APIGroup, groupFilter string) []v1.APIGroup {
+	result := make([]v1.APIGroup, 0, len(groups))
+	for _, group := range groups {
+		if groupFilter == "" || group.Name == groupFilter {
+			result = append(result, group)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// flattenCciApiResources converts the given resources of an API group version to the format of 'dsCciApiResourceSchema',
+// sorted by kind. Subresources, like 'vpcs/status', are skipped as they are not standalone objects
+func flattenCciApiResources(group, version string, resources []v1.APIResource) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, resource := range resources {
+		if strings.Contains(resource.Name, "/") {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"group":      group,
+			"version":    version,
+			"kind":       resource.Kind,
+			"name":       resource.Name,
+			"namespaced": resource.Namespaced,
+			"verbs":      []string(resource.Verbs),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["kind"].(string) < result[j]["kind"].(string)
+	})
+	return result
+}
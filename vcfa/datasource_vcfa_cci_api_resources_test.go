@@ -0,0 +1,74 @@
+//go:build api || cci || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+)
+
+func TestAccVcfaCciApiResources(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+
+	var params = StringMap{
+		"Group": ccitypes.SupervisorNamespaceAPI,
+		"Tags":  "cci",
+	}
+	testParamsNotEmpty(t, params)
+
+	configText := templateFill(testAccVcfaCciApiResources, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	supervisorNamespaceKind := ccitypes.SupervisorNamespaceAPI + "/" + ccitypes.SupervisorNamespaceVersion + "/" + ccitypes.SupervisorNamespaceKind
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vcfa_cci_api_resources.all", "api_groups.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckTypeSetElemAttr("data.vcfa_cci_api_resources.all", "kinds.*", supervisorNamespaceKind),
+					resource.TestCheckResourceAttr("data.vcfa_cci_api_resources.supervisor_namespaces", "api_groups.#", "1"),
+					resource.TestCheckResourceAttr("data.vcfa_cci_api_resources.supervisor_namespaces", "api_groups.0.name", ccitypes.SupervisorNamespaceAPI),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcfa_cci_api_resources.supervisor_namespaces", "api_resources.*", map[string]string{
+						"kind":       ccitypes.SupervisorNamespaceKind,
+						"name":       "supervisornamespaces",
+						"namespaced": "true",
+					}),
+				),
+			},
+			{
+				Config:      templateFill(testAccVcfaCciApiResourcesNotFound, params),
+				ExpectError: regexp.MustCompile(`is not served by the CCI endpoint`),
+			},
+		},
+	})
+}
+
+const testAccVcfaCciApiResources = `
+data "vcfa_cci_api_resources" "all" {
+}
+
+data "vcfa_cci_api_resources" "supervisor_namespaces" {
+  group = "{{.Group}}"
+}
+`
+
+const testAccVcfaCciApiResourcesNotFound = `
+data "vcfa_cci_api_resources" "not_found" {
+  group = "does-not-exist.vmware.com"
+}
+`
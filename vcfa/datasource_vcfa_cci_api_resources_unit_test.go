@@ -0,0 +1,49 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestFilterCciApiGroups checks that API groups are sorted and filtered by name
+func TestFilterCciApiGroups(t *testing.T) {
+	groups := []v1.APIGroup{{Name: "vpc.nsx.vmware.com"}, {Name: "infrastructure.cci.vmware.com"}, {Name: "project.cci.vmware.com"}}
+
+	all := filterCciApiGroups(groups, "")
+	if len(all) != 3 || all[0].Name != "infrastructure.cci.vmware.com" || all[2].Name != "vpc.nsx.vmware.com" {
+		t.Errorf("filterCciApiGroups() without filter returned %v", all)
+	}
+	filtered := filterCciApiGroups(groups, "vpc.nsx.vmware.com")
+	if len(filtered) != 1 || filtered[0].Name != "vpc.nsx.vmware.com" {
+		t.Errorf("filterCciApiGroups() with filter returned %v", filtered)
+	}
+	if missing := filterCciApiGroups(groups, "does-not-exist"); len(missing) != 0 {
+		t.Errorf("filterCciApiGroups() with unknown filter returned %v", missing)
+	}
+}
+
+// TestFlattenCciApiResources checks that subresources are skipped and resources are sorted by kind
+func TestFlattenCciApiResources(t *testing.T) {
+	resources := []v1.APIResource{
+		{Name: "vpcs", Kind: "VPC", Namespaced: true, Verbs: v1.Verbs{"get", "list"}},
+		{Name: "vpcs/status", Kind: "VPC", Namespaced: true, Verbs: v1.Verbs{"get"}},
+		{Name: "dhcpprofiles", Kind: "DHCPProfile", Namespaced: true, Verbs: v1.Verbs{"create"}},
+	}
+	got := flattenCciApiResources("vpc.nsx.vmware.com", "v1alpha1", resources)
+	if len(got) != 2 {
+		t.Fatalf("flattenCciApiResources() returned %d resources, expected 2", len(got))
+	}
+	if got[0]["kind"] != "DHCPProfile" || got[1]["kind"] != "VPC" {
+		t.Errorf("flattenCciApiResources() returned resources in unexpected order: %v", got)
+	}
+	if got[1]["group"] != "vpc.nsx.vmware.com" || got[1]["version"] != "v1alpha1" || got[1]["name"] != "vpcs" || got[1]["namespaced"] != true {
+		t.Errorf("flattenCciApiResources() returned unexpected values: %v", got[1])
+	}
+}
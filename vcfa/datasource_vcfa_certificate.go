@@ -57,7 +57,7 @@ func datasourceVcfaCertificateRead(_ context.Context, d *schema.ResourceData, me
 	tmClient := meta.(ClientContainer).tmClient
 	alias := d.Get("alias").(string)
 
-	org, err := tmClient.GetTmOrgById(d.Get("org_id").(string))
+	org, err := getCachedTmOrgById(meta, d.Get("org_id").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
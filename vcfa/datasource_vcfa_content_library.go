@@ -79,6 +79,11 @@ func datasourceVcfaContentLibrary() *schema.Resource {
 							Computed:    true,
 							Description: fmt.Sprintf("Subscription url of this %s", labelVcfaContentLibrary),
 						},
+						"need_local_copy": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the content is eagerly downloaded from the publisher and stored locally",
+						},
 					},
 				},
 			},
@@ -126,6 +131,7 @@ func datasourceVcfaContentLibrary() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Status of this %s. Can be 'READY', 'NOT_READY', 'FAILED' or 'PARTIALLY_READY'", labelVcfaContentLibrary),
 			},
+			"metadata_entry": getMetadataEntrySchema(labelVcfaContentLibrary, true),
 		},
 	}
 }
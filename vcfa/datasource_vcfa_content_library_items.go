@@ -0,0 +1,162 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+var dsContentLibraryItemsContentLibraryItemSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelVcfaContentLibraryItem),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelVcfaContentLibraryItem),
+		},
+		"item_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The type of %s, either 'TEMPLATE' or 'ISO'", labelVcfaContentLibraryItem),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Status of the %s", labelVcfaContentLibraryItem),
+		},
+		"image_identifier": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Virtual Machine Identifier (VMI) of the %s", labelVcfaContentLibraryItem),
+		},
+		"version": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Version of the %s", labelVcfaContentLibraryItem),
+		},
+	},
+}
+
+func datasourceVcfaContentLibraryItems() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaContentLibraryItemsRead,
+
+		Schema: map[string]*schema.Schema{
+			"content_library_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s to list the %ss from", labelVcfaContentLibrary, labelVcfaContentLibraryItem),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("Regular expression to filter the %ss by name", labelVcfaContentLibraryItem),
+			},
+			"item_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TEMPLATE", "ISO"}, false),
+				Description:  fmt.Sprintf("Type of the %ss to return, either 'TEMPLATE' or 'ISO'", labelVcfaContentLibraryItem),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Status of the %ss to return, for example 'READY'", labelVcfaContentLibraryItem),
+			},
+			"content_library_items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ss that match the filters", labelVcfaContentLibraryItem),
+				Elem:        dsContentLibraryItemsContentLibraryItemSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaContentLibraryItemsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	clId := d.Get("content_library_id").(string)
+	nameRegex := d.Get("name_regex").(string)
+	itemType := d.Get("item_type").(string)
+	status := d.Get("status").(string)
+
+	cl, err := tmClient.GetContentLibraryById(clId, nil)
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaContentLibrary, err)
+	}
+
+	items, err := cl.GetAllContentLibraryItems(nil)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss of %s '%s': %s", labelVcfaContentLibraryItem, labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+
+	items, err = filterContentLibraryItems(items, nameRegex, itemType, status)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ContentLibraryItem.Name < items[j].ContentLibraryItem.Name
+	})
+
+	itemList := make([]interface{}, len(items))
+	for i, cli := range items {
+		itemList[i] = map[string]interface{}{
+			"id":               cli.ContentLibraryItem.ID,
+			"name":             cli.ContentLibraryItem.Name,
+			"item_type":        cli.ContentLibraryItem.ItemType,
+			"status":           cli.ContentLibraryItem.Status,
+			"image_identifier": cli.ContentLibraryItem.ImageIdentifier,
+			"version":          cli.ContentLibraryItem.Version,
+		}
+	}
+	if err := d.Set("content_library_items", itemList); err != nil {
+		return diag.Errorf("error storing 'content_library_items': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("content_library_id='%s',name_regex='%s',item_type='%s',status='%s'", clId, nameRegex, itemType, status))
+	return nil
+}
+
+// filterContentLibraryItems returns the Content Library Items whose name matches the given regular expression, and whose
+// type and status are the given ones. Empty filters match all the Content Library Items
+func filterContentLibraryItems(items []*govcd.ContentLibraryItem, nameRegex, itemType, status string) ([]*govcd.ContentLibraryItem, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling 'name_regex' '%s': %s", nameRegex, err)
+	}
+	var result []*govcd.ContentLibraryItem
+	for _, cli := range items {
+		if cli == nil || cli.ContentLibraryItem == nil {
+			continue
+		}
+		if !re.MatchString(cli.ContentLibraryItem.Name) {
+			continue
+		}
+		if itemType != "" && cli.ContentLibraryItem.ItemType != itemType {
+			continue
+		}
+		if status != "" && cli.ContentLibraryItem.Status != status {
+			continue
+		}
+		result = append(result, cli)
+	}
+	return result, nil
+}
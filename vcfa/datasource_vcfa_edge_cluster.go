@@ -38,6 +38,11 @@ func datasourceVcfaEdgeCluster() *schema.Resource {
 				Default:     false,
 				Description: fmt.Sprintf("Will trigger SYNC operation before looking for a given %s", labelVcfaEdgeCluster),
 			},
+			"backing_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("ID of the NSX Edge Cluster that backs the %s", labelVcfaEdgeCluster),
+			},
 			"node_count": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -112,6 +117,10 @@ func setTmEdgeClusterData(_ *VCDClient, d *schema.ResourceData, t *govcd.TmEdgeC
 	if t.TmEdgeCluster.RegionRef != nil {
 		dSet(d, "region_id", t.TmEdgeCluster.RegionRef.ID)
 	}
+	dSet(d, "backing_id", "")
+	if t.TmEdgeCluster.BackingRef != nil {
+		dSet(d, "backing_id", t.TmEdgeCluster.BackingRef.ID)
+	}
 	dSet(d, "deployment_type", t.TmEdgeCluster.DeploymentType)
 	dSet(d, "node_count", t.TmEdgeCluster.NodeCount)
 	dSet(d, "org_count", t.TmEdgeCluster.OrgCount)
@@ -0,0 +1,151 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var dsEdgeClustersEdgeClusterSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelVcfaEdgeCluster),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelVcfaEdgeCluster),
+		},
+		"region_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Region ID of the %s", labelVcfaEdgeCluster),
+		},
+		"backing_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the NSX Edge Cluster that backs the %s", labelVcfaEdgeCluster),
+		},
+		"node_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Node count in %s", labelVcfaEdgeCluster),
+		},
+		"org_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Org count %s", labelVcfaEdgeCluster),
+		},
+		"vpc_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("VPC count %s", labelVcfaEdgeCluster),
+		},
+		"health_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Health status of %s", labelVcfaEdgeCluster),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Status of %s", labelVcfaEdgeCluster),
+		},
+		"deployment_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Deployment type of %s", labelVcfaEdgeCluster),
+		},
+	},
+}
+
+func datasourceVcfaEdgeClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaEdgeClustersRead,
+
+		Schema: map[string]*schema.Schema{
+			"region_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Region ID to filter the %ss. All %ss are returned if it is not set", labelVcfaEdgeCluster, labelVcfaEdgeCluster),
+			},
+			"sync_before_read": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: fmt.Sprintf("Will trigger SYNC operation before discovering the %ss", labelVcfaEdgeCluster),
+			},
+			"edge_clusters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of discovered %ss", labelVcfaEdgeCluster),
+				Elem:        dsEdgeClustersEdgeClusterSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaEdgeClustersRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	if err := syncTmEdgeClustersBeforeReadHook(tmClient, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionId := d.Get("region_id").(string)
+	var queryParams url.Values
+	if regionId != "" {
+		queryParams = url.Values{}
+		queryParams.Add("filter", "regionRef.id=="+regionId)
+	}
+
+	edgeClusters, err := tmClient.GetAllTmEdgeClusters(queryParams)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss: %s", labelVcfaEdgeCluster, err)
+	}
+
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(edgeClusters, func(i, j int) bool {
+		return edgeClusters[i].TmEdgeCluster.Name < edgeClusters[j].TmEdgeCluster.Name
+	})
+
+	edgeClusterList := make([]interface{}, len(edgeClusters))
+	for i, ec := range edgeClusters {
+		edgeClusterRegionId, backingId := "", ""
+		if ec.TmEdgeCluster.RegionRef != nil {
+			edgeClusterRegionId = ec.TmEdgeCluster.RegionRef.ID
+		}
+		if ec.TmEdgeCluster.BackingRef != nil {
+			backingId = ec.TmEdgeCluster.BackingRef.ID
+		}
+		edgeClusterList[i] = map[string]interface{}{
+			"id":              ec.TmEdgeCluster.ID,
+			"name":            ec.TmEdgeCluster.Name,
+			"region_id":       edgeClusterRegionId,
+			"backing_id":      backingId,
+			"node_count":      ec.TmEdgeCluster.NodeCount,
+			"org_count":       ec.TmEdgeCluster.OrgCount,
+			"vpc_count":       ec.TmEdgeCluster.VpcCount,
+			"health_status":   ec.TmEdgeCluster.HealthStatus,
+			"status":          ec.TmEdgeCluster.Status,
+			"deployment_type": ec.TmEdgeCluster.DeploymentType,
+		}
+	}
+	if err := d.Set("edge_clusters", edgeClusterList); err != nil {
+		return diag.Errorf("error storing 'edge_clusters': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("region_id='%s'", regionId))
+	return nil
+}
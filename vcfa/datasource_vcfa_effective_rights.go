@@ -0,0 +1,223 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+const labelVcfaEffectiveRights = "Effective Rights"
+
+var effectiveRightsPrincipals = []string{"user_name", "group_name", "role_name"}
+
+func datasourceVcfaEffectiveRights() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaEffectiveRightsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The ID of the %s of the user, group or %s", labelVcfaOrg, labelVcfaRole),
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveRightsPrincipals,
+				Description:  "Name of the user to retrieve the effective rights for, including the ones of the roles inherited from its groups",
+			},
+			"group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveRightsPrincipals,
+				Description:  "Name of the group to retrieve the effective rights for",
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: effectiveRightsPrincipals,
+				Description:  fmt.Sprintf("Name of the %s to retrieve the effective rights for", labelVcfaRole),
+			},
+			"allowed_rights": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Set of %s names that are allowed. Effective rights not in this set are reported in 'excess_rights'", labelVcfaRight),
+			},
+			"role_ids": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("IDs of the %ss that grant the effective rights", labelVcfaRole),
+			},
+			"role_names": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the %ss that grant the effective rights", labelVcfaRole),
+			},
+			"rights": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the effective %ss, the union of the %ss of all the %ss", labelVcfaRight, labelVcfaRight, labelVcfaRole),
+			},
+			"rights_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of effective %ss", labelVcfaRight),
+			},
+			"excess_rights": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Effective rights that are not in 'allowed_rights'. Empty when 'allowed_rights' is not set",
+			},
+		},
+	}
+}
+
+func datasourceVcfaEffectiveRightsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+
+	// TODO: TM: Change to tmClient.GetTmOrgById(orgId), requires implementing Role support for that type
+	org, err := tmClient.GetAdminOrgById(orgId)
+	if err != nil {
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgId, err)
+	}
+
+	id, roles, err := getEffectiveRoles(tmClient, org, d)
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaEffectiveRights, err)
+	}
+
+	roleIds := make([]string, 0, len(roles))
+	roleNames := make([]string, 0, len(roles))
+	rightsPerRole := make(map[string][]string, len(roles))
+	for _, role := range roles {
+		rights, err := role.GetRights(nil)
+		if err != nil {
+			return diag.Errorf("error retrieving %ss of %s '%s': %s", labelVcfaRight, labelVcfaRole, role.Role.Name, err)
+		}
+		roleIds = append(roleIds, role.Role.ID)
+		roleNames = append(roleNames, role.Role.Name)
+		for _, right := range rights {
+			rightsPerRole[role.Role.Name] = append(rightsPerRole[role.Role.Name], right.Name)
+		}
+	}
+
+	var allowedRights []string
+	if allowed, ok := d.GetOk("allowed_rights"); ok {
+		allowedRights = convertSchemaSetToSliceOfStrings(allowed.(*schema.Set))
+	}
+	rights, excessRights := computeEffectiveRights(rightsPerRole, allowedRights)
+
+	d.SetId(id)
+	for key, value := range map[string][]string{
+		"role_ids":      roleIds,
+		"role_names":    roleNames,
+		"rights":        rights,
+		"excess_rights": excessRights,
+	} {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf("error setting '%s' of %s: %s", key, labelVcfaEffectiveRights, err)
+		}
+	}
+	dSet(d, "rights_count", len(rights))
+	return nil
+}
+
+// getEffectiveRoles returns the ID of the user, group or role set in the data source, and the roles that grant its
+// effective rights
+func getEffectiveRoles(tmClient *VCDClient, org *govcd.AdminOrg, d *schema.ResourceData) (string, []*govcd.Role, error) {
+	if roleName := d.Get("role_name").(string); roleName != "" {
+		role, err := org.GetRoleByName(roleName)
+		if err != nil {
+			return "", nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaRole, roleName, err)
+		}
+		return role.Role.ID, []*govcd.Role{role}, nil
+	}
+
+	if groupName := d.Get("group_name").(string); groupName != "" {
+		group, err := org.GetGroupByName(groupName, true)
+		if err != nil {
+			return "", nil, fmt.Errorf("error retrieving group '%s': %s", groupName, err)
+		}
+		if group.Group.Role == nil || group.Group.Role.Name == "" {
+			return group.Group.ID, nil, nil
+		}
+		role, err := org.GetRoleByName(group.Group.Role.Name)
+		if err != nil {
+			return "", nil, fmt.Errorf("error retrieving %s '%s' of group '%s': %s", labelVcfaRole, group.Group.Role.Name, groupName, err)
+		}
+		return group.Group.ID, []*govcd.Role{role}, nil
+	}
+
+	userName := d.Get("user_name").(string)
+	tenantContext, err := getTenantContextFromOrgId(tmClient, org.AdminOrg.ID)
+	if err != nil {
+		return "", nil, err
+	}
+	user, err := tmClient.GetUserByName(userName, tenantContext)
+	if err != nil {
+		return "", nil, fmt.Errorf("error retrieving user '%s': %s", userName, err)
+	}
+	// The effective roles include the ones inherited from the groups of the user, but they are not always returned
+	var roleIds []string
+	for _, reference := range user.User.EffectiveRoleEntityRefs {
+		if reference != nil {
+			roleIds = append(roleIds, reference.ID)
+		}
+	}
+	if len(roleIds) == 0 {
+		roleIds = extractIdsFromOpenApiReferences(user.User.RoleEntityRefs)
+	}
+	roles := make([]*govcd.Role, 0, len(roleIds))
+	for _, roleId := range roleIds {
+		role, err := org.GetRoleById(roleId)
+		if err != nil {
+			return "", nil, fmt.Errorf("error retrieving %s '%s' of user '%s': %s", labelVcfaRole, roleId, userName, err)
+		}
+		roles = append(roles, role)
+	}
+	return user.User.ID, roles, nil
+}
+
+// computeEffectiveRights returns the sorted union of the rights of all the given roles and, if a list of allowed
+// rights is given, the rights that are not in that list
+func computeEffectiveRights(rightsPerRole map[string][]string, allowedRights []string) ([]string, []string) {
+	unique := make(map[string]bool)
+	for _, rights := range rightsPerRole {
+		for _, right := range rights {
+			unique[right] = true
+		}
+	}
+	rights := make([]string, 0, len(unique))
+	for right := range unique {
+		rights = append(rights, right)
+	}
+	sort.Strings(rights)
+
+	if len(allowedRights) == 0 {
+		return rights, []string{}
+	}
+	allowed := make(map[string]bool, len(allowedRights))
+	for _, right := range allowedRights {
+		allowed[right] = true
+	}
+	excessRights := []string{}
+	for _, right := range rights {
+		if !allowed[right] {
+			excessRights = append(excessRights, right)
+		}
+	}
+	return rights, excessRights
+}
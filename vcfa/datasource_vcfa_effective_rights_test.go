@@ -0,0 +1,81 @@
+//go:build tm || role || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVcfaEffectiveRights(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	var params = StringMap{
+		"Testname": t.Name(),
+		"Username": "testeffectiverights",
+		"Tags":     "tm role",
+	}
+	testParamsNotEmpty(t, params)
+
+	configText := templateFill(testAccVcfaEffectiveRights, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION: %s\n", configText)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					// The effective rights of a role are the rights of the role
+					resource.TestCheckResourceAttrPair("data.vcfa_effective_rights.role", "id", "data.vcfa_role.org-user", "id"),
+					resource.TestCheckResourceAttrPair("data.vcfa_effective_rights.role", "rights.#", "data.vcfa_role.org-user", "rights.#"),
+					resource.TestCheckResourceAttrPair("data.vcfa_effective_rights.role", "rights_count", "data.vcfa_role.org-user", "rights.#"),
+					resource.TestCheckResourceAttr("data.vcfa_effective_rights.role", "role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.vcfa_effective_rights.role", "role_names.*", "Organization User"),
+					resource.TestCheckResourceAttr("data.vcfa_effective_rights.role", "excess_rights.#", "0"),
+
+					// The effective rights of a user are the union of the rights of its roles
+					resource.TestCheckResourceAttrPair("data.vcfa_effective_rights.user", "id", "vcfa_org_local_user.test", "id"),
+					resource.TestCheckResourceAttr("data.vcfa_effective_rights.user", "role_ids.#", "2"),
+					resource.TestMatchResourceAttr("data.vcfa_effective_rights.user", "rights_count", regexp.MustCompile(`^[1-9]\d*$`)),
+
+					// Only allowing the rights of 'Organization User', the extra rights of 'Organization Administrator' are reported
+					resource.TestMatchResourceAttr("data.vcfa_effective_rights.user", "excess_rights.#", regexp.MustCompile(`^[1-9]\d*$`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaEffectiveRights = testAccVcfaLocalUserPrerequisites + `
+resource "vcfa_org_local_user" "test" {
+  org_id   = vcfa_org.test.id
+  role_ids = [data.vcfa_role.org-user.id, data.vcfa_role.org-admin.id]
+  username = "{{.Username}}"
+  password = "long-change-ME1"
+}
+
+data "vcfa_effective_rights" "role" {
+  org_id    = vcfa_org.test.id
+  role_name = data.vcfa_role.org-user.name
+}
+
+data "vcfa_effective_rights" "user" {
+  org_id         = vcfa_org.test.id
+  user_name      = vcfa_org_local_user.test.username
+  allowed_rights = data.vcfa_role.org-user.rights
+}
+`
@@ -0,0 +1,58 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestComputeEffectiveRights checks the union of the rights of several roles and the detection of excess rights
+func TestComputeEffectiveRights(t *testing.T) {
+	rightsPerRole := map[string][]string{
+		"Organization Administrator": {"Organization: View", "Organization: Edit Name", "Role: View"},
+		"Organization User":          {"Organization: View", "Catalog: View"},
+	}
+
+	tests := []struct {
+		name          string
+		rightsPerRole map[string][]string
+		allowedRights []string
+		wantRights    []string
+		wantExcess    []string
+	}{
+		{
+			name:       "NoRoles",
+			wantRights: []string{},
+			wantExcess: []string{},
+		},
+		{
+			name:          "Union",
+			rightsPerRole: rightsPerRole,
+			wantRights:    []string{"Catalog: View", "Organization: Edit Name", "Organization: View", "Role: View"},
+			wantExcess:    []string{},
+		},
+		{
+			name:          "ExcessRights",
+			rightsPerRole: rightsPerRole,
+			allowedRights: []string{"Organization: View", "Catalog: View", "Unused: Right"},
+			wantRights:    []string{"Catalog: View", "Organization: Edit Name", "Organization: View", "Role: View"},
+			wantExcess:    []string{"Organization: Edit Name", "Role: View"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rights, excess := computeEffectiveRights(tt.rightsPerRole, tt.allowedRights)
+			if !reflect.DeepEqual(rights, tt.wantRights) {
+				t.Errorf("computeEffectiveRights() rights = %v, want %v", rights, tt.wantRights)
+			}
+			if !reflect.DeepEqual(excess, tt.wantExcess) {
+				t.Errorf("computeEffectiveRights() excess = %v, want %v", excess, tt.wantExcess)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcfaIpSpaceAllocation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaIpSpaceAllocationRead,
+
+		Schema: map[string]*schema.Schema{
+			"ip_space_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the allocated %s", labelVcfaIpSpace),
+			},
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s that receives the %s", labelVcfaOrg, labelVcfaIpSpaceAllocation),
+			},
+			"custom_quota_max_subnet_size": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum subnet size that the %s can allocate, if it overrides the default quota", labelVcfaOrg),
+			},
+			"custom_quota_max_cidr_count": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum number of subnets that the %s can allocate, if it overrides the default quota", labelVcfaOrg),
+			},
+			"custom_quota_max_ip_count": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum number of single floating IP addresses that the %s can allocate, if it overrides the default quota", labelVcfaOrg),
+			},
+			"default_quota_max_subnet_size": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum subnet size of the default quota of the %s", labelVcfaIpSpace),
+			},
+			"default_quota_max_cidr_count": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum number of subnets of the default quota of the %s", labelVcfaIpSpace),
+			},
+			"default_quota_max_ip_count": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum number of single floating IP addresses of the default quota of the %s", labelVcfaIpSpace),
+			},
+		},
+	}
+}
+
+func datasourceVcfaIpSpaceAllocationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	ipSpaceId := d.Get("ip_space_id").(string)
+	orgId := d.Get("org_id").(string)
+
+	assignment, err := getIpSpaceOrgAssignmentByIds(tmClient, ipSpaceId, orgId)
+	if err != nil {
+		return diag.Errorf("error retrieving %s for %s %s and %s %s: %s", labelVcfaIpSpaceAllocation, labelVcfaIpSpace, ipSpaceId, labelVcfaOrg, orgId, err)
+	}
+
+	setIpSpaceAllocationData(d, assignment)
+	return nil
+}
@@ -6,16 +6,10 @@ package vcfa
 
 import (
 	"context"
-	"encoding/json"
-	"errors"
 	"fmt"
-	"strings"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/vmware/go-vcloud-director/v3/ccitypes"
-	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
 )
 
 func datasourceVcfaKubeConfig() *schema.Resource {
@@ -73,96 +67,22 @@ func datasourceVcfaKubeConfig() *schema.Resource {
 func datasourceVcfaKubeConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 
-	clusterName := fmt.Sprintf("%s:%s", tmClient.Org, tmClient.Client.VCDHREF.Host)
-	clusterServer := fmt.Sprintf(ccitypes.KubernetesSubpath, tmClient.Client.VCDHREF.Scheme, tmClient.Client.VCDHREF.Host)
-	contextName := tmClient.Org
-
-	projectName, okProjectName := d.GetOk("project_name")
-	supervisorNamespaceName, okSupervisorNamespace := d.GetOk("supervisor_namespace_name")
-	if okProjectName && okSupervisorNamespace {
-		supervisorNamespace, err := readSupervisorNamespace(tmClient, projectName.(string), supervisorNamespaceName.(string))
-		if err != nil {
-			return diag.Errorf("error reading %s: %s", labelSupervisorNamespace, err)
-		}
-		readyStatus := false
-		for _, condition := range supervisorNamespace.Status.Conditions {
-			if strings.ToLower(condition.Type) == "ready" {
-				if strings.ToLower(condition.Status) == "true" {
-					readyStatus = true
-				}
-				break
-			}
-		}
-		if !readyStatus {
-			return diag.Errorf("%s %s is not in a ready status", labelSupervisorNamespace, supervisorNamespaceName)
-		}
-		if supervisorNamespace.Status.NamespaceEndpointURL == "" {
-			return diag.Errorf("unable to retrieve the endpoint URL for %s %s", labelSupervisorNamespace, supervisorNamespaceName)
-		}
-		clusterName = fmt.Sprintf("%s:%s@%s", tmClient.Org, supervisorNamespaceName.(string), tmClient.Client.VCDHREF.Host)
-		clusterServer = supervisorNamespace.Status.NamespaceEndpointURL
-		contextName = fmt.Sprintf("%s:%s:%s", tmClient.Org, supervisorNamespaceName.(string), projectName.(string))
-	}
-
-	token, _, err := new(jwt.Parser).ParseUnverified(tmClient.Client.VCDToken, jwt.MapClaims{})
+	sessionToken, err := GetSessionToken(tmClient)
 	if err != nil {
-		return diag.Errorf("error parsing JWT token: %s", err)
-	}
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return diag.FromErr(errors.New("could not parse claims from JWT token"))
-	}
-	preferredUsername, ok := claims["preferred_username"].(string)
-	if !ok {
-		return diag.FromErr(errors.New("could not parse preferred username from JWT token claims"))
-	}
-	username := fmt.Sprintf("%s:%s@%s", tmClient.Org, preferredUsername, tmClient.Client.VCDHREF.Host)
-
-	kubeconfig := &clientcmdapi.Config{
-		Kind:       "Config",
-		APIVersion: clientcmdapi.SchemeGroupVersion.Version,
-		Clusters: []clientcmdapi.NamedCluster{{
-			Name: clusterName,
-			Cluster: clientcmdapi.Cluster{
-				InsecureSkipTLSVerify: tmClient.InsecureFlag,
-				Server:                clusterServer,
-			},
-		}},
-		Contexts: []clientcmdapi.NamedContext{
-			{
-				Name: contextName,
-				Context: clientcmdapi.Context{
-					Cluster:  clusterName,
-					AuthInfo: username,
-				},
-			},
-		},
-		AuthInfos: []clientcmdapi.NamedAuthInfo{
-			{
-				Name: username,
-				AuthInfo: clientcmdapi.AuthInfo{
-					Token: token.Raw,
-				},
-			},
-		},
-		CurrentContext: contextName,
-	}
-	if okProjectName && okSupervisorNamespace {
-		kubeconfig.Contexts[0].Context.Namespace = supervisorNamespaceName.(string)
+		return diag.FromErr(err)
 	}
-
-	kubeconfigBytes, err := json.MarshalIndent(kubeconfig, "", "  ")
+	kubeconfig, err := BuildKubeconfig(ctx, tmClient, sessionToken, d.Get("project_name").(string), d.Get("supervisor_namespace_name").(string))
 	if err != nil {
-		return diag.Errorf("error marshaling kubeconfig: %s", err)
+		return diag.FromErr(err)
 	}
 
-	d.SetId(contextName)
-	dSet(d, "host", clusterServer)
-	dSet(d, "insecure_skip_tls_verify", tmClient.InsecureFlag)
-	dSet(d, "token", token.Raw)
-	dSet(d, "user", username)
-	dSet(d, "context_name", contextName)
-	dSet(d, "kube_config_raw", string(kubeconfigBytes))
+	d.SetId(kubeconfig.ContextName)
+	dSet(d, "host", kubeconfig.Host)
+	dSet(d, "insecure_skip_tls_verify", kubeconfig.InsecureSkipTLSVerify)
+	dSet(d, "token", kubeconfig.Token)
+	dSet(d, "user", kubeconfig.User)
+	dSet(d, "context_name", kubeconfig.ContextName)
+	dSet(d, "kube_config_raw", kubeconfig.Raw)
 
 	return nil
 }
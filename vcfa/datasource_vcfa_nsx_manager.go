@@ -66,6 +66,11 @@ func datasourceVcfaNsxManager() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("HREF of %s", labelVcfaNsxManager),
 			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Version of %s", labelVcfaNsxManager),
+			},
 		},
 	}
 }
@@ -23,6 +23,11 @@ func datasourceVcfaOrg() *schema.Resource {
 				Required:    true,
 				Description: fmt.Sprintf("The unique identifier in the full URL with which users log in to this %s", labelVcfaOrg),
 			},
+			"normalized_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s converted to an RFC 1123 Label Name (lowercase alphanumeric characters and hyphens), to be used in Kubernetes names", labelVcfaOrg),
+			},
 			"display_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -93,6 +98,7 @@ func datasourceVcfaOrg() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Defines whether the %s is a classic VRA-style tenant", labelVcfaOrg),
 			},
+			"metadata_entry": getMetadataEntrySchema(labelVcfaOrg, true),
 		},
 	}
 }
@@ -100,9 +106,15 @@ func datasourceVcfaOrg() *schema.Resource {
 func datasourceVcfaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 	c := dsReadConfig[*govcd.TmOrg, types.TmOrg]{
-		entityLabel:    labelVcfaOrg,
-		getEntityFunc:  tmClient.GetTmOrgByName,
-		stateStoreFunc: setOrgData,
+		entityLabel:   labelVcfaOrg,
+		getEntityFunc: tmClient.GetTmOrgByName,
+		stateStoreFunc: func(client *VCDClient, d *schema.ResourceData, org *govcd.TmOrg) error {
+			if err := setOrgData(client, d, org); err != nil {
+				return err
+			}
+			dSet(d, "normalized_name", normalizeRfc1123Name(org.TmOrg.Name))
+			return nil
+		},
 	}
 	return readDatasource(ctx, d, meta, c)
 }
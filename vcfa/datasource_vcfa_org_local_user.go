@@ -35,6 +35,11 @@ func datasourceVcfaLocalUser() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: fmt.Sprintf("%ss to use for %s", labelVcfaRole, labelLocalUser),
 			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s can log in", labelLocalUser),
+			},
 		},
 	}
 }
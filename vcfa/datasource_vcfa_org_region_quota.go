@@ -7,6 +7,7 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -104,6 +105,46 @@ var orgRegionQuotaDsZoneResourceAllocation = &schema.Resource{
 			Computed:    true,
 			Description: "CPU reservation in MHz",
 		},
+		"memory_used_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Memory given to the Supervisor Namespaces of the Organization in MiB",
+		},
+		"memory_reservation_used_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Memory reservation given to the Supervisor Namespaces of the Organization in MiB",
+		},
+		"cpu_used_mhz": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "CPU given to the Supervisor Namespaces of the Organization in MHz",
+		},
+		"cpu_reservation_used_mhz": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "CPU reservation given to the Supervisor Namespaces of the Organization in MHz",
+		},
+		"memory_limit_remaining_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Memory that can still be given to Supervisor Namespaces in MiB",
+		},
+		"memory_reservation_remaining_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Memory reservation that can still be given to Supervisor Namespaces in MiB",
+		},
+		"cpu_limit_remaining_mhz": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "CPU that can still be given to Supervisor Namespaces in MHz",
+		},
+		"cpu_reservation_remaining_mhz": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "CPU reservation that can still be given to Supervisor Namespaces in MHz",
+		},
 	},
 }
 
@@ -134,13 +175,18 @@ var orgRegionQuotaDsRegionStoragePolicy = &schema.Resource{
 			Computed:    true,
 			Description: "Amount of storage used in mebibytes",
 		},
+		"storage_remaining_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Amount of storage that can still be allocated in mebibytes",
+		},
 	},
 }
 
 func datasourceVcfaOrgRegionQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 	getByNameAndOrgId := func(_ string) (*govcd.RegionQuota, error) {
-		region, err := tmClient.GetRegionById(d.Get("region_id").(string))
+		region, err := getCachedRegionById(meta, d.Get("region_id").(string))
 		if err != nil {
 			return nil, err
 		}
@@ -159,6 +205,10 @@ func datasourceVcfaOrgRegionQuotaRead(ctx context.Context, d *schema.ResourceDat
 			if err != nil {
 				return err
 			}
+			err = saveOrgRegionQuotaUsageInState(tmClient, d, outerType)
+			if err != nil {
+				return err
+			}
 			err = saveVmClassesInState(tmClient, d, outerType.TmVdc.ID)
 			if err != nil {
 				return err
@@ -168,3 +218,76 @@ func datasourceVcfaOrgRegionQuotaRead(ctx context.Context, d *schema.ResourceDat
 	}
 	return readDatasource(ctx, d, meta, c)
 }
+
+// saveOrgRegionQuotaUsageInState stores the resource allocations of the Region Quota together with the amount used by
+// the Organization in each Region Zone, and the amount that remains available for new Supervisor Namespaces
+func saveOrgRegionQuotaUsageInState(tmClient *VCDClient, d *schema.ResourceData, rq *govcd.RegionQuota) error {
+	if rq.TmVdc.Org == nil || rq.TmVdc.Region == nil {
+		return fmt.Errorf("%s '%s' has no Organization or Region", labelVcfaOrgRegionQuota, rq.TmVdc.Name)
+	}
+	zoneUsage, err := getOrgRegionZoneUsage(tmClient, rq.TmVdc.Org.ID, rq.TmVdc.Org.Name, rq.TmVdc.Region.ID)
+	if err != nil {
+		return fmt.Errorf("error retrieving usage of %s '%s': %s", labelVcfaOrgRegionQuota, rq.TmVdc.Name, err)
+	}
+
+	zoneCompute := make([]interface{}, len(rq.TmVdc.ZoneResourceAllocation))
+	for zoneIndex, zone := range rq.TmVdc.ZoneResourceAllocation {
+		allocation := zone.ResourceAllocation
+		used := zoneUsage[zone.Zone.ID]
+		if used == nil {
+			used = &types.Zone{}
+		}
+		zoneCompute[zoneIndex] = map[string]interface{}{
+			"region_zone_name":                 zone.Zone.Name,
+			"region_zone_id":                   zone.Zone.ID,
+			"memory_limit_mib":                 allocation.MemoryLimitMiB,
+			"memory_reservation_mib":           allocation.MemoryReservationMiB,
+			"cpu_limit_mhz":                    allocation.CPULimitMHz,
+			"cpu_reservation_mhz":              allocation.CPUReservationMHz,
+			"memory_used_mib":                  used.MemoryUsedMiB,
+			"memory_reservation_used_mib":      used.MemoryReservationUsedMiB,
+			"cpu_used_mhz":                     used.CPUUsedMhz,
+			"cpu_reservation_used_mhz":         used.CPUReservationUsedMhz,
+			"memory_limit_remaining_mib":       remainingCapacity(allocation.MemoryLimitMiB, used.MemoryUsedMiB),
+			"memory_reservation_remaining_mib": remainingCapacity(allocation.MemoryReservationMiB, used.MemoryReservationUsedMiB),
+			"cpu_limit_remaining_mhz":          remainingCapacity(allocation.CPULimitMHz, used.CPUUsedMhz),
+			"cpu_reservation_remaining_mhz":    remainingCapacity(allocation.CPUReservationMHz, used.CPUReservationUsedMhz),
+		}
+	}
+
+	err = d.Set("zone_resource_allocations", schema.NewSet(schema.HashResource(orgRegionQuotaDsZoneResourceAllocation), zoneCompute))
+	if err != nil {
+		return fmt.Errorf("error setting 'zone_resource_allocations' after read: %s", err)
+	}
+	return nil
+}
+
+// getOrgRegionZoneUsage returns the Region Zones of the given Region, indexed by ID, as seen by the given Organization.
+// For tenants, the used values of a Region Zone are the total given to the Supervisor Namespaces of the Organization
+func getOrgRegionZoneUsage(tmClient *VCDClient, orgId, orgName, regionId string) (map[string]*types.Zone, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(types.OpenApiPathVcf + types.OpenApiEndpointZones)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", "region.id=="+regionId)
+
+	var zones []*types.Zone
+	if err := client.OpenApiGetAllItems(minVcfaApiVersion, urlRef, queryParams, &zones, orgSessionHeaders(orgId, orgName)); err != nil {
+		return nil, err
+	}
+
+	zonesById := make(map[string]*types.Zone, len(zones))
+	for _, zone := range zones {
+		zonesById[zone.ID] = zone
+	}
+	return zonesById, nil
+}
+
+// remainingCapacity returns how much of the given limit is still available, which is never negative, as the usage can
+// exceed the limit when the latter is reduced
+func remainingCapacity(limit, used int) int {
+	return max(limit-used, 0)
+}
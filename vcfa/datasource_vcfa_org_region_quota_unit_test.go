@@ -0,0 +1,31 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import "testing"
+
+func TestRemainingCapacity(t *testing.T) {
+	tests := []struct {
+		name  string
+		limit int
+		used  int
+		want  int
+	}{
+		{name: "Unused", limit: 2000, used: 0, want: 2000},
+		{name: "PartiallyUsed", limit: 2000, used: 500, want: 1500},
+		{name: "FullyUsed", limit: 2000, used: 2000, want: 0},
+		// The limit of the Region Quota was reduced below the usage
+		{name: "OverUsed", limit: 1000, used: 1500, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := remainingCapacity(tt.limit, tt.used); got != tt.want {
+				t.Errorf("remainingCapacity(%d, %d) = %d, want %d", tt.limit, tt.used, got, tt.want)
+			}
+		})
+	}
+}
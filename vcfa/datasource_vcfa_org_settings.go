@@ -44,7 +44,7 @@ func datasourceVcfaOrgSettings() *schema.Resource {
 
 func datasourceVcfaOrgSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
-	org, err := tmClient.GetTmOrgById(d.Get("org_id").(string))
+	org, err := getCachedTmOrgById(meta, d.Get("org_id").(string))
 	if err != nil {
 		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
 	}
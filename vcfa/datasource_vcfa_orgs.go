@@ -0,0 +1,239 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+var dsOrgsOrgSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelVcfaOrg),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The unique identifier in the full URL with which users log in to this %s", labelVcfaOrg),
+		},
+		"normalized_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s converted to an RFC 1123 Label Name, to be used in Kubernetes names", labelVcfaOrg),
+		},
+		"display_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Appears in the Cloud application as a human-readable name of the %s", labelVcfaOrg),
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description",
+		},
+		"is_enabled": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: fmt.Sprintf("Defines if the %s enabled", labelVcfaOrg),
+		},
+		"is_classic_tenant": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: fmt.Sprintf("Defines whether the %s is a classic VRA-style tenant", labelVcfaOrg),
+		},
+		"managed_by_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("%s owner ID", labelVcfaOrg),
+		},
+		"managed_by_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("%s owner Name", labelVcfaOrg),
+		},
+	},
+}
+
+var dsOrgsMetadataFilterSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Key of the metadata entry that the Organizations must have",
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Description: "Value of the metadata entry, compared as a string, like '10' or 'true'. If not set, the " +
+				"Organizations only need to have an entry with the given key",
+		},
+	},
+}
+
+func datasourceVcfaOrgs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaOrgsRead,
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("Regular expression to filter the %ss by name", labelVcfaOrg),
+			},
+			"metadata_filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: fmt.Sprintf("Metadata entries that the %ss must have. All of them must match", labelVcfaOrg),
+				Elem:        dsOrgsMetadataFilterSchema,
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the %ss that match the filters, sorted", labelVcfaOrg),
+			},
+			"orgs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ss that match the filters, sorted by name", labelVcfaOrg),
+				Elem:        dsOrgsOrgSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaOrgsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	nameRegex := d.Get("name_regex").(string)
+	metadataFilters := getOrgsMetadataFilters(d)
+
+	orgs, err := tmClient.GetAllTmOrgs(nil)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss: %s", labelVcfaOrg, err)
+	}
+	orgs, err = filterOrgsByName(orgs, nameRegex)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Metadata is retrieved per Organization, so it is only done when there are metadata filters
+	if len(metadataFilters) > 0 {
+		var filtered []*govcd.TmOrg
+		for _, org := range orgs {
+			entries, err := getEntityMetadata(tmClient, org.TmOrg.ID)
+			if err != nil {
+				return diag.Errorf("error retrieving metadata of %s '%s': %s", labelVcfaOrg, org.TmOrg.Name, err)
+			}
+			if matchesMetadataFilters(entries, metadataFilters) {
+				filtered = append(filtered, org)
+			}
+		}
+		orgs = filtered
+	}
+
+	names := make([]string, len(orgs))
+	orgList := make([]interface{}, len(orgs))
+	for i, org := range orgs {
+		names[i] = org.TmOrg.Name
+		var managedById, managedByName string
+		if org.TmOrg.ManagedBy != nil {
+			managedById = org.TmOrg.ManagedBy.ID
+			managedByName = org.TmOrg.ManagedBy.Name
+		}
+		orgList[i] = map[string]interface{}{
+			"id":                org.TmOrg.ID,
+			"name":              org.TmOrg.Name,
+			"normalized_name":   normalizeRfc1123Name(org.TmOrg.Name),
+			"display_name":      org.TmOrg.DisplayName,
+			"description":       org.TmOrg.Description,
+			"is_enabled":        org.TmOrg.IsEnabled,
+			"is_classic_tenant": org.TmOrg.IsClassicTenant,
+			"managed_by_id":     managedById,
+			"managed_by_name":   managedByName,
+		}
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.Errorf("error storing 'names': %s", err)
+	}
+	if err := d.Set("orgs", orgList); err != nil {
+		return diag.Errorf("error storing 'orgs': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	filterStrings := make([]string, len(metadataFilters))
+	for i, filter := range metadataFilters {
+		filterStrings[i] = fmt.Sprintf("%s=%s", filter.key, filter.value)
+	}
+	sort.Strings(filterStrings)
+	d.SetId(fmt.Sprintf("name_regex='%s',metadata_filter='%s'", nameRegex, strings.Join(filterStrings, ",")))
+	return nil
+}
+
+// metadataFilter is a metadata entry that an entity must have. An empty value matches any value of the key
+type metadataFilter struct {
+	key   string
+	value string
+}
+
+func getOrgsMetadataFilters(d *schema.ResourceData) []metadataFilter {
+	var filters []metadataFilter
+	for _, item := range d.Get("metadata_filter").(*schema.Set).List() {
+		filterMap := item.(map[string]interface{})
+		filters = append(filters, metadataFilter{
+			key:   filterMap["key"].(string),
+			value: filterMap["value"].(string),
+		})
+	}
+	return filters
+}
+
+// matchesMetadataFilters returns true if the given metadata entries match all the filters
+func matchesMetadataFilters(entries []*types.OpenApiMetadataEntry, filters []metadataFilter) bool {
+	for _, filter := range filters {
+		found := false
+		for _, entry := range entries {
+			if entry.KeyValue.Key == filter.key && (filter.value == "" || metadataValueString(entry) == filter.value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// filterOrgsByName returns the Organizations whose name matches the given regular expression, sorted by name. An
+// empty expression matches all the Organizations
+func filterOrgsByName(orgs []*govcd.TmOrg, nameRegex string) ([]*govcd.TmOrg, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling 'name_regex' '%s': %s", nameRegex, err)
+	}
+	var filtered []*govcd.TmOrg
+	for _, org := range orgs {
+		if re.MatchString(org.TmOrg.Name) {
+			filtered = append(filtered, org)
+		}
+	}
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].TmOrg.Name < filtered[j].TmOrg.Name
+	})
+	return filtered, nil
+}
@@ -0,0 +1,87 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func TestFilterOrgsByName(t *testing.T) {
+	orgs := []*govcd.TmOrg{
+		{TmOrg: &types.TmOrg{Name: "tenant-b"}},
+		{TmOrg: &types.TmOrg{Name: "System"}},
+		{TmOrg: &types.TmOrg{Name: "tenant-a"}},
+	}
+
+	tests := []struct {
+		name      string
+		nameRegex string
+		want      []string
+		wantErr   bool
+	}{
+		{name: "All", nameRegex: "", want: []string{"System", "tenant-a", "tenant-b"}},
+		{name: "Prefix", nameRegex: "^tenant-", want: []string{"tenant-a", "tenant-b"}},
+		{name: "NoMatch", nameRegex: "^customer$", want: nil},
+		{name: "InvalidRegex", nameRegex: "[", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := filterOrgsByName(orgs, tt.nameRegex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("filterOrgsByName() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			var got []string
+			for _, org := range filtered {
+				got = append(got, org.TmOrg.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterOrgsByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testMetadataEntry(key string, value interface{}) *types.OpenApiMetadataEntry {
+	return &types.OpenApiMetadataEntry{
+		KeyValue: types.OpenApiMetadataKeyValue{Key: key, Value: types.OpenApiMetadataTypedValue{Value: value}},
+	}
+}
+
+func TestMatchesMetadataFilters(t *testing.T) {
+	entries := []*types.OpenApiMetadataEntry{
+		testMetadataEntry("cost-center", "1234"),
+		testMetadataEntry("tier", float64(2)),
+		testMetadataEntry("billable", true),
+	}
+
+	tests := []struct {
+		name    string
+		filters []metadataFilter
+		want    bool
+	}{
+		{name: "NoFilters", filters: nil, want: true},
+		{name: "String", filters: []metadataFilter{{key: "cost-center", value: "1234"}}, want: true},
+		{name: "Number", filters: []metadataFilter{{key: "tier", value: "2"}}, want: true},
+		{name: "Boolean", filters: []metadataFilter{{key: "billable", value: "true"}}, want: true},
+		{name: "KeyOnly", filters: []metadataFilter{{key: "tier"}}, want: true},
+		{name: "All", filters: []metadataFilter{{key: "tier", value: "2"}, {key: "billable", value: "true"}}, want: true},
+		{name: "WrongValue", filters: []metadataFilter{{key: "tier", value: "3"}}, want: false},
+		{name: "MissingKey", filters: []metadataFilter{{key: "owner"}}, want: false},
+		{name: "OneMismatch", filters: []metadataFilter{{key: "tier", value: "2"}, {key: "billable", value: "false"}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesMetadataFilters(entries, tt.filters); got != tt.want {
+				t.Errorf("matchesMetadataFilters() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcfaProviderGeneralSettings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaProviderGeneralSettingsRead,
+		Schema: map[string]*schema.Schema{
+			"session_timeout_minutes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Idle time, in minutes, after which a session is terminated",
+			},
+			"absolute_session_timeout_minutes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum lifetime, in minutes, of a session regardless of activity",
+			},
+			"api_explorer_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the API Explorer is available to users",
+			},
+			"show_stack_traces": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether error responses include stack traces",
+			},
+		},
+	}
+}
+
+func datasourceVcfaProviderGeneralSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceVcfaProviderGeneralSettingsRead(ctx, d, meta)
+}
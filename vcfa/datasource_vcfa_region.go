@@ -24,6 +24,11 @@ func datasourceVcfaRegion() *schema.Resource {
 				Required:    true,
 				Description: fmt.Sprintf("%s name", labelVcfaRegion),
 			},
+			"normalized_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s converted to an RFC 1123 Label Name (lowercase alphanumeric characters and hyphens), to be used in Kubernetes names", labelVcfaRegion),
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -75,6 +80,7 @@ func datasourceVcfaRegion() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"metadata_entry": getMetadataEntrySchema(labelVcfaRegion, true),
 		},
 	}
 }
@@ -82,9 +88,15 @@ func datasourceVcfaRegion() *schema.Resource {
 func datasourceVcfaRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 	c := dsReadConfig[*govcd.Region, types.Region]{
-		entityLabel:    labelVcfaRegion,
-		getEntityFunc:  tmClient.GetRegionByName,
-		stateStoreFunc: setRegionData,
+		entityLabel:   labelVcfaRegion,
+		getEntityFunc: tmClient.GetRegionByName,
+		stateStoreFunc: func(client *VCDClient, d *schema.ResourceData, region *govcd.Region) error {
+			if err := setRegionData(client, d, region); err != nil {
+				return err
+			}
+			dSet(d, "normalized_name", normalizeRfc1123Name(region.Region.Name))
+			return nil
+		},
 	}
 	return readDatasource(ctx, d, meta, c)
 }
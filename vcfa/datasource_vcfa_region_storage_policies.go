@@ -0,0 +1,156 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+var dsRegionStoragePoliciesPolicySchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelVcfaRegionStoragePolicy),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelVcfaRegionStoragePolicy),
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Description of the %s", labelVcfaRegionStoragePolicy),
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The creation status of the %s. Can be [NOT_READY, READY]", labelVcfaRegionStoragePolicy),
+		},
+		"storage_capacity_mb": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Storage capacity in megabytes for this %s", labelVcfaRegionStoragePolicy),
+		},
+		"storage_consumed_mb": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Consumed storage in megabytes for this %s", labelVcfaRegionStoragePolicy),
+		},
+	},
+}
+
+func datasourceVcfaRegionStoragePolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaRegionStoragePoliciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s whose %ss are returned", labelVcfaRegion, labelVcfaRegionStoragePolicy),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("Regular expression to filter the %ss by name", labelVcfaRegionStoragePolicy),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the %ss that match the filters, sorted", labelVcfaRegionStoragePolicy),
+			},
+			"storage_policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ss that match the filters, sorted by name", labelVcfaRegionStoragePolicy),
+				Elem:        dsRegionStoragePoliciesPolicySchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaRegionStoragePoliciesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	regionId := d.Get("region_id").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	region, err := getCachedRegionById(meta, regionId)
+	if err != nil {
+		return diag.Errorf("error retrieving %s with ID '%s': %s", labelVcfaRegion, regionId, err)
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", "region.id=="+region.Region.ID)
+	allPolicies, err := tmClient.GetAllRegionStoragePolicies(queryParams)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss of %s '%s': %s", labelVcfaRegionStoragePolicy, labelVcfaRegion, region.Region.Name, err)
+	}
+
+	policies := make([]*types.RegionStoragePolicy, len(allPolicies))
+	for i, policy := range allPolicies {
+		policies[i] = policy.RegionStoragePolicy
+	}
+	policies, err = filterRegionStoragePolicies(policies, nameRegex)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	names := make([]string, len(policies))
+	policyList := make([]interface{}, len(policies))
+	for i, policy := range policies {
+		names[i] = policy.Name
+		policyList[i] = map[string]interface{}{
+			"id":                  policy.ID,
+			"name":                policy.Name,
+			"description":         policy.Description,
+			"status":              policy.Status,
+			"storage_capacity_mb": int(policy.StorageCapacityMB),
+			"storage_consumed_mb": int(policy.StorageConsumedMB),
+		}
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.Errorf("error storing 'names': %s", err)
+	}
+	if err := d.Set("storage_policies", policyList); err != nil {
+		return diag.Errorf("error storing 'storage_policies': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("region_id='%s',name_regex='%s'", regionId, nameRegex))
+	return nil
+}
+
+// filterRegionStoragePolicies returns the Region Storage Policies whose name matches the given regular expression,
+// sorted by name. An empty expression matches all the policies
+func filterRegionStoragePolicies(policies []*types.RegionStoragePolicy, nameRegex string) ([]*types.RegionStoragePolicy, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling 'name_regex' '%s': %s", nameRegex, err)
+	}
+	var filtered []*types.RegionStoragePolicy
+	for _, policy := range policies {
+		if re.MatchString(policy.Name) {
+			filtered = append(filtered, policy)
+		}
+	}
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Name < filtered[j].Name
+	})
+	return filtered, nil
+}
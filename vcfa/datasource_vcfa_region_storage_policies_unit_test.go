@@ -0,0 +1,49 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func TestFilterRegionStoragePolicies(t *testing.T) {
+	items := []*types.RegionStoragePolicy{
+		{Name: "vsan-default-storage-policy"},
+		{Name: "gold"},
+		{Name: "vsan-esa-policy"},
+	}
+
+	tests := []struct {
+		name      string
+		nameRegex string
+		want      []string
+		wantErr   bool
+	}{
+		{name: "All", nameRegex: "", want: []string{"gold", "vsan-default-storage-policy", "vsan-esa-policy"}},
+		{name: "Prefix", nameRegex: "^vsan-", want: []string{"vsan-default-storage-policy", "vsan-esa-policy"}},
+		{name: "NoMatch", nameRegex: "^platinum$", want: nil},
+		{name: "InvalidRegex", nameRegex: "[", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := filterRegionStoragePolicies(items, tt.nameRegex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("filterRegionStoragePolicies() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			var got []string
+			for _, item := range filtered {
+				got = append(got, item.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterRegionStoragePolicies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,9 +7,11 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 	"github.com/vmware/go-vcloud-director/v3/types/v56"
+	"github.com/vmware/go-vcloud-director/v3/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,14 +53,41 @@ func datasourceVcfaRegionStoragePolicy() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Consumed storage in megabytes for this %s", labelVcfaRegionStoragePolicy),
 			},
+			"storage_class_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("ID of the %s created for this %s", labelVcfaStorageClass, labelVcfaRegionStoragePolicy),
+			},
+			"vcenter_storage_policy": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("vCenter storage policies backing this %s, which can be used with the vSphere provider", labelVcfaRegionStoragePolicy),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vcenter_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("ID of the %s that contains the storage policy", labelVcfaVirtualCenter),
+						},
+						"storage_policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the storage policy in vCenter",
+						},
+						"storage_policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the storage policy in vCenter",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func datasourceVcfaRegionStoragePolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tmClient := meta.(ClientContainer).tmClient
-
-	region, err := tmClient.GetRegionById(d.Get("region_id").(string))
+	region, err := getCachedRegionById(meta, d.Get("region_id").(string))
 	if err != nil {
 		return diag.Errorf("error retrieving %s with ID '%s: %s", labelVcfaRegion, d.Get("region_id").(string), err)
 	}
@@ -73,7 +102,7 @@ func datasourceVcfaRegionStoragePolicyRead(ctx context.Context, d *schema.Resour
 	return readDatasource(ctx, d, meta, c)
 }
 
-func setRegionStoragePolicyData(_ *VCDClient, d *schema.ResourceData, rsp *govcd.RegionStoragePolicy) error {
+func setRegionStoragePolicyData(tmClient *VCDClient, d *schema.ResourceData, rsp *govcd.RegionStoragePolicy) error {
 	if rsp == nil || rsp.RegionStoragePolicy == nil {
 		return fmt.Errorf("provided %s is nil", labelVcfaRegionStoragePolicy)
 	}
@@ -88,8 +117,77 @@ func setRegionStoragePolicyData(_ *VCDClient, d *schema.ResourceData, rsp *govcd
 	dSet(d, "storage_capacity_mb", rsp.RegionStoragePolicy.StorageCapacityMB)
 	dSet(d, "storage_consumed_mb", rsp.RegionStoragePolicy.StorageConsumedMB)
 	dSet(d, "status", rsp.RegionStoragePolicy.Status)
+	dSet(d, "storage_class_id", getRegionStoragePolicyStorageClassId(tmClient, rsp.RegionStoragePolicy))
+
+	err := d.Set("vcenter_storage_policy", getRegionStoragePolicyVcenterPolicies(tmClient, rsp.RegionStoragePolicy))
+	if err != nil {
+		return fmt.Errorf("error storing 'vcenter_storage_policy': %s", err)
+	}
 
 	d.SetId(rsp.RegionStoragePolicy.ID)
 
 	return nil
 }
+
+// getRegionStoragePolicyVcenterPolicies finds the vCenter storage policies that back the given Region Storage Policy,
+// by looking at the vCenters of all the Supervisors in the Region. The lookup is best-effort, as this information
+// is only informative, and any error is logged instead of being returned
+func getRegionStoragePolicyVcenterPolicies(tmClient *VCDClient, rsp *types.RegionStoragePolicy) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	if rsp.Region == nil {
+		return result
+	}
+
+	region, err := tmClient.GetRegionById(rsp.Region.ID)
+	if err != nil {
+		util.Logger.Printf("[DEBUG] could not retrieve %s '%s' of %s '%s': %s", labelVcfaRegion, rsp.Region.ID, labelVcfaRegionStoragePolicy, rsp.Name, err)
+		return result
+	}
+
+	vcenterIds := make([]string, 0)
+	for _, supervisorRef := range region.Region.Supervisors {
+		supervisor, err := tmClient.GetSupervisorById(supervisorRef.ID)
+		if err != nil {
+			util.Logger.Printf("[DEBUG] could not retrieve Supervisor '%s': %s", supervisorRef.ID, err)
+			continue
+		}
+		if supervisor.Supervisor.VirtualCenter != nil && !contains(vcenterIds, supervisor.Supervisor.VirtualCenter.ID) {
+			vcenterIds = append(vcenterIds, supervisor.Supervisor.VirtualCenter.ID)
+		}
+	}
+
+	for _, vcenterId := range vcenterIds {
+		vc, err := tmClient.GetVCenterById(vcenterId)
+		if err != nil {
+			util.Logger.Printf("[DEBUG] could not retrieve %s '%s': %s", labelVcfaVirtualCenter, vcenterId, err)
+			continue
+		}
+		storageProfiles, err := vc.GetAllStorageProfiles("", nil)
+		if err != nil {
+			util.Logger.Printf("[DEBUG] could not retrieve storage policies of %s '%s': %s", labelVcfaVirtualCenter, vcenterId, err)
+			continue
+		}
+		for _, sp := range storageProfiles {
+			if !regionStoragePolicyNameMatches(sp.StorageProfile.Name, rsp.Name) {
+				continue
+			}
+			result = append(result, map[string]interface{}{
+				"vcenter_id":          vcenterId,
+				"storage_policy_id":   sp.StorageProfile.Moref,
+				"storage_policy_name": sp.StorageProfile.Name,
+			})
+		}
+	}
+
+	return result
+}
+
+// regionStoragePolicyNameMatches checks whether a vCenter storage policy name corresponds to a Region Storage Policy
+// name. Region Storage Policy names follow RFC 1123 Label Names, so vCenter names are normalized before comparing them
+func regionStoragePolicyNameMatches(vcenterPolicyName, regionPolicyName string) bool {
+	if strings.EqualFold(vcenterPolicyName, regionPolicyName) {
+		return true
+	}
+	normalized := strings.Trim(rfc1123InvalidCharactersRegex.ReplaceAllString(strings.ToLower(vcenterPolicyName), "-"), "-")
+	return normalized == regionPolicyName
+}
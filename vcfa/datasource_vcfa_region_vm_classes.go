@@ -0,0 +1,153 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+var dsRegionVmClassesVmClassSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelVcfaRegionVmClass),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelVcfaRegionVmClass),
+		},
+		"cpu_reservation_mhz": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("CPU that a Virtual Machine reserves when this %s is applied", labelVcfaRegionVmClass),
+		},
+		"memory_reservation_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Memory in MiB that a Virtual Machine reserves when this %s is applied", labelVcfaRegionVmClass),
+		},
+		"cpu_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Number of CPUs that a Virtual Machine gets when this %s is applied", labelVcfaRegionVmClass),
+		},
+		"memory_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Memory in MiB that a Virtual Machine gets when this %s is applied", labelVcfaRegionVmClass),
+		},
+		"reserved": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: fmt.Sprintf("Whether this %s can be used to reserve number of its instances within a namespace", labelVcfaRegionVmClass),
+		},
+	},
+}
+
+func datasourceVcfaRegionVmClasses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaRegionVmClassesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s whose %ses are returned", labelVcfaRegion, labelVcfaRegionVmClass),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("Regular expression to filter the %ses by name", labelVcfaRegionVmClass),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the %ses that match the filters, sorted", labelVcfaRegionVmClass),
+			},
+			"vm_classes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ses that match the filters, sorted by name", labelVcfaRegionVmClass),
+				Elem:        dsRegionVmClassesVmClassSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaRegionVmClassesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	regionId := d.Get("region_id").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	region, err := getCachedRegionById(meta, regionId)
+	if err != nil {
+		return diag.Errorf("error retrieving %s with ID '%s': %s", labelVcfaRegion, regionId, err)
+	}
+
+	vmClasses, err := region.GetAllVmClasses(nil)
+	if err != nil {
+		return diag.Errorf("error retrieving %ses of %s '%s': %s", labelVcfaRegionVmClass, labelVcfaRegion, region.Region.Name, err)
+	}
+	vmClasses, err = filterRegionVmClasses(vmClasses, nameRegex)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	names := make([]string, len(vmClasses))
+	vmClassList := make([]interface{}, len(vmClasses))
+	for i, vmClass := range vmClasses {
+		names[i] = vmClass.Name
+		vmClassList[i] = map[string]interface{}{
+			"id":                     vmClass.ID,
+			"name":                   vmClass.Name,
+			"cpu_reservation_mhz":    vmClass.CpuReservationMHz,
+			"memory_reservation_mib": vmClass.MemoryReservationMiB,
+			"cpu_count":              vmClass.CpuCount,
+			"memory_mib":             vmClass.MemoryMiB,
+			"reserved":               vmClass.Reserved,
+		}
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.Errorf("error storing 'names': %s", err)
+	}
+	if err := d.Set("vm_classes", vmClassList); err != nil {
+		return diag.Errorf("error storing 'vm_classes': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("region_id='%s',name_regex='%s'", regionId, nameRegex))
+	return nil
+}
+
+// filterRegionVmClasses returns the Region VM Classes whose name matches the given regular expression, sorted by name.
+// An empty expression matches all the VM Classes
+func filterRegionVmClasses(vmClasses []*types.RegionVirtualMachineClass, nameRegex string) ([]*types.RegionVirtualMachineClass, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling 'name_regex' '%s': %s", nameRegex, err)
+	}
+	var filtered []*types.RegionVirtualMachineClass
+	for _, vmClass := range vmClasses {
+		if re.MatchString(vmClass.Name) {
+			filtered = append(filtered, vmClass)
+		}
+	}
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Name < filtered[j].Name
+	})
+	return filtered, nil
+}
@@ -0,0 +1,49 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func TestFilterRegionVmClasses(t *testing.T) {
+	items := []*types.RegionVirtualMachineClass{
+		{Name: "best-effort-small"},
+		{Name: "guaranteed-large"},
+		{Name: "best-effort-large"},
+	}
+
+	tests := []struct {
+		name      string
+		nameRegex string
+		want      []string
+		wantErr   bool
+	}{
+		{name: "All", nameRegex: "", want: []string{"best-effort-large", "best-effort-small", "guaranteed-large"}},
+		{name: "Prefix", nameRegex: "^best-effort-", want: []string{"best-effort-large", "best-effort-small"}},
+		{name: "NoMatch", nameRegex: "^platinum$", want: nil},
+		{name: "InvalidRegex", nameRegex: "[", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := filterRegionVmClasses(items, tt.nameRegex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("filterRegionVmClasses() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			var got []string
+			for _, item := range filtered {
+				got = append(got, item.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterRegionVmClasses() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -66,8 +66,7 @@ func datasourceVcfaRegionZone() *schema.Resource {
 }
 
 func resourceVcfaRegionZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tmClient := meta.(ClientContainer).tmClient
-	region, err := tmClient.GetRegionById(d.Get("region_id").(string))
+	region, err := getCachedRegionById(meta, d.Get("region_id").(string))
 	if err != nil {
 		return diag.Errorf("error retrieving %s: %s", labelVcfaRegion, err)
 	}
@@ -0,0 +1,548 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// resourceListItem is an existing object that can be imported into a resource
+type resourceListItem struct {
+	name     string
+	id       string
+	importId string
+	// config contains the arguments and nested blocks of the resource that manages the object, for the resource
+	// types that support configuration generation
+	config hclBlock
+}
+
+// resourceLister lists the existing objects of a resource type. When 'parentLabel' is set, the objects are listed
+// from the parent with the given name, which is mandatory. When 'generatesConfig' is set, the listed objects contain
+// the skeleton configuration of their resources
+type resourceLister struct {
+	parentLabel     string
+	generatesConfig bool
+	list            func(tmClient *VCDClient, parent string) ([]resourceListItem, error)
+}
+
+// resourceListers contains the resource types supported by the 'vcfa_resource_list' data source
+var resourceListers = map[string]resourceLister{
+	"vcfa_org":                  {generatesConfig: true, list: listOrgsForImport},
+	"vcfa_region":               {generatesConfig: true, list: listRegionsForImport},
+	"vcfa_vcenter":              {list: listVcentersForImport},
+	"vcfa_nsx_manager":          {list: listNsxManagersForImport},
+	"vcfa_global_role":          {list: listGlobalRolesForImport},
+	"vcfa_rights_bundle":        {list: listRightsBundlesForImport},
+	"vcfa_ip_space":             {parentLabel: labelVcfaRegion, list: listIpSpacesForImport},
+	"vcfa_provider_gateway":     {parentLabel: labelVcfaRegion, list: listProviderGatewaysForImport},
+	"vcfa_content_library":      {parentLabel: labelVcfaOrg, generatesConfig: true, list: listContentLibrariesForImport},
+	"vcfa_org_local_user":       {parentLabel: labelVcfaOrg, list: listLocalUsersForImport},
+	"vcfa_role":                 {parentLabel: labelVcfaOrg, list: listRolesForImport},
+	"vcfa_supervisor_namespace": {parentLabel: "Project", generatesConfig: true, list: listSupervisorNamespacesForImport},
+}
+
+var dsResourceListResourceSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the object",
+		},
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the object, the same as the one of the resource once imported",
+		},
+		"import_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID to import the object, to be used in 'terraform import' or in an 'import' block",
+		},
+	},
+}
+
+func datasourceVcfaResourceList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaResourceListRead,
+		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(supportedResourceListTypes(), false),
+				Description:  fmt.Sprintf("Type of the resource to list the importable objects of. One of %s", strings.Join(supportedResourceListTypes(), ", ")),
+			},
+			"parent": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: fmt.Sprintf("Name of the parent of the objects, required for some resource types: the %s for "+
+					"'vcfa_ip_space' and 'vcfa_provider_gateway', the %s for 'vcfa_content_library' ('System' for Provider "+
+					"Content Libraries), 'vcfa_org_local_user' and 'vcfa_role', and the Project for 'vcfa_supervisor_namespace'",
+					labelVcfaRegion, labelVcfaOrg),
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Objects that can be imported, sorted by name",
+				Elem:        dsResourceListResourceSchema,
+			},
+			"import_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs to import the objects, sorted by name",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"import_blocks": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "An 'import' block for each object, to be written to a file and used with " +
+					"'terraform plan -generate-config-out' (Terraform 1.5+)",
+			},
+			"generate_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: fmt.Sprintf("Whether to generate a skeleton 'resource' block for each object in 'generated_config'. "+
+					"Supported for %s", strings.Join(supportedResourceListConfigTypes(), ", ")),
+			},
+			"generated_config": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "A 'resource' block for each object, with the arguments read from VCFA, when 'generate_config' " +
+					"is set. It must be reviewed before use, as it doesn't contain the arguments that VCFA doesn't return",
+			},
+		},
+	}
+}
+
+func datasourceVcfaResourceListRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	resourceType := d.Get("resource_type").(string)
+	parent := d.Get("parent").(string)
+
+	lister := resourceListers[resourceType]
+	if lister.parentLabel != "" && parent == "" {
+		return diag.Errorf("'parent' must be set to the name of the %s to list the objects of '%s'", lister.parentLabel, resourceType)
+	}
+	if lister.parentLabel == "" && parent != "" {
+		return diag.Errorf("'parent' can't be set for '%s', whose objects have no parent", resourceType)
+	}
+	generateConfig := d.Get("generate_config").(bool)
+	if generateConfig && !lister.generatesConfig {
+		return diag.Errorf("'generate_config' is not supported for '%s'. It is supported for %s", resourceType,
+			strings.Join(supportedResourceListConfigTypes(), ", "))
+	}
+
+	items, err := lister.list(tmClient, parent)
+	if err != nil {
+		return diag.Errorf("error listing the objects of '%s': %s", resourceType, err)
+	}
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].name < items[j].name
+	})
+
+	resources := make([]interface{}, len(items))
+	importIds := make([]string, len(items))
+	for i, item := range items {
+		resources[i] = map[string]interface{}{
+			"name":      item.name,
+			"id":        item.id,
+			"import_id": item.importId,
+		}
+		importIds[i] = item.importId
+	}
+	if err := d.Set("resources", resources); err != nil {
+		return diag.Errorf("error storing 'resources': %s", err)
+	}
+	if err := d.Set("import_ids", importIds); err != nil {
+		return diag.Errorf("error storing 'import_ids': %s", err)
+	}
+	resourceNames := buildResourceListResourceNames(items)
+	dSet(d, "import_blocks", buildImportBlocks(resourceType, resourceNames, items))
+	generatedConfig := ""
+	if generateConfig {
+		generatedConfig = buildResourceBlocks(resourceType, resourceNames, items)
+	}
+	dSet(d, "generated_config", generatedConfig)
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("resource_type='%s',parent='%s'", resourceType, parent))
+	return nil
+}
+
+// supportedResourceListTypes returns the sorted resource types supported by the 'vcfa_resource_list' data source
+func supportedResourceListTypes() []string {
+	resourceTypes := make([]string, 0, len(resourceListers))
+	for resourceType := range resourceListers {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+	return resourceTypes
+}
+
+// supportedResourceListConfigTypes returns the sorted resource types whose configuration can be generated by the
+// 'vcfa_resource_list' data source
+func supportedResourceListConfigTypes() []string {
+	var resourceTypes []string
+	for _, resourceType := range supportedResourceListTypes() {
+		if resourceListers[resourceType].generatesConfig {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	return resourceTypes
+}
+
+// invalidResourceNameCharsRegex matches the characters that are not allowed in the names of Terraform resources, and
+// validResourceNameStartRegex the names that start with an allowed character
+var (
+	invalidResourceNameCharsRegex = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+	validResourceNameStartRegex   = regexp.MustCompile(`^[a-zA-Z_]`)
+)
+
+// buildResourceListResourceNames returns the names of the resources of the given objects in the generated blocks. They
+// are taken from the object names, replacing the characters that Terraform doesn't allow, and adding a suffix to the
+// duplicated ones
+func buildResourceListResourceNames(items []resourceListItem) []string {
+	resourceNames := make([]string, len(items))
+	usedNames := map[string]int{}
+	for i, item := range items {
+		resourceName := strings.Trim(invalidResourceNameCharsRegex.ReplaceAllString(item.name, "_"), "_")
+		if resourceName == "" || !validResourceNameStartRegex.MatchString(resourceName) {
+			resourceName = "r_" + resourceName
+		}
+		usedNames[resourceName]++
+		if usedNames[resourceName] > 1 {
+			resourceName = fmt.Sprintf("%s_%d", resourceName, usedNames[resourceName])
+		}
+		resourceNames[i] = resourceName
+	}
+	return resourceNames
+}
+
+// buildImportBlocks returns an 'import' block for each of the given objects
+func buildImportBlocks(resourceType string, resourceNames []string, items []resourceListItem) string {
+	blocks := make([]string, len(items))
+	for i, item := range items {
+		blocks[i] = fmt.Sprintf("import {\n  to = %s.%s\n  id = %s\n}\n", resourceType, resourceNames[i], hclString(item.importId))
+	}
+	return strings.Join(blocks, "\n")
+}
+
+// buildResourceBlocks returns a 'resource' block for each of the given objects, with the configuration set by the
+// lister of the resource type
+func buildResourceBlocks(resourceType string, resourceNames []string, items []resourceListItem) string {
+	blocks := make([]string, len(items))
+	for i, item := range items {
+		block := item.config
+		block.blockType = "resource"
+		block.labels = []string{resourceType, resourceNames[i]}
+		var sb strings.Builder
+		writeHclBlock(&sb, block, "")
+		blocks[i] = sb.String()
+	}
+	return strings.Join(blocks, "\n")
+}
+
+func listOrgsForImport(tmClient *VCDClient, _ string) ([]resourceListItem, error) {
+	orgs, err := tmClient.GetAllTmOrgs(nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]resourceListItem, len(orgs))
+	for i, org := range orgs {
+		items[i] = resourceListItem{name: org.TmOrg.Name, id: org.TmOrg.ID, importId: org.TmOrg.Name}
+		items[i].config.arguments = []hclArgument{
+			{"name", org.TmOrg.Name},
+			{"display_name", org.TmOrg.DisplayName},
+			{"description", org.TmOrg.Description},
+			{"is_enabled", org.TmOrg.IsEnabled},
+			{"is_classic_tenant", org.TmOrg.IsClassicTenant},
+		}
+	}
+	return items, nil
+}
+
+func listRegionsForImport(tmClient *VCDClient, _ string) ([]resourceListItem, error) {
+	regions, err := tmClient.GetAllRegions(nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]resourceListItem, len(regions))
+	for i, region := range regions {
+		items[i] = resourceListItem{name: region.Region.Name, id: region.Region.ID, importId: region.Region.Name}
+		nsxManagerId := ""
+		if region.Region.NsxManager != nil {
+			nsxManagerId = region.Region.NsxManager.ID
+		}
+		supervisorIds := make([]string, len(region.Region.Supervisors))
+		for j, supervisor := range region.Region.Supervisors {
+			supervisorIds[j] = supervisor.ID
+		}
+		items[i].config.arguments = []hclArgument{
+			{"name", region.Region.Name},
+			{"description", region.Region.Description},
+			{"nsx_manager_id", nsxManagerId},
+			{"supervisor_ids", supervisorIds},
+			{"storage_policy_names", region.Region.StoragePolicies},
+		}
+	}
+	return items, nil
+}
+
+func listVcentersForImport(tmClient *VCDClient, _ string) ([]resourceListItem, error) {
+	vcenters, err := tmClient.GetAllVCenters(nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]resourceListItem, len(vcenters))
+	for i, vcenter := range vcenters {
+		items[i] = resourceListItem{name: vcenter.VSphereVCenter.Name, id: vcenter.VSphereVCenter.VcId, importId: vcenter.VSphereVCenter.Name}
+	}
+	return items, nil
+}
+
+func listNsxManagersForImport(tmClient *VCDClient, _ string) ([]resourceListItem, error) {
+	nsxManagers, err := tmClient.GetAllNsxtManagersOpenApi(nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]resourceListItem, len(nsxManagers))
+	for i, nsxManager := range nsxManagers {
+		items[i] = resourceListItem{name: nsxManager.NsxtManagerOpenApi.Name, id: nsxManager.NsxtManagerOpenApi.ID, importId: nsxManager.NsxtManagerOpenApi.Name}
+	}
+	return items, nil
+}
+
+func listGlobalRolesForImport(tmClient *VCDClient, _ string) ([]resourceListItem, error) {
+	globalRoles, err := tmClient.Client.GetAllGlobalRoles(nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]resourceListItem, len(globalRoles))
+	for i, globalRole := range globalRoles {
+		items[i] = resourceListItem{name: globalRole.GlobalRole.Name, id: globalRole.GlobalRole.Id, importId: globalRole.GlobalRole.Name}
+	}
+	return items, nil
+}
+
+func listRightsBundlesForImport(tmClient *VCDClient, _ string) ([]resourceListItem, error) {
+	rightsBundles, err := tmClient.Client.GetAllRightsBundles(nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]resourceListItem, len(rightsBundles))
+	for i, rightsBundle := range rightsBundles {
+		items[i] = resourceListItem{name: rightsBundle.RightsBundle.Name, id: rightsBundle.RightsBundle.Id, importId: buildImportId(rightsBundle.RightsBundle.Name)}
+	}
+	return items, nil
+}
+
+func listIpSpacesForImport(tmClient *VCDClient, regionName string) ([]resourceListItem, error) {
+	region, err := tmClient.GetRegionByName(regionName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaRegion, regionName, err)
+	}
+	ipSpaces, err := tmClient.GetAllTmIpSpaces(nil)
+	if err != nil {
+		return nil, err
+	}
+	var items []resourceListItem
+	for _, ipSpace := range ipSpaces {
+		if ipSpace.TmIpSpace.RegionRef.ID != region.Region.ID {
+			continue
+		}
+		items = append(items, resourceListItem{
+			name:     ipSpace.TmIpSpace.Name,
+			id:       ipSpace.TmIpSpace.ID,
+			importId: buildImportId(region.Region.Name, ipSpace.TmIpSpace.Name),
+		})
+	}
+	return items, nil
+}
+
+func listProviderGatewaysForImport(tmClient *VCDClient, regionName string) ([]resourceListItem, error) {
+	region, err := tmClient.GetRegionByName(regionName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaRegion, regionName, err)
+	}
+	providerGateways, err := tmClient.GetAllTmProviderGateways(nil)
+	if err != nil {
+		return nil, err
+	}
+	var items []resourceListItem
+	for _, providerGateway := range providerGateways {
+		if providerGateway.TmProviderGateway.RegionRef.ID != region.Region.ID {
+			continue
+		}
+		items = append(items, resourceListItem{
+			name:     providerGateway.TmProviderGateway.Name,
+			id:       providerGateway.TmProviderGateway.ID,
+			importId: buildImportId(region.Region.Name, providerGateway.TmProviderGateway.Name),
+		})
+	}
+	return items, nil
+}
+
+func listContentLibrariesForImport(tmClient *VCDClient, orgName string) ([]resourceListItem, error) {
+	var tenantContext *govcd.TenantContext
+	orgId := ""
+	if !strings.EqualFold(orgName, "system") {
+		org, err := tmClient.GetTmOrgByName(orgName)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgName, err)
+		}
+		orgId = org.TmOrg.ID
+		tenantContext = &govcd.TenantContext{OrgId: org.TmOrg.ID, OrgName: org.TmOrg.Name}
+	}
+	contentLibraries, err := tmClient.GetAllContentLibraries(nil, tenantContext)
+	if err != nil {
+		return nil, err
+	}
+	var items []resourceListItem
+	for _, contentLibrary := range contentLibraries {
+		// Tenants can see the Provider Content Libraries, and providers the Tenant ones, which are imported
+		// with another parent
+		libraryOrgId := ""
+		if contentLibrary.ContentLibrary.Org != nil {
+			libraryOrgId = contentLibrary.ContentLibrary.Org.ID
+		}
+		if libraryOrgId != orgId {
+			continue
+		}
+		storageClassIds := make([]string, len(contentLibrary.ContentLibrary.StorageClasses))
+		for j, storageClass := range contentLibrary.ContentLibrary.StorageClasses {
+			storageClassIds[j] = storageClass.ID
+		}
+		arguments := []hclArgument{{"name", contentLibrary.ContentLibrary.Name}}
+		if libraryOrgId != "" {
+			arguments = append(arguments, hclArgument{"org_id", libraryOrgId})
+		}
+		arguments = append(arguments,
+			hclArgument{"description", contentLibrary.ContentLibrary.Description},
+			hclArgument{"storage_class_ids", storageClassIds},
+			hclArgument{"auto_attach", contentLibrary.ContentLibrary.AutoAttach},
+		)
+		items = append(items, resourceListItem{
+			name:     contentLibrary.ContentLibrary.Name,
+			id:       contentLibrary.ContentLibrary.ID,
+			importId: buildImportId(orgName, contentLibrary.ContentLibrary.Name),
+			config:   hclBlock{arguments: arguments},
+		})
+	}
+	return items, nil
+}
+
+func listLocalUsersForImport(tmClient *VCDClient, orgName string) ([]resourceListItem, error) {
+	org, err := tmClient.GetTmOrgByName(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgName, err)
+	}
+	users, err := tmClient.GetAllUsers(nil, &govcd.TenantContext{OrgId: org.TmOrg.ID, OrgName: org.TmOrg.Name})
+	if err != nil {
+		return nil, err
+	}
+	var items []resourceListItem
+	for _, user := range users {
+		// Users of external identity providers are not managed with 'vcfa_org_local_user'
+		if user.User.ProviderType != "" && user.User.ProviderType != "LOCAL" {
+			continue
+		}
+		items = append(items, resourceListItem{
+			name:     user.User.Username,
+			id:       user.User.ID,
+			importId: buildImportId(org.TmOrg.Name, user.User.Username),
+		})
+	}
+	return items, nil
+}
+
+func listRolesForImport(tmClient *VCDClient, orgName string) ([]resourceListItem, error) {
+	adminOrg, err := tmClient.GetAdminOrgByName(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgName, err)
+	}
+	roles, err := adminOrg.GetAllRoles(nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]resourceListItem, len(roles))
+	for i, role := range roles {
+		items[i] = resourceListItem{
+			name:     role.Role.Name,
+			id:       role.Role.ID,
+			importId: buildImportId(adminOrg.AdminOrg.Name, role.Role.Name),
+		}
+	}
+	return items, nil
+}
+
+func listSupervisorNamespacesForImport(tmClient *VCDClient, projectName string) ([]resourceListItem, error) {
+	supervisorNamespaces, err := listSupervisorNamespaces(tmClient, projectName)
+	if err != nil {
+		return nil, projectAccessError(tmClient, projectName, err)
+	}
+	items := make([]resourceListItem, len(supervisorNamespaces))
+	for i, supervisorNamespace := range supervisorNamespaces {
+		items[i] = resourceListItem{
+			name:     supervisorNamespace.Name,
+			id:       buildResourceId(projectName, supervisorNamespace.Name),
+			importId: buildImportId(projectName, supervisorNamespace.Name),
+			config:   buildSupervisorNamespaceConfig(projectName, supervisorNamespace),
+		}
+	}
+	return items, nil
+}
+
+// buildSupervisorNamespaceConfig returns the configuration of a 'vcfa_supervisor_namespace' resource for the given
+// Supervisor Namespace. Its name is generated by VCFA, so the prefix that it was generated from is used
+func buildSupervisorNamespaceConfig(projectName string, supervisorNamespace ccitypes.SupervisorNamespace) hclBlock {
+	namePrefix := supervisorNamespace.GenerateName
+	if namePrefix == "" {
+		namePrefix = supervisorNamespace.Name
+	}
+	spec := supervisorNamespace.Spec
+	block := hclBlock{
+		arguments: []hclArgument{
+			{"name_prefix", namePrefix},
+			{"project_name", projectName},
+			{"class_name", spec.ClassName},
+			{"description", spec.Description},
+			{"region_name", spec.RegionName},
+			{"vpc_name", spec.VpcName},
+		},
+	}
+	for _, storageClass := range spec.ClassConfigOverrides.StorageClasses {
+		block.nestedBlocks = append(block.nestedBlocks, hclBlock{
+			blockType: "storage_classes_class_config_overrides",
+			arguments: []hclArgument{
+				{"limit", storageClass.Limit},
+				{"name", storageClass.Name},
+			},
+		})
+	}
+	for _, zone := range spec.ClassConfigOverrides.Zones {
+		block.nestedBlocks = append(block.nestedBlocks, hclBlock{
+			blockType: "zones_class_config_overrides",
+			arguments: []hclArgument{
+				{"cpu_limit", zone.CpuLimit},
+				{"cpu_reservation", zone.CpuReservation},
+				{"memory_limit", zone.MemoryLimit},
+				{"memory_reservation", zone.MemoryReservation},
+				{"name", zone.Name},
+			},
+		})
+	}
+	return block
+}
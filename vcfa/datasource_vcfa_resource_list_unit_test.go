@@ -0,0 +1,83 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import "testing"
+
+// TestBuildImportBlocks checks that the resource names of the import blocks are valid and unique
+func TestBuildImportBlocks(t *testing.T) {
+	items := []resourceListItem{
+		{name: "my org", importId: "my org"},
+		{name: "my.org", importId: "my.org"},
+		{name: "1st-org", importId: "1st-org"},
+		{name: "***", importId: "***"},
+	}
+	want := `import {
+  to = vcfa_org.my_org
+  id = "my org"
+}
+
+import {
+  to = vcfa_org.my_org_2
+  id = "my.org"
+}
+
+import {
+  to = vcfa_org.r_1st-org
+  id = "1st-org"
+}
+
+import {
+  to = vcfa_org.r_
+  id = "***"
+}
+`
+	if got := buildImportBlocks("vcfa_org", buildResourceListResourceNames(items), items); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+// TestBuildResourceBlocks checks the format of the generated configuration
+func TestBuildResourceBlocks(t *testing.T) {
+	items := []resourceListItem{
+		{
+			name: "ns1",
+			config: hclBlock{
+				arguments: []hclArgument{
+					{"name_prefix", "ns"},
+					{"description", "Costs ${100}"},
+					{"enabled", true},
+					{"zone_names", []string{"zone1", "zone2"}},
+				},
+				nestedBlocks: []hclBlock{
+					{blockType: "storage", arguments: []hclArgument{{"limit", "10Gi"}, {"name", "default"}}},
+					{blockType: "storage", arguments: []hclArgument{{"limit", "5Gi"}, {"name", "fast"}}},
+				},
+			},
+		},
+	}
+	want := `resource "vcfa_supervisor_namespace" "ns1" {
+  name_prefix = "ns"
+  description = "Costs $${100}"
+  enabled     = true
+  zone_names  = ["zone1", "zone2"]
+
+  storage {
+    limit = "10Gi"
+    name  = "default"
+  }
+
+  storage {
+    limit = "5Gi"
+    name  = "fast"
+  }
+}
+`
+	if got := buildResourceBlocks("vcfa_supervisor_namespace", buildResourceListResourceNames(items), items); got != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+	}
+}
@@ -58,3 +58,53 @@ data "vcfa_right" "right" {
   name = "{{.Name}}"
 }
 `
+
+func TestAccVcfaRights(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	var params = StringMap{
+		"NameRegex": "^Organization: ",
+		"RightType": "MODIFY",
+		"Tags":      "tm role",
+	}
+	testParamsNotEmpty(t, params)
+
+	configText := templateFill(testAccVcfaRights, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION: %s\n", configText)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	datasourceName := "data.vcfa_rights.rights"
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "id", "name_regex='^Organization: ',category_id='',right_type='MODIFY'"),
+					resource.TestMatchResourceAttr(datasourceName, "rights.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestCheckTypeSetElemAttr(datasourceName, "names.*", "Organization: Edit Limits"),
+					resource.TestCheckTypeSetElemNestedAttrs(datasourceName, "rights.*", map[string]string{
+						"name":       "Organization: Edit Limits",
+						"right_type": "MODIFY",
+					}),
+					resource.TestMatchResourceAttr(datasourceName, "rights.0.id", regexp.MustCompile(`^urn:vcloud:right:.+$`)),
+					resource.TestMatchResourceAttr(datasourceName, "rights.0.name", regexp.MustCompile(`^Organization: `)),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaRights = `
+data "vcfa_rights" "rights" {
+  name_regex = "{{.NameRegex}}"
+  right_type = "{{.RightType}}"
+}
+`
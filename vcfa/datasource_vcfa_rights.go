@@ -0,0 +1,156 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+var dsRightsRightSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelVcfaRight),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelVcfaRight),
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("%s description", labelVcfaRight),
+		},
+		"category_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the category for this %s", labelVcfaRight),
+		},
+		"right_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Type of the %s, either 'VIEW' or 'MODIFY'", labelVcfaRight),
+		},
+	},
+}
+
+func datasourceVcfaRights() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaRightsRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("Regular expression to filter the %ss by name", labelVcfaRight),
+			},
+			"category_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("ID of the category of the %ss to return", labelVcfaRight),
+			},
+			"right_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"VIEW", "MODIFY"}, false),
+				Description:  fmt.Sprintf("Type of the %ss to return, either 'VIEW' or 'MODIFY'", labelVcfaRight),
+			},
+			"names": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the %ss that match the filters", labelVcfaRight),
+			},
+			"rights": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ss that match the filters", labelVcfaRight),
+				Elem:        dsRightsRightSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaRightsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	nameRegex := d.Get("name_regex").(string)
+	categoryId := d.Get("category_id").(string)
+	rightType := d.Get("right_type").(string)
+
+	rights, err := tmClient.Client.GetAllRights(nil)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss: %s", labelVcfaRight, err)
+	}
+
+	rights, err = filterRights(rights, nameRegex, categoryId, rightType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(rights, func(i, j int) bool {
+		return rights[i].Name < rights[j].Name
+	})
+
+	names := make([]string, len(rights))
+	rightList := make([]interface{}, len(rights))
+	for i, right := range rights {
+		names[i] = right.Name
+		rightList[i] = map[string]interface{}{
+			"id":          right.ID,
+			"name":        right.Name,
+			"description": right.Description,
+			"category_id": right.Category,
+			"right_type":  right.RightType,
+		}
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.Errorf("error storing 'names': %s", err)
+	}
+	if err := d.Set("rights", rightList); err != nil {
+		return diag.Errorf("error storing 'rights': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("name_regex='%s',category_id='%s',right_type='%s'", nameRegex, categoryId, rightType))
+	return nil
+}
+
+// filterRights returns the Rights whose name matches the given regular expression, and whose category and type are
+// the given ones. Empty filters match all the Rights
+func filterRights(rights []*types.Right, nameRegex, categoryId, rightType string) ([]*types.Right, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling 'name_regex' '%s': %s", nameRegex, err)
+	}
+	var result []*types.Right
+	for _, right := range rights {
+		if right == nil {
+			continue
+		}
+		if !re.MatchString(right.Name) {
+			continue
+		}
+		if categoryId != "" && right.Category != categoryId {
+			continue
+		}
+		if rightType != "" && right.RightType != rightType {
+			continue
+		}
+		result = append(result, right)
+	}
+	return result, nil
+}
@@ -0,0 +1,105 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+)
+
+const labelVcfaSite = "VCFA site"
+
+// datasourceVcfaSite defines the data source that exports the site that the provider configuration points to, so
+// modules that span several sites (for example primary and DR) can share their endpoints without hardcoding URLs
+func datasourceVcfaSite() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaSiteRead,
+		Schema: map[string]*schema.Schema{
+			"site_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s, as set in the provider 'site_name'. Defaults to the host name", labelVcfaSite),
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Host name (and port, if not the default one) of the %s", labelVcfaSite),
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("URL of the %s, as used by the provider", labelVcfaSite),
+			},
+			"api_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Endpoint of the legacy API of the %s", labelVcfaSite),
+			},
+			"cloudapi_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Endpoint of the OpenAPI of the %s", labelVcfaSite),
+			},
+			"cci_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Endpoint of the CCI Kubernetes API of the %s", labelVcfaSite),
+			},
+			"org": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization that the provider uses for API operations",
+			},
+			"sysorg": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization that the provider uses for authentication",
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("API version that the provider uses with the %s", labelVcfaSite),
+			},
+		},
+	}
+}
+
+func datasourceVcfaSiteRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	container := meta.(ClientContainer)
+	tmClient := container.tmClient
+
+	endpoints := getSiteEndpoints(tmClient.Client.VCDHREF)
+	siteName := container.siteName
+	if siteName == "" {
+		siteName = endpoints["host"]
+	}
+
+	dSet(d, "site_name", siteName)
+	for key, value := range endpoints {
+		dSet(d, key, value)
+	}
+	dSet(d, "org", tmClient.Org)
+	dSet(d, "sysorg", tmClient.SysOrg)
+	dSet(d, "api_version", tmClient.Client.APIVersion)
+
+	d.SetId(fmt.Sprintf("site_name='%s',host='%s'", siteName, endpoints["host"]))
+	return nil
+}
+
+// getSiteEndpoints returns the endpoints of the VCFA site with the given API URL, by attribute name
+func getSiteEndpoints(vcfaUrl url.URL) map[string]string {
+	return map[string]string{
+		"host":              vcfaUrl.Host,
+		"url":               vcfaUrl.String(),
+		"api_endpoint":      fmt.Sprintf("%s://%s/api", vcfaUrl.Scheme, vcfaUrl.Host),
+		"cloudapi_endpoint": fmt.Sprintf("%s://%s/cloudapi", vcfaUrl.Scheme, vcfaUrl.Host),
+		"cci_endpoint":      fmt.Sprintf(ccitypes.KubernetesSubpath, vcfaUrl.Scheme, vcfaUrl.Host),
+	}
+}
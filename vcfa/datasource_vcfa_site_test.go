@@ -0,0 +1,61 @@
+//go:build ALL || tm || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVcfaSite(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+
+	vcfaUrl, err := url.ParseRequestURI(testConfig.Provider.Url)
+	if err != nil {
+		t.Fatalf("could not parse URL '%s': %s", testConfig.Provider.Url, err)
+	}
+
+	var params = StringMap{
+		"FuncName": t.Name(),
+		"Tags":     "tm",
+	}
+	testParamsNotEmpty(t, params)
+
+	configText := templateFill(testAccVcfaSite, params)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	endpoints := getSiteEndpoints(*vcfaUrl)
+	resource.ParallelTest(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					// The test provider doesn't set 'site_name', so the host is used
+					resource.TestCheckResourceAttr("data.vcfa_site.site", "site_name", endpoints["host"]),
+					resource.TestCheckResourceAttr("data.vcfa_site.site", "host", endpoints["host"]),
+					resource.TestCheckResourceAttr("data.vcfa_site.site", "api_endpoint", endpoints["api_endpoint"]),
+					resource.TestCheckResourceAttr("data.vcfa_site.site", "cloudapi_endpoint", endpoints["cloudapi_endpoint"]),
+					resource.TestCheckResourceAttr("data.vcfa_site.site", "cci_endpoint", endpoints["cci_endpoint"]),
+					resource.TestCheckResourceAttrSet("data.vcfa_site.site", "org"),
+					resource.TestCheckResourceAttrSet("data.vcfa_site.site", "api_version"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaSite = `
+data "vcfa_site" "site" {}
+`
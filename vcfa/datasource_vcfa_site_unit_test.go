@@ -0,0 +1,50 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestGetSiteEndpoints(t *testing.T) {
+	tests := []struct {
+		rawUrl string
+		want   map[string]string
+	}{
+		{
+			rawUrl: "https://vcfa-primary.example.com/api",
+			want: map[string]string{
+				"host":              "vcfa-primary.example.com",
+				"url":               "https://vcfa-primary.example.com/api",
+				"api_endpoint":      "https://vcfa-primary.example.com/api",
+				"cloudapi_endpoint": "https://vcfa-primary.example.com/cloudapi",
+				"cci_endpoint":      "https://vcfa-primary.example.com/cci/kubernetes",
+			},
+		},
+		{
+			rawUrl: "https://10.0.0.10:8443/api",
+			want: map[string]string{
+				"host":              "10.0.0.10:8443",
+				"url":               "https://10.0.0.10:8443/api",
+				"api_endpoint":      "https://10.0.0.10:8443/api",
+				"cloudapi_endpoint": "https://10.0.0.10:8443/cloudapi",
+				"cci_endpoint":      "https://10.0.0.10:8443/cci/kubernetes",
+			},
+		},
+	}
+	for _, tt := range tests {
+		vcfaUrl, err := url.ParseRequestURI(tt.rawUrl)
+		if err != nil {
+			t.Fatalf("unexpected error parsing '%s': %s", tt.rawUrl, err)
+		}
+		if got := getSiteEndpoints(*vcfaUrl); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("getSiteEndpoints(%s) = %v, want %v", tt.rawUrl, got, tt.want)
+		}
+	}
+}
@@ -55,9 +55,7 @@ func datasourceVcfaStorageClass() *schema.Resource {
 }
 
 func datasourceVcfaStorageClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tmClient := meta.(ClientContainer).tmClient
-
-	region, err := tmClient.GetRegionById(d.Get("region_id").(string))
+	region, err := getCachedRegionById(meta, d.Get("region_id").(string))
 	if err != nil {
 		return diag.Errorf("error retrieving %s with ID '%s: %s", labelVcfaRegion, d.Get("region_id").(string), err)
 	}
@@ -12,6 +12,8 @@ import (
 	"github.com/vmware/go-vcloud-director/v3/types/v56"
 )
 
+const labelSupervisor = "Supervisor"
+
 func datasourceVcfaSupervisor() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: datasourceVcfaSupervisorRead,
@@ -32,6 +34,11 @@ func datasourceVcfaSupervisor() *schema.Resource {
 				Computed:    true,
 				Description: "Parent Region ID",
 			},
+			"supervisor_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Identifier of the Supervisor in vCenter, which can be used with the vSphere provider",
+			},
 		},
 	}
 }
@@ -67,6 +74,7 @@ func setSupervisorData(d *schema.ResourceData, s *types.Supervisor) error {
 	}
 
 	dSet(d, "region_id", regionId)
+	dSet(d, "supervisor_id", s.SupervisorID)
 
 	return nil
 }
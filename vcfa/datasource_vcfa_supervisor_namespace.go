@@ -7,9 +7,11 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
 )
 
 func datasourceVcfaSupervisorNamespace() *schema.Resource {
@@ -26,11 +28,32 @@ func datasourceVcfaSupervisorNamespace() *schema.Resource {
 				Required:    true,
 				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelSupervisorNamespace),
 			},
+			"api_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Project scoped API path of the %s, relative to the CCI Kubernetes endpoint '<url>/cci/kubernetes'", labelSupervisorNamespace),
+			},
 			"class_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The name of the Supervisor Namespace Class",
 			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Time when the %s expires, in RFC 3339 format. Empty if it does not have a TTL", labelSupervisorNamespace),
+			},
+			"expired": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the TTL of the %s is over", labelSupervisorNamespace),
+			},
+			"audit_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: fmt.Sprintf("Audit trail annotations recorded in the metadata of the %s", labelSupervisorNamespace),
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"conditions": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -49,6 +72,12 @@ func datasourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: "Class Config Overrides for Content Sources",
 				Elem:        supervisorNamespaceContentSourcesClassConfigOverridesSchema,
 			},
+			"content_sources_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for Content Sources as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceStatusContentLibrariesSchema,
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -66,6 +95,12 @@ func datasourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: fmt.Sprintf("List of Non-mandatory Infra Policies to be associated with the %s", labelSupervisorNamespace),
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"kubernetes_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf("Name of the Kubernetes namespace backing the %s, to be used as 'metadata.namespace' of the "+
+					"resources created inside it", labelSupervisorNamespace),
+			},
 			"phase": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -92,6 +127,27 @@ func datasourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: fmt.Sprintf("Shared subnets associated with the %s", labelSupervisorNamespace),
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"default_storage_class": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage Class used by the volumes of the %s that don't request any. Empty if it could not be determined", labelSupervisorNamespace),
+			},
+			"storage_class_usage": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage used versus limit for each Storage Class of the %s", labelSupervisorNamespace),
+				Elem:        supervisorNamespaceStorageClassUsageSchema,
+			},
+			"cpu_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("CPU requested by the workloads of the %s across all its Zones. Empty if the usage could not be retrieved", labelSupervisorNamespace),
+			},
+			"memory_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Memory requested by the workloads of the %s across all its Zones. Empty if the usage could not be retrieved", labelSupervisorNamespace),
+			},
 			"storage_classes": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -111,6 +167,12 @@ func datasourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: "Initial Class Config Overrides for Storage Classes",
 				Elem:        supervisorNamespaceStorageClassesClassConfigOverridesSchema,
 			},
+			"storage_classes_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for Storage Classes as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceStorageClassesSchema,
+			},
 			"vm_classes": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -123,11 +185,39 @@ func datasourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: "Class Config Overrides for VM Classes",
 				Elem:        supervisorNamespaceVMClassesClassConfigOverridesSchema,
 			},
+			"vm_classes_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for VM Classes as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceVMClassesSchema,
+			},
 			"vpc_name": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Name of the VPC",
 			},
+			"vpc_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: fmt.Sprintf("Names of the VPCs attached to the %s. The first one is the primary VPC, "+
+					"equal to 'vpc_name'", labelSupervisorNamespace),
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"vm_service_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the VM Service is active in the %s, so Virtual Machines can be deployed", labelSupervisorNamespace),
+			},
+			"tkg_service_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the TKG Service is active in the %s, so VKS Clusters can be created", labelSupervisorNamespace),
+			},
+			"registry_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether an image registry is active in the %s", labelSupervisorNamespace),
+			},
 			"zones": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -147,6 +237,12 @@ func datasourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: "Initial Class Config Overrides for Zones",
 				Elem:        supervisorNamespaceZonesClassConfigOverridesSchema,
 			},
+			"zones_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for Zones as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceZonesEffectiveClassConfigOverridesSchema,
+			},
 		},
 	}
 }
@@ -162,13 +258,56 @@ func datasourceVcfaSupervisorNamespaceRead(ctx context.Context, d *schema.Resour
 		return diag.Errorf("project_name not specified")
 	}
 
-	supervisorNamespace, err := readSupervisorNamespace(tmClient, projectName.(string), name.(string))
+	supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName.(string), name.(string))
 	if err != nil {
-		return diag.Errorf("error reading %s: %s", labelSupervisorNamespace, err)
+		return diag.Errorf("error reading %s: %s", labelSupervisorNamespace, projectAccessError(tmClient, projectName.(string), err))
 	}
-	if err := setSupervisorNamespaceData(tmClient, d, projectName.(string), name.(string), supervisorNamespace); err != nil {
+	if err := setSupervisorNamespaceData(tmClient, d, projectName.(string), name.(string), supervisorNamespace, false); err != nil {
 		return diag.Errorf("error setting %s data: %s", labelSupervisorNamespace, err)
 	}
+	if err := setSupervisorNamespaceUsage(ctx, tmClient, d, supervisorNamespace); err != nil {
+		return diag.Errorf("error setting %s usage: %s", labelSupervisorNamespace, err)
+	}
+	for attribute, enabled := range getSupervisorNamespaceServices(supervisorNamespace) {
+		dSet(d, attribute, enabled)
+	}
 
 	return nil
 }
+
+// supervisorNamespaceServiceConditions contains, for each service attribute, the keywords of the condition types that
+// report the status of that service
+var supervisorNamespaceServiceConditions = map[string][]string{
+	"vm_service_enabled":  {"vmservice", "vmoperator"},
+	"tkg_service_enabled": {"tkg", "tanzukubernetes"},
+	"registry_enabled":    {"registry", "harbor"},
+}
+
+// getSupervisorNamespaceServices returns whether each service is active in the given Supervisor Namespace. When a
+// condition reports the status of a service, it is used. Otherwise, the service is considered active when the
+// Supervisor Namespace has the resources it requires: VM Classes and Content Libraries for the VM Service, and
+// VM Classes and Storage Classes for the TKG Service. Registries can only be detected from their condition
+func getSupervisorNamespaceServices(supervisorNamespace ccitypes.SupervisorNamespace) map[string]bool {
+	services := make(map[string]bool, len(supervisorNamespaceServiceConditions))
+	for attribute := range supervisorNamespaceServiceConditions {
+		services[attribute] = false
+	}
+	status := supervisorNamespace.Status
+	if status == nil {
+		return services
+	}
+	services["vm_service_enabled"] = len(status.VMClasses) > 0 && len(status.ContentLibraries) > 0
+	services["tkg_service_enabled"] = len(status.VMClasses) > 0 && len(status.StorageClasses) > 0
+
+	for attribute, keywords := range supervisorNamespaceServiceConditions {
+		for _, condition := range status.Conditions {
+			conditionType := strings.ToLower(condition.Type)
+			for _, keyword := range keywords {
+				if strings.Contains(conditionType, keyword) {
+					services[attribute] = strings.EqualFold(condition.Status, "True")
+				}
+			}
+		}
+	}
+	return services
+}
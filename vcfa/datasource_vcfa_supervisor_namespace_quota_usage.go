@@ -0,0 +1,250 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const labelSupervisorNamespaceQuotaUsage = "Supervisor Namespace Quota Usage"
+
+var supervisorNamespaceQuotaUsageStorageClassSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the Storage Class",
+		},
+		"limit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Limit (format: `<number><unit>`, where `<unit>` can be `Mi`, `Gi`, or `Ti`)",
+		},
+		"used": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Storage requested by the volumes of the Storage Class, as a Kubernetes quantity",
+		},
+		"used_bytes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Storage requested by the volumes of the Storage Class, in bytes",
+		},
+	},
+}
+
+var supervisorNamespaceQuotaUsageZoneSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the Zone",
+		},
+		"cpu_limit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CPU limit (format: `<number><unit>`, where `<unit>` can be `M` or `G`)",
+		},
+		"cpu_reservation": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CPU reservation (format: `<number><unit>`, where `<unit>` can be `M` or `G`)",
+		},
+		"memory_limit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Memory limit (format: `<number><unit>`, where `<unit>` can be `Mi`, `Gi`, or `Ti`)",
+		},
+		"memory_reservation": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Memory reservation (format: `<number><unit>`, where `<unit>` can be `Mi`, `Gi`, or `Ti`)",
+		},
+	},
+}
+
+func datasourceVcfaSupervisorNamespaceQuotaUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaSupervisorNamespaceQuotaUsageRead,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelSupervisorNamespace),
+			},
+			"supervisor_namespace_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The name of the %s to retrieve the usage for", labelSupervisorNamespace),
+			},
+			"cpu_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("CPU requested by the workloads of the %s, as a Kubernetes quantity", labelSupervisorNamespace),
+			},
+			"cpu_used_millicores": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("CPU requested by the workloads of the %s, in millicores", labelSupervisorNamespace),
+			},
+			"cpu_limit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("CPU limit enforced in the %s, as a Kubernetes quantity. Empty if it is not limited", labelSupervisorNamespace),
+			},
+			"memory_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Memory requested by the workloads of the %s, as a Kubernetes quantity", labelSupervisorNamespace),
+			},
+			"memory_used_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Memory requested by the workloads of the %s, in bytes", labelSupervisorNamespace),
+			},
+			"memory_limit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Memory limit enforced in the %s, as a Kubernetes quantity. Empty if it is not limited", labelSupervisorNamespace),
+			},
+			"storage_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage requested by the volumes of the %s across all its Storage Classes, as a Kubernetes quantity", labelSupervisorNamespace),
+			},
+			"storage_used_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage requested by the volumes of the %s across all its Storage Classes, in bytes", labelSupervisorNamespace),
+			},
+			"storage_limit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage limit enforced in the %s across all its Storage Classes, as a Kubernetes quantity. Empty if it is not limited", labelSupervisorNamespace),
+			},
+			"storage_class": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Storage used versus limit for each Storage Class, sorted by name",
+				Elem:        supervisorNamespaceQuotaUsageStorageClassSchema,
+			},
+			"zone": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "CPU and memory allocated in each Zone, sorted by name",
+				Elem:        supervisorNamespaceQuotaUsageZoneSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaSupervisorNamespaceQuotaUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	projectName := d.Get("project_name").(string)
+	name := d.Get("supervisor_namespace_name").(string)
+
+	supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, name)
+	if err != nil {
+		return diag.Errorf("error reading %s: %s", labelSupervisorNamespace, projectAccessError(tmClient, projectName, err))
+	}
+
+	// Unlike the vcfa_supervisor_namespace data source, reading the usage is the purpose of this one, so any error
+	// reaching the namespace endpoint is returned
+	client, err := getSupervisorNamespaceKubernetesClient(tmClient, supervisorNamespace)
+	if err != nil {
+		return diag.Errorf("error reading %s of %s %s: %s", labelSupervisorNamespaceQuotaUsage, labelSupervisorNamespace, name, err)
+	}
+	quotas, err := client.CoreV1().ResourceQuotas(name).List(ctx, v1.ListOptions{})
+	if err != nil {
+		return diag.Errorf("error reading %s of %s %s: %s", labelSupervisorNamespaceQuotaUsage, labelSupervisorNamespace, name, err)
+	}
+
+	if err := setSupervisorNamespaceQuotaUsageData(d, supervisorNamespace, quotas.Items); err != nil {
+		return diag.Errorf("error setting %s data: %s", labelSupervisorNamespaceQuotaUsage, err)
+	}
+	d.SetId(buildResourceId(projectName, name))
+	return nil
+}
+
+// setSupervisorNamespaceQuotaUsageData sets the consumption of the given Supervisor Namespace, as reported by the
+// ResourceQuotas of its Kubernetes namespace, together with the limits and allocations of its status
+func setSupervisorNamespaceQuotaUsageData(d *schema.ResourceData, supervisorNamespace ccitypes.SupervisorNamespace, quotas []corev1.ResourceQuota) error {
+	used := getQuotaUsedQuantities(quotas)
+	hard := getQuotaHardQuantities(quotas)
+
+	cpuUsed := used[corev1.ResourceRequestsCPU]
+	memoryUsed := used[corev1.ResourceRequestsMemory]
+	storageUsed := used[corev1.ResourceRequestsStorage]
+	dSet(d, "cpu_used", cpuUsed.String())
+	dSet(d, "cpu_used_millicores", int(cpuUsed.MilliValue()))
+	dSet(d, "cpu_limit", getQuotaLimit(hard, corev1.ResourceLimitsCPU, corev1.ResourceRequestsCPU))
+	dSet(d, "memory_used", memoryUsed.String())
+	dSet(d, "memory_used_bytes", int(memoryUsed.Value()))
+	dSet(d, "memory_limit", getQuotaLimit(hard, corev1.ResourceLimitsMemory, corev1.ResourceRequestsMemory))
+	dSet(d, "storage_used", storageUsed.String())
+	dSet(d, "storage_used_bytes", int(storageUsed.Value()))
+	dSet(d, "storage_limit", getQuotaLimit(hard, corev1.ResourceRequestsStorage))
+
+	var statusStorageClasses []ccitypes.SupervisorNamespaceStatusStorageClasses
+	var statusZones []ccitypes.SupervisorNamespaceStatusZones
+	if supervisorNamespace.Status != nil {
+		statusStorageClasses = append(statusStorageClasses, supervisorNamespace.Status.StorageClasses...)
+		statusZones = append(statusZones, supervisorNamespace.Status.Zones...)
+	}
+	sort.SliceStable(statusStorageClasses, func(i, j int) bool {
+		return statusStorageClasses[i].Name < statusStorageClasses[j].Name
+	})
+	sort.SliceStable(statusZones, func(i, j int) bool {
+		return statusZones[i].Name < statusZones[j].Name
+	})
+
+	storageClasses := make([]interface{}, 0, len(statusStorageClasses))
+	for _, storageClass := range statusStorageClasses {
+		storageClassUsed := used[corev1.ResourceName(storageClass.Name+storageClassQuotaSuffix)]
+		storageClasses = append(storageClasses, map[string]interface{}{
+			"name":       storageClass.Name,
+			"limit":      storageClass.Limit,
+			"used":       storageClassUsed.String(),
+			"used_bytes": int(storageClassUsed.Value()),
+		})
+	}
+	if err := d.Set("storage_class", storageClasses); err != nil {
+		return fmt.Errorf("error setting storage_class: %s", err)
+	}
+
+	zones := make([]interface{}, 0, len(statusZones))
+	for _, zone := range statusZones {
+		zones = append(zones, map[string]interface{}{
+			"name":               zone.Name,
+			"cpu_limit":          zone.CpuLimit,
+			"cpu_reservation":    zone.CpuReservation,
+			"memory_limit":       zone.MemoryLimit,
+			"memory_reservation": zone.MemoryReservation,
+		})
+	}
+	if err := d.Set("zone", zones); err != nil {
+		return fmt.Errorf("error setting zone: %s", err)
+	}
+	return nil
+}
+
+// getQuotaLimit returns the first of the given resources that is limited, or an empty string if none is
+func getQuotaLimit(hard map[corev1.ResourceName]resource.Quantity, resourceNames ...corev1.ResourceName) string {
+	for _, resourceName := range resourceNames {
+		if quantity, ok := hard[resourceName]; ok {
+			return quantity.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+//go:build cci || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+)
+
+// TestAccVcfaSupervisorNamespaceQuotaUsageDS tests reading the usage of a new Supervisor Namespace, which has no workloads
+func TestAccVcfaSupervisorNamespaceQuotaUsageDS(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfSysAdmin(t)
+
+	var params = StringMap{
+		"ProjectName":        "tf-project",
+		"RegionName":         testConfig.Cci.Region,
+		"VpcName":            testConfig.Cci.Vpc,
+		"StorageClassName":   testConfig.Cci.StoragePolicy,
+		"SupervisorZoneName": testConfig.Cci.SupervisorZone,
+		"VmClass":            testConfig.Cci.VmClass1,
+		"FuncName":           t.Name(),
+
+		"Tags": "cci",
+	}
+	testParamsNotEmpty(t, params)
+
+	configText := templateFill(testAccVcfaSupervisorNamespaceQuotaUsageDS, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s\n", configText)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	cleanup := testutils.SetupProject(t, params["ProjectName"].(string))
+	defer cleanup()
+
+	dataSourceDef := "data.vcfa_supervisor_namespace_quota_usage.test"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceDef, "supervisor_namespace_name", "vcfa_supervisor_namespace.test", "name"),
+					resource.TestCheckResourceAttr(dataSourceDef, "storage_class.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceDef, "storage_class.0.name", params["StorageClassName"].(string)),
+					resource.TestCheckResourceAttr(dataSourceDef, "storage_class.0.limit", "100Mi"),
+					resource.TestCheckResourceAttr(dataSourceDef, "storage_class.0.used_bytes", "0"),
+					resource.TestCheckResourceAttr(dataSourceDef, "storage_used_bytes", "0"),
+					resource.TestCheckResourceAttrSet(dataSourceDef, "cpu_used_millicores"),
+					resource.TestCheckResourceAttrSet(dataSourceDef, "memory_used_bytes"),
+					resource.TestCheckResourceAttr(dataSourceDef, "zone.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceDef, "zone.0.name", params["SupervisorZoneName"].(string)),
+					resource.TestCheckResourceAttr(dataSourceDef, "zone.0.cpu_limit", "100M"),
+					resource.TestCheckResourceAttr(dataSourceDef, "zone.0.memory_limit", "200Mi"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaSupervisorNamespaceQuotaUsageDS = `
+resource "vcfa_supervisor_namespace" "test" {
+  name_prefix  = "terraform-test"
+  project_name = "{{.ProjectName}}"
+  class_name   = "small"
+  description  = "Supervisor Namespace created by Terraform"
+  region_name  = "{{.RegionName}}"
+  vpc_name     = "{{.VpcName}}"
+
+  storage_classes_class_config_overrides {
+    limit = "100Mi"
+    name  = "{{.StorageClassName}}"
+  }
+
+  vm_classes_class_config_overrides {
+    name = "{{.VmClass}}"
+  }
+
+  zones_class_config_overrides {
+    cpu_limit          = "100M"
+    cpu_reservation    = "0M"
+    memory_limit       = "200Mi"
+    memory_reservation = "0Mi"
+    name               = "{{.SupervisorZoneName}}"
+  }
+}
+
+data "vcfa_supervisor_namespace_quota_usage" "test" {
+  project_name              = vcfa_supervisor_namespace.test.project_name
+  supervisor_namespace_name = vcfa_supervisor_namespace.test.name
+}
+`
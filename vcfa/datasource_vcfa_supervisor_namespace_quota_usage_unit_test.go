@@ -0,0 +1,111 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// TestSetSupervisorNamespaceQuotaUsageData checks that the usage is aggregated from all the ResourceQuotas, keeping
+// the lowest limit, and that the Storage Classes and Zones are sorted by name
+func TestSetSupervisorNamespaceQuotaUsageData(t *testing.T) {
+	newQuota := func(hard, used map[string]string) corev1.ResourceQuota {
+		quota := corev1.ResourceQuota{Status: corev1.ResourceQuotaStatus{Hard: corev1.ResourceList{}, Used: corev1.ResourceList{}}}
+		for name, quantity := range hard {
+			quota.Status.Hard[corev1.ResourceName(name)] = resource.MustParse(quantity)
+		}
+		for name, quantity := range used {
+			quota.Status.Used[corev1.ResourceName(name)] = resource.MustParse(quantity)
+		}
+		return quota
+	}
+	quotas := []corev1.ResourceQuota{
+		newQuota(map[string]string{
+			"limits.cpu":       "4",
+			"limits.memory":    "8Gi",
+			"requests.storage": "200Gi",
+		}, map[string]string{
+			"requests.cpu":     "1500m",
+			"requests.memory":  "2Gi",
+			"requests.storage": "10Gi",
+			"gold.storageclass.storage.k8s.io/requests.storage": "10Gi",
+		}),
+		newQuota(map[string]string{
+			"limits.cpu": "2",
+		}, map[string]string{
+			"requests.cpu": "1",
+		}),
+	}
+	supervisorNamespace := ccitypes.SupervisorNamespace{
+		Status: &ccitypes.SupervisorNamespaceStatus{
+			StorageClasses: []ccitypes.SupervisorNamespaceStatusStorageClasses{
+				{Name: "silver", Limit: "50Gi"},
+				{Name: "gold", Limit: "100Gi"},
+			},
+			Zones: []ccitypes.SupervisorNamespaceStatusZones{
+				{Name: "zone2", CpuLimit: "2G", MemoryLimit: "4Gi"},
+				{Name: "zone1", CpuLimit: "1G", CpuReservation: "500M", MemoryLimit: "2Gi", MemoryReservation: "1Gi"},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, datasourceVcfaSupervisorNamespaceQuotaUsage().Schema, map[string]interface{}{})
+	if err := setSupervisorNamespaceQuotaUsageData(d, supervisorNamespace, quotas); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"cpu_used":                   "1500m",
+		"cpu_used_millicores":        1500,
+		"cpu_limit":                  "2",
+		"memory_used":                "2Gi",
+		"memory_used_bytes":          2 * 1024 * 1024 * 1024,
+		"memory_limit":               "8Gi",
+		"storage_used":               "10Gi",
+		"storage_used_bytes":         10 * 1024 * 1024 * 1024,
+		"storage_limit":              "200Gi",
+		"storage_class.#":            2,
+		"storage_class.0.name":       "gold",
+		"storage_class.0.limit":      "100Gi",
+		"storage_class.0.used":       "10Gi",
+		"storage_class.1.name":       "silver",
+		"storage_class.1.used":       "0",
+		"storage_class.1.used_bytes": 0,
+		"zone.#":                     2,
+		"zone.0.name":                "zone1",
+		"zone.0.cpu_reservation":     "500M",
+		"zone.0.memory_reservation":  "1Gi",
+		"zone.1.name":                "zone2",
+		"zone.1.cpu_limit":           "2G",
+	}
+	for key, value := range expected {
+		if got := d.Get(key); got != value {
+			t.Errorf("expected %s to be '%v', got '%v'", key, value, got)
+		}
+	}
+	// The status of the Supervisor Namespace must not be reordered
+	if supervisorNamespace.Status.Zones[0].Name != "zone2" {
+		t.Errorf("expected the Zones of the Supervisor Namespace to keep their order")
+	}
+}
+
+func TestGetQuotaLimit(t *testing.T) {
+	hard := map[corev1.ResourceName]resource.Quantity{
+		corev1.ResourceRequestsCPU: resource.MustParse("2"),
+	}
+	if got := getQuotaLimit(hard, corev1.ResourceLimitsCPU, corev1.ResourceRequestsCPU); got != "2" {
+		t.Errorf("expected the requests limit to be used when there is no limits one, got '%s'", got)
+	}
+	if got := getQuotaLimit(hard, corev1.ResourceLimitsMemory, corev1.ResourceRequestsMemory); got != "" {
+		t.Errorf("expected no limit, got '%s'", got)
+	}
+}
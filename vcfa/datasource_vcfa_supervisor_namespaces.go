@@ -0,0 +1,284 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+)
+
+var dsSupervisorNamespacesSupervisorNamespaceSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s, the same as the one of the 'vcfa_supervisor_namespace' resource", labelSupervisorNamespace),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelSupervisorNamespace),
+		},
+		"project_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("The name of the Project the %s belongs to", labelSupervisorNamespace),
+		},
+		"api_path": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Project scoped API path of the %s, relative to the CCI Kubernetes endpoint '<url>/cci/kubernetes'", labelSupervisorNamespace),
+		},
+		"class_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the Supervisor Namespace Class",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description",
+		},
+		"region_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelVcfaRegion),
+		},
+		"vpc_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the VPC",
+		},
+		"vpc_names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Names of the VPCs attached to the %s. The first one is the primary VPC", labelSupervisorNamespace),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"seg_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Service Engine Group associated with the %s", labelSupervisorNamespace),
+		},
+		"infra_policy_names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("List of Non-mandatory Infra Policies associated with the %s", labelSupervisorNamespace),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"shared_subnet_names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Shared subnets associated with the %s", labelSupervisorNamespace),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"phase": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Phase of the %s", labelSupervisorNamespace),
+		},
+		"ready": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: fmt.Sprintf("Whether the %s is in a ready status or not", labelSupervisorNamespace),
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Time when the %s expires, in RFC 3339 format. Empty if it does not have a TTL", labelSupervisorNamespace),
+		},
+		"expired": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: fmt.Sprintf("Whether the TTL of the %s is over", labelSupervisorNamespace),
+		},
+		"audit_annotations": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: fmt.Sprintf("Audit trail annotations recorded in the metadata of the %s", labelSupervisorNamespace),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"conditions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("Detailed conditions tracking %s health and lifecycle events", labelSupervisorNamespace),
+			Elem:        supervisorNamespaceConditionsSchema,
+		},
+	},
+}
+
+func datasourceVcfaSupervisorNamespaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaSupervisorNamespacesRead,
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: fmt.Sprintf("The name of the Project whose %ss are listed. If not set, the %ss of all Projects "+
+					"are listed, which requires System Administrator privileges", labelSupervisorNamespace, labelSupervisorNamespace),
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Only list the %ss in this phase, like 'CREATED' or 'ERROR'. Case insensitive", labelSupervisorNamespace),
+			},
+			"class_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Only list the %ss of this Supervisor Namespace Class", labelSupervisorNamespace),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Only list the %ss of this %s", labelSupervisorNamespace, labelVcfaRegion),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the %ss that match the filters, sorted by Project and name", labelSupervisorNamespace),
+			},
+			"supervisor_namespaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ss that match the filters, sorted by Project and name", labelSupervisorNamespace),
+				Elem:        dsSupervisorNamespacesSupervisorNamespaceSchema,
+			},
+		},
+	}
+}
+
+// supervisorNamespaceFilter contains the criteria that the listed Supervisor Namespaces must meet. Empty fields
+// match any value
+type supervisorNamespaceFilter struct {
+	phase      string
+	className  string
+	regionName string
+}
+
+func datasourceVcfaSupervisorNamespacesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	projectName := d.Get("project_name").(string)
+	filter := supervisorNamespaceFilter{
+		phase:      d.Get("phase").(string),
+		className:  d.Get("class_name").(string),
+		regionName: d.Get("region_name").(string),
+	}
+
+	var supervisorNamespaces []ccitypes.SupervisorNamespace
+	var err error
+	if projectName != "" {
+		supervisorNamespaces, err = listSupervisorNamespaces(tmClient, projectName)
+		err = projectAccessError(tmClient, projectName, err)
+	} else {
+		supervisorNamespaces, err = listAllSupervisorNamespaces(tmClient)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	supervisorNamespaces = filterSupervisorNamespaces(supervisorNamespaces, filter)
+
+	now := time.Now()
+	names := make([]string, len(supervisorNamespaces))
+	supervisorNamespaceList := make([]interface{}, len(supervisorNamespaces))
+	for i, supervisorNamespace := range supervisorNamespaces {
+		names[i] = supervisorNamespace.Name
+		supervisorNamespaceList[i] = flattenSupervisorNamespaceSummary(supervisorNamespace, now)
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.Errorf("error storing 'names': %s", err)
+	}
+	if err := d.Set("supervisor_namespaces", supervisorNamespaceList); err != nil {
+		return diag.Errorf("error storing 'supervisor_namespaces': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("project_name='%s',phase='%s',class_name='%s',region_name='%s'",
+		projectName, filter.phase, filter.className, filter.regionName))
+	return nil
+}
+
+// listAllSupervisorNamespaces returns the Supervisor Namespaces of all the Projects
+func listAllSupervisorNamespaces(tmClient *VCDClient) ([]ccitypes.SupervisorNamespace, error) {
+	supervisorNamespacesURL, err := tmClient.VCDClient.Client.GetEntityUrl(allSupervisorNamespacesURL)
+	if err != nil {
+		return nil, fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)
+	}
+	supervisorNamespaces, err := getAllCciItems[ccitypes.SupervisorNamespace](tmClient, supervisorNamespacesURL)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %ss of all Projects: %s", labelSupervisorNamespace, err)
+	}
+	return supervisorNamespaces, nil
+}
+
+// filterSupervisorNamespaces returns the Supervisor Namespaces that match the given filter, sorted by Project and name
+func filterSupervisorNamespaces(supervisorNamespaces []ccitypes.SupervisorNamespace, filter supervisorNamespaceFilter) []ccitypes.SupervisorNamespace {
+	var filtered []ccitypes.SupervisorNamespace
+	for _, supervisorNamespace := range supervisorNamespaces {
+		phase := ""
+		if supervisorNamespace.Status != nil {
+			phase = supervisorNamespace.Status.Phase
+		}
+		if filter.phase != "" && !strings.EqualFold(phase, filter.phase) {
+			continue
+		}
+		if filter.className != "" && supervisorNamespace.Spec.ClassName != filter.className {
+			continue
+		}
+		if filter.regionName != "" && supervisorNamespace.Spec.RegionName != filter.regionName {
+			continue
+		}
+		filtered = append(filtered, supervisorNamespace)
+	}
+	// Sorting to avoid spurious differences in the list order
+	sort.SliceStable(filtered, func(i, j int) bool {
+		if filtered[i].Namespace != filtered[j].Namespace {
+			return filtered[i].Namespace < filtered[j].Namespace
+		}
+		return filtered[i].Name < filtered[j].Name
+	})
+	return filtered
+}
+
+// flattenSupervisorNamespaceSummary converts a Supervisor Namespace to the format of
+// 'dsSupervisorNamespacesSupervisorNamespaceSchema'. The Project of a Supervisor Namespace is the Kubernetes namespace
+// of its object
+func flattenSupervisorNamespaceSummary(supervisorNamespace ccitypes.SupervisorNamespace, now time.Time) map[string]interface{} {
+	projectName := supervisorNamespace.Namespace
+	expiresAt := supervisorNamespace.Annotations[supervisorNamespaceExpiresAtAnnotation]
+	phase := ""
+	var conditions []ccitypes.SupervisorNamespaceStatusConditions
+	if supervisorNamespace.Status != nil {
+		phase = supervisorNamespace.Status.Phase
+		conditions = supervisorNamespace.Status.Conditions
+	}
+	return map[string]interface{}{
+		"id":                  buildResourceId(projectName, supervisorNamespace.Name),
+		"name":                supervisorNamespace.Name,
+		"project_name":        projectName,
+		"api_path":            buildSupervisorNamespaceApiPath(projectName, supervisorNamespace.Name),
+		"class_name":          supervisorNamespace.Spec.ClassName,
+		"description":         supervisorNamespace.Spec.Description,
+		"region_name":         supervisorNamespace.Spec.RegionName,
+		"vpc_name":            supervisorNamespace.Spec.VpcName,
+		"vpc_names":           getSupervisorNamespaceVpcNames(supervisorNamespace),
+		"seg_name":            supervisorNamespace.Spec.SegName,
+		"infra_policy_names":  supervisorNamespace.Spec.InfraPolicyNames,
+		"shared_subnet_names": supervisorNamespace.Spec.SharedSubnetNames,
+		"phase":               phase,
+		"ready":               isCciConditionReady(conditions),
+		"expires_at":          expiresAt,
+		"expired":             isSupervisorNamespaceExpired(expiresAt, now),
+		"audit_annotations":   getAuditTrailAnnotations(supervisorNamespace.Annotations),
+		"conditions":          flattenCciConditions(conditions),
+	}
+}
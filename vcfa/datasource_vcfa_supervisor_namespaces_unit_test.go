@@ -0,0 +1,85 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testSupervisorNamespace(projectName, name, className, regionName, phase string) ccitypes.SupervisorNamespace {
+	return ccitypes.SupervisorNamespace{
+		ObjectMeta: v1.ObjectMeta{Namespace: projectName, Name: name},
+		Spec:       ccitypes.SupervisorNamespaceSpec{ClassName: className, RegionName: regionName},
+		Status:     &ccitypes.SupervisorNamespaceStatus{Phase: phase},
+	}
+}
+
+func TestFilterSupervisorNamespaces(t *testing.T) {
+	items := []ccitypes.SupervisorNamespace{
+		testSupervisorNamespace("project-b", "ns-1", "small", "region-1", "CREATED"),
+		testSupervisorNamespace("project-a", "ns-3", "large", "region-2", "ERROR"),
+		testSupervisorNamespace("project-a", "ns-2", "small", "region-2", "CREATED"),
+		{ObjectMeta: v1.ObjectMeta{Namespace: "project-c", Name: "ns-4"}},
+	}
+
+	tests := []struct {
+		name   string
+		filter supervisorNamespaceFilter
+		want   []string
+	}{
+		{name: "All", filter: supervisorNamespaceFilter{}, want: []string{"project-a/ns-2", "project-a/ns-3", "project-b/ns-1", "project-c/ns-4"}},
+		{name: "PhaseCaseInsensitive", filter: supervisorNamespaceFilter{phase: "created"}, want: []string{"project-a/ns-2", "project-b/ns-1"}},
+		{name: "ClassName", filter: supervisorNamespaceFilter{className: "large"}, want: []string{"project-a/ns-3"}},
+		{name: "RegionName", filter: supervisorNamespaceFilter{regionName: "region-2"}, want: []string{"project-a/ns-2", "project-a/ns-3"}},
+		{name: "Combined", filter: supervisorNamespaceFilter{phase: "CREATED", className: "small", regionName: "region-1"}, want: []string{"project-b/ns-1"}},
+		{name: "NoMatch", filter: supervisorNamespaceFilter{className: "medium"}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, item := range filterSupervisorNamespaces(items, tt.filter) {
+				got = append(got, item.Namespace+"/"+item.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterSupervisorNamespaces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenSupervisorNamespaceSummary(t *testing.T) {
+	supervisorNamespace := testSupervisorNamespace("project-a", "ns-1", "small", "region-1", "CREATED")
+	supervisorNamespace.Spec.VpcName = "vpc-1"
+	supervisorNamespace.Annotations = map[string]string{supervisorNamespaceExpiresAtAnnotation: "2000-01-01T00:00:00Z"}
+	supervisorNamespace.Status.Conditions = []ccitypes.SupervisorNamespaceStatusConditions{{Type: "Ready", Status: "True"}}
+
+	summary := flattenSupervisorNamespaceSummary(supervisorNamespace, time.Now())
+	expected := map[string]interface{}{
+		"id":           "project-a:ns-1",
+		"project_name": "project-a",
+		"phase":        "CREATED",
+		"ready":        true,
+		"expired":      true,
+		"vpc_names":    []string{"vpc-1"},
+	}
+	for key, value := range expected {
+		if !reflect.DeepEqual(summary[key], value) {
+			t.Errorf("expected '%s' to be %v, got %v", key, value, summary[key])
+		}
+	}
+
+	// Supervisor Namespaces that are being created may not have a status yet
+	summary = flattenSupervisorNamespaceSummary(ccitypes.SupervisorNamespace{ObjectMeta: v1.ObjectMeta{Namespace: "project-a", Name: "ns-2"}}, time.Now())
+	if summary["phase"] != "" || summary["ready"] != false {
+		t.Errorf("unexpected summary without status: %v", summary)
+	}
+}
@@ -0,0 +1,154 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dsSupervisorZonesZoneSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelSupervisorZone),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelSupervisorZone),
+		},
+		"vcenter_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the vCenter of the %s", labelSupervisorZone),
+		},
+		"region_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the Region of the %s", labelSupervisorZone),
+		},
+		"cpu_capacity_mhz": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "CPU Capacity in MHz",
+		},
+		"cpu_used_mhz": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "CPU used in MHz",
+		},
+		"memory_capacity_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Memory capacity in MiB",
+		},
+		"memory_used_mib": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Memory used in MiB",
+		},
+	},
+}
+
+func datasourceVcfaSupervisorZones() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaSupervisorZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"supervisor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s whose %ss are returned", labelSupervisor, labelSupervisorZone),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("Regular expression to filter the %ss by name", labelSupervisorZone),
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Sorted names of the %ss that match the filters", labelSupervisorZone),
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ss that match the filters", labelSupervisorZone),
+				Elem:        dsSupervisorZonesZoneSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaSupervisorZonesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	supervisorId := d.Get("supervisor_id").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	supervisor, err := tmClient.GetSupervisorById(supervisorId)
+	if err != nil {
+		return diag.Errorf("error getting %s: %s", labelSupervisor, err)
+	}
+	supervisorZones, err := supervisor.GetAllSupervisorZones(nil)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss of %s '%s': %s", labelSupervisorZone, labelSupervisor, supervisor.Supervisor.Name, err)
+	}
+
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return diag.Errorf("error compiling 'name_regex' '%s': %s", nameRegex, err)
+	}
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(supervisorZones, func(i, j int) bool {
+		return supervisorZones[i].SupervisorZone.Name < supervisorZones[j].SupervisorZone.Name
+	})
+
+	var names []string
+	var zoneList []interface{}
+	for _, supervisorZone := range supervisorZones {
+		zone := supervisorZone.SupervisorZone
+		if !re.MatchString(zone.Name) {
+			continue
+		}
+		vcenterId, regionId := "", ""
+		if zone.VirtualCenter != nil {
+			vcenterId = zone.VirtualCenter.ID
+		}
+		if zone.Region != nil {
+			regionId = zone.Region.ID
+		}
+		names = append(names, zone.Name)
+		zoneList = append(zoneList, map[string]interface{}{
+			"id":                  zone.ID,
+			"name":                zone.Name,
+			"vcenter_id":          vcenterId,
+			"region_id":           regionId,
+			"cpu_capacity_mhz":    int(zone.TotalCPUCapacityMHz),
+			"cpu_used_mhz":        int(zone.CpuUsedMHz),
+			"memory_capacity_mib": int(zone.TotalMemoryCapacityMiB),
+			"memory_used_mib":     int(zone.MemoryUsedMiB),
+		})
+	}
+	if err := d.Set("names", names); err != nil {
+		return diag.Errorf("error storing 'names': %s", err)
+	}
+	if err := d.Set("zones", zoneList); err != nil {
+		return diag.Errorf("error storing 'zones': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("supervisor_id='%s',name_regex='%s'", supervisorId, nameRegex))
+	return nil
+}
@@ -0,0 +1,138 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+var dsSupervisorsSupervisorSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", labelSupervisor),
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Name of the %s", labelSupervisor),
+		},
+		"vcenter_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the vCenter of the %s", labelSupervisor),
+		},
+		"region_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the Region of the %s. It is empty if the %s is not associated with a Region", labelSupervisor, labelSupervisor),
+		},
+	},
+}
+
+func datasourceVcfaSupervisors() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaSupervisorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"vcenter_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("vCenter ID to filter the %ss. %ss of all vCenters are returned if it is not set", labelSupervisor, labelSupervisor),
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+				Description:  fmt.Sprintf("Regular expression to filter the %ss by name", labelSupervisor),
+			},
+			"supervisors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("List of %ss that match the filters", labelSupervisor),
+				Elem:        dsSupervisorsSupervisorSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaSupervisorsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	vcenterId := d.Get("vcenter_id").(string)
+	nameRegex := d.Get("name_regex").(string)
+
+	var queryParams url.Values
+	if vcenterId != "" {
+		queryParams = url.Values{}
+		queryParams.Add("filter", "virtualCenter.id=="+vcenterId)
+	}
+	allSupervisors, err := tmClient.GetAllSupervisors(queryParams)
+	if err != nil {
+		return diag.Errorf("error retrieving %ss: %s", labelSupervisor, err)
+	}
+
+	supervisors := make([]*types.Supervisor, len(allSupervisors))
+	for i, supervisor := range allSupervisors {
+		supervisors[i] = supervisor.Supervisor
+	}
+	supervisors, err = filterSupervisors(supervisors, nameRegex)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	supervisorList := make([]interface{}, len(supervisors))
+	for i, supervisor := range supervisors {
+		supervisorVcenterId, regionId := "", ""
+		if supervisor.VirtualCenter != nil {
+			supervisorVcenterId = supervisor.VirtualCenter.ID
+		}
+		if supervisor.Region != nil {
+			regionId = supervisor.Region.ID
+		}
+		supervisorList[i] = map[string]interface{}{
+			"id":         supervisor.SupervisorID,
+			"name":       supervisor.Name,
+			"vcenter_id": supervisorVcenterId,
+			"region_id":  regionId,
+		}
+	}
+	if err := d.Set("supervisors", supervisorList); err != nil {
+		return diag.Errorf("error storing 'supervisors': %s", err)
+	}
+
+	// The ID is artificial, and we try to identify each data source instance unequivocally through its parameters.
+	d.SetId(fmt.Sprintf("vcenter_id='%s',name_regex='%s'", vcenterId, nameRegex))
+	return nil
+}
+
+// filterSupervisors returns the Supervisors whose name matches the given regular expression, sorted by name. An empty
+// expression matches all the Supervisors
+func filterSupervisors(supervisors []*types.Supervisor, nameRegex string) ([]*types.Supervisor, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, fmt.Errorf("error compiling 'name_regex' '%s': %s", nameRegex, err)
+	}
+	var filtered []*types.Supervisor
+	for _, supervisor := range supervisors {
+		if re.MatchString(supervisor.Name) {
+			filtered = append(filtered, supervisor)
+		}
+	}
+	// Sorting by name to avoid spurious differences in the list order
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Name < filtered[j].Name
+	})
+	return filtered, nil
+}
@@ -0,0 +1,49 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func TestFilterSupervisors(t *testing.T) {
+	supervisors := []*types.Supervisor{
+		{Name: "supervisor-b"},
+		{Name: "supervisor-a"},
+		{Name: "dr-supervisor"},
+	}
+
+	tests := []struct {
+		name      string
+		nameRegex string
+		want      []string
+		wantErr   bool
+	}{
+		{name: "All", nameRegex: "", want: []string{"dr-supervisor", "supervisor-a", "supervisor-b"}},
+		{name: "Prefix", nameRegex: "^supervisor-", want: []string{"supervisor-a", "supervisor-b"}},
+		{name: "NoMatch", nameRegex: "^prod-", want: nil},
+		{name: "InvalidRegex", nameRegex: "(", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filtered, err := filterSupervisors(supervisors, tt.nameRegex)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("filterSupervisors() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			var got []string
+			for _, supervisor := range filtered {
+				got = append(got, supervisor.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterSupervisors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
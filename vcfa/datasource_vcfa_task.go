@@ -0,0 +1,188 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// Default time to wait for a task to finish when 'wait_for_completion' is set. It can be overridden with the
+// 'timeouts' block of the data source
+const defaultTaskWaitTimeout = 20 * time.Minute
+
+func datasourceVcfaTask() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaTaskRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultTaskWaitTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s, either a URN or a UUID", labelVcfaTask),
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: fmt.Sprintf("If true, the data source waits for the %s to finish, successfully or not, "+
+					"before reading it", labelVcfaTask),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s", labelVcfaTask),
+			},
+			"operation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Message describing the operation tracked by the %s", labelVcfaTask),
+			},
+			"operation_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Short name of the operation tracked by the %s", labelVcfaTask),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf("Status of the %s. One of 'queued', 'preRunning', 'running', 'postRunning', "+
+					"'success', 'error' or 'aborted'", labelVcfaTask),
+			},
+			"finished": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s has finished, regardless of its outcome", labelVcfaTask),
+			},
+			"progress": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Approximate progress of the %s, as a percentage. Not available for all tasks", labelVcfaTask),
+			},
+			"details": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Detailed message about the %s", labelVcfaTask),
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Time when the %s started", labelVcfaTask),
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Time when the %s finished. Empty if it is still running", labelVcfaTask),
+			},
+			"expiry_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Time when the %s is removed and can no longer be retrieved", labelVcfaTask),
+			},
+			"cancel_requested": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the cancellation of the %s was requested", labelVcfaTask),
+			},
+			"owner_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("ID of the entity that the %s creates or updates", labelVcfaTask),
+			},
+			"owner_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the entity that the %s creates or updates", labelVcfaTask),
+			},
+			"owner_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Type of the entity that the %s creates or updates", labelVcfaTask),
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the user who started the %s", labelVcfaTask),
+			},
+			"org_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s of the user who started the %s", labelVcfaOrg, labelVcfaTask),
+			},
+			"error_message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Error of the %s, with its codes, when it failed", labelVcfaTask),
+			},
+		},
+	}
+}
+
+func datasourceVcfaTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	taskId := d.Get("task_id").(string)
+
+	var task *govcd.Task
+	var err error
+	if d.Get("wait_for_completion").(bool) {
+		task, err = waitForTask(ctx, tmClient, taskId, operationTimeout(d, meta, schema.TimeoutRead))
+	} else {
+		task, err = getTask(tmClient, taskId)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setTaskData(d, task)
+	d.SetId(task.Task.ID)
+	return nil
+}
+
+func setTaskData(d *schema.ResourceData, task *govcd.Task) {
+	t := task.Task
+	dSet(d, "name", t.Name)
+	dSet(d, "operation", t.Operation)
+	dSet(d, "operation_name", t.OperationName)
+	dSet(d, "description", t.Description)
+	dSet(d, "status", t.Status)
+	dSet(d, "finished", isTaskFinished(t))
+	dSet(d, "progress", t.Progress)
+	dSet(d, "details", t.Details)
+	dSet(d, "start_time", t.StartTime)
+	dSet(d, "end_time", t.EndTime)
+	dSet(d, "expiry_time", t.ExpiryTime)
+	dSet(d, "cancel_requested", t.CancelRequested)
+	dSet(d, "error_message", taskErrorMessage(t))
+
+	var ownerId, ownerName, ownerType string
+	if t.Owner != nil {
+		ownerId, ownerName, ownerType = t.Owner.ID, t.Owner.Name, t.Owner.Type
+	}
+	dSet(d, "owner_id", ownerId)
+	dSet(d, "owner_name", ownerName)
+	dSet(d, "owner_type", ownerType)
+
+	var userName, orgName string
+	if t.User != nil {
+		userName = t.User.Name
+	}
+	if t.Organization != nil {
+		orgName = t.Organization.Name
+	}
+	dSet(d, "user_name", userName)
+	dSet(d, "org_name", orgName)
+}
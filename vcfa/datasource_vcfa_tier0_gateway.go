@@ -54,7 +54,7 @@ func datasourceVcfaTier0GatewayRead(ctx context.Context, d *schema.ResourceData,
 	tmClient := meta.(ClientContainer).tmClient
 	// Fetching the region to conform to standard of returning 'ErrorEntityNotFound', because the API behind
 	// GetTmTier0GatewayWithContextByName does not handle it well
-	region, err := tmClient.GetRegionById(d.Get("region_id").(string))
+	region, err := getCachedRegionById(meta, d.Get("region_id").(string))
 	if err != nil {
 		return diag.Errorf("no region with ID '%s' found: %s", d.Get("region_id").(string), err)
 	}
@@ -0,0 +1,141 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaTlsProbe = "TLS Probe"
+
+// datasourceVcfaTlsProbe defines a data source that makes VCFA connect to a TLS endpoint, like the "trust" action of the
+// UI does, to retrieve the certificate chain that the endpoint presents
+func datasourceVcfaTlsProbe() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaTlsProbeRead,
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name or IP address of the TLS endpoint, as VCFA resolves it",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      443,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "Port of the TLS endpoint",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum time in seconds that VCFA waits for each step of the probe",
+			},
+			"resolved_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IP address that the host was resolved to",
+			},
+			"connection_result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Result of the connection. One of 'SUCCESS', 'ERROR_CANNOT_RESOLVE_IP' or 'ERROR_CANNOT_CONNECT'",
+			},
+			"ssl_result": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Result of the SSL handshake. One of 'SUCCESS', 'ERROR_SSL_ERROR', 'ERROR_UNTRUSTED_CERTIFICATE' " +
+					"or 'ERROR_CANNOT_VERIFY_HOSTNAME'",
+			},
+			"trusted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether VCFA already trusts the certificate of the endpoint",
+			},
+			"certificate_chain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded certificate chain presented by the endpoint",
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "PEM encoded certificates of the chain, starting with the one of the endpoint",
+			},
+			"root_certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "PEM encoded last certificate of the chain, which is the one that the UI trusts",
+			},
+		},
+	}
+}
+
+func datasourceVcfaTlsProbeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	host := d.Get("host").(string)
+	port := d.Get("port").(int)
+
+	result, err := tmClient.Client.TestConnection(types.TestConnection{
+		Host:                          host,
+		Port:                          port,
+		Secure:                        addrOf(true),
+		Timeout:                       d.Get("timeout").(int),
+		HostnameVerificationAlgorithm: "HTTPS",
+	})
+	if err != nil {
+		return diag.Errorf("[%s] error probing '%s:%d': %s", labelVcfaTlsProbe, host, port, err)
+	}
+	probe := result.TargetProbe
+	if probe == nil || probe.ConnectionResult != "SUCCESS" {
+		message := "no result"
+		if probe != nil {
+			message = fmt.Sprintf("%s: %s", probe.ConnectionResult, probe.Result)
+		}
+		return diag.Errorf("[%s] VCFA could not connect to '%s:%d': %s", labelVcfaTlsProbe, host, port, message)
+	}
+
+	certificates := splitCertificateChain(probe.CertificateChain)
+	rootCertificate := ""
+	if len(certificates) > 0 {
+		rootCertificate = certificates[len(certificates)-1]
+	}
+
+	dSet(d, "resolved_ip", probe.ResolvedIp)
+	dSet(d, "connection_result", probe.ConnectionResult)
+	dSet(d, "ssl_result", probe.SSLResult)
+	dSet(d, "trusted", probe.SSLResult == "SUCCESS")
+	dSet(d, "certificate_chain", probe.CertificateChain)
+	dSet(d, "root_certificate", rootCertificate)
+	if err := d.Set("certificates", certificates); err != nil {
+		return diag.Errorf("[%s] error storing 'certificates': %s", labelVcfaTlsProbe, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", host, port))
+	return nil
+}
+
+// splitCertificateChain returns the PEM encoded certificates of the given chain, in the same order
+func splitCertificateChain(chain string) []string {
+	const endMarker = "-----END CERTIFICATE-----"
+	var certificates []string
+	for _, block := range strings.SplitAfter(chain, endMarker) {
+		block = strings.TrimSpace(block)
+		if strings.HasSuffix(block, endMarker) {
+			certificates = append(certificates, block+"\n")
+		}
+	}
+	return certificates
+}
@@ -0,0 +1,64 @@
+//go:build tm || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaTlsProbe tests that VCFA retrieves the certificate chain of the vCenter from the testing configuration
+func TestAccVcfaTlsProbe(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	vcenterUrl, err := url.Parse(testConfig.Tm.VcenterUrl)
+	if err != nil || vcenterUrl.Hostname() == "" {
+		t.Skipf("a valid tm.vcenterUrl is required in the testing configuration: %v", err)
+	}
+
+	var params = StringMap{
+		"Host":     vcenterUrl.Hostname(),
+		"FuncName": t.Name(),
+		"Tags":     "tm",
+	}
+	testParamsNotEmpty(t, params)
+
+	configText := templateFill(testAccVcfaTlsProbe, params)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION: %s\n", configText)
+
+	probeDef := "data.vcfa_tls_probe.vc"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(probeDef, "id", params["Host"].(string)+":443"),
+					resource.TestCheckResourceAttr(probeDef, "connection_result", "SUCCESS"),
+					resource.TestCheckResourceAttrSet(probeDef, "resolved_ip"),
+					resource.TestMatchResourceAttr(probeDef, "certificates.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr(probeDef, "root_certificate", regexp.MustCompile(`BEGIN CERTIFICATE`)),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaTlsProbe = `
+data "vcfa_tls_probe" "vc" {
+  host = "{{.Host}}"
+}
+`
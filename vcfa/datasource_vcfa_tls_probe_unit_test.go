@@ -0,0 +1,36 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCertificateChain(t *testing.T) {
+	leaf := "-----BEGIN CERTIFICATE-----\nbGVhZg==\n-----END CERTIFICATE-----"
+	root := "-----BEGIN CERTIFICATE-----\ncm9vdA==\n-----END CERTIFICATE-----"
+
+	tests := []struct {
+		name  string
+		chain string
+		want  []string
+	}{
+		{name: "Empty", chain: "", want: nil},
+		{name: "Single", chain: leaf + "\n", want: []string{leaf + "\n"}},
+		{name: "Chain", chain: leaf + "\n" + root + "\n", want: []string{leaf + "\n", root + "\n"}},
+		{name: "NoNewLines", chain: leaf + root, want: []string{leaf + "\n", root + "\n"}},
+		{name: "TrailingGarbage", chain: leaf + "\n\n  \n", want: []string{leaf + "\n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitCertificateChain(tt.chain); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitCertificateChain() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+const labelVcfaTmInventory = "Inventory Summary"
+
+// allSupervisorNamespacesURL lists the Supervisor Namespaces of all Projects
+const allSupervisorNamespacesURL = "/apis/" + ccitypes.SupervisorNamespaceAPI + "/" + ccitypes.SupervisorNamespaceVersion + "/supervisornamespaces"
+
+func datasourceVcfaTmInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaTmInventoryRead,
+		Schema: map[string]*schema.Schema{
+			"org_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of %ss", labelVcfaOrg),
+			},
+			"enabled_org_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of enabled %ss", labelVcfaOrg),
+			},
+			"region_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of %ss", labelVcfaRegion),
+			},
+			"ready_region_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of %ss in READY status", labelVcfaRegion),
+			},
+			"vcenter_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of %ss", labelVcfaVirtualCenter),
+			},
+			"connected_vcenter_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Number of connected %ss", labelVcfaVirtualCenter),
+			},
+			"supervisor_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of Supervisors",
+			},
+			"content_library_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of Content Libraries",
+			},
+			"ready_content_library_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of Content Libraries in READY status",
+			},
+			"supervisor_namespace_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: fmt.Sprintf("Number of %ss visible to the session. It is '-1' when they can't be listed with the current session",
+					labelSupervisorNamespace),
+			},
+			"ready_supervisor_namespace_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: fmt.Sprintf("Number of %ss in CREATED phase. It is '-1' when they can't be listed with the current session",
+					labelSupervisorNamespace),
+			},
+		},
+	}
+}
+
+// tmInventoryCount holds the total and healthy number of entities of a given type
+type tmInventoryCount struct {
+	total   int
+	healthy int
+	err     error
+}
+
+func datasourceVcfaTmInventoryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	counters := map[string]func(*VCDClient) tmInventoryCount{
+		"org":                  countTmInventoryOrgs,
+		"region":               countTmInventoryRegions,
+		"vcenter":              countTmInventoryVcenters,
+		"supervisor":           countTmInventorySupervisors,
+		"content_library":      countTmInventoryContentLibraries,
+		"supervisor_namespace": countTmInventorySupervisorNamespaces,
+	}
+
+	// All the entities are counted in parallel, as each count requires one or more API calls
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	results := make(map[string]tmInventoryCount, len(counters))
+	for name, counter := range counters {
+		wg.Add(1)
+		go func(name string, counter func(*VCDClient) tmInventoryCount) {
+			defer wg.Done()
+			result := counter(tmClient)
+			mutex.Lock()
+			results[name] = result
+			mutex.Unlock()
+		}(name, counter)
+	}
+	wg.Wait()
+
+	for _, name := range []string{"org", "region", "vcenter", "supervisor", "content_library"} {
+		if results[name].err != nil {
+			return diag.Errorf("error retrieving %s: %s", labelVcfaTmInventory, results[name].err)
+		}
+	}
+
+	dSet(d, "org_count", results["org"].total)
+	dSet(d, "enabled_org_count", results["org"].healthy)
+	dSet(d, "region_count", results["region"].total)
+	dSet(d, "ready_region_count", results["region"].healthy)
+	dSet(d, "vcenter_count", results["vcenter"].total)
+	dSet(d, "connected_vcenter_count", results["vcenter"].healthy)
+	dSet(d, "supervisor_count", results["supervisor"].total)
+	dSet(d, "content_library_count", results["content_library"].total)
+	dSet(d, "ready_content_library_count", results["content_library"].healthy)
+
+	// Supervisor Namespaces are tenant entities, so listing them may not be allowed for every session
+	if err := results["supervisor_namespace"].err; err != nil {
+		util.Logger.Printf("[DEBUG] could not count %ss for %s: %s", labelSupervisorNamespace, labelVcfaTmInventory, err)
+		dSet(d, "supervisor_namespace_count", -1)
+		dSet(d, "ready_supervisor_namespace_count", -1)
+	} else {
+		dSet(d, "supervisor_namespace_count", results["supervisor_namespace"].total)
+		dSet(d, "ready_supervisor_namespace_count", results["supervisor_namespace"].healthy)
+	}
+
+	d.SetId(tmClient.Client.VCDHREF.Host)
+	return nil
+}
+
+func countTmInventoryOrgs(tmClient *VCDClient) tmInventoryCount {
+	orgs, err := tmClient.GetAllTmOrgs(nil)
+	if err != nil {
+		return tmInventoryCount{err: fmt.Errorf("error retrieving %ss: %s", labelVcfaOrg, err)}
+	}
+	result := tmInventoryCount{total: len(orgs)}
+	for _, org := range orgs {
+		if org.TmOrg.IsEnabled {
+			result.healthy++
+		}
+	}
+	return result
+}
+
+func countTmInventoryRegions(tmClient *VCDClient) tmInventoryCount {
+	regions, err := tmClient.GetAllRegions(nil)
+	if err != nil {
+		return tmInventoryCount{err: fmt.Errorf("error retrieving %ss: %s", labelVcfaRegion, err)}
+	}
+	result := tmInventoryCount{total: len(regions)}
+	for _, region := range regions {
+		if strings.EqualFold(region.Region.Status, "READY") {
+			result.healthy++
+		}
+	}
+	return result
+}
+
+func countTmInventoryVcenters(tmClient *VCDClient) tmInventoryCount {
+	vcenters, err := tmClient.GetAllVCenters(nil)
+	if err != nil {
+		return tmInventoryCount{err: fmt.Errorf("error retrieving %ss: %s", labelVcfaVirtualCenter, err)}
+	}
+	result := tmInventoryCount{total: len(vcenters)}
+	for _, vc := range vcenters {
+		if vc.VSphereVCenter.IsConnected {
+			result.healthy++
+		}
+	}
+	return result
+}
+
+func countTmInventorySupervisors(tmClient *VCDClient) tmInventoryCount {
+	supervisors, err := tmClient.GetAllSupervisors(nil)
+	if err != nil {
+		return tmInventoryCount{err: fmt.Errorf("error retrieving Supervisors: %s", err)}
+	}
+	return tmInventoryCount{total: len(supervisors)}
+}
+
+func countTmInventoryContentLibraries(tmClient *VCDClient) tmInventoryCount {
+	contentLibraries, err := tmClient.GetAllContentLibraries(nil, nil)
+	if err != nil {
+		return tmInventoryCount{err: fmt.Errorf("error retrieving Content Libraries: %s", err)}
+	}
+	result := tmInventoryCount{total: len(contentLibraries)}
+	for _, cl := range contentLibraries {
+		if strings.EqualFold(cl.ContentLibrary.Status, "READY") {
+			result.healthy++
+		}
+	}
+	return result
+}
+
+func countTmInventorySupervisorNamespaces(tmClient *VCDClient) tmInventoryCount {
+	supervisorNamespacesURL, err := tmClient.VCDClient.Client.GetEntityUrl(allSupervisorNamespacesURL)
+	if err != nil {
+		return tmInventoryCount{err: fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)}
+	}
+
+	supervisorNamespaces, err := getAllCciItems[ccitypes.SupervisorNamespace](tmClient, supervisorNamespacesURL)
+	if err != nil {
+		return tmInventoryCount{err: fmt.Errorf("error listing %ss: %s", labelSupervisorNamespace, err)}
+	}
+
+	result := tmInventoryCount{total: len(supervisorNamespaces)}
+	for _, supervisorNamespace := range supervisorNamespaces {
+		if supervisorNamespace.Status != nil && strings.EqualFold(supervisorNamespace.Status.Phase, "CREATED") {
+			result.healthy++
+		}
+	}
+	return result
+}
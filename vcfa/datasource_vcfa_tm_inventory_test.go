@@ -0,0 +1,70 @@
+//go:build ALL || tm || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVcfaTmInventory(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	tmClient := createSystemTemporaryVCFAConnection()
+	orgs, err := tmClient.GetAllTmOrgs(nil)
+	if err != nil {
+		t.Fatalf("could not retrieve Organizations: %s", err)
+	}
+	regions, err := tmClient.GetAllRegions(nil)
+	if err != nil {
+		t.Fatalf("could not retrieve Regions: %s", err)
+	}
+
+	var params = StringMap{
+		"Tags": "tm",
+	}
+	testParamsNotEmpty(t, params)
+
+	configText := templateFill(testAccVcfaTmInventory, params)
+	debugPrintf("#[DEBUG] CONFIGURATION: %s", configText)
+
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vcfa_tm_inventory.test", "org_count", fmt.Sprintf("%d", len(orgs))),
+					resource.TestCheckResourceAttr("data.vcfa_tm_inventory.test", "region_count", fmt.Sprintf("%d", len(regions))),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "enabled_org_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "ready_region_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "vcenter_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "connected_vcenter_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "supervisor_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "content_library_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "ready_content_library_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "supervisor_namespace_count"),
+					resource.TestCheckResourceAttrSet("data.vcfa_tm_inventory.test", "ready_supervisor_namespace_count"),
+				),
+			},
+		},
+	})
+}
+
+const testAccVcfaTmInventory = `
+data "vcfa_tm_inventory" "test" {
+}
+`
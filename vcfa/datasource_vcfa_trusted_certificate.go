@@ -0,0 +1,55 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcfaTrustedCertificate() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaTrustedCertificateRead,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The ID of the %s that trusts the certificate", labelVcfaOrg),
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Alias of the %s", labelVcfaTrustedCertificate),
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("PEM encoded content of the %s", labelVcfaTrustedCertificate),
+			},
+		},
+	}
+}
+
+func datasourceVcfaTrustedCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	tenantContext, err := getTenantContextFromOrgId(tmClient, d.Get("org_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
+	}
+
+	alias := d.Get("alias").(string)
+	certificate, err := tmClient.GetTrustedCertificateByAlias(alias, tenantContext)
+	if err != nil {
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaTrustedCertificate, alias, err)
+	}
+
+	d.SetId(certificate.TrustedCertificate.ID)
+	setTrustedCertificateData(d, certificate.TrustedCertificate)
+	return nil
+}
@@ -84,6 +84,11 @@ func datasourceVcfaVcenter() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("%s hostname", labelVcfaVirtualCenter),
 			},
+			"vsphere_web_client_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("URL of the vSphere Client of the %s", labelVcfaVirtualCenter),
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
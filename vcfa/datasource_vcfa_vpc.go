@@ -0,0 +1,101 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func datasourceVcfaVpc() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceVcfaVpcRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s", labelVcfaVpc),
+			},
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelVcfaVpc),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the Region where the %s is created", labelVcfaVpc),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Description of the %s", labelVcfaVpc),
+			},
+			"private_ips": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Set of private CIDR blocks that can be used by the Subnets of the %s", labelVcfaVpc),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"connectivity_profile_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the VPC Connectivity Profile that defines the external connectivity of the %s", labelVcfaVpc),
+			},
+			"dhcp_profile_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s used by the Subnets of the %s", labelVcfaVpcDhcpProfile, labelVcfaVpc),
+			},
+			"external_connectivity_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is connected to the outside through its Service Gateway", labelVcfaVpc),
+			},
+			"default_snat_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether a default SNAT rule is created for the private CIDR blocks of the %s", labelVcfaVpc),
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Phase of the %s", labelVcfaVpc),
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is in a ready status or not", labelVcfaVpc),
+			},
+			"conditions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Detailed conditions tracking %s health and lifecycle events", labelVcfaVpc),
+				Elem:        supervisorNamespaceConditionsSchema,
+			},
+		},
+	}
+}
+
+func datasourceVcfaVpcRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	name := d.Get("name").(string)
+	projectName := d.Get("project_name").(string)
+
+	vpc, err := readVpc(tmClient, projectName, name)
+	if err != nil {
+		return diag.Errorf("error reading %s: %s", labelVcfaVpc, projectAccessError(tmClient, projectName, err))
+	}
+	if err := setVpcData(tmClient, d, projectName, name, vpc); err != nil {
+		return diag.Errorf("error setting %s data: %s", labelVcfaVpc, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxListedDependents is the maximum number of dependent objects named in the error of a protected deletion
+const maxListedDependents = 20
+
+// preventDestroyIfNotEmptySchema returns the schema of the 'prevent_destroy_if_not_empty' argument, which makes the
+// deletion of an object fail while it still contains dependent objects
+func preventDestroyIfNotEmptySchema(label, dependentLabel, forceArgument string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: fmt.Sprintf("If true, the deletion of the %s fails while it contains %ss, unless '%s' is set. "+
+			"It is a client side setting", label, dependentLabel, forceArgument),
+	}
+}
+
+// forceDeleteSchema returns the schema of the 'force_delete' argument, which skips the check of
+// 'prevent_destroy_if_not_empty'
+func forceDeleteSchema(label, dependentLabel string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Description: fmt.Sprintf("If true, the %s is deleted even if it contains %ss and 'prevent_destroy_if_not_empty' "+
+			"is set. It is a client side setting", label, dependentLabel),
+	}
+}
+
+// checkDeleteProtection returns an error if 'prevent_destroy_if_not_empty' is set, the deletion is not forced and
+// 'listDependents' returns any object. The dependent objects are only retrieved when the protection is enabled
+func checkDeleteProtection(d *schema.ResourceData, forced bool, label, name, dependentLabel, forceArgument string, listDependents func() ([]string, error)) error {
+	if forced || !d.Get("prevent_destroy_if_not_empty").(bool) {
+		return nil
+	}
+	dependents, err := listDependents()
+	if err != nil {
+		return fmt.Errorf("error retrieving the %ss of %s '%s' before deleting it: %s", dependentLabel, label, name, err)
+	}
+	return nonEmptyDeletionError(label, name, dependentLabel, forceArgument, dependents)
+}
+
+// nonEmptyDeletionError returns the error of a protected deletion, listing the names of the dependent objects, or
+// nil if there are none
+func nonEmptyDeletionError(label, name, dependentLabel, forceArgument string, dependents []string) error {
+	if len(dependents) == 0 {
+		return nil
+	}
+	names := make([]string, len(dependents))
+	copy(names, dependents)
+	sort.Strings(names)
+
+	listed := names
+	if len(names) > maxListedDependents {
+		listed = names[:maxListedDependents]
+	}
+	list := "'" + strings.Join(listed, "', '") + "'"
+	if len(names) > len(listed) {
+		list += fmt.Sprintf(" and %d more", len(names)-len(listed))
+	}
+	return fmt.Errorf("%s '%s' was not deleted because 'prevent_destroy_if_not_empty' is set and it contains %d %s(s): %s. "+
+		"Delete them first, or set '%s' to delete it anyway", label, name, len(names), dependentLabel, list, forceArgument)
+}
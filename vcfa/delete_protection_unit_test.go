@@ -0,0 +1,70 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestNonEmptyDeletionError checks that the error lists the dependent objects, sorted and truncated
+func TestNonEmptyDeletionError(t *testing.T) {
+	if err := nonEmptyDeletionError(labelVcfaVpc, "vpc1", labelVcfaVpcSubnet, "force_delete", nil); err != nil {
+		t.Errorf("expected no error without dependent objects, got %s", err)
+	}
+
+	err := nonEmptyDeletionError(labelVcfaVpc, "vpc1", labelVcfaVpcSubnet, "force_delete", []string{"subnet2", "subnet1"})
+	if err == nil || !strings.Contains(err.Error(), "contains 2 VPC Subnet(s): 'subnet1', 'subnet2'") || !strings.Contains(err.Error(), "set 'force_delete'") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	var dependents []string
+	for i := 0; i < maxListedDependents+5; i++ {
+		dependents = append(dependents, fmt.Sprintf("subnet%02d", i))
+	}
+	err = nonEmptyDeletionError(labelVcfaVpc, "vpc1", labelVcfaVpcSubnet, "force_delete", dependents)
+	if err == nil || !strings.Contains(err.Error(), "'subnet19' and 5 more") || strings.Contains(err.Error(), "subnet20") {
+		t.Errorf("expected the list to be truncated, got %v", err)
+	}
+}
+
+// TestCheckDeleteProtection checks that the dependent objects are only retrieved when the protection applies
+func TestCheckDeleteProtection(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"prevent_destroy_if_not_empty": preventDestroyIfNotEmptySchema(labelVcfaVpc, labelVcfaVpcSubnet, "force_delete"),
+	}
+	tests := []struct {
+		name          string
+		prevent       bool
+		forced        bool
+		expectListed  bool
+		expectedError bool
+	}{
+		{name: "Disabled", prevent: false, forced: false, expectListed: false, expectedError: false},
+		{name: "Forced", prevent: true, forced: true, expectListed: false, expectedError: false},
+		{name: "Protected", prevent: true, forced: false, expectListed: true, expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"prevent_destroy_if_not_empty": tt.prevent})
+			listed := false
+			err := checkDeleteProtection(d, tt.forced, labelVcfaVpc, "vpc1", labelVcfaVpcSubnet, "force_delete", func() ([]string, error) {
+				listed = true
+				return []string{"subnet1"}, nil
+			})
+			if listed != tt.expectListed {
+				t.Errorf("expected the dependent objects to be retrieved: %t, got %t", tt.expectListed, listed)
+			}
+			if (err != nil) != tt.expectedError {
+				t.Errorf("expected an error: %t, got %v", tt.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dryRunSupportedResources are the resources that, when 'dry_run' is set in the provider, send their changes as
+// server-side dry runs (Kubernetes 'dryRun=All'), so they are validated, including admission webhooks, but not persisted.
+// Any other resource refuses to apply changes in dry-run mode
+var dryRunSupportedResources = map[string]bool{
+	"vcfa_cci_resource":                               true,
+	"vcfa_supervisor_namespace":                       true,
+	"vcfa_supervisor_namespace_role_binding":          true,
+	"vcfa_supervisor_namespace_storage_class_binding": true,
+	"vcfa_vpc":              true,
+	"vcfa_vpc_dhcp_profile": true,
+	"vcfa_vpc_dns_service":  true,
+	"vcfa_vpc_subnet":       true,
+}
+
+// isDryRun returns whether the provider is configured with 'dry_run'
+func isDryRun(meta interface{}) bool {
+	container, ok := meta.(ClientContainer)
+	return ok && container.dryRun
+}
+
+// dryRunParams returns the query parameters that turn CCI write requests into server-side dry runs when the
+// provider is configured with 'dry_run', or nil otherwise
+func dryRunParams(meta interface{}) url.Values {
+	if !isDryRun(meta) {
+		return nil
+	}
+	return url.Values{"dryRun": []string{"All"}}
+}
+
+// dryRunDiagnostics reports that a dry run succeeded. It is an error, so Terraform doesn't store an object that
+// was never persisted, and the apply stops without changing anything. The resource is marked as partial, so the planned
+// values of an update are not saved in the state and the change is planned again
+func dryRunDiagnostics(d *schema.ResourceData, entityLabel, name, operation string) diag.Diagnostics {
+	d.Partial(true)
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "[dry run] " + entityLabel + " " + name + " passed the server-side validation and was not " + operation,
+		Detail: "The provider is configured with 'dry_run', so changes are only validated by VCFA. Remove 'dry_run' " +
+			"from the provider configuration to apply them.",
+	}}
+}
+
+// guardResourceForDryRun makes the create, update and delete operations of a resource that doesn't support dry runs
+// fail when the provider is configured with 'dry_run', so nothing is changed. A refused update marks the resource as
+// partial, so its planned values are not saved in the state
+func guardResourceForDryRun(name string, resource *schema.Resource) {
+	refuse := func(operation string) diag.Diagnostics {
+		return diag.Errorf("[dry run] %s doesn't support dry runs, so it was not %s. Remove 'dry_run' from the "+
+			"provider configuration to apply the changes", name, operation)
+	}
+	guard := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if isDryRun(meta) {
+				if operation == "updated" {
+					d.Partial(true)
+				}
+				return refuse(operation)
+			}
+			return f(ctx, d, meta)
+		}
+	}
+	resource.CreateContext = guard("created", resource.CreateContext)
+	resource.UpdateContext = guard("updated", resource.UpdateContext)
+	resource.DeleteContext = guard("deleted", resource.DeleteContext)
+	resource.CreateWithoutTimeout = guard("created", resource.CreateWithoutTimeout)
+	resource.UpdateWithoutTimeout = guard("updated", resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = guard("deleted", resource.DeleteWithoutTimeout)
+}
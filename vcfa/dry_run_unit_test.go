@@ -0,0 +1,90 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestDryRunParams checks that CCI write requests are only sent as dry runs when the provider is configured with 'dry_run'
+func TestDryRunParams(t *testing.T) {
+	if params := dryRunParams(ClientContainer{}); params != nil {
+		t.Errorf("expected no parameters without 'dry_run', got %v", params)
+	}
+	if params := dryRunParams(ClientContainer{dryRun: true}); params.Get("dryRun") != "All" {
+		t.Errorf("expected 'dryRun=All' with 'dry_run', got %v", params)
+	}
+}
+
+// TestGuardResourceForDryRun checks that resources that don't support dry runs refuse to change anything in dry-run mode
+func TestGuardResourceForDryRun(t *testing.T) {
+	called := false
+	resource := &schema.Resource{
+		CreateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			called = true
+			return nil
+		},
+	}
+	guardResourceForDryRun("vcfa_test", resource)
+	if resource.UpdateContext != nil || resource.DeleteContext != nil {
+		t.Fatalf("expected undefined operations to remain undefined")
+	}
+
+	diags := resource.CreateContext(context.Background(), nil, ClientContainer{dryRun: true})
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[dry run]") || called {
+		t.Errorf("expected create to be refused in dry-run mode, got %v (called: %t)", diags, called)
+	}
+
+	diags = resource.CreateContext(context.Background(), nil, ClientContainer{})
+	if diags.HasError() || !called {
+		t.Errorf("expected create to be called without 'dry_run', got %v (called: %t)", diags, called)
+	}
+
+	updateResource := &schema.Resource{
+		UpdateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+	}
+	guardResourceForDryRun("vcfa_test", updateResource)
+	diags = updateResource.UpdateContext(context.Background(), schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, nil), ClientContainer{dryRun: true})
+	if !diags.HasError() {
+		t.Errorf("expected update to be refused in dry-run mode, got %v", diags)
+	}
+
+	for name := range dryRunSupportedResources {
+		if _, ok := globalResourceMap[name]; !ok {
+			t.Errorf("resource %s supports dry runs but it is not defined in the provider", name)
+		}
+	}
+}
+
+// TestDryRunSupportedResourcesAreNotGuarded checks that the resources that send their changes as server-side dry runs
+// are called in dry-run mode, instead of being refused by the guard of wrapResource
+func TestDryRunSupportedResourcesAreNotGuarded(t *testing.T) {
+	names := []string{
+		"vcfa_cci_resource",
+		"vcfa_supervisor_namespace_storage_class_binding",
+		"vcfa_supervisor_namespace_role_binding",
+	}
+	for _, name := range names {
+		called := false
+		resource := &schema.Resource{
+			CreateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+				called = true
+				return nil
+			},
+		}
+		wrapResource(name, resource)
+		diags := resource.CreateWithoutTimeout(context.Background(), resource.TestResourceData(), ClientContainer{dryRun: true})
+		if diags.HasError() || !called {
+			t.Errorf("expected %s to be called in dry-run mode, got %v (called: %t)", name, diags, called)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiErrorClass is a kind of error returned by VCFA, recognized by the messages of the OpenAPI, the legacy API and
+// the CCI Kubernetes API, with a hint about how to solve it
+type apiErrorClass struct {
+	code    string
+	pattern *regexp.Regexp
+	hint    string
+}
+
+// apiErrorClasses are checked in order, so the most specific ones go first
+var apiErrorClasses = []apiErrorClass{
+	{
+		code:    "REGION_QUOTA_MISSING",
+		pattern: regexp.MustCompile(`(?i)region\b.*\bnot (assigned|allocated|available) to\b|no (region quota|vdc) (found )?for|does not have (a )?region quota`),
+		hint:    "The Organization has no Region Quota in that Region. Assign one with 'vcfa_org_region_quota' before creating resources in it",
+	},
+	{
+		code:    "QUOTA_EXCEEDED",
+		pattern: regexp.MustCompile(`(?i)exceeded quota|quota exceeded|exceeds? the (allowed |maximum )?(limit|quota)|reason: Forbidden.*quota`),
+		hint:    "A quota would be exceeded. Raise the limits of the Region Quota or the Project, or remove unused resources",
+	},
+	{
+		code:    "FORBIDDEN",
+		pattern: regexp.MustCompile(`(?i)ACCESS_TO_RESOURCE_IS_FORBIDDEN|API Error: 403|code: 403|reason: Forbidden|403 Forbidden|NOT_AUTHORIZED|is not authorized`),
+		hint:    "The user or API token lacks the rights for this operation, or the object belongs to another Organization. Check its role with the 'vcfa_effective_rights' data source",
+	},
+	{
+		code:    "CONFLICT",
+		pattern: regexp.MustCompile(`(?i)DUPLICATE_NAME|BUSY_ENTITY|CONCURRENT_MODIFICATION|API Error: 409|code: 409|reason: (AlreadyExists|Conflict)|409 Conflict|already exists`),
+		hint:    "The object already exists, or it is being changed by another operation. Import it, or retry when the other operation has finished",
+	},
+	{
+		code:    "NOT_FOUND",
+		pattern: regexp.MustCompile(`(?i)\[ENF\]|API Error: 404|code: 404|reason: NotFound|NOT_FOUND|404 Not Found`),
+		hint:    "The object, or one that it refers to, doesn't exist or isn't visible to this user. Check the IDs and names of its references",
+	},
+}
+
+// apiMinorErrorCodeRegex matches the minor error codes of VCFA, like 'ACCESS_TO_RESOURCE_IS_FORBIDDEN' in the OpenAPI
+// errors and the reasons of the CCI errors, like 'reason: AlreadyExists'
+var apiMinorErrorCodeRegex = regexp.MustCompile(`\b([A-Z][A-Z0-9]*(?:_[A-Z0-9]+)+) - |reason: ([A-Za-z]+)`)
+
+// classifiedApiError is the classification of an error returned by VCFA
+type classifiedApiError struct {
+	code      string
+	minorCode string
+	hint      string
+}
+
+// classifyApiError returns the class and the minor error code of the given error message. It returns false if the
+// message is not recognized
+func classifyApiError(message string) (classifiedApiError, bool) {
+	for _, class := range apiErrorClasses {
+		if !class.pattern.MatchString(message) {
+			continue
+		}
+		result := classifiedApiError{code: class.code, hint: class.hint}
+		if matches := apiMinorErrorCodeRegex.FindStringSubmatch(message); matches != nil {
+			result.minorCode = matches[1] + matches[2]
+		}
+		return result, true
+	}
+	return classifiedApiError{}, false
+}
+
+// addErrorClassification appends the error code, the minor error code of VCFA and a remediation hint to the detail
+// of the error diagnostics that are recognized by classifyApiError. Other diagnostics are returned unchanged
+func addErrorClassification(diags diag.Diagnostics) diag.Diagnostics {
+	for i, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		classified, ok := classifyApiError(d.Summary + " " + d.Detail)
+		if !ok {
+			continue
+		}
+		code := classified.code
+		if classified.minorCode != "" {
+			code = fmt.Sprintf("%s (%s)", classified.code, classified.minorCode)
+		}
+		detail := fmt.Sprintf("Error code: %s. Hint: %s", code, classified.hint)
+		if d.Detail != "" {
+			detail = strings.TrimRight(d.Detail, "\n") + "\n\n" + detail
+		}
+		diags[i].Detail = detail
+	}
+	return diags
+}
+
+// classifyOperationErrors adds the classification of addErrorClassification to the errors of every operation of a
+// resource or data source
+func classifyOperationErrors(resource *schema.Resource) {
+	classify := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return addErrorClassification(f(ctx, d, meta))
+		}
+	}
+	resource.CreateContext = classify(resource.CreateContext)
+	resource.ReadContext = classify(resource.ReadContext)
+	resource.UpdateContext = classify(resource.UpdateContext)
+	resource.DeleteContext = classify(resource.DeleteContext)
+	resource.CreateWithoutTimeout = classify(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = classify(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = classify(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = classify(resource.DeleteWithoutTimeout)
+}
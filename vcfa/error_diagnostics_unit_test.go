@@ -0,0 +1,89 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// TestClassifyApiError checks the classification of the errors of the different VCFA APIs
+func TestClassifyApiError(t *testing.T) {
+	tests := []struct {
+		message           string
+		expectedCode      string
+		expectedMinorCode string
+	}{
+		{
+			message:           "error getting Region: error in HTTP GET request: ACCESS_TO_RESOURCE_IS_FORBIDDEN - [ 1234 ] Access is forbidden",
+			expectedCode:      "FORBIDDEN",
+			expectedMinorCode: "ACCESS_TO_RESOURCE_IS_FORBIDDEN",
+		},
+		{
+			message:      "error creating Organization: API Error: 403: Either you need some or all of the following rights",
+			expectedCode: "FORBIDDEN",
+		},
+		{
+			message:           "error creating Supervisor Namespace: apiVersion: v1 code: 409 kind Status: reason: AlreadyExists, message: already exists",
+			expectedCode:      "CONFLICT",
+			expectedMinorCode: "AlreadyExists",
+		},
+		{
+			message:           "error updating Content Library: error in HTTP PUT request: BUSY_ENTITY - [ 5678 ] The entity is busy",
+			expectedCode:      "CONFLICT",
+			expectedMinorCode: "BUSY_ENTITY",
+		},
+		{
+			message:      "error retrieving Organization: [ENF] entity not found",
+			expectedCode: "NOT_FOUND",
+		},
+		{
+			message:           "error creating Supervisor Namespace: apiVersion: v1 code: 403 kind Status: reason: Forbidden, message: exceeded quota: project-quota, requested: count/supervisornamespaces=1",
+			expectedCode:      "QUOTA_EXCEEDED",
+			expectedMinorCode: "Forbidden",
+		},
+		{
+			message:      "error creating Supervisor Namespace: Region region1 is not assigned to Organization org1",
+			expectedCode: "REGION_QUOTA_MISSING",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expectedCode, func(t *testing.T) {
+			classified, ok := classifyApiError(tt.message)
+			if !ok {
+				t.Fatalf("expected %q to be classified", tt.message)
+			}
+			if classified.code != tt.expectedCode || classified.minorCode != tt.expectedMinorCode {
+				t.Errorf("expected code %s and minor code %q, got %s and %q", tt.expectedCode, tt.expectedMinorCode, classified.code, classified.minorCode)
+			}
+			if classified.hint == "" {
+				t.Errorf("expected a hint")
+			}
+		})
+	}
+
+	if _, ok := classifyApiError("error setting 'name': invalid value"); ok {
+		t.Errorf("expected errors that are not returned by VCFA to stay unclassified")
+	}
+}
+
+// TestAddErrorClassification checks that only error diagnostics get the classification
+func TestAddErrorClassification(t *testing.T) {
+	diags := addErrorClassification(diag.Diagnostics{
+		{Severity: diag.Error, Summary: "error deleting Region: API Error: 409: still in use", Detail: "Some detail"},
+		{Severity: diag.Warning, Summary: "code: 404"},
+		{Severity: diag.Error, Summary: "error setting 'name'"},
+	})
+	if !strings.HasPrefix(diags[0].Detail, "Some detail\n\nError code: CONFLICT. Hint: ") {
+		t.Errorf("unexpected detail of the classified error: %q", diags[0].Detail)
+	}
+	if diags[1].Detail != "" || diags[2].Detail != "" {
+		t.Errorf("expected warnings and unclassified errors to stay unchanged, got %q and %q", diags[1].Detail, diags[2].Detail)
+	}
+}
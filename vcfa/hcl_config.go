@@ -0,0 +1,73 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// hclArgument is an argument of a generated HCL block. Its value must be a string, a bool or a slice of strings
+type hclArgument struct {
+	name  string
+	value interface{}
+}
+
+// hclBlock is a generated HCL block, like a resource or one of its nested blocks
+type hclBlock struct {
+	blockType    string
+	labels       []string
+	arguments    []hclArgument
+	nestedBlocks []hclBlock
+}
+
+// writeHclBlock writes the given block formatted as 'terraform fmt' does
+func writeHclBlock(sb *strings.Builder, block hclBlock, indent string) {
+	sb.WriteString(indent + block.blockType)
+	for _, label := range block.labels {
+		sb.WriteString(" " + hclString(label))
+	}
+	sb.WriteString(" {\n")
+
+	nameWidth := 0
+	for _, argument := range block.arguments {
+		nameWidth = max(nameWidth, len(argument.name))
+	}
+	for _, argument := range block.arguments {
+		fmt.Fprintf(sb, "%s  %-*s = %s\n", indent, nameWidth, argument.name, hclValue(argument.value))
+	}
+	for i, nestedBlock := range block.nestedBlocks {
+		if i > 0 || len(block.arguments) > 0 {
+			sb.WriteString("\n")
+		}
+		writeHclBlock(sb, nestedBlock, indent+"  ")
+	}
+	sb.WriteString(indent + "}\n")
+}
+
+// hclValue returns the HCL representation of a string, a bool or a slice of strings
+func hclValue(value interface{}) string {
+	switch typedValue := value.(type) {
+	case bool:
+		return strconv.FormatBool(typedValue)
+	case []string:
+		elements := make([]string, len(typedValue))
+		for i, element := range typedValue {
+			elements[i] = hclString(element)
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	default:
+		return hclString(fmt.Sprintf("%s", typedValue))
+	}
+}
+
+// hclString returns a quoted HCL string. Besides the Go escapes, the template sequences '${' and '%{' are escaped, so
+// the value is not interpreted by Terraform
+func hclString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
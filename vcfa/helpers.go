@@ -5,9 +5,13 @@
 package vcfa
 
 import (
+	"fmt"
 	"os"
+	"regexp"
+	"sort"
 	"strings"
 
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 	"github.com/vmware/go-vcloud-director/v3/util"
 )
@@ -39,3 +43,79 @@ func safeClose(file *os.File) {
 		util.Logger.Printf("Error closing file: %s\n", err)
 	}
 }
+
+// projectAccessError inspects an error returned by the CCI API for an entity that lives inside the Project
+// 'projectName'. The CCI API answers with 403 or 404 when the Project belongs to a different Organization than
+// the one of the session, which is confusing. In that case, this function returns an error that names the session's
+// Organization and the Projects that are visible to it. In any other case, the original error is returned.
+func projectAccessError(tmClient *VCDClient, projectName string, err error) error {
+	if err == nil || !isCciAccessError(err) {
+		return err
+	}
+
+	projectNames, listErr := getVisibleProjectNames(tmClient)
+	if listErr != nil {
+		util.Logger.Printf("[DEBUG] could not list Projects to diagnose error: %s", listErr)
+		return err
+	}
+	for _, name := range projectNames {
+		if name == projectName {
+			// The Project is visible, so the error is not caused by it
+			return err
+		}
+	}
+
+	visibleProjects := "none"
+	if len(projectNames) > 0 {
+		visibleProjects = strings.Join(projectNames, ", ")
+	}
+	return fmt.Errorf("the Project '%s' is not accessible from Organization '%s', which is the Organization of the current session "+
+		"(check that 'project_name' refers to a Project of that Organization). Projects visible to this session: %s. Original error: %s",
+		projectName, tmClient.sessionOrg(), visibleProjects, err)
+}
+
+// isCciAccessError returns true if the given error was caused by a 403 (Forbidden) or 404 (Not Found) response
+// from the CCI API
+func isCciAccessError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return govcd.ContainsNotFound(err) || strings.Contains(err.Error(), "code: 403")
+}
+
+// getVisibleProjectNames returns the sorted names of all Projects that are visible to the current session
+func getVisibleProjectNames(tmClient *VCDClient) ([]string, error) {
+	projectsURL, err := tmClient.VCDClient.Client.GetEntityUrl(ccitypes.ProjectsURL)
+	if err != nil {
+		return nil, fmt.Errorf("error building Projects URL: %s", err)
+	}
+
+	projects, err := getAllCciItems[ccitypes.Project](tmClient, projectsURL)
+	if err != nil {
+		return nil, fmt.Errorf("error listing Projects: %s", err)
+	}
+
+	projectNames := make([]string, len(projects))
+	for i, project := range projects {
+		projectNames[i] = project.GetName()
+	}
+	sort.Strings(projectNames)
+	return projectNames, nil
+}
+
+// rfc1123InvalidCharactersRegex matches the sequences of characters that are not allowed in RFC 1123 Label Names
+var rfc1123InvalidCharactersRegex = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// rfc1123LabelMaxLength is the maximum length of an RFC 1123 Label Name
+const rfc1123LabelMaxLength = 63
+
+// normalizeRfc1123Name converts a name into an RFC 1123 Label Name, so it can be used where Kubernetes names are
+// expected: it is lowercased, the sequences of invalid characters are replaced by a hyphen, and it is trimmed to 63
+// characters that start and end with an alphanumeric character. For example, "My Org_1" becomes "my-org-1"
+func normalizeRfc1123Name(name string) string {
+	normalized := strings.Trim(rfc1123InvalidCharactersRegex.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if len(normalized) > rfc1123LabelMaxLength {
+		normalized = strings.TrimRight(normalized[:rfc1123LabelMaxLength], "-")
+	}
+	return normalized
+}
@@ -0,0 +1,38 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeRfc1123Name(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "region1", want: "region1"},
+		{name: "My Org_1", want: "my-org-1"},
+		{name: "  --ACME  Corp.--  ", want: "acme-corp"},
+		{name: "Dev/Test (EU)", want: "dev-test-eu"},
+		{name: "1st-project", want: "1st-project"},
+		{name: "___", want: ""},
+		// Truncated to 63 characters, without ending in a hyphen
+		{name: strings.Repeat("a", 62) + "-b", want: strings.Repeat("a", 62)},
+		{name: strings.Repeat("ab", 40), want: strings.Repeat("ab", 31) + "a"},
+	}
+	for _, tt := range tests {
+		got := normalizeRfc1123Name(tt.name)
+		if got != tt.want {
+			t.Errorf("normalizeRfc1123Name(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+		if len(got) > rfc1123LabelMaxLength {
+			t.Errorf("normalizeRfc1123Name(%q) is longer than %d characters", tt.name, rfc1123LabelMaxLength)
+		}
+	}
+}
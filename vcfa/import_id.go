@@ -0,0 +1,86 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// URN prefixes of the objects that can be imported by ID, in addition to their name paths
+const (
+	urnPrefixOrg                = "urn:vcloud:org:"
+	urnPrefixRegion             = "urn:vcloud:region:"
+	urnPrefixVcenter            = "urn:vcloud:vimserver:"
+	urnPrefixNsxManager         = "urn:vcloud:nsxtmanager:"
+	urnPrefixProviderGateway    = "urn:vcloud:providerGateway:"
+	urnPrefixIpSpace            = "urn:vcloud:ipSpace:"
+	urnPrefixContentLibrary     = "urn:vcloud:contentLibrary:"
+	urnPrefixContentLibraryItem = "urn:vcloud:contentLibraryItem:"
+	urnPrefixGlobalRole         = "urn:vcloud:globalRole:"
+	urnPrefixRightsBundle       = "urn:vcloud:rightsBundle:"
+)
+
+// getImportUrn returns the URN of the object to import when the import ID is a URN with the given prefix, like
+// 'urn:vcloud:org:<uuid>', or a bare UUID, which is completed with the prefix. Objects imported this way don't
+// need to be looked up by name, so it works even when their names are ambiguous or contain the import separator.
+// It returns false when the import ID must be handled as a name path
+func getImportUrn(importId, urnPrefix string) (string, bool) {
+	if govcd.IsUuid(importId) {
+		return urnPrefix + importId, true
+	}
+	if strings.HasPrefix(importId, urnPrefix) && govcd.IsUuid(strings.TrimPrefix(importId, urnPrefix)) {
+		return importId, true
+	}
+	return "", false
+}
+
+// SplitImportId splits a composite import ID, like '<org name>.<user name>', by the import separator into at most n
+// elements, with the same meaning of n as in strings.SplitN. Names that contain the separator can be given
+// percent-encoded, like 'my%2Eorg' for 'my.org' with the default separator, in which case '%' must be given as '%25'
+func SplitImportId(importId string, n int) []string {
+	elements := strings.SplitN(importId, ImportSeparator, n)
+	for i, element := range elements {
+		elements[i] = decodeImportIdElement(element)
+	}
+	return elements
+}
+
+// decodeImportIdElement decodes a percent-encoded element of an import ID. Elements that are not valid percent-encoded
+// strings, like names with a '%' that is not followed by two hexadecimal digits, are returned unchanged
+func decodeImportIdElement(element string) string {
+	decoded, err := url.PathUnescape(element)
+	if err != nil {
+		return element
+	}
+	return decoded
+}
+
+// buildImportId joins the given names with the import separator, percent-encoding the ones that contain it, so that
+// the result can be split back with SplitImportId
+func buildImportId(elements ...string) string {
+	encoded := make([]string, len(elements))
+	for i, element := range elements {
+		encoded[i] = encodeImportIdElement(element)
+	}
+	return strings.Join(encoded, ImportSeparator)
+}
+
+// encodeImportIdElement percent-encodes the import separator and '%' in the given name. Names without them are
+// returned unchanged, so the import IDs stay readable
+func encodeImportIdElement(element string) string {
+	if !strings.Contains(element, ImportSeparator) && !strings.Contains(element, "%") {
+		return element
+	}
+	var encodedSeparator strings.Builder
+	for _, b := range []byte(ImportSeparator) {
+		fmt.Fprintf(&encodedSeparator, "%%%02X", b)
+	}
+	element = strings.ReplaceAll(element, "%", "%25")
+	return strings.ReplaceAll(element, ImportSeparator, encodedSeparator.String())
+}
@@ -0,0 +1,83 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestGetImportUrn checks which import IDs are handled as URNs instead of name paths
+func TestGetImportUrn(t *testing.T) {
+	const uuid = "8a0e7a12-1c4e-4d1b-9f52-2b8b5c3f1d0e"
+	tests := []struct {
+		name     string
+		importId string
+		wantUrn  string
+		wantOk   bool
+	}{
+		{name: "Urn", importId: urnPrefixOrg + uuid, wantUrn: urnPrefixOrg + uuid, wantOk: true},
+		{name: "BareUuid", importId: uuid, wantUrn: urnPrefixOrg + uuid, wantOk: true},
+		{name: "OtherEntityUrn", importId: urnPrefixRegion + uuid},
+		{name: "InvalidUuid", importId: urnPrefixOrg + "not-a-uuid"},
+		{name: "Name", importId: "my-org"},
+		{name: "NameLikeUrn", importId: "urn:vcloud:org:my-org"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			urn, ok := getImportUrn(tt.importId, urnPrefixOrg)
+			if ok != tt.wantOk || urn != tt.wantUrn {
+				t.Errorf("expected ('%s', %t), got ('%s', %t)", tt.wantUrn, tt.wantOk, urn, ok)
+			}
+		})
+	}
+}
+
+// TestSplitImportId checks that the elements of composite import IDs are split and decoded
+func TestSplitImportId(t *testing.T) {
+	tests := []struct {
+		importId string
+		n        int
+		want     []string
+	}{
+		{importId: "my-org.my-user", n: -1, want: []string{"my-org", "my-user"}},
+		{importId: "my%2Eorg.my.user", n: -1, want: []string{"my.org", "my", "user"}},
+		{importId: "my%2Eorg.my.user", n: 2, want: []string{"my.org", "my.user"}},
+		{importId: "100%25.50%", n: -1, want: []string{"100%", "50%"}},
+		{importId: "System.my library", n: -1, want: []string{"System", "my library"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.importId, func(t *testing.T) {
+			if got := SplitImportId(tt.importId, tt.n); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestBuildImportId checks that the names that contain the import separator are encoded and split back
+func TestBuildImportId(t *testing.T) {
+	tests := []struct {
+		elements []string
+		want     string
+	}{
+		{elements: []string{"my-org", "my-user"}, want: "my-org.my-user"},
+		{elements: []string{"my.org", "100%"}, want: "my%2Eorg.100%25"},
+		{elements: []string{"System", "my library"}, want: "System.my library"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := buildImportId(tt.elements...)
+			if got != tt.want {
+				t.Errorf("expected '%s', got '%s'", tt.want, got)
+			}
+			if split := SplitImportId(got, -1); !reflect.DeepEqual(split, tt.elements) {
+				t.Errorf("expected %q after splitting, got %q", tt.elements, split)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+// SessionToken is the bearer token of the provider session, with the details taken from its claims
+type SessionToken struct {
+	Token string
+	// Username is the preferred username of the user of the session
+	Username string
+	// ExpiresAt is the expiration time of the token, or the zero time if the token does not expire
+	ExpiresAt time.Time
+}
+
+// Kubeconfig is a kubeconfig for the CCI Kubernetes endpoint of VCFA, or for the endpoint of a Supervisor Namespace,
+// that authenticates with a bearer token
+type Kubeconfig struct {
+	Host                  string
+	InsecureSkipTLSVerify bool
+	Token                 string
+	User                  string
+	ContextName           string
+	// Raw is the kubeconfig in JSON format
+	Raw string
+}
+
+// GetSessionToken returns the bearer token of the provider session. It is used by the 'vcfa_kubeconfig' data source
+func GetSessionToken(tmClient *VCDClient) (*SessionToken, error) {
+	return parseSessionToken(tmClient.Client.VCDToken)
+}
+
+// parseSessionToken returns the details of the given bearer token, taken from its claims
+func parseSessionToken(bearerToken string) (*SessionToken, error) {
+	token, _, err := new(jwt.Parser).ParseUnverified(bearerToken, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing JWT token: %s", err)
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("could not parse claims from JWT token")
+	}
+	preferredUsername, ok := claims["preferred_username"].(string)
+	if !ok {
+		return nil, errors.New("could not parse preferred username from JWT token claims")
+	}
+	sessionToken := &SessionToken{
+		Token:    token.Raw,
+		Username: preferredUsername,
+	}
+	expiration, err := claims.GetExpirationTime()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse expiration time from JWT token claims: %s", err)
+	}
+	if expiration != nil {
+		sessionToken.ExpiresAt = expiration.Time
+	}
+	return sessionToken, nil
+}
+
+// BuildKubeconfig returns a kubeconfig that authenticates with the given token for the CCI Kubernetes endpoint of
+// VCFA or, when the Project and Supervisor Namespace names are set, for the endpoint of that Supervisor Namespace,
+// which must be ready
+func BuildKubeconfig(ctx context.Context, tmClient *VCDClient, sessionToken *SessionToken, projectName, supervisorNamespaceName string) (*Kubeconfig, error) {
+	clusterName := fmt.Sprintf("%s:%s", tmClient.Org, tmClient.Client.VCDHREF.Host)
+	clusterServer := fmt.Sprintf(ccitypes.KubernetesSubpath, tmClient.Client.VCDHREF.Scheme, tmClient.Client.VCDHREF.Host)
+	contextName := tmClient.Org
+
+	if projectName != "" && supervisorNamespaceName != "" {
+		supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, supervisorNamespaceName)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %s", labelSupervisorNamespace, projectAccessError(tmClient, projectName, err))
+		}
+		readyStatus := false
+		for _, condition := range supervisorNamespace.Status.Conditions {
+			if strings.ToLower(condition.Type) == "ready" {
+				if strings.ToLower(condition.Status) == "true" {
+					readyStatus = true
+				}
+				break
+			}
+		}
+		if !readyStatus {
+			return nil, fmt.Errorf("%s %s is not in a ready status", labelSupervisorNamespace, supervisorNamespaceName)
+		}
+		if supervisorNamespace.Status.NamespaceEndpointURL == "" {
+			return nil, fmt.Errorf("unable to retrieve the endpoint URL for %s %s", labelSupervisorNamespace, supervisorNamespaceName)
+		}
+		clusterName = fmt.Sprintf("%s:%s@%s", tmClient.Org, supervisorNamespaceName, tmClient.Client.VCDHREF.Host)
+		clusterServer = supervisorNamespace.Status.NamespaceEndpointURL
+		contextName = fmt.Sprintf("%s:%s:%s", tmClient.Org, supervisorNamespaceName, projectName)
+	}
+
+	username := fmt.Sprintf("%s:%s@%s", tmClient.Org, sessionToken.Username, tmClient.Client.VCDHREF.Host)
+
+	kubeconfig := &clientcmdapi.Config{
+		Kind:       "Config",
+		APIVersion: clientcmdapi.SchemeGroupVersion.Version,
+		Clusters: []clientcmdapi.NamedCluster{{
+			Name: clusterName,
+			Cluster: clientcmdapi.Cluster{
+				InsecureSkipTLSVerify: tmClient.InsecureFlag,
+				Server:                clusterServer,
+			},
+		}},
+		Contexts: []clientcmdapi.NamedContext{
+			{
+				Name: contextName,
+				Context: clientcmdapi.Context{
+					Cluster:  clusterName,
+					AuthInfo: username,
+				},
+			},
+		},
+		AuthInfos: []clientcmdapi.NamedAuthInfo{
+			{
+				Name: username,
+				AuthInfo: clientcmdapi.AuthInfo{
+					Token: sessionToken.Token,
+				},
+			},
+		},
+		CurrentContext: contextName,
+	}
+	// Kubernetes clients reject a CA together with the insecure flag
+	if tmClient.CaCertificate != "" && !tmClient.InsecureFlag {
+		kubeconfig.Clusters[0].Cluster.CertificateAuthorityData = []byte(tmClient.CaCertificate)
+	}
+	if projectName != "" && supervisorNamespaceName != "" {
+		kubeconfig.Contexts[0].Context.Namespace = supervisorNamespaceName
+	}
+
+	kubeconfigBytes, err := json.MarshalIndent(kubeconfig, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling kubeconfig: %s", err)
+	}
+
+	return &Kubeconfig{
+		Host:                  clusterServer,
+		InsecureSkipTLSVerify: tmClient.InsecureFlag,
+		Token:                 sessionToken.Token,
+		User:                  username,
+		ContextName:           contextName,
+		Raw:                   string(kubeconfigBytes),
+	}, nil
+}
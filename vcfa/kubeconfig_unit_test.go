@@ -0,0 +1,84 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api/v1"
+)
+
+// newKubeconfigTestClient returns a client whose session token has the given claims
+func newKubeconfigTestClient(t *testing.T, claims jwt.MapClaims) *VCDClient {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("test-key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := govcd.NewVCDClient(url.URL{Scheme: "https", Host: "vcfa.example.com", Path: "/api"}, true)
+	client.Client.VCDToken = token
+	return &VCDClient{VCDClient: client, Org: "tenant1", InsecureFlag: true}
+}
+
+// TestGetSessionToken checks the details taken from the claims of the session token
+func TestGetSessionToken(t *testing.T) {
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	tmClient := newKubeconfigTestClient(t, jwt.MapClaims{"preferred_username": "admin", "exp": expiresAt.Unix()})
+	sessionToken, err := GetSessionToken(tmClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if sessionToken.Username != "admin" || !sessionToken.ExpiresAt.Equal(expiresAt) || sessionToken.Token != tmClient.Client.VCDToken {
+		t.Errorf("unexpected session token %+v", sessionToken)
+	}
+
+	tmClient = newKubeconfigTestClient(t, jwt.MapClaims{"preferred_username": "admin"})
+	sessionToken, err = GetSessionToken(tmClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !sessionToken.ExpiresAt.IsZero() {
+		t.Errorf("expected no expiration, got %s", sessionToken.ExpiresAt)
+	}
+
+	tmClient = newKubeconfigTestClient(t, jwt.MapClaims{"sub": "admin"})
+	if _, err := GetSessionToken(tmClient); err == nil {
+		t.Errorf("expected an error for a token without preferred username")
+	}
+}
+
+// TestBuildKubeconfig checks the kubeconfig of the CCI Kubernetes endpoint
+func TestBuildKubeconfig(t *testing.T) {
+	tmClient := newKubeconfigTestClient(t, jwt.MapClaims{"preferred_username": "admin"})
+	sessionToken, err := GetSessionToken(tmClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	kubeconfig, err := BuildKubeconfig(context.Background(), tmClient, sessionToken, "", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if kubeconfig.ContextName != "tenant1" || kubeconfig.User != "tenant1:admin@vcfa.example.com" || !kubeconfig.InsecureSkipTLSVerify {
+		t.Errorf("unexpected kubeconfig %+v", kubeconfig)
+	}
+
+	var config clientcmdapi.Config
+	if err := json.Unmarshal([]byte(kubeconfig.Raw), &config); err != nil {
+		t.Fatalf("invalid raw kubeconfig: %s", err)
+	}
+	if config.CurrentContext != "tenant1" || len(config.AuthInfos) != 1 || config.AuthInfos[0].AuthInfo.Token != tmClient.Client.VCDToken {
+		t.Errorf("unexpected raw kubeconfig %s", kubeconfig.Raw)
+	}
+	if config.Clusters[0].Cluster.Server != kubeconfig.Host {
+		t.Errorf("expected server '%s', got '%s'", kubeconfig.Host, config.Clusters[0].Cluster.Server)
+	}
+}
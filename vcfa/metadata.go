@@ -0,0 +1,346 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+const labelVcfaMetadataEntry = "Metadata Entry"
+
+// metadataEntryTypes are the types of the values of the metadata entries
+var metadataEntryTypes = []string{types.OpenApiMetadataStringEntry, types.OpenApiMetadataNumberEntry, types.OpenApiMetadataBooleanEntry}
+
+// metadataEntryDomains are the domains of the metadata entries. Entries of the 'PROVIDER' domain are only visible to
+// System Administrators
+var metadataEntryDomains = []string{"TENANT", "PROVIDER"}
+
+// metadataEntryFields describes the fields of a metadata entry, shared by the 'metadata_entry' blocks and the
+// 'vcfa_metadata_entry' resource
+var metadataEntryFields = map[string]string{
+	"key":        "Key of the metadata entry",
+	"value":      "Value of the metadata entry, written as a string, like '10' for numbers or 'true' for booleans",
+	"type":       "Type of the value of the metadata entry. One of 'StringEntry', 'NumberEntry' or 'BoolEntry'",
+	"domain":     "Domain of the metadata entry. One of 'TENANT' or 'PROVIDER'. Entries of the 'PROVIDER' domain are only visible to System Administrators",
+	"namespace":  "Namespace of the metadata entry, to have several entries with the same key",
+	"readonly":   "Whether the metadata entry can only be read by the tenants",
+	"persistent": "Whether the metadata entry is kept when the entity is copied, like when cloning it",
+}
+
+// getMetadataEntrySchema returns the schema of the 'metadata_entry' blocks of the given entity. In data sources, all
+// the fields are computed
+func getMetadataEntrySchema(entityLabel string, isDatasource bool) *schema.Schema {
+	if isDatasource {
+		elemSchema := make(map[string]*schema.Schema, len(metadataEntryFields))
+		for field, description := range metadataEntryFields {
+			fieldType := schema.TypeString
+			if field == "readonly" || field == "persistent" {
+				fieldType = schema.TypeBool
+			}
+			elemSchema[field] = &schema.Schema{Type: fieldType, Computed: true, Description: description}
+		}
+		return &schema.Schema{
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Description: fmt.Sprintf("Metadata entries of the %s", entityLabel),
+			Elem:        &schema.Resource{Schema: elemSchema},
+		}
+	}
+
+	return &schema.Schema{
+		Type: schema.TypeSet,
+		// Computed, so the entries added with 'vcfa_metadata_entry' don't cause differences when no block is set
+		Optional: true,
+		Computed: true,
+		Description: fmt.Sprintf("Metadata entries of the %s. When set, all the entries of the %s are managed, so it must "+
+			"not be combined with 'vcfa_metadata_entry' resources for the same %s", entityLabel, entityLabel, entityLabel),
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: metadataEntryFields["key"],
+				},
+				"value": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: metadataEntryFields["value"],
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      types.OpenApiMetadataStringEntry,
+					ValidateFunc: validation.StringInSlice(metadataEntryTypes, false),
+					Description:  metadataEntryFields["type"],
+				},
+				"domain": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "TENANT",
+					ValidateFunc: validation.StringInSlice(metadataEntryDomains, false),
+					Description:  metadataEntryFields["domain"],
+				},
+				"namespace": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: metadataEntryFields["namespace"],
+				},
+				"readonly": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: metadataEntryFields["readonly"],
+				},
+				"persistent": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: metadataEntryFields["persistent"],
+				},
+			},
+		},
+	}
+}
+
+// metadataEntryIdentity returns what identifies a metadata entry within its entity: its domain, namespace and key
+func metadataEntryIdentity(entry *types.OpenApiMetadataEntry) string {
+	return fmt.Sprintf("%s/%s/%s", entry.KeyValue.Domain, entry.KeyValue.Namespace, entry.KeyValue.Key)
+}
+
+// buildMetadataEntry converts the fields of a metadata entry to its API type, converting the value to the given type
+func buildMetadataEntry(key, value, valueType, domain, namespace string, readonly, persistent bool) (*types.OpenApiMetadataEntry, error) {
+	var typedValue interface{}
+	switch valueType {
+	case types.OpenApiMetadataNumberEntry:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' of metadata entry '%s' is not a number", value, key)
+		}
+		typedValue = number
+	case types.OpenApiMetadataBooleanEntry:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("value '%s' of metadata entry '%s' is not a boolean", value, key)
+		}
+		typedValue = boolean
+	default:
+		typedValue = value
+	}
+
+	return &types.OpenApiMetadataEntry{
+		IsPersistent: persistent,
+		IsReadOnly:   readonly,
+		KeyValue: types.OpenApiMetadataKeyValue{
+			Domain:    domain,
+			Key:       key,
+			Namespace: namespace,
+			Value:     types.OpenApiMetadataTypedValue{Value: typedValue, Type: valueType},
+		},
+	}, nil
+}
+
+// expandMetadataEntries converts the 'metadata_entry' blocks to their API type
+func expandMetadataEntries(metadataEntries *schema.Set) ([]*types.OpenApiMetadataEntry, error) {
+	entries := make([]*types.OpenApiMetadataEntry, 0, metadataEntries.Len())
+	identities := make(map[string]bool, metadataEntries.Len())
+	for _, item := range metadataEntries.List() {
+		entryMap := item.(map[string]interface{})
+		entry, err := buildMetadataEntry(entryMap["key"].(string), entryMap["value"].(string), entryMap["type"].(string),
+			entryMap["domain"].(string), entryMap["namespace"].(string), entryMap["readonly"].(bool), entryMap["persistent"].(bool))
+		if err != nil {
+			return nil, err
+		}
+		identity := metadataEntryIdentity(entry)
+		if identities[identity] {
+			return nil, fmt.Errorf("metadata entry with key '%s' is defined more than once in domain '%s' and namespace '%s'",
+				entry.KeyValue.Key, entry.KeyValue.Domain, entry.KeyValue.Namespace)
+		}
+		identities[identity] = true
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// flattenMetadataEntries converts the given metadata entries to the format of the 'metadata_entry' blocks
+func flattenMetadataEntries(entries []*types.OpenApiMetadataEntry) []interface{} {
+	result := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, map[string]interface{}{
+			"key":        entry.KeyValue.Key,
+			"value":      metadataValueString(entry),
+			"type":       entry.KeyValue.Value.Type,
+			"domain":     entry.KeyValue.Domain,
+			"namespace":  entry.KeyValue.Namespace,
+			"readonly":   entry.IsReadOnly,
+			"persistent": entry.IsPersistent,
+		})
+	}
+	return result
+}
+
+// updateMetadataEntries makes the metadata of the given entity match its 'metadata_entry' blocks: the entries that
+// were removed from the configuration are deleted, the new ones are added, and the changed ones are updated. As only
+// the value and the persistence of an entry can be updated, the entries with other changes are replaced
+func updateMetadataEntries(tmClient *VCDClient, d *schema.ResourceData, entityId string) error {
+	if !d.IsNewResource() && !d.HasChange("metadata_entry") {
+		return nil
+	}
+	oldSet, newSet := d.GetChange("metadata_entry")
+	desired, err := expandMetadataEntries(newSet.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	previous, err := expandMetadataEntries(oldSet.(*schema.Set))
+	if err != nil {
+		return err
+	}
+	current, err := getEntityMetadata(tmClient, entityId)
+	if err != nil {
+		return err
+	}
+
+	currentByIdentity := make(map[string]*types.OpenApiMetadataEntry, len(current))
+	for _, entry := range current {
+		currentByIdentity[metadataEntryIdentity(entry)] = entry
+	}
+	desiredIdentities := make(map[string]bool, len(desired))
+	for _, entry := range desired {
+		desiredIdentities[metadataEntryIdentity(entry)] = true
+	}
+
+	for _, entry := range previous {
+		identity := metadataEntryIdentity(entry)
+		if existing, ok := currentByIdentity[identity]; ok && !desiredIdentities[identity] {
+			if err := deleteEntityMetadataEntry(tmClient, entityId, existing.ID); err != nil {
+				return fmt.Errorf("error removing metadata entry '%s': %s", entry.KeyValue.Key, err)
+			}
+		}
+	}
+
+	for _, entry := range desired {
+		existing, ok := currentByIdentity[metadataEntryIdentity(entry)]
+		switch {
+		case !ok:
+			if _, err := addEntityMetadataEntry(tmClient, entityId, entry); err != nil {
+				return fmt.Errorf("error adding metadata entry '%s': %s", entry.KeyValue.Key, err)
+			}
+		case existing.KeyValue.Value.Type != entry.KeyValue.Value.Type || existing.IsReadOnly != entry.IsReadOnly:
+			if err := deleteEntityMetadataEntry(tmClient, entityId, existing.ID); err != nil {
+				return fmt.Errorf("error replacing metadata entry '%s': %s", entry.KeyValue.Key, err)
+			}
+			if _, err := addEntityMetadataEntry(tmClient, entityId, entry); err != nil {
+				return fmt.Errorf("error replacing metadata entry '%s': %s", entry.KeyValue.Key, err)
+			}
+		case metadataValueString(existing) != metadataValueString(entry) || existing.IsPersistent != entry.IsPersistent:
+			entry.ID = existing.ID
+			if err := updateEntityMetadataEntry(tmClient, entityId, entry); err != nil {
+				return fmt.Errorf("error updating metadata entry '%s': %s", entry.KeyValue.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// setMetadataEntries stores the metadata of the given entity in the 'metadata_entry' blocks. VCFA versions without
+// the metadata endpoint leave the blocks empty
+func setMetadataEntries(tmClient *VCDClient, d *schema.ResourceData, entityId string) error {
+	entries, err := getEntityMetadata(tmClient, entityId)
+	if ok, err := handleOptionalEndpointError(d, "Metadata", err, "metadata_entry"); !ok {
+		return err
+	}
+	if err := d.Set("metadata_entry", flattenMetadataEntries(entries)); err != nil {
+		return fmt.Errorf("error setting 'metadata_entry': %s", err)
+	}
+	return nil
+}
+
+// getEntityMetadata retrieves all the metadata entries of the entity with the given URN
+func getEntityMetadata(tmClient *VCDClient, entityId string) ([]*types.OpenApiMetadataEntry, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.EntityMetadataEndpoint, entityId))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []*types.OpenApiMetadataEntry{{}}
+	if err := client.OpenApiGetAllItems(minVcfaApiVersion, urlRef, nil, &entries, nil); err != nil {
+		return nil, fmt.Errorf("error retrieving metadata of '%s': %s", entityId, err)
+	}
+	return entries, nil
+}
+
+// getEntityMetadataEntry retrieves the metadata entry with the given ID of an entity, and the ETag required to
+// update it
+func getEntityMetadataEntry(tmClient *VCDClient, entityId, entryId string) (*types.OpenApiMetadataEntry, string, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.EntityMetadataEndpoint, entityId), "/", url.PathEscape(entryId))
+	if err != nil {
+		return nil, "", err
+	}
+
+	entry := &types.OpenApiMetadataEntry{}
+	headers, err := client.OpenApiGetItemAndHeaders(minVcfaApiVersion, urlRef, nil, entry, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	return entry, headers.Get("Etag"), nil
+}
+
+// addEntityMetadataEntry adds the given metadata entry to an entity
+func addEntityMetadataEntry(tmClient *VCDClient, entityId string, entry *types.OpenApiMetadataEntry) (*types.OpenApiMetadataEntry, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.EntityMetadataEndpoint, entityId))
+	if err != nil {
+		return nil, err
+	}
+
+	created := &types.OpenApiMetadataEntry{}
+	if err := client.OpenApiPostItem(minVcfaApiVersion, urlRef, nil, entry, created, nil); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// updateEntityMetadataEntry sends the value and persistence of the given metadata entry, identified by its ID
+func updateEntityMetadataEntry(tmClient *VCDClient, entityId string, entry *types.OpenApiMetadataEntry) error {
+	_, etag, err := getEntityMetadataEntry(tmClient, entityId, entry.ID)
+	if err != nil {
+		return err
+	}
+
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.EntityMetadataEndpoint, entityId), "/", url.PathEscape(entry.ID))
+	if err != nil {
+		return err
+	}
+	_, err = client.OpenApiPutItemAndGetHeaders(minVcfaApiVersion, urlRef, nil, entry, nil, map[string]string{"If-Match": etag})
+	return err
+}
+
+// deleteEntityMetadataEntry removes the metadata entry with the given ID from an entity
+func deleteEntityMetadataEntry(tmClient *VCDClient, entityId, entryId string) error {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.EntityMetadataEndpoint, entityId), "/", url.PathEscape(entryId))
+	if err != nil {
+		return err
+	}
+	return client.OpenApiDeleteItem(minVcfaApiVersion, urlRef, nil, nil)
+}
+
+// metadataValueString returns the value of a metadata entry as it is written in Terraform configurations, like "10"
+// for numbers or "true" for booleans
+func metadataValueString(entry *types.OpenApiMetadataEntry) string {
+	if entry.KeyValue.Value.Value == nil {
+		return ""
+	}
+	return fmt.Sprint(entry.KeyValue.Value.Value)
+}
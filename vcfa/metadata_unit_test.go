@@ -0,0 +1,103 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func TestBuildMetadataEntry(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		valueType string
+		want      interface{}
+		wantErr   bool
+	}{
+		{name: "String", value: "1234", valueType: types.OpenApiMetadataStringEntry, want: "1234"},
+		{name: "Number", value: "10", valueType: types.OpenApiMetadataNumberEntry, want: float64(10)},
+		{name: "Boolean", value: "true", valueType: types.OpenApiMetadataBooleanEntry, want: true},
+		{name: "InvalidNumber", value: "ten", valueType: types.OpenApiMetadataNumberEntry, wantErr: true},
+		{name: "InvalidBoolean", value: "yes please", valueType: types.OpenApiMetadataBooleanEntry, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := buildMetadataEntry("key", tt.value, tt.valueType, "TENANT", "", false, true)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildMetadataEntry() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(entry.KeyValue.Value.Value, tt.want) || entry.KeyValue.Value.Type != tt.valueType || !entry.IsPersistent {
+				t.Errorf("unexpected entry %+v", entry)
+			}
+			// The value must be stored in the state as it was written
+			if got := metadataValueString(entry); got != tt.value {
+				t.Errorf("metadataValueString() = %s, want %s", got, tt.value)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenMetadataEntries(t *testing.T) {
+	entrySchema := getMetadataEntrySchema(labelVcfaOrg, false)
+	set := schema.NewSet(schema.HashResource(entrySchema.Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{"key": "cost-center", "value": "1234", "type": "StringEntry", "domain": "TENANT", "namespace": "", "readonly": false, "persistent": false},
+		map[string]interface{}{"key": "tier", "value": "2", "type": "NumberEntry", "domain": "PROVIDER", "namespace": "billing", "readonly": true, "persistent": true},
+	})
+
+	entries, err := expandMetadataEntries(set)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	flattened := schema.NewSet(schema.HashResource(entrySchema.Elem.(*schema.Resource)), flattenMetadataEntries(entries))
+	if !set.Equal(flattened) {
+		t.Errorf("expected flattened entries to be equal to the original ones:\n%v\n%v", set.List(), flattened.List())
+	}
+
+	set.Add(map[string]interface{}{"key": "tier", "value": "3", "type": "NumberEntry", "domain": "PROVIDER", "namespace": "billing", "readonly": true, "persistent": true})
+	if _, err := expandMetadataEntries(set); err == nil {
+		t.Errorf("expected an error for a duplicated key")
+	}
+}
+
+func TestFindMetadataEntry(t *testing.T) {
+	entries := []*types.OpenApiMetadataEntry{
+		{ID: "1", KeyValue: types.OpenApiMetadataKeyValue{Key: "owner", Namespace: "team-a"}},
+		{ID: "2", KeyValue: types.OpenApiMetadataKeyValue{Key: "owner", Namespace: "team-b"}},
+		{ID: "3", KeyValue: types.OpenApiMetadataKeyValue{Key: "tier"}},
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		key       string
+		wantId    string
+		wantErr   bool
+	}{
+		{name: "UniqueKey", key: "tier", wantId: "3"},
+		{name: "WithNamespace", namespace: "team-b", key: "owner", wantId: "2"},
+		{name: "AmbiguousKey", key: "owner", wantErr: true},
+		{name: "MissingKey", key: "cost-center", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := findMetadataEntry(entries, tt.namespace, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("findMetadataEntry() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if err == nil && entry.ID != tt.wantId {
+				t.Errorf("findMetadataEntry() = %s, want %s", entry.ID, tt.wantId)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// operationLimiter is a semaphore with as many slots as operations can run at the same time, set with
+// 'max_concurrent_operations'. A nil operationLimiter does not limit anything
+type operationLimiter chan struct{}
+
+// newOperationLimiter returns an operationLimiter that lets 'maxOperations' operations run at the same time, or nil
+// when 'maxOperations' is 0, which means that operations are not limited
+func newOperationLimiter(maxOperations int) operationLimiter {
+	if maxOperations <= 0 {
+		return nil
+	}
+	return make(operationLimiter, maxOperations)
+}
+
+// acquire waits until there is a free slot and returns the function that releases it, which must always be called.
+// It returns an error if the context is cancelled while waiting
+func (l operationLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("cancelled while waiting for one of the %d operations allowed by 'max_concurrent_operations' to finish: %s", cap(l), ctx.Err())
+	}
+}
+
+// AcquireOperationSlot waits until the provider runs fewer operations than 'max_concurrent_operations', and returns
+// the function that releases the slot taken by the caller, which must always be called
+func (c ClientContainer) AcquireOperationSlot(ctx context.Context) (func(), error) {
+	return c.operationLimiter.acquire(ctx)
+}
+
+// limitOperationConcurrency makes every operation of a resource or data source wait for a free slot when the provider
+// is configured with 'max_concurrent_operations', so that all of them, including uploads and CCI requests, share the
+// same limit regardless of the parallelism of Terraform
+func limitOperationConcurrency(resource *schema.Resource) {
+	limit := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			container, ok := meta.(ClientContainer)
+			if !ok {
+				return f(ctx, d, meta)
+			}
+			release, err := container.AcquireOperationSlot(ctx)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			defer release()
+			return f(ctx, d, meta)
+		}
+	}
+	resource.CreateContext = limit(resource.CreateContext)
+	resource.ReadContext = limit(resource.ReadContext)
+	resource.UpdateContext = limit(resource.UpdateContext)
+	resource.DeleteContext = limit(resource.DeleteContext)
+	resource.CreateWithoutTimeout = limit(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = limit(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = limit(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = limit(resource.DeleteWithoutTimeout)
+}
@@ -0,0 +1,97 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestLimitOperationConcurrency checks that no more than 'max_concurrent_operations' operations run at the same time
+func TestLimitOperationConcurrency(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxOperations int
+		expectedPeak  int32
+	}{
+		{name: "Limited", maxOperations: 2, expectedPeak: 2},
+		{name: "Serialized", maxOperations: 1, expectedPeak: 1},
+		{name: "Unlimited", maxOperations: 0, expectedPeak: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var running, peak atomic.Int32
+			// The first operations wait until the expected peak is reached, so a limit that is not enforced is detected
+			var started atomic.Int32
+			allStarted := make(chan struct{})
+			operation := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				current := running.Add(1)
+				for {
+					previous := peak.Load()
+					if current <= previous || peak.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+				if started.Add(1) == tt.expectedPeak {
+					close(allStarted)
+				}
+				<-allStarted
+				running.Add(-1)
+				return nil
+			}
+			resource := &schema.Resource{CreateContext: operation, ReadContext: operation}
+			limitOperationConcurrency(resource)
+			meta := ClientContainer{operationLimiter: newOperationLimiter(tt.maxOperations)}
+
+			var wg sync.WaitGroup
+			for i := 0; i < 8; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					var diags diag.Diagnostics
+					if i%2 == 0 {
+						diags = resource.CreateContext(context.Background(), nil, meta)
+					} else {
+						diags = resource.ReadContext(context.Background(), nil, meta)
+					}
+					if diags.HasError() {
+						t.Errorf("unexpected error: %v", diags)
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			if peak.Load() != tt.expectedPeak {
+				t.Errorf("expected at most %d concurrent operations, got %d", tt.expectedPeak, peak.Load())
+			}
+		})
+	}
+}
+
+// TestOperationLimiterCancelled checks that waiting for a slot stops when the context is cancelled
+func TestOperationLimiterCancelled(t *testing.T) {
+	limiter := newOperationLimiter(1)
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = limiter.acquire(ctx)
+	if err == nil || !strings.Contains(err.Error(), "max_concurrent_operations") {
+		t.Errorf("expected an error about 'max_concurrent_operations', got %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// missingEndpointErrorRegex matches the 'not found' errors of the CCI Kubernetes API and of the endpoints that are
+// not served by the OpenAPI of a given VCFA version
+var missingEndpointErrorRegex = regexp.MustCompile(`(?i)code: 404|reason: NotFound|NOT_FOUND|404 Not Found|the server could not find the requested resource`)
+
+// isMissingEndpointError returns whether the given error was returned because an endpoint, or the entity behind it,
+// does not exist
+func isMissingEndpointError(err error) bool {
+	return err != nil && (govcd.ContainsNotFound(err) || missingEndpointErrorRegex.MatchString(err.Error()))
+}
+
+// handleOptionalEndpointError checks the error of reading an optional endpoint, like the status and usage
+// sub-endpoints that are not available in every VCFA version. It returns whether the endpoint was read successfully.
+// When the endpoint is missing, the given attributes are set to null and a warning is reported instead of failing the
+// whole read, so the same provider version works with older and newer VCFA versions. Any other error is returned
+func handleOptionalEndpointError(d *schema.ResourceData, endpointLabel string, err error, attributes ...string) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if !isMissingEndpointError(err) {
+		return false, err
+	}
+
+	for _, attribute := range attributes {
+		if setErr := d.Set(attribute, nil); setErr != nil {
+			return false, fmt.Errorf("error setting '%s': %s", attribute, setErr)
+		}
+	}
+	warnOptionalEndpointMissing(endpointLabel, fmt.Sprintf("The attributes %s are null. %s", strings.Join(attributes, ", "), err))
+	return false, nil
+}
+
+// warnOptionalEndpointMissing reports, once per provider run, that an optional endpoint is not available
+func warnOptionalEndpointMissing(endpointLabel, detail string) {
+	apiWarnings.add(fmt.Sprintf("%s is not available in this VCFA version", endpointLabel), detail)
+}
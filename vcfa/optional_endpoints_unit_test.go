@@ -0,0 +1,85 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+func TestIsMissingEndpointError(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{err: nil, expected: false},
+		{err: fmt.Errorf("%s: API_ERROR", govcd.ErrorEntityNotFound), expected: true},
+		{err: errors.New("error reading: code: 404, message: not found, reason: NotFound"), expected: true},
+		{err: errors.New("error in HTTP GET request: NOT_FOUND - [ 1-2-3 ] Not found"), expected: true},
+		{err: errors.New("the server could not find the requested resource"), expected: true},
+		{err: errors.New("code: 403, reason: Forbidden"), expected: false},
+		{err: errors.New("error in HTTP GET request: BAD_REQUEST - [ 1-2-3 ] Invalid filter"), expected: false},
+	}
+	for _, tt := range tests {
+		if got := isMissingEndpointError(tt.err); got != tt.expected {
+			t.Errorf("expected %t for error '%v', got %t", tt.expected, tt.err, got)
+		}
+	}
+}
+
+// TestHandleOptionalEndpointError checks that missing endpoints null their attributes and report a warning, while
+// other errors are returned
+func TestHandleOptionalEndpointError(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"version": {Type: schema.TypeString, Computed: true},
+		"usage":   {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	dSet(d, "version", "1.0")
+	if err := d.Set("usage", []string{"1Gi"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	apiWarnings.drain()
+
+	available, err := handleOptionalEndpointError(d, "Test endpoint", nil, "version")
+	if !available || err != nil {
+		t.Errorf("expected the endpoint to be available without errors, got %t and %v", available, err)
+	}
+
+	available, err = handleOptionalEndpointError(d, "Test endpoint", errors.New("code: 500, reason: InternalError"), "version")
+	if available || err == nil {
+		t.Errorf("expected other errors to be returned, got %t and %v", available, err)
+	}
+	if d.Get("version").(string) != "1.0" {
+		t.Errorf("expected the attributes to be kept on other errors")
+	}
+
+	available, err = handleOptionalEndpointError(d, "Test endpoint", errors.New("code: 404, reason: NotFound"), "version", "usage")
+	if available || err != nil {
+		t.Errorf("expected a missing endpoint without errors, got %t and %v", available, err)
+	}
+	if d.Get("version").(string) != "" || len(d.Get("usage").([]interface{})) != 0 {
+		t.Errorf("expected the attributes to be null, got '%v' and %v", d.Get("version"), d.Get("usage"))
+	}
+	warnings := apiWarnings.drain()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Summary, "Test endpoint is not available") || !strings.Contains(warnings[0].Detail, "version, usage") {
+		t.Errorf("expected a warning about the missing endpoint, got %+v", warnings)
+	}
+
+	// The warning is only reported once
+	if _, err := handleOptionalEndpointError(d, "Test endpoint", errors.New("code: 404"), "version"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if warnings := apiWarnings.drain(); len(warnings) != 0 {
+		t.Errorf("expected no more warnings, got %+v", warnings)
+	}
+}
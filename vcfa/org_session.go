@@ -0,0 +1,126 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// orgScopedSchema returns the 'org' argument of the tenant resources, which sets the Organization whose session is
+// used to manage them
+func orgScopedSchema(entityLabel string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		Description: fmt.Sprintf("Name of the %s whose tenant manages the %s, when the provider is logged in to the System %s. "+
+			"Defaults to the provider 'org' if 'org_scoped_sessions' is set, or to the provider session otherwise", labelVcfaOrg, entityLabel, labelVcfaOrg),
+	}
+}
+
+// getOrgScopedClient returns the client that manages the tenant objects of the given resource: a session scoped to
+// the Organization of its 'org' argument, or of the provider 'org' when 'org_scoped_sessions' is set, or the provider
+// session otherwise
+func getOrgScopedClient(d *schema.ResourceData, meta interface{}) (*VCDClient, error) {
+	container := meta.(ClientContainer)
+	orgName := d.Get("org").(string)
+	if orgName == "" && container.orgScopedSessions {
+		orgName = container.tmClient.Org
+	}
+	return container.tmClient.orgScopedClient(orgName)
+}
+
+// orgScopedClient returns a session that acts as the tenant of the given Organization. It shares the authentication of
+// this session, which must be a System administrator one, and sends the tenant context headers in every request.
+// Sessions are opened once per Organization and reused afterward
+func (cli *VCDClient) orgScopedClient(orgName string) (*VCDClient, error) {
+	if orgName == "" || strings.EqualFold(orgName, cli.SysOrg) {
+		return cli, nil
+	}
+	// The sessions are kept by the client that is not bound to an operation, and bound to the context of this one
+	if cli.unbound != nil {
+		session, err := cli.unbound.orgScopedClient(orgName)
+		if err != nil {
+			return nil, err
+		}
+		return session.WithContext(cli.boundContext), nil
+	}
+	if !cli.Client.IsSysAdmin {
+		return nil, fmt.Errorf("cannot open a session for %s '%s': only System administrators can act as other tenants, "+
+			"but the provider is logged in to %s '%s'", labelVcfaOrg, orgName, labelVcfaOrg, cli.SysOrg)
+	}
+
+	cli.orgSessionsLock.Lock()
+	defer cli.orgSessionsLock.Unlock()
+	if session, ok := cli.orgSessions[strings.ToLower(orgName)]; ok {
+		return session, nil
+	}
+
+	org, err := cli.GetTmOrgByName(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open a session for %s '%s': %s", labelVcfaOrg, orgName, err)
+	}
+	session := &VCDClient{
+		VCDClient: &govcd.VCDClient{
+			Client:    cli.Client,
+			QueryHREF: cli.QueryHREF,
+		},
+		SysOrg:        cli.SysOrg,
+		Org:           org.TmOrg.Name,
+		InsecureFlag:  cli.InsecureFlag,
+		CaCertificate: cli.CaCertificate,
+		Proxy:         cli.Proxy,
+		BaseTransport: cli.BaseTransport,
+		orgScoped:     true,
+	}
+	// The copied client shares the custom headers of this session, which must not be modified
+	session.Client.RemoveCustomHeader()
+	session.Client.SetCustomHeader(orgSessionHeaders(org.TmOrg.ID, org.TmOrg.Name))
+
+	if cli.orgSessions == nil {
+		cli.orgSessions = make(map[string]*VCDClient)
+	}
+	cli.orgSessions[strings.ToLower(orgName)] = session
+	return session, nil
+}
+
+// sessionOrg returns the name of the Organization that the requests of this session act as: the tenant of an org-scoped
+// session, or the Organization that the provider is logged in to otherwise
+func (cli *VCDClient) sessionOrg() string {
+	if cli.orgScoped {
+		return cli.Org
+	}
+	return cli.SysOrg
+}
+
+// orgSessionHeaders returns the headers that make a System administrator request act as the tenant of the given
+// Organization. The tenant context requires the bare UUID of the Organization, not its URN
+func orgSessionHeaders(orgId, orgName string) map[string]string {
+	return map[string]string{
+		types.HeaderTenantContext: orgId[strings.LastIndex(orgId, ":")+1:],
+		types.HeaderAuthContext:   orgName,
+	}
+}
+
+// splitOrgScopedImportId splits the import ID of a tenant resource, which is <project_name><sep><name> or
+// <org><sep><project_name><sep><name>. When the Organization is given, it is set in 'org', so the imported resource is
+// managed with a session scoped to it
+func splitOrgScopedImportId(d *schema.ResourceData) (string, string, bool) {
+	idSlice := SplitImportId(d.Id(), -1)
+	switch len(idSlice) {
+	case 2:
+		return idSlice[0], idSlice[1], true
+	case 3:
+		dSet(d, "org", idSlice[0])
+		return idSlice[1], idSlice[2], true
+	default:
+		return "", "", false
+	}
+}
@@ -0,0 +1,94 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// TestOrgScopedClient checks that org-scoped sessions are only opened for other Organizations, and only by System
+// administrators
+func TestOrgScopedClient(t *testing.T) {
+	tenantClient := &VCDClient{VCDClient: &govcd.VCDClient{}, SysOrg: "tenant1"}
+	for _, orgName := range []string{"", "tenant1", "TENANT1"} {
+		client, err := tenantClient.orgScopedClient(orgName)
+		if err != nil || client != tenantClient {
+			t.Errorf("expected the provider session for Organization '%s', got %p (error: %v)", orgName, client, err)
+		}
+	}
+	if _, err := tenantClient.orgScopedClient("tenant2"); err == nil {
+		t.Errorf("expected an error opening a session for another Organization without being System administrator")
+	}
+
+	sysClient := &VCDClient{VCDClient: &govcd.VCDClient{}, SysOrg: "System"}
+	sysClient.Client.IsSysAdmin = true
+	cached := &VCDClient{VCDClient: &govcd.VCDClient{}, SysOrg: "System", Org: "tenant1"}
+	sysClient.orgSessions = map[string]*VCDClient{"tenant1": cached}
+	client, err := sysClient.orgScopedClient("Tenant1")
+	if err != nil || client != cached {
+		t.Errorf("expected the cached session of Organization 'tenant1', got %p (error: %v)", client, err)
+	}
+}
+
+// TestSessionOrg checks that the Organization of a session is the tenant of org-scoped sessions, and the Organization
+// that the provider is logged in to otherwise
+func TestSessionOrg(t *testing.T) {
+	sysClient := &VCDClient{VCDClient: &govcd.VCDClient{}, SysOrg: "System", Org: "tenant1"}
+	if got := sysClient.sessionOrg(); got != "System" {
+		t.Errorf("expected the provider session to act as 'System', got '%s'", got)
+	}
+	orgSession := &VCDClient{VCDClient: &govcd.VCDClient{}, SysOrg: "System", Org: "tenant1", orgScoped: true}
+	if got := orgSession.sessionOrg(); got != "tenant1" {
+		t.Errorf("expected the org-scoped session to act as 'tenant1', got '%s'", got)
+	}
+	if got := orgSession.WithContext(context.Background()).sessionOrg(); got != "tenant1" {
+		t.Errorf("expected the bound org-scoped session to act as 'tenant1', got '%s'", got)
+	}
+}
+
+func TestOrgSessionHeaders(t *testing.T) {
+	headers := orgSessionHeaders("urn:vcloud:org:6127c856-7315-46b8-b774-f2b8f1686c80", "tenant1")
+	if headers[types.HeaderTenantContext] != "6127c856-7315-46b8-b774-f2b8f1686c80" {
+		t.Errorf("expected the tenant context to be the Organization UUID, got '%s'", headers[types.HeaderTenantContext])
+	}
+	if headers[types.HeaderAuthContext] != "tenant1" {
+		t.Errorf("expected the auth context to be the Organization name, got '%s'", headers[types.HeaderAuthContext])
+	}
+}
+
+func TestSplitOrgScopedImportId(t *testing.T) {
+	tests := []struct {
+		id          string
+		wantOk      bool
+		wantOrg     string
+		wantProject string
+		wantName    string
+	}{
+		{id: "project1" + ImportSeparator + "vpc1", wantOk: true, wantProject: "project1", wantName: "vpc1"},
+		{id: "tenant1" + ImportSeparator + "project1" + ImportSeparator + "vpc1", wantOk: true, wantOrg: "tenant1", wantProject: "project1", wantName: "vpc1"},
+		{id: "my%2Eorg" + ImportSeparator + "project1" + ImportSeparator + "vpc1", wantOk: true, wantOrg: "my.org", wantProject: "project1", wantName: "vpc1"},
+		{id: "vpc1", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceVcfaVpc().Schema, map[string]interface{}{})
+			d.SetId(tt.id)
+			projectName, name, ok := splitOrgScopedImportId(d)
+			if ok != tt.wantOk || projectName != tt.wantProject || name != tt.wantName {
+				t.Errorf("splitOrgScopedImportId() = (%s, %s, %t), want (%s, %s, %t)", projectName, name, ok, tt.wantProject, tt.wantName, tt.wantOk)
+			}
+			if org := d.Get("org").(string); org != tt.wantOrg {
+				t.Errorf("expected 'org' to be '%s', got '%s'", tt.wantOrg, org)
+			}
+		})
+	}
+}
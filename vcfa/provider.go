@@ -9,12 +9,12 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/vmware/go-vcloud-director/v3/util"
 )
 
 // BuildVersion holds version which is meant to be injected at build time using ldflags
@@ -72,6 +72,29 @@ var globalDataSourceMap = map[string]*schema.Resource{
 	"vcfa_supervisor_namespace":            datasourceVcfaSupervisorNamespace(),         // 1.0
 	"vcfa_shared_subnet":                   datasourceVcfaSharedSubnet(),                // 1.1
 	"vcfa_distributed_vlan_connection":     datasourceVcfaDistributedVlanConnection(),   // 1.1
+	"vcfa_provider_general_settings":       datasourceVcfaProviderGeneralSettings(),     // 1.3
+	"vcfa_vpc":                             datasourceVcfaVpc(),                         // 1.3
+	"vcfa_ip_space_allocation":             datasourceVcfaIpSpaceAllocation(),           // 1.3
+	"vcfa_tm_inventory":                    datasourceVcfaTmInventory(),                 // 1.3
+	"vcfa_edge_clusters":                   datasourceVcfaEdgeClusters(),                // 1.3
+	"vcfa_content_library_items":           datasourceVcfaContentLibraryItems(),         // 1.3
+	"vcfa_cci_api_resources":               datasourceVcfaCciApiResources(),             // 1.3
+	"vcfa_effective_rights":                datasourceVcfaEffectiveRights(),             // 1.3
+	"vcfa_rights":                          datasourceVcfaRights(),                      // 1.3
+	"vcfa_trusted_certificate":             datasourceVcfaTrustedCertificate(),          // 1.3
+	"vcfa_tls_probe":                       datasourceVcfaTlsProbe(),                    // 1.3
+	"vcfa_supervisors":                     datasourceVcfaSupervisors(),                 // 1.3
+	"vcfa_supervisor_zones":                datasourceVcfaSupervisorZones(),             // 1.3
+	"vcfa_site":                            datasourceVcfaSite(),                        // 1.3
+	"vcfa_region_storage_policies":         datasourceVcfaRegionStoragePolicies(),       // 1.3
+	"vcfa_region_vm_classes":               datasourceVcfaRegionVmClasses(),             // 1.3
+	"vcfa_supervisor_namespaces":           datasourceVcfaSupervisorNamespaces(),        // 1.3
+	"vcfa_orgs":                            datasourceVcfaOrgs(),                        // 1.3
+	"vcfa_task":                            datasourceVcfaTask(),                        // 1.3
+	"vcfa_audit_trail":                     datasourceVcfaAuditTrail(),                  // 1.3
+	"vcfa_resource_list":                   datasourceVcfaResourceList(),                // 1.3
+
+	"vcfa_supervisor_namespace_quota_usage": datasourceVcfaSupervisorNamespaceQuotaUsage(), // 1.3
 }
 
 var globalResourceMap = map[string]*schema.Resource{
@@ -101,6 +124,61 @@ var globalResourceMap = map[string]*schema.Resource{
 	"vcfa_supervisor_namespace":            resourceVcfaSupervisorNamespace(),         // 1.0
 	"vcfa_shared_subnet":                   resourceVcfaSharedSubnet(),                // 1.1
 	"vcfa_distributed_vlan_connection":     resourceVcfaDistributedVlanConnection(),   // 1.1
+	"vcfa_provider_general_settings":       resourceVcfaProviderGeneralSettings(),     // 1.3
+	"vcfa_vpc":                             resourceVcfaVpc(),                         // 1.3
+	"vcfa_ip_space_allocation":             resourceVcfaIpSpaceAllocation(),           // 1.3
+	"vcfa_content_library_sync":            resourceVcfaContentLibrarySync(),          // 1.3
+	"vcfa_vpc_dhcp_profile":                resourceVcfaVpcDhcpProfile(),              // 1.3
+	"vcfa_vpc_dns_service":                 resourceVcfaVpcDnsService(),               // 1.3
+	"vcfa_vpc_subnet":                      resourceVcfaVpcSubnet(),                   // 1.3
+	"vcfa_org_certificate_rotation":        resourceVcfaOrgCertificateRotation(),      // 1.3
+	"vcfa_org_group":                       resourceVcfaOrgGroup(),                    // 1.3
+	"vcfa_org_saml":                        resourceVcfaOrgSaml(),                     // 1.3
+	"vcfa_trusted_certificate":             resourceVcfaTrustedCertificate(),          // 1.3
+	"vcfa_global_role_tenant_publication":  resourceVcfaGlobalRoleTenantPublication(), // 1.3
+	"vcfa_cci_resource":                    resourceVcfaCciResource(),                 // 1.3
+	"vcfa_org_branding":                    resourceVcfaOrgBranding(),                 // 1.3
+	"vcfa_metadata_entry":                  resourceVcfaMetadataEntry(),               // 1.3
+	"vcfa_region_storage_policy":           resourceVcfaRegionStoragePolicy(),         // 1.3
+
+	"vcfa_supervisor_namespace_storage_class_binding": resourceVcfaSupervisorNamespaceStorageClassBinding(), // 1.3
+	"vcfa_supervisor_namespace_role_binding":          resourceVcfaSupervisorNamespaceRoleBinding(),         // 1.3
+}
+
+func init() {
+	for name, resource := range globalResourceMap {
+		wrapResource(name, resource)
+	}
+	for name, dataSource := range globalDataSourceMap {
+		wrapDataSource(name, dataSource)
+	}
+}
+
+// wrapResource adds the behaviors that are common to all resources around their operations. Every middleware wraps
+// the previous ones, so the last one runs first: 'read_only' refuses changes before 'dry_run' is considered, and
+// the refused operations are neither limited, measured nor classified. The timeout of the operation bounds all of them
+func wrapResource(name string, resource *schema.Resource) {
+	bindOperationContext(resource)
+	reportApiWarnings(resource)
+	if !dryRunSupportedResources[name] {
+		guardResourceForDryRun(name, resource)
+	}
+	classifyOperationErrors(resource)
+	limitOperationConcurrency(resource)
+	recordOperationMetrics("resource", name, resource)
+	guardResourceForReadOnly(name, resource)
+	applyOperationTimeouts(resource)
+}
+
+// wrapDataSource adds the behaviors that are common to all data sources around their read operation, in the same
+// order as wrapResource
+func wrapDataSource(name string, dataSource *schema.Resource) {
+	bindOperationContext(dataSource)
+	reportApiWarnings(dataSource)
+	classifyOperationErrors(dataSource)
+	limitOperationConcurrency(dataSource)
+	recordOperationMetrics("data_source", name, dataSource)
+	applyOperationTimeouts(dataSource)
 }
 
 // Provider returns a terraform.ResourceProvider.
@@ -174,6 +252,13 @@ func Provider() *schema.Provider {
 				Description: "Set this to true if you understand the security risks of using Service Account token files and would like to suppress the warnings",
 			},
 
+			"token_cache_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_TOKEN_CACHE_FILE", ""),
+				Description: "If set, the session token is cached in this file and reused by next Terraform runs while it is valid, instead of logging in every time",
+			},
+
 			"sysorg": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -202,6 +287,34 @@ func Provider() *schema.Provider {
 				Description: "If set, VCFAClient will permit unverifiable SSL certificates.",
 			},
 
+			"allow_insecure": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Host names (or 'host:port' pairs) of the endpoints for which unverifiable SSL certificates are permitted, such as the VCFA host or content library download servers",
+			},
+
+			"ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_CA_CERTIFICATE", ""),
+				Description: "PEM bundle of additional CA certificates to trust, for endpoints signed by an internal CA",
+			},
+
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_HTTP_PROXY", ""),
+				Description: "URL of the proxy for HTTP requests. If not set, the HTTP_PROXY environment variable is used",
+			},
+
+			"https_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_HTTPS_PROXY", ""),
+				Description: "URL of the proxy for HTTPS requests. If not set, the HTTPS_PROXY environment variable is used",
+			},
+
 			"logging": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -215,12 +328,107 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VCFA_API_LOGGING_FILE", "go-vcloud-director.log"),
 				Description: "Defines the full name of the logging file for API calls (requires 'logging')",
 			},
+
+			"api_logging": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Settings of the logging of API requests and responses. They take precedence over 'logging' and 'logging_file'",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set, API requests and responses are logged",
+						},
+						"file": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Full name of the logging file for API calls. Defaults to 'logging_file'",
+						},
+						"redact_secrets": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set, passwords, tokens and Authorization headers are masked in the log",
+						},
+						"include_kubernetes_calls": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set, the CCI Kubernetes API calls are logged too",
+						},
+					},
+				},
+			},
 			"import_separator": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("VCFA_IMPORT_SEPARATOR", "."),
 				Description: "Defines the import separation string to be used with 'terraform import'",
 			},
+			"default_operation_timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_DEFAULT_OPERATION_TIMEOUT", ""),
+				Description: "Defines a minimum timeout (e.g. '45m') for long running operations of all resources, that extends the ones of their 'timeouts' blocks",
+			},
+			"deletion_grace_period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_DELETION_GRACE_PERIOD", ""),
+				Description: "Defines how long (e.g. '10m') deletions wait for a regular deletion to succeed before forcing it or re-issuing it",
+			},
+			"max_clock_skew": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_MAX_CLOCK_SKEW", ""),
+				Description: "Defines the tolerated clock difference (e.g. '2m') between this machine and VCFA, which is checked when the provider is configured. Defaults to '5m', and '0' disables the check",
+			},
+			"probe_endpoints": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_PROBE_ENDPOINTS", false),
+				Description: "If set, the API version and CCI endpoints are probed before authenticating, reporting reachability and version problems in a single diagnostic",
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_DRY_RUN", false),
+				Description: "If set, changes to resources that support it are sent as server-side dry runs, which are validated but not persisted, and any other resource refuses to apply changes",
+			},
+			"org_scoped_sessions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_ORG_SCOPED_SESSIONS", false),
+				Description: "If set, when the provider is logged in to the System Organization, the tenant resources like Supervisor Namespaces and VPCs are managed as the tenant of 'org', unless they set their own 'org'",
+			},
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_READ_ONLY", false),
+				Description: "If set, any create, update or delete operation is refused, so the configuration can only be used to plan and refresh",
+			},
+			"metrics_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_METRICS_FILE", ""),
+				Description: "If set, the operation durations, retry counts and upload throughput of the provider are written to this file in Prometheus text format, which is rewritten after every operation",
+			},
+			"site_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VCFA_SITE_NAME", ""),
+				Description: "Name of the VCFA site of this provider configuration (e.g. 'primary' or 'dr'), exported by the 'vcfa_site' data source",
+			},
+			"max_concurrent_operations": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("VCFA_MAX_CONCURRENT_OPERATIONS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of resource and data source operations that the provider runs at the same time, regardless of the Terraform parallelism. Defaults to 0, which means no limit",
+			},
 		},
 		ResourcesMap:         globalResourceMap,
 		DataSourcesMap:       globalDataSourceMap,
@@ -232,13 +440,46 @@ func Provider() *schema.Provider {
 // meta `meta interface{}` argument. It is being initialized in providerConfigure method
 type ClientContainer struct {
 	tmClient *VCDClient
+	// defaultOperationTimeout is the minimum timeout for long running operations, set with
+	// 'default_operation_timeout' property in Provider or environment variable "VCFA_DEFAULT_OPERATION_TIMEOUT"
+	defaultOperationTimeout time.Duration
+	// deletionGracePeriod is the time that deletions wait before using their force or fallback behaviors, set with
+	// 'deletion_grace_period' property in Provider or environment variable "VCFA_DELETION_GRACE_PERIOD"
+	deletionGracePeriod time.Duration
+	// dryRun turns the changes of the resources that support it into server-side dry runs, set with 'dry_run'
+	// property in Provider or environment variable "VCFA_DRY_RUN"
+	dryRun bool
+	// orgScopedSessions makes tenant resources act as the tenant of the provider 'org' when logged in to System, set
+	// with 'org_scoped_sessions' property in Provider or environment variable "VCFA_ORG_SCOPED_SESSIONS"
+	orgScopedSessions bool
+	// readOnly makes every create, update and delete operation fail, set with 'read_only' property in Provider or
+	// environment variable "VCFA_READ_ONLY"
+	readOnly bool
+	// siteName identifies the VCFA site of this provider configuration in multi-site modules, set with 'site_name'
+	// property in Provider or environment variable "VCFA_SITE_NAME"
+	siteName string
+	// operationLimiter limits the number of operations that run at the same time, set with 'max_concurrent_operations'
+	// property in Provider or environment variable "VCFA_MAX_CONCURRENT_OPERATIONS"
+	operationLimiter operationLimiter
+	// referenceCache keeps the Organizations and Regions that are looked up by URN during this Terraform run
+	referenceCache *referenceCache
 }
 
 func (c ClientContainer) GetTMClient() *VCDClient {
 	return c.tmClient
 }
 
-func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+// IsDryRun returns whether the provider is configured with 'dry_run'
+func (c ClientContainer) IsDryRun() bool {
+	return c.dryRun
+}
+
+// IsReadOnly returns whether the provider is configured with 'read_only'
+func (c ClientContainer) IsReadOnly() bool {
+	return c.readOnly
+}
+
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	if err := validateProviderSchema(d); err != nil {
 		return nil, diag.Errorf("[provider validation] :%s", err)
 	}
@@ -259,10 +500,15 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		AllowApiTokenFile:       d.Get("allow_api_token_file").(bool),
 		ServiceAccountTokenFile: d.Get("service_account_token_file").(string),
 		AllowSATokenFile:        d.Get("allow_service_account_token_file").(bool),
+		TokenCacheFile:          d.Get("token_cache_file").(string),
 		SysOrg:                  connectOrg,            // Connection org
 		Org:                     d.Get("org").(string), // Default org for operations
 		Href:                    d.Get("url").(string),
 		InsecureFlag:            d.Get("allow_unverified_ssl").(bool),
+		InsecureHosts:           convertSchemaSetToSliceOfStrings(d.Get("allow_insecure").(*schema.Set)),
+		HttpProxy:               d.Get("http_proxy").(string),
+		HttpsProxy:              d.Get("https_proxy").(string),
+		CaCertificate:           d.Get("ca_certificate").(string),
 	}
 
 	// auth_type dependent configuration
@@ -318,17 +564,7 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 
 	// If the provider includes logging directives,
 	// it will activate logging from upstream go-vcloud-director
-	logging := d.Get("logging").(bool)
-	// Logging is disabled by default.
-	// If enabled, we set the log file name and invoke the upstream logging set-up
-	if logging {
-		loggingFile := d.Get("logging_file").(string)
-		if loggingFile != "" {
-			util.EnableLogging = true
-			util.ApiLogFileName = loggingFile
-			util.InitLogging()
-		}
-	}
+	configureApiLogging(getApiLoggingSettings(d))
 
 	separator := os.Getenv("VCFA_IMPORT_SEPARATOR")
 	if separator != "" {
@@ -337,13 +573,65 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 		ImportSeparator = d.Get("import_separator").(string)
 	}
 
+	rawDefaultOperationTimeout := os.Getenv("VCFA_DEFAULT_OPERATION_TIMEOUT")
+	if rawDefaultOperationTimeout == "" {
+		rawDefaultOperationTimeout = d.Get("default_operation_timeout").(string)
+	}
+	defaultOperationTimeout, err := parseDefaultOperationTimeout(rawDefaultOperationTimeout)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	rawDeletionGracePeriod := os.Getenv("VCFA_DELETION_GRACE_PERIOD")
+	if rawDeletionGracePeriod == "" {
+		rawDeletionGracePeriod = d.Get("deletion_grace_period").(string)
+	}
+	deletionGracePeriod, err := parseDeletionGracePeriod(rawDeletionGracePeriod)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	rawMaxClockSkew := os.Getenv("VCFA_MAX_CLOCK_SKEW")
+	if rawMaxClockSkew == "" {
+		rawMaxClockSkew = d.Get("max_clock_skew").(string)
+	}
+	config.MaxClockSkew, err = parseMaxClockSkew(rawMaxClockSkew)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	if d.Get("probe_endpoints").(bool) {
+		transport, err := config.newHttpTransport()
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		if probeDiagnostics := probeProviderEndpoints(ctx, config.Href, transport); probeDiagnostics.HasError() {
+			return nil, append(providerDiagnostics, probeDiagnostics...)
+		}
+	}
+
+	// Set before connecting, so the HTTP requests of the authentication are measured too
+	metricsFile := os.Getenv("VCFA_METRICS_FILE")
+	if metricsFile == "" {
+		metricsFile = d.Get("metrics_file").(string)
+	}
+	providerMetrics.setFile(metricsFile)
+
 	tmClient, err := config.Client()
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
 
 	metaContainer := ClientContainer{
-		tmClient: tmClient,
+		tmClient:                tmClient,
+		defaultOperationTimeout: defaultOperationTimeout,
+		deletionGracePeriod:     deletionGracePeriod,
+		dryRun:                  d.Get("dry_run").(bool),
+		orgScopedSessions:       d.Get("org_scoped_sessions").(bool),
+		readOnly:                d.Get("read_only").(bool),
+		siteName:                d.Get("site_name").(string),
+		operationLimiter:        newOperationLimiter(d.Get("max_concurrent_operations").(int)),
+		referenceCache:          newReferenceCache(),
 	}
 
 	return metaContainer, providerDiagnostics
@@ -0,0 +1,280 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// providerMetrics collects the metrics of the provider operations during a run, and writes them to the file set with
+// 'metrics_file'
+var providerMetrics = newMetricsRegistry(time.Now)
+
+// operationMetricKey identifies a series of the operation duration metric
+type operationMetricKey struct {
+	kind      string
+	name      string
+	operation string
+	result    string
+}
+
+// httpMetricKey identifies a series of the HTTP request duration metric
+type httpMetricKey struct {
+	method      string
+	statusClass string
+}
+
+// durationSummary is a Prometheus summary without quantiles: the count and the sum of the observed durations
+type durationSummary struct {
+	count int64
+	sum   float64
+}
+
+func (s *durationSummary) observe(duration time.Duration) {
+	s.count++
+	s.sum += duration.Seconds()
+}
+
+// metricsRegistry accumulates the metrics of a provider run. As Terraform does not tell providers when a run ends, the
+// file is written again after every operation, so it contains the metrics of the whole run once Terraform exits
+type metricsRegistry struct {
+	sync.Mutex
+	now       func() time.Time
+	startTime time.Time
+	file      string
+
+	operations    map[operationMetricKey]*durationSummary
+	httpRequests  map[httpMetricKey]*durationSummary
+	retries       map[string]int64
+	uploadBytes   int64
+	uploadSeconds float64
+}
+
+func newMetricsRegistry(now func() time.Time) *metricsRegistry {
+	return &metricsRegistry{
+		now:          now,
+		startTime:    now(),
+		operations:   make(map[operationMetricKey]*durationSummary),
+		httpRequests: make(map[httpMetricKey]*durationSummary),
+		retries:      make(map[string]int64),
+	}
+}
+
+// setFile sets the file where the metrics are written. An empty name disables writing them
+func (m *metricsRegistry) setFile(file string) {
+	m.Lock()
+	defer m.Unlock()
+	m.file = file
+}
+
+func (m *metricsRegistry) observeOperation(key operationMetricKey, duration time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+	summary, ok := m.operations[key]
+	if !ok {
+		summary = &durationSummary{}
+		m.operations[key] = summary
+	}
+	summary.observe(duration)
+}
+
+func (m *metricsRegistry) observeHttpRequest(key httpMetricKey, duration time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+	summary, ok := m.httpRequests[key]
+	if !ok {
+		summary = &durationSummary{}
+		m.httpRequests[key] = summary
+	}
+	summary.observe(duration)
+}
+
+// addRetries counts the attempts that were repeated after a failure, by the kind of work that was retried
+func (m *metricsRegistry) addRetries(kind string, retries int) {
+	if retries <= 0 {
+		return
+	}
+	m.Lock()
+	defer m.Unlock()
+	m.retries[kind] += int64(retries)
+}
+
+// observeUpload records a file upload of the given size that lasted the given time
+func (m *metricsRegistry) observeUpload(bytes int64, duration time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+	m.uploadBytes += bytes
+	m.uploadSeconds += duration.Seconds()
+}
+
+// flush writes the metrics to the configured file, if any. The file is replaced atomically, so scrapers never read a
+// partial file. Errors are only logged, as metrics must not make the operations fail
+func (m *metricsRegistry) flush() {
+	m.Lock()
+	file := m.file
+	m.Unlock()
+	if file == "" {
+		return
+	}
+	if err := m.writeFile(file); err != nil {
+		log.Printf("[WARN] could not write provider metrics to '%s': %s", file, err)
+	}
+}
+
+func (m *metricsRegistry) writeFile(file string) error {
+	tmpFile, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Only left behind if the rename didn't happen
+		_ = os.Remove(tmpFile.Name())
+	}()
+	if err := m.write(tmpFile); err != nil {
+		_ = tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile.Name(), file)
+}
+
+// write renders the metrics in the Prometheus text exposition format, sorted so the output is stable
+func (m *metricsRegistry) write(w io.Writer) error {
+	m.Lock()
+	defer m.Unlock()
+
+	var b strings.Builder
+	writeMetricHeader(&b, "vcfa_provider_info", "gauge", "Version of the provider that wrote the metrics")
+	fmt.Fprintf(&b, "vcfa_provider_info{version=%q} 1\n", BuildVersion)
+	writeMetricHeader(&b, "vcfa_provider_run_start_time_seconds", "gauge", "Unix time when the provider process started")
+	fmt.Fprintf(&b, "vcfa_provider_run_start_time_seconds %d\n", m.startTime.Unix())
+	writeMetricHeader(&b, "vcfa_provider_run_duration_seconds", "gauge", "Time since the provider process started")
+	fmt.Fprintf(&b, "vcfa_provider_run_duration_seconds %s\n", formatMetricValue(m.now().Sub(m.startTime).Seconds()))
+
+	operationKeys := make([]operationMetricKey, 0, len(m.operations))
+	for key := range m.operations {
+		operationKeys = append(operationKeys, key)
+	}
+	sort.Slice(operationKeys, func(i, j int) bool {
+		a, b := operationKeys[i], operationKeys[j]
+		return fmt.Sprint(a.kind, a.name, a.operation, a.result) < fmt.Sprint(b.kind, b.name, b.operation, b.result)
+	})
+	writeMetricHeader(&b, "vcfa_provider_operation_duration_seconds", "summary", "Duration of the Terraform operations of resources and data sources")
+	for _, key := range operationKeys {
+		labels := fmt.Sprintf("kind=%q,name=%q,operation=%q,result=%q", key.kind, key.name, key.operation, key.result)
+		writeMetricSummary(&b, "vcfa_provider_operation_duration_seconds", labels, m.operations[key])
+	}
+
+	httpKeys := make([]httpMetricKey, 0, len(m.httpRequests))
+	for key := range m.httpRequests {
+		httpKeys = append(httpKeys, key)
+	}
+	sort.Slice(httpKeys, func(i, j int) bool {
+		return httpKeys[i].method+httpKeys[i].statusClass < httpKeys[j].method+httpKeys[j].statusClass
+	})
+	writeMetricHeader(&b, "vcfa_provider_http_request_duration_seconds", "summary", "Duration of the HTTP requests sent to VCFA")
+	for _, key := range httpKeys {
+		labels := fmt.Sprintf("method=%q,status=%q", key.method, key.statusClass)
+		writeMetricSummary(&b, "vcfa_provider_http_request_duration_seconds", labels, m.httpRequests[key])
+	}
+
+	retryKinds := make([]string, 0, len(m.retries))
+	for kind := range m.retries {
+		retryKinds = append(retryKinds, kind)
+	}
+	sort.Strings(retryKinds)
+	writeMetricHeader(&b, "vcfa_provider_retries_total", "counter", "Attempts that were repeated after a transient failure")
+	for _, kind := range retryKinds {
+		fmt.Fprintf(&b, "vcfa_provider_retries_total{kind=%q} %d\n", kind, m.retries[kind])
+	}
+
+	writeMetricHeader(&b, "vcfa_provider_upload_bytes_total", "counter", "Bytes of the files uploaded to VCFA")
+	fmt.Fprintf(&b, "vcfa_provider_upload_bytes_total %d\n", m.uploadBytes)
+	writeMetricHeader(&b, "vcfa_provider_upload_duration_seconds_total", "counter", "Time spent uploading files to VCFA")
+	fmt.Fprintf(&b, "vcfa_provider_upload_duration_seconds_total %s\n", formatMetricValue(m.uploadSeconds))
+	throughput := 0.0
+	if m.uploadSeconds > 0 {
+		throughput = float64(m.uploadBytes) / m.uploadSeconds
+	}
+	writeMetricHeader(&b, "vcfa_provider_upload_throughput_bytes_per_second", "gauge", "Average throughput of the files uploaded to VCFA")
+	fmt.Fprintf(&b, "vcfa_provider_upload_throughput_bytes_per_second %s\n", formatMetricValue(throughput))
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMetricHeader(b *strings.Builder, name, metricType, help string) {
+	fmt.Fprintf(b, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+func writeMetricSummary(b *strings.Builder, name, labels string, summary *durationSummary) {
+	fmt.Fprintf(b, "%s_sum{%s} %s\n", name, labels, formatMetricValue(summary.sum))
+	fmt.Fprintf(b, "%s_count{%s} %d\n", name, labels, summary.count)
+}
+
+func formatMetricValue(value float64) string {
+	return fmt.Sprintf("%.6f", value)
+}
+
+// recordOperationMetrics makes every operation of a resource or data source record its duration and write the metrics
+// file once it finishes
+func recordOperationMetrics(kind, name string, resource *schema.Resource) {
+	record := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			start := providerMetrics.now()
+			diags := f(ctx, d, meta)
+			result := "success"
+			if diags.HasError() {
+				result = "error"
+			}
+			providerMetrics.observeOperation(operationMetricKey{kind: kind, name: name, operation: operation, result: result}, providerMetrics.now().Sub(start))
+			providerMetrics.flush()
+			return diags
+		}
+	}
+	resource.CreateContext = record("create", resource.CreateContext)
+	resource.ReadContext = record("read", resource.ReadContext)
+	resource.UpdateContext = record("update", resource.UpdateContext)
+	resource.DeleteContext = record("delete", resource.DeleteContext)
+	resource.CreateWithoutTimeout = record("create", resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = record("read", resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = record("update", resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = record("delete", resource.DeleteWithoutTimeout)
+}
+
+// metricsTransport records the duration of the HTTP requests, by method and class of the response status
+type metricsTransport struct {
+	wrapped  http.RoundTripper
+	registry *metricsRegistry
+}
+
+func (t *metricsTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	start := t.registry.now()
+	response, err := t.wrapped.RoundTrip(request)
+	statusClass := "error"
+	if err == nil && response != nil {
+		statusClass = fmt.Sprintf("%dxx", response.StatusCode/100)
+	}
+	t.registry.observeHttpRequest(httpMetricKey{method: request.Method, statusClass: statusClass}, t.registry.now().Sub(start))
+	return response, err
+}
@@ -0,0 +1,163 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeClock returns a clock that advances one second every time it is read
+func fakeClock() func() time.Time {
+	current := time.Unix(1700000000, 0)
+	return func() time.Time {
+		current = current.Add(time.Second)
+		return current
+	}
+}
+
+// TestMetricsRegistryWrite checks the Prometheus text rendering of the collected metrics
+func TestMetricsRegistryWrite(t *testing.T) {
+	registry := newMetricsRegistry(fakeClock())
+	registry.observeOperation(operationMetricKey{kind: "resource", name: "vcfa_org", operation: "create", result: "success"}, 3*time.Second)
+	registry.observeOperation(operationMetricKey{kind: "resource", name: "vcfa_org", operation: "create", result: "success"}, time.Second)
+	registry.observeOperation(operationMetricKey{kind: "data_source", name: "vcfa_region", operation: "read", result: "error"}, 500*time.Millisecond)
+	registry.observeHttpRequest(httpMetricKey{method: "GET", statusClass: "2xx"}, 250*time.Millisecond)
+	registry.addRetries("operation", 2)
+	registry.addRetries("operation", 0)
+	registry.addRetries("upload_piece", 1)
+	registry.observeUpload(4096, 2*time.Second)
+
+	var b strings.Builder
+	if err := registry.write(&b); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	output := b.String()
+
+	expectedLines := []string{
+		`vcfa_provider_run_start_time_seconds 1700000001`,
+		`vcfa_provider_run_duration_seconds 1.000000`,
+		`# TYPE vcfa_provider_operation_duration_seconds summary`,
+		`vcfa_provider_operation_duration_seconds_sum{kind="resource",name="vcfa_org",operation="create",result="success"} 4.000000`,
+		`vcfa_provider_operation_duration_seconds_count{kind="resource",name="vcfa_org",operation="create",result="success"} 2`,
+		`vcfa_provider_operation_duration_seconds_count{kind="data_source",name="vcfa_region",operation="read",result="error"} 1`,
+		`vcfa_provider_http_request_duration_seconds_sum{method="GET",status="2xx"} 0.250000`,
+		`vcfa_provider_retries_total{kind="operation"} 2`,
+		`vcfa_provider_retries_total{kind="upload_piece"} 1`,
+		`vcfa_provider_upload_bytes_total 4096`,
+		`vcfa_provider_upload_duration_seconds_total 2.000000`,
+		`vcfa_provider_upload_throughput_bytes_per_second 2048.000000`,
+	}
+	for _, line := range expectedLines {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("expected line %q in output:\n%s", line, output)
+		}
+	}
+	if strings.Index(output, `name="vcfa_region"`) > strings.Index(output, `name="vcfa_org"`) {
+		t.Errorf("expected data sources to be sorted before resources:\n%s", output)
+	}
+}
+
+// TestMetricsRegistryFlush checks that the metrics file is only written when it is configured
+func TestMetricsRegistryFlush(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "vcfa.prom")
+	registry := newMetricsRegistry(fakeClock())
+
+	registry.flush()
+	if _, err := os.Stat(file); !os.IsNotExist(err) {
+		t.Fatalf("expected no metrics file to be written, got %v", err)
+	}
+
+	registry.setFile(file)
+	registry.flush()
+	content, err := os.ReadFile(file) // #nosec G304 -- test file
+	if err != nil {
+		t.Fatalf("expected the metrics file to be written: %s", err)
+	}
+	if !strings.Contains(string(content), "# TYPE vcfa_provider_info gauge") {
+		t.Errorf("unexpected metrics file content:\n%s", content)
+	}
+	entries, err := os.ReadDir(filepath.Dir(file))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected temporary files to be removed, got %d files", len(entries))
+	}
+}
+
+// TestRecordOperationMetrics checks that the duration and result of the operations are recorded
+func TestRecordOperationMetrics(t *testing.T) {
+	previous := providerMetrics
+	providerMetrics = newMetricsRegistry(fakeClock())
+	defer func() { providerMetrics = previous }()
+
+	resource := &schema.Resource{
+		CreateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+		ReadContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return diag.Errorf("failed")
+		},
+	}
+	recordOperationMetrics("resource", "vcfa_test", resource)
+	if resource.UpdateContext != nil {
+		t.Errorf("expected missing operations to stay nil")
+	}
+
+	_ = resource.CreateContext(context.Background(), nil, nil)
+	_ = resource.ReadContext(context.Background(), nil, nil)
+
+	created := providerMetrics.operations[operationMetricKey{kind: "resource", name: "vcfa_test", operation: "create", result: "success"}]
+	if created == nil || created.count != 1 || created.sum != 1 {
+		t.Errorf("unexpected create metrics %+v", created)
+	}
+	read := providerMetrics.operations[operationMetricKey{kind: "resource", name: "vcfa_test", operation: "read", result: "error"}]
+	if read == nil || read.count != 1 {
+		t.Errorf("unexpected read metrics %+v", read)
+	}
+}
+
+// TestMetricsTransport checks that the HTTP requests are recorded by method and status class
+func TestMetricsTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	registry := newMetricsRegistry(time.Now)
+	client := &http.Client{Transport: &metricsTransport{wrapped: http.DefaultTransport, registry: registry}}
+	for _, method := range []string{http.MethodGet, http.MethodGet, http.MethodDelete} {
+		request, err := http.NewRequest(method, server.URL, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		response, err := client.Do(request)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		_ = response.Body.Close()
+	}
+
+	if summary := registry.httpRequests[httpMetricKey{method: "GET", statusClass: "2xx"}]; summary == nil || summary.count != 2 {
+		t.Errorf("unexpected GET metrics %+v", summary)
+	}
+	if summary := registry.httpRequests[httpMetricKey{method: "DELETE", statusClass: "4xx"}]; summary == nil || summary.count != 1 {
+		t.Errorf("unexpected DELETE metrics %+v", summary)
+	}
+}
@@ -0,0 +1,143 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	semver "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// providerProbeTimeout is the maximum time that each endpoint probe can take
+const providerProbeTimeout = 30 * time.Second
+
+// probeProviderEndpoints checks, before authenticating, that the VCFA API version endpoint and the CCI endpoint
+// of the given URL are reachable, and that VCFA supports the API version required by the provider.
+// All the problems are reported in a single diagnostic, together with the checks that succeeded.
+func probeProviderEndpoints(ctx context.Context, rawUrl string, transport http.RoundTripper) diag.Diagnostics {
+	vcfaUrl, err := url.ParseRequestURI(rawUrl)
+	if err != nil {
+		return diag.Errorf("[provider probe] invalid URL '%s': %s", rawUrl, err)
+	}
+
+	httpClient := &http.Client{
+		Timeout:   providerProbeTimeout,
+		Transport: transport,
+	}
+
+	var results, problems []string
+
+	versionsUrl := fmt.Sprintf("%s://%s/api/versions", vcfaUrl.Scheme, vcfaUrl.Host)
+	maxVersion, err := probeApiVersions(ctx, httpClient, versionsUrl)
+	switch {
+	case err != nil:
+		problems = append(problems, fmt.Sprintf("API version endpoint %s: %s", versionsUrl, err))
+	case !isApiVersionSupported(maxVersion, minVcfaApiVersion):
+		problems = append(problems, fmt.Sprintf("API version endpoint %s: the maximum supported API version is %s, "+
+			"but this provider requires at least %s", versionsUrl, maxVersion, minVcfaApiVersion))
+	default:
+		results = append(results, fmt.Sprintf("API version endpoint %s: reachable, maximum supported API version %s", versionsUrl, maxVersion))
+	}
+
+	cciUrl := fmt.Sprintf(ccitypes.KubernetesSubpath, vcfaUrl.Scheme, vcfaUrl.Host) + "/apis"
+	if err := probeEndpointReachable(ctx, httpClient, cciUrl); err != nil {
+		problems = append(problems, fmt.Sprintf("CCI endpoint %s: %s", cciUrl, err))
+	} else {
+		results = append(results, fmt.Sprintf("CCI endpoint %s: reachable", cciUrl))
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	detail := "Problems:\n  - " + strings.Join(problems, "\n  - ")
+	if len(results) > 0 {
+		detail += "\nSuccessful checks:\n  - " + strings.Join(results, "\n  - ")
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("[provider probe] VCFA at '%s' is not usable by this provider", rawUrl),
+		Detail:   detail,
+	}}
+}
+
+// probeApiVersions retrieves the list of supported API versions and returns the highest one
+func probeApiVersions(ctx context.Context, httpClient *http.Client, versionsUrl string) (string, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, versionsUrl, nil)
+	if err != nil {
+		return "", err
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return "", fmt.Errorf("not reachable: %s", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status '%s'", response.Status)
+	}
+
+	var supportedVersions govcd.SupportedVersions
+	if err := xml.NewDecoder(response.Body).Decode(&supportedVersions); err != nil {
+		return "", fmt.Errorf("could not decode the supported API versions: %s", err)
+	}
+
+	var maxVersion *semver.Version
+	for _, versionInfo := range supportedVersions.VersionInfos {
+		version, err := semver.NewVersion(versionInfo.Version)
+		if err != nil {
+			continue
+		}
+		if maxVersion == nil || version.GreaterThan(maxVersion) {
+			maxVersion = version
+		}
+	}
+	if maxVersion == nil {
+		return "", fmt.Errorf("no supported API versions were returned")
+	}
+	return maxVersion.Original(), nil
+}
+
+// probeEndpointReachable checks that the given endpoint answers. As the probe is not authenticated, authorization
+// errors are considered a successful answer
+func probeEndpointReachable(ctx context.Context, httpClient *http.Client, endpointUrl string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpointUrl, nil)
+	if err != nil {
+		return err
+	}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("not reachable: %s", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	if response.StatusCode == http.StatusNotFound || response.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("unexpected status '%s'", response.Status)
+	}
+	return nil
+}
+
+// isApiVersionSupported returns true if 'maxVersion' is equal or greater than 'minVersion'
+func isApiVersionSupported(maxVersion, minVersion string) bool {
+	maxSemver, err := semver.NewVersion(maxVersion)
+	if err != nil {
+		return false
+	}
+	minSemver, err := semver.NewVersion(minVersion)
+	if err != nil {
+		return false
+	}
+	return maxSemver.GreaterThanOrEqual(minSemver)
+}
@@ -0,0 +1,89 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestProbeProviderEndpoints checks the provider endpoint probe against fake VCFA endpoints
+func TestProbeProviderEndpoints(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxVersion    string
+		cciStatus     int
+		wantErr       bool
+		errorContains []string
+	}{
+		{
+			name:       "Healthy",
+			maxVersion: "40.0",
+			cciStatus:  http.StatusUnauthorized,
+		},
+		{
+			name:          "VersionSkew",
+			maxVersion:    "39.1",
+			cciStatus:     http.StatusOK,
+			wantErr:       true,
+			errorContains: []string{"maximum supported API version is 39.1", "CCI endpoint", "reachable"},
+		},
+		{
+			name:          "CciNotAvailable",
+			maxVersion:    "40.0",
+			cciStatus:     http.StatusNotFound,
+			wantErr:       true,
+			errorContains: []string{"CCI endpoint", "404", "maximum supported API version 40.0"},
+		},
+		{
+			name:          "AllFailing",
+			maxVersion:    "",
+			cciStatus:     http.StatusBadGateway,
+			wantErr:       true,
+			errorContains: []string{"no supported API versions", "502"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/api/versions":
+					versionInfo := ""
+					if tt.maxVersion != "" {
+						versionInfo = fmt.Sprintf("<VersionInfo><Version>37.0</Version></VersionInfo><VersionInfo><Version>%s</Version></VersionInfo>", tt.maxVersion)
+					}
+					_, _ = fmt.Fprintf(w, `<SupportedVersions xmlns="http://www.vmware.com/vcloud/versions">%s</SupportedVersions>`, versionInfo)
+				case "/cci/kubernetes/apis":
+					w.WriteHeader(tt.cciStatus)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			diags := probeProviderEndpoints(context.Background(), server.URL+"/tm", server.Client().Transport)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("probeProviderEndpoints() = %v, wantErr %v", diags, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("probeProviderEndpoints() returned %d diagnostics, expected a single one", len(diags))
+			}
+			for _, expected := range tt.errorContains {
+				if !strings.Contains(diags[0].Detail, expected) {
+					t.Errorf("probeProviderEndpoints() detail does not contain '%s':\n%s", expected, diags[0].Detail)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// transportTLSHandshakeTimeout is the TLS handshake timeout of the provider HTTP transport, the same as the SDK default
+const transportTLSHandshakeTimeout = 120 * time.Second
+
+// proxyFunc returns the proxy to use for a given request, like http.Transport.Proxy
+type proxyFunc func(*http.Request) (*url.URL, error)
+
+// newHttpTransport builds the HTTP transport used for all the requests of the provider to VCFA, honoring the proxy,
+// CA certificate and insecure settings of the provider configuration, and recording the API warnings and metrics
+func (c *Config) newHttpTransport() (http.RoundTripper, error) {
+	base, err := c.newBaseHttpTransport()
+	if err != nil {
+		return nil, err
+	}
+	return wrapApiTransport(base), nil
+}
+
+// wrapApiTransport adds to the given transport the recording of the API warnings and metrics of the VCFA requests
+func wrapApiTransport(base http.RoundTripper) http.RoundTripper {
+	return &apiWarningTransport{wrapped: &metricsTransport{wrapped: base, registry: providerMetrics}, collector: apiWarnings}
+}
+
+// newBaseHttpTransport builds the HTTP transport that only honors the proxy, CA certificate and insecure settings of
+// the provider configuration. It is meant for requests to hosts other than VCFA, such as downloads, whose responses
+// must not be taken as VCFA API warnings, metrics or clock
+func (c *Config) newBaseHttpTransport() (http.RoundTripper, error) {
+	proxy, err := buildProxyFunc(c.HttpProxy, c.HttpsProxy)
+	if err != nil {
+		return nil, err
+	}
+	rootCAs, err := buildRootCAs(c.CaCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec G402 -- The user explicitly allows unverified SSL with 'allow_unverified_ssl'
+	secure := &http.Transport{
+		Proxy:               proxy,
+		TLSClientConfig:     &tls.Config{RootCAs: rootCAs, InsecureSkipVerify: c.InsecureFlag},
+		TLSHandshakeTimeout: transportTLSHandshakeTimeout,
+	}
+	if c.InsecureFlag || len(c.InsecureHosts) == 0 {
+		return secure, nil
+	}
+
+	// #nosec G402 -- The user explicitly allows unverified SSL for these hosts with 'allow_insecure'
+	insecure := &http.Transport{
+		Proxy:               proxy,
+		TLSClientConfig:     &tls.Config{InsecureSkipVerify: true},
+		TLSHandshakeTimeout: transportTLSHandshakeTimeout,
+	}
+	return &hostTransport{secure: secure, insecure: insecure, insecureHosts: c.InsecureHosts}, nil
+}
+
+// isInsecureHost returns whether unverifiable SSL certificates are permitted for the given URL
+func (c *Config) isInsecureHost(u *url.URL) bool {
+	return c.InsecureFlag || matchesHost(c.InsecureHosts, u)
+}
+
+// withHttpTransport replaces the HTTP transport of the SDK client
+func withHttpTransport(transport http.RoundTripper) govcd.VCDClientOption {
+	return func(vcdClient *govcd.VCDClient) error {
+		vcdClient.Client.Http.Transport = transport
+		return nil
+	}
+}
+
+// hostTransport sends the requests to the hosts listed in 'allow_insecure' through a transport that doesn't verify
+// SSL certificates, and any other request through the regular transport
+type hostTransport struct {
+	secure        http.RoundTripper
+	insecure      http.RoundTripper
+	insecureHosts []string
+}
+
+func (t *hostTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if matchesHost(t.insecureHosts, request.URL) {
+		return t.insecure.RoundTrip(request)
+	}
+	return t.secure.RoundTrip(request)
+}
+
+// matchesHost returns whether the host of the given URL is in the list. Entries can be a host name, which matches
+// any port, or a 'host:port' pair
+func matchesHost(hosts []string, u *url.URL) bool {
+	for _, host := range hosts {
+		if strings.EqualFold(host, u.Hostname()) || strings.EqualFold(host, u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildProxyFunc returns the proxy function for the given proxy URLs. Empty URLs fall back to the HTTP_PROXY and
+// HTTPS_PROXY environment variables, and NO_PROXY is always honored
+func buildProxyFunc(httpProxy, httpsProxy string) (proxyFunc, error) {
+	if httpProxy == "" && httpsProxy == "" {
+		return http.ProxyFromEnvironment, nil
+	}
+	proxies := []struct {
+		argument string
+		url      string
+	}{
+		{"http_proxy", httpProxy},
+		{"https_proxy", httpsProxy},
+	}
+	for _, proxy := range proxies {
+		if proxy.url == "" {
+			continue
+		}
+		if _, err := url.ParseRequestURI(proxy.url); err != nil {
+			return nil, fmt.Errorf("invalid '%s' URL '%s': %s", proxy.argument, proxy.url, err)
+		}
+	}
+
+	config := httpproxy.FromEnvironment()
+	if httpProxy != "" {
+		config.HTTPProxy = httpProxy
+	}
+	if httpsProxy != "" {
+		config.HTTPSProxy = httpsProxy
+	}
+	proxyForUrl := config.ProxyFunc()
+	return func(request *http.Request) (*url.URL, error) {
+		return proxyForUrl(request.URL)
+	}, nil
+}
+
+// buildRootCAs returns the system certificate pool with the given PEM bundle appended, or nil (the system pool) when
+// no bundle is given
+func buildRootCAs(caCertificate string) (*x509.CertPool, error) {
+	if caCertificate == "" {
+		return nil, nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(caCertificate)) {
+		return nil, fmt.Errorf("'ca_certificate' does not contain any valid PEM encoded certificate")
+	}
+	return pool, nil
+}
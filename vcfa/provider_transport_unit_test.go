@@ -0,0 +1,146 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+// TestNewHttpTransport checks that the provider transport verifies SSL certificates, unless the host is allowed to
+// be insecure, and that it trusts the given CA certificate
+func TestNewHttpTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	serverUrl, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverCa := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "Default", config: Config{}, wantErr: true},
+		{name: "AllowUnverifiedSsl", config: Config{InsecureFlag: true}},
+		{name: "AllowInsecureHost", config: Config{InsecureHosts: []string{serverUrl.Hostname()}}},
+		{name: "AllowInsecureHostAndPort", config: Config{InsecureHosts: []string{serverUrl.Host}}},
+		{name: "AllowInsecureOtherHost", config: Config{InsecureHosts: []string{"vcfa.example.com"}}, wantErr: true},
+		{name: "CaCertificate", config: Config{CaCertificate: serverCa}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, err := tt.config.newHttpTransport()
+			if err != nil {
+				t.Fatalf("newHttpTransport() unexpected error: %s", err)
+			}
+			response, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if err == nil {
+				_ = response.Body.Close()
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("request error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := (&Config{CaCertificate: "not a certificate"}).newHttpTransport(); err == nil {
+		t.Errorf("newHttpTransport() expected an error for an invalid CA certificate")
+	}
+}
+
+// TestNewBaseHttpTransport checks that the base transport, used for requests to other hosts, doesn't record the
+// responses as VCFA API warnings, while the transport of the VCFA requests does
+func TestNewBaseHttpTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Warning", "download host warning")
+	}))
+	defer server.Close()
+	apiWarnings.Lock()
+	apiWarnings.pending, apiWarnings.reported = nil, make(map[string]bool)
+	apiWarnings.Unlock()
+
+	base, err := (&Config{}).newBaseHttpTransport()
+	if err != nil {
+		t.Fatalf("newBaseHttpTransport() unexpected error: %s", err)
+	}
+	api, err := (&Config{}).newHttpTransport()
+	if err != nil {
+		t.Fatalf("newHttpTransport() unexpected error: %s", err)
+	}
+
+	get := func(transport http.RoundTripper) {
+		response, err := (&http.Client{Transport: transport}).Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		_ = response.Body.Close()
+	}
+	get(base)
+	if diags := apiWarnings.drain(); len(diags) != 0 {
+		t.Errorf("expected no API warnings from the base transport, got %v", diags)
+	}
+	get(api)
+	if diags := apiWarnings.drain(); len(diags) != 1 {
+		t.Errorf("expected one API warning from the VCFA transport, got %v", diags)
+	}
+}
+
+// TestBuildProxyFunc checks that the proxy arguments override the environment variables
+func TestBuildProxyFunc(t *testing.T) {
+	t.Setenv("HTTP_PROXY", "http://env-proxy.example.com:3128")
+	t.Setenv("HTTPS_PROXY", "http://env-proxy.example.com:3128")
+	t.Setenv("NO_PROXY", "internal.example.com")
+
+	tests := []struct {
+		name       string
+		httpProxy  string
+		httpsProxy string
+		requestUrl string
+		wantProxy  string
+		wantErr    bool
+	}{
+		{name: "HttpsOverride", httpsProxy: "http://proxy.example.com:8080", requestUrl: "https://vcfa.example.com", wantProxy: "http://proxy.example.com:8080"},
+		{name: "HttpFromEnvironment", httpsProxy: "http://proxy.example.com:8080", requestUrl: "http://vcfa.example.com", wantProxy: "http://env-proxy.example.com:3128"},
+		{name: "HttpOverride", httpProxy: "http://proxy.example.com:8080", requestUrl: "http://vcfa.example.com", wantProxy: "http://proxy.example.com:8080"},
+		{name: "NoProxy", httpsProxy: "http://proxy.example.com:8080", requestUrl: "https://internal.example.com"},
+		{name: "InvalidUrl", httpsProxy: "not a url", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxy, err := buildProxyFunc(tt.httpProxy, tt.httpsProxy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildProxyFunc() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			request, err := http.NewRequest(http.MethodGet, tt.requestUrl, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			proxyUrl, err := proxy(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := ""
+			if proxyUrl != nil {
+				got = proxyUrl.String()
+			}
+			if got != tt.wantProxy {
+				t.Errorf("proxy for %s = '%s', want '%s'", tt.requestUrl, got, tt.wantProxy)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@
 package vcfa
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -15,7 +16,9 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	semver "github.com/hashicorp/go-version"
@@ -308,6 +311,119 @@ func TestVcfaSchemaFilter(t *testing.T) {
 	}
 }
 
+func TestParseDefaultOperationTimeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "Empty", value: "", want: 0},
+		{name: "Minutes", value: "45m", want: 45 * time.Minute},
+		{name: "HoursAndMinutes", value: "1h30m", want: 90 * time.Minute},
+		{name: "Invalid", value: "forever", wantErr: true},
+		{name: "MissingUnit", value: "30", wantErr: true},
+		{name: "Negative", value: "-5m", wantErr: true},
+		{name: "Zero", value: "0s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDefaultOperationTimeout(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseDefaultOperationTimeout() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseDefaultOperationTimeout() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestApplyOperationTimeouts checks that the provider 'default_operation_timeout' extends the deadline of the operations,
+// which the SDK would otherwise bound by the timeout of the resource
+func TestApplyOperationTimeouts(t *testing.T) {
+	var deadline time.Time
+	resource := &schema.Resource{
+		CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			deadline, _ = ctx.Deadline()
+			return nil
+		},
+	}
+	applyOperationTimeouts(resource)
+	if resource.CreateContext != nil || resource.CreateWithoutTimeout == nil {
+		t.Fatalf("expected the create operation to apply its own timeout")
+	}
+
+	start := time.Now()
+	resource.CreateWithoutTimeout(context.Background(), resource.TestResourceData(), ClientContainer{})
+	if deadline.Sub(start) > 21*time.Minute {
+		t.Errorf("expected the timeout of the resource without a provider default, got %s", deadline.Sub(start))
+	}
+	resource.CreateWithoutTimeout(context.Background(), resource.TestResourceData(), ClientContainer{defaultOperationTimeout: time.Hour})
+	if deadline.Sub(start) < 59*time.Minute {
+		t.Errorf("expected the provider default to extend the timeout, got %s", deadline.Sub(start))
+	}
+
+	if err := Provider().InternalValidate(); err != nil {
+		t.Errorf("unexpected error validating the provider: %s", err)
+	}
+}
+
+func TestParseDeletionGracePeriod(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "Empty", value: "", want: 0},
+		{name: "Zero", value: "0s", want: 0},
+		{name: "Minutes", value: "10m", want: 10 * time.Minute},
+		{name: "Invalid", value: "a while", wantErr: true},
+		{name: "Negative", value: "-5m", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseDeletionGracePeriod(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseDeletionGracePeriod() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("parseDeletionGracePeriod() got = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDuringGracePeriod(t *testing.T) {
+	attempts := 0
+	err := retryDuringGracePeriod(context.Background(), time.Second, 10*time.Millisecond, func() error {
+		attempts++
+		if attempts < 3 {
+			return fmt.Errorf("attempt %d failed", attempts)
+		}
+		return nil
+	})
+	if err != nil || attempts != 3 {
+		t.Errorf("retryDuringGracePeriod() error = %v after %d attempts, expected success after 3 attempts", err, attempts)
+	}
+
+	start := time.Now()
+	err = retryDuringGracePeriod(context.Background(), 50*time.Millisecond, 10*time.Millisecond, func() error {
+		return fmt.Errorf("always failing")
+	})
+	if err == nil || err.Error() != "always failing" {
+		t.Errorf("retryDuringGracePeriod() error = %v, expected the error of the last attempt", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("retryDuringGracePeriod() took %s, expected to stop after the grace period", elapsed)
+	}
+}
+
 // TestDocsNames checks that all documentation files are named "filename.html.markdown'
 func TestDocsNames(t *testing.T) {
 	docsDirectories := []string{"data-sources", "resources", "guides"}
@@ -332,3 +448,52 @@ func TestDocsNames(t *testing.T) {
 		}
 	}
 }
+
+// TestWrapResourceOrder checks the order of the middlewares added by wrapResource: 'read_only' refuses changes before
+// 'dry_run' is considered and without waiting for 'max_concurrent_operations', and resources that support dry runs
+// are not guarded
+func TestWrapResourceOrder(t *testing.T) {
+	called := false
+	newResource := func() *schema.Resource {
+		return &schema.Resource{
+			CreateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+				called = true
+				return nil
+			},
+		}
+	}
+
+	resource := newResource()
+	wrapResource("vcfa_test", resource)
+
+	diags := resource.CreateWithoutTimeout(context.Background(), resource.TestResourceData(), ClientContainer{readOnly: true, dryRun: true})
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[read only]") || called {
+		t.Errorf("expected 'read_only' to take precedence over 'dry_run', got %v (called: %t)", diags, called)
+	}
+
+	// All the slots are taken and the context is cancelled, so any operation that waits for a slot fails
+	limiter := newOperationLimiter(1)
+	release, err := limiter.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error acquiring the only slot: %s", err)
+	}
+	defer release()
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	diags = resource.CreateWithoutTimeout(cancelledCtx, resource.TestResourceData(), ClientContainer{readOnly: true, operationLimiter: limiter})
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[read only]") {
+		t.Errorf("expected 'read_only' to refuse the change without waiting for an operation slot, got %v", diags)
+	}
+
+	diags = resource.CreateWithoutTimeout(context.Background(), resource.TestResourceData(), ClientContainer{dryRun: true})
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[dry run]") || called {
+		t.Errorf("expected 'dry_run' to refuse the change, got %v (called: %t)", diags, called)
+	}
+
+	supported := newResource()
+	wrapResource("vcfa_vpc", supported)
+	diags = supported.CreateWithoutTimeout(context.Background(), supported.TestResourceData(), ClientContainer{dryRun: true})
+	if diags.HasError() || !called {
+		t.Errorf("expected a resource that supports dry runs to be called, got %v (called: %t)", diags, called)
+	}
+}
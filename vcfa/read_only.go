@@ -0,0 +1,59 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// isReadOnly returns whether the provider is configured with 'read_only'
+func isReadOnly(meta interface{}) bool {
+	container, ok := meta.(ClientContainer)
+	return ok && container.readOnly
+}
+
+// changesOnReadAllowed returns whether the reads can trigger operations that change VCFA, like the synchronization
+// of subscribed Content Libraries or the refresh of vCenters. They are skipped when the provider is configured with
+// 'read_only' or 'dry_run', as planning must not change anything
+func changesOnReadAllowed(meta interface{}) bool {
+	return !isReadOnly(meta) && !isDryRun(meta)
+}
+
+// guardResourceForReadOnly makes the create, update and delete operations of a resource fail before calling VCFA
+// when the provider is configured with 'read_only', so reading and planning work but nothing can be changed. A refused
+// update marks the resource as partial, so its planned values are not saved in the state
+func guardResourceForReadOnly(name string, resource *schema.Resource) {
+	refuse := func(operation string) diag.Diagnostics {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "[read only] " + name + " was not " + operation,
+			Detail: "The provider is configured with 'read_only', so no resource can be created, updated or deleted. " +
+				"Remove 'read_only' from the provider configuration to apply the changes.",
+		}}
+	}
+	guard := func(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if isReadOnly(meta) {
+				if operation == "updated" {
+					d.Partial(true)
+				}
+				return refuse(operation)
+			}
+			return f(ctx, d, meta)
+		}
+	}
+	resource.CreateContext = guard("created", resource.CreateContext)
+	resource.UpdateContext = guard("updated", resource.UpdateContext)
+	resource.DeleteContext = guard("deleted", resource.DeleteContext)
+	resource.CreateWithoutTimeout = guard("created", resource.CreateWithoutTimeout)
+	resource.UpdateWithoutTimeout = guard("updated", resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = guard("deleted", resource.DeleteWithoutTimeout)
+}
@@ -0,0 +1,83 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestGuardResourceForReadOnly checks that resources refuse to change anything in read-only mode
+func TestGuardResourceForReadOnly(t *testing.T) {
+	called := false
+	resource := &schema.Resource{
+		DeleteContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			called = true
+			return nil
+		},
+	}
+	guardResourceForReadOnly("vcfa_test", resource)
+	if resource.CreateContext != nil || resource.UpdateContext != nil {
+		t.Fatalf("expected undefined operations to remain undefined")
+	}
+
+	diags := resource.DeleteContext(context.Background(), nil, ClientContainer{readOnly: true})
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[read only] vcfa_test was not deleted") || called {
+		t.Errorf("expected delete to be refused in read-only mode, got %v (called: %t)", diags, called)
+	}
+
+	diags = resource.DeleteContext(context.Background(), nil, ClientContainer{})
+	if diags.HasError() || !called {
+		t.Errorf("expected delete to be called without 'read_only', got %v (called: %t)", diags, called)
+	}
+
+	updateResource := &schema.Resource{
+		UpdateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics { return nil },
+	}
+	guardResourceForReadOnly("vcfa_test", updateResource)
+	diags = updateResource.UpdateContext(context.Background(), schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, nil), ClientContainer{readOnly: true})
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[read only] vcfa_test was not updated") {
+		t.Errorf("expected update to be refused in read-only mode, got %v", diags)
+	}
+}
+
+// TestReadOnlyGuardsAllResources checks that every resource of the provider, including the ones that support dry
+// runs, refuses to change anything in read-only mode
+func TestReadOnlyGuardsAllResources(t *testing.T) {
+	for name, resource := range globalResourceMap {
+		if resource.CreateWithoutTimeout == nil {
+			continue
+		}
+		diags := resource.CreateWithoutTimeout(context.Background(), resource.TestResourceData(), ClientContainer{readOnly: true, dryRun: true})
+		if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, "[read only]") {
+			t.Errorf("expected %s to refuse creations in read-only mode, got %v", name, diags)
+		}
+	}
+}
+
+// TestChangesOnReadAllowed checks that reads don't change VCFA, like synchronizing Content Libraries, in read-only
+// and dry-run modes
+func TestChangesOnReadAllowed(t *testing.T) {
+	tests := []struct {
+		container ClientContainer
+		want      bool
+	}{
+		{container: ClientContainer{}, want: true},
+		{container: ClientContainer{readOnly: true}, want: false},
+		{container: ClientContainer{dryRun: true}, want: false},
+		{container: ClientContainer{readOnly: true, dryRun: true}, want: false},
+	}
+	for _, tt := range tests {
+		if got := changesOnReadAllowed(tt.container); got != tt.want {
+			t.Errorf("changesOnReadAllowed(read_only: %t, dry_run: %t) = %t, want %t", tt.container.readOnly, tt.container.dryRun, got, tt.want)
+		}
+	}
+}
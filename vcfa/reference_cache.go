@@ -0,0 +1,85 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"sync"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/util"
+)
+
+// referenceCache keeps the objects that resources and data sources look up by URN as references, like the
+// Organization of 'org_id' or the Region of 'region_id', so they are retrieved once per Terraform run instead of once
+// per resource. It lives in the ClientContainer, so it is discarded when Terraform finishes the plan or the apply.
+// Cached objects must only be read: the generic update and delete operations invalidate the URN of the object they
+// change, so the next lookup retrieves it again
+type referenceCache struct {
+	sync.Mutex
+	entries map[string]interface{}
+}
+
+func newReferenceCache() *referenceCache {
+	return &referenceCache{entries: make(map[string]interface{})}
+}
+
+// invalidate removes the object with the given URN from the cache, if it is there
+func (c *referenceCache) invalidate(urn string) {
+	if c == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	delete(c.entries, urn)
+}
+
+// getCachedReference returns the object with the given URN from the cache or, if it is not there, retrieves it with
+// the given function and stores it. Errors are never cached. A nil cache always retrieves the object
+func getCachedReference[T any](c *referenceCache, urn string, getByIdFunc func(string) (T, error)) (T, error) {
+	if c == nil {
+		return getByIdFunc(urn)
+	}
+
+	c.Lock()
+	cached, ok := c.entries[urn]
+	c.Unlock()
+	if typed, isType := cached.(T); ok && isType {
+		util.Logger.Printf("[TRACE] reference cache hit for '%s'", urn)
+		return typed, nil
+	}
+
+	// The lock is not held while retrieving the object, so slow requests don't block other lookups. Two concurrent
+	// lookups of the same URN may then retrieve it twice, which is harmless
+	retrieved, err := getByIdFunc(urn)
+	if err != nil {
+		return retrieved, err
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.entries[urn] = retrieved
+	return retrieved, nil
+}
+
+// getCachedTmOrgById returns the Organization with the given ID, using the reference cache of the provider. The cached
+// objects outlive the operation, so they are retrieved with the client that is not bound to its context
+func getCachedTmOrgById(meta interface{}, orgId string) (*govcd.TmOrg, error) {
+	container := meta.(ClientContainer)
+	return getCachedReference(container.referenceCache, orgId, container.tmClient.withoutContext().GetTmOrgById)
+}
+
+// getCachedRegionById returns the Region with the given ID, using the reference cache of the provider. The cached
+// objects outlive the operation, so they are retrieved with the client that is not bound to its context
+func getCachedRegionById(meta interface{}, regionId string) (*govcd.Region, error) {
+	container := meta.(ClientContainer)
+	return getCachedReference(container.referenceCache, regionId, container.tmClient.withoutContext().GetRegionById)
+}
+
+// invalidateCachedReference removes the object with the given URN from the reference cache of the provider, after
+// it has been changed or deleted
+func invalidateCachedReference(meta interface{}, urn string) {
+	if container, ok := meta.(ClientContainer); ok {
+		container.referenceCache.invalidate(urn)
+	}
+}
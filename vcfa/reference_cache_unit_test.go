@@ -0,0 +1,66 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestReferenceCache checks that objects are retrieved once until they are invalidated, and that errors are not cached
+func TestReferenceCache(t *testing.T) {
+	const urn = "urn:vcloud:org:11111111-2222-3333-4444-555555555555"
+	calls := 0
+	failing := true
+	getById := func(id string) (*string, error) {
+		calls++
+		if failing {
+			return nil, fmt.Errorf("temporary error")
+		}
+		name := fmt.Sprintf("org-%d", calls)
+		return &name, nil
+	}
+
+	cache := newReferenceCache()
+	if _, err := getCachedReference(cache, urn, getById); err == nil {
+		t.Fatalf("expected an error")
+	}
+	failing = false
+
+	first, err := getCachedReference(cache, urn, getById)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	second, err := getCachedReference(cache, urn, getById)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 2 || first != second {
+		t.Errorf("expected the second lookup to be cached, got %d calls and objects %s and %s", calls, *first, *second)
+	}
+
+	cache.invalidate(urn)
+	third, err := getCachedReference(cache, urn, getById)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if calls != 3 || *third != "org-3" {
+		t.Errorf("expected the object to be retrieved again after the invalidation, got %d calls and object %s", calls, *third)
+	}
+
+	// A nil cache, like the one of a ClientContainer that is not built by the provider, always retrieves the object
+	var nilCache *referenceCache
+	nilCache.invalidate(urn)
+	for i := 0; i < 2; i++ {
+		if _, err := getCachedReference(nilCache, urn, getById); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+	if calls != 5 {
+		t.Errorf("expected 5 calls without cache, got %d", calls)
+	}
+}
@@ -53,6 +53,9 @@ type crudConfig[O updateDeleter[O, I], I any] struct {
 	// preUpdateHooks will be executed before submitting the data for update
 	preUpdateHooks []outerEntityHookInnerEntityType[O, *I]
 
+	// postUpdateHooks will be executed after the entity is updated, but before it is read
+	postUpdateHooks []outerEntityHook[O]
+
 	// preDeleteHooks will be executed before the entity is deleted
 	preDeleteHooks []outerEntityHook[O]
 
@@ -102,7 +105,7 @@ func createResource[O updateDeleter[O, I], I any](ctx context.Context, d *schema
 			return diag.Errorf("error creating async %s: %s", c.entityLabel, err)
 		}
 
-		err = task.WaitTaskCompletion()
+		err = waitForTaskCompletion(ctx, task)
 		if err != nil {
 			if task != nil && task.Task != nil {
 				util.Logger.Printf("[DEBUG] entity '%s' task with ID '%s' failed. Attempting to recover ID", c.entityLabel, task.Task.ID)
@@ -177,11 +180,17 @@ func updateResource[O updateDeleter[O, I], I any](ctx context.Context, d *schema
 		return diag.Errorf("error executing pre-update %s hooks: %s", c.entityLabel, err)
 	}
 
-	_, err = retrievedEntity.Update(t)
+	updatedEntity, err := retrievedEntity.Update(t)
+	invalidateCachedReference(meta, d.Id())
 	if err != nil {
 		return diag.Errorf("error updating %s with ID: %s", c.entityLabel, err)
 	}
 
+	err = execEntityHook(updatedEntity, c.postUpdateHooks)
+	if err != nil {
+		return diag.Errorf("error executing post-update %s hooks: %s", c.entityLabel, err)
+	}
+
 	if c.resourceReadFunc != nil {
 		return c.resourceReadFunc(ctx, d, meta)
 	}
@@ -213,7 +222,7 @@ func readResource[O updateDeleter[O, I], I any](_ context.Context, d *schema.Res
 	return nil
 }
 
-func deleteResource[O updateDeleter[O, I], I any](_ context.Context, d *schema.ResourceData, _ interface{}, c crudConfig[O, I]) diag.Diagnostics {
+func deleteResource[O updateDeleter[O, I], I any](_ context.Context, d *schema.ResourceData, meta interface{}, c crudConfig[O, I]) diag.Diagnostics {
 	retrievedEntity, err := c.getEntityFunc(d.Id())
 	if err != nil {
 		return diag.Errorf("error getting %s for delete: %s", c.entityLabel, err)
@@ -225,6 +234,7 @@ func deleteResource[O updateDeleter[O, I], I any](_ context.Context, d *schema.R
 	}
 
 	err = retrievedEntity.Delete()
+	invalidateCachedReference(meta, d.Id())
 	if err != nil {
 		return diag.Errorf("error deleting %s with ID '%s': %s", c.entityLabel, d.Id(), err)
 	}
@@ -8,11 +8,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
 )
 
 const labelVcfaApiToken = "API Token"
@@ -22,6 +25,7 @@ func resourceVcfaApiToken() *schema.Resource {
 		CreateContext: resourceVcfaApiTokenCreate,
 		ReadContext:   resourceVcfaApiTokenRead,
 		DeleteContext: resourceVcfaApiTokenDelete,
+		CustomizeDiff: resourceVcfaApiTokenCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceVcfaApiTokenImport,
 		},
@@ -34,15 +38,17 @@ func resourceVcfaApiToken() *schema.Resource {
 				Description: fmt.Sprintf("Name of %s", labelVcfaApiToken),
 			},
 			"file_name": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-				Description: fmt.Sprintf("Name of the file that the %s will be saved to", labelVcfaApiToken),
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"allow_token_file"},
+				Description:  fmt.Sprintf("Name of the file that the %s will be saved to", labelVcfaApiToken),
 			},
 			"allow_token_file": {
-				Type:     schema.TypeBool,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"file_name"},
 				Description: fmt.Sprintf("Set this to true if you understand the security risks of using"+
 					" %s files and agree to creating them", labelVcfaApiToken),
 				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
@@ -61,6 +67,42 @@ func resourceVcfaApiToken() *schema.Resource {
 					return nil
 				},
 			},
+			"scope": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Space separated list of OAuth scopes requested for the %s. By default, it has the same scope as the user", labelVcfaApiToken),
+			},
+			"expires_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IsRFC3339Time),
+				Description: fmt.Sprintf("Date and time (RFC 3339) after which the %s is replaced by the next apply, which "+
+					"revokes it and creates a new one", labelVcfaApiToken),
+			},
+			"expired": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s reached the date of 'expires_at', so the next apply replaces it", labelVcfaApiToken),
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+				Description: fmt.Sprintf("The %s value. It is only available in the state of the Terraform run that "+
+					"created it, and it is empty for imported %ss", labelVcfaApiToken, labelVcfaApiToken),
+			},
+			"owner_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the user that owns the %s", labelVcfaApiToken),
+			},
+			"org_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s of the owner of the %s", labelVcfaOrg, labelVcfaApiToken),
+			},
 		},
 	}
 }
@@ -75,8 +117,7 @@ func resourceVcfaApiTokenCreate(ctx context.Context, d *schema.ResourceData, met
 		org = tmClient.Org
 	}
 
-	tokenName := d.Get("name").(string)
-	token, err := tmClient.CreateToken(org, tokenName)
+	token, err := createApiToken(tmClient, org, d.Get("name").(string), d.Get("scope").(string))
 	if err != nil {
 		return diag.Errorf("[%s create] error creating %s: %s", labelVcfaApiToken, labelVcfaApiToken, err)
 	}
@@ -87,11 +128,14 @@ func resourceVcfaApiTokenCreate(ctx context.Context, d *schema.ResourceData, met
 		return diag.Errorf("[%s create] error getting refresh token from %s: %s", labelVcfaApiToken, labelVcfaApiToken, err)
 	}
 
-	filename := d.Get("file_name").(string)
+	// The token value can only be retrieved once, so it is kept in the state
+	dSet(d, "token", apiToken.RefreshToken)
 
-	err = govcd.SaveApiTokenToFile(filename, tmClient.Client.UserAgent, apiToken)
-	if err != nil {
-		return diag.Errorf("[%s create] error saving %s to file: %s", labelVcfaApiToken, labelVcfaApiToken, err)
+	if filename := d.Get("file_name").(string); filename != "" {
+		err = govcd.SaveApiTokenToFile(filename, tmClient.Client.UserAgent, apiToken)
+		if err != nil {
+			return diag.Errorf("[%s create] error saving %s to file: %s", labelVcfaApiToken, labelVcfaApiToken, err)
+		}
 	}
 
 	return resourceVcfaApiTokenRead(ctx, d, meta)
@@ -104,17 +148,45 @@ func resourceVcfaApiTokenRead(ctx context.Context, d *schema.ResourceData, meta
 	if govcd.ContainsNotFound(err) {
 		d.SetId("")
 		log.Printf("[DEBUG] %s no longer exists. Removing from tfstate", labelVcfaApiToken)
+		return nil
 	}
 	if err != nil {
 		return diag.Errorf("[%s read] error getting %s: %s", labelVcfaApiToken, labelVcfaApiToken, err)
 	}
 
+	// Reads never revoke the expired token, as planning must not change anything. It is replaced by the next apply
+	expired, err := isApiTokenExpired(d.Get("expires_at").(string), time.Now())
+	if err != nil {
+		return diag.Errorf("[%s read] error checking the expiration of %s: %s", labelVcfaApiToken, labelVcfaApiToken, err)
+	}
+	dSet(d, "expired", expired)
+
 	d.SetId(token.Token.ID)
 	dSet(d, "name", token.Token.Name)
+	setApiTokenReferences(d, token.Token)
 
 	return nil
 }
 
+// resourceVcfaApiTokenCustomizeDiff plans the replacement of an API Token that reached the date of 'expires_at', so
+// the apply revokes it in Delete and creates a new one
+func resourceVcfaApiTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChange("expires_at") {
+		return nil
+	}
+	expired, err := isApiTokenExpired(d.Get("expires_at").(string), time.Now())
+	if err != nil || !expired {
+		return err
+	}
+	log.Printf("[DEBUG] %s expired on %s and will be replaced", labelVcfaApiToken, d.Get("expires_at").(string))
+	// ForceNew only accepts changed keys. The planned value doesn't matter, as the replacement diff computes the
+	// attributes of the new API Token again
+	if err := d.SetNew("expired", !d.Get("expired").(bool)); err != nil {
+		return err
+	}
+	return d.ForceNew("expired")
+}
+
 func resourceVcfaApiTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 
@@ -147,6 +219,51 @@ func resourceVcfaApiTokenImport(ctx context.Context, d *schema.ResourceData, met
 
 	d.SetId(token.Token.ID)
 	dSet(d, "name", token.Token.Name)
+	setApiTokenReferences(d, token.Token)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// createApiToken registers an API Token with the given scope, or the scope of the user if it is empty, and retrieves it
+func createApiToken(tmClient *VCDClient, org, tokenName, scope string) (*govcd.Token, error) {
+	if scope == "" {
+		return tmClient.CreateToken(org, tokenName)
+	}
+	tokenParams, err := tmClient.RegisterToken(org, &types.ApiTokenParams{
+		ClientName: tokenName,
+		Scope:      scope,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register API token: %s", err)
+	}
+	tokenUrn, err := govcd.BuildUrnWithUuid("urn:vcloud:token:", tokenParams.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build URN: %s", err)
+	}
+	return tmClient.GetTokenById(tokenUrn)
+}
+
+func setApiTokenReferences(d *schema.ResourceData, token *types.Token) {
+	ownerName, orgName := "", ""
+	if token.Owner != nil {
+		ownerName = token.Owner.Name
+	}
+	if token.Org != nil {
+		orgName = token.Org.Name
+	}
+	dSet(d, "owner_name", ownerName)
+	dSet(d, "org_name", orgName)
+}
+
+// isApiTokenExpired returns whether the given RFC 3339 expiration date is reached. Tokens without expiration date
+// never expire
+func isApiTokenExpired(expiresAt string, now time.Time) (bool, error) {
+	if expiresAt == "" {
+		return false, nil
+	}
+	expiration, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	return !now.Before(expiration), nil
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -48,6 +49,11 @@ func TestAccVcfaApiToken(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", t.Name()),
 					testCheckFileExists(params["FileName"].(string)),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrSet(resourceName, "owner_name"),
+					resource.TestCheckResourceAttr("vcfa_api_token.no_file", "name", t.Name()+"-no-file"),
+					resource.TestCheckResourceAttrSet("vcfa_api_token.no_file", "token"),
+					resource.TestCheckResourceAttr("vcfa_api_token.no_file", "expires_at", "2099-01-01T00:00:00Z"),
 				),
 			},
 		},
@@ -62,6 +68,11 @@ resource "vcfa_api_token" "custom" {
   file_name        = "{{.FileName}}"
   allow_token_file = true
 }
+
+resource "vcfa_api_token" "no_file" {
+  name       = "{{.TokenName}}-no-file"
+  expires_at = "2099-01-01T00:00:00Z"
+}
 `
 
 // This is a helper function that attempts to remove created API token file no matter of the test outcome
@@ -90,7 +101,7 @@ func testAccCheckApiTokenDestroy(tokenName string) resource.TestCheckFunc {
 		conn := testAccProvider.Meta().(ClientContainer).tmClient
 
 		for _, rs := range s.RootModule().Resources {
-			if rs.Type != "vcfa_api_token" || rs.Primary.Attributes["name"] != tokenName {
+			if rs.Type != "vcfa_api_token" || !strings.HasPrefix(rs.Primary.Attributes["name"], tokenName) {
 				continue
 			}
 
@@ -98,8 +109,6 @@ func testAccCheckApiTokenDestroy(tokenName string) resource.TestCheckFunc {
 			if err == nil {
 				return fmt.Errorf("error: %s still exists post-destroy", labelVcfaApiToken)
 			}
-
-			return nil
 		}
 
 		return nil
@@ -0,0 +1,75 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestIsApiTokenExpired(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expiresAt string
+		want      bool
+		wantErr   bool
+	}{
+		{expiresAt: "", want: false},
+		{expiresAt: "2026-01-16T00:00:00Z", want: false},
+		{expiresAt: "2026-01-15T12:00:00Z", want: true},
+		{expiresAt: "2026-01-15T13:00:00+02:00", want: true},
+		{expiresAt: "2026-01-15", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expiresAt, func(t *testing.T) {
+			got, err := isApiTokenExpired(tt.expiresAt, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("isApiTokenExpired() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("isApiTokenExpired() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestResourceVcfaApiTokenCustomizeDiff checks that an expired API Token is planned for replacement, even when the
+// state was not refreshed after its expiration, and that a valid one is kept
+func TestResourceVcfaApiTokenCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		expiresAt   string
+		expired     string
+		requiresNew bool
+	}{
+		{expiresAt: "2000-01-01T00:00:00Z", expired: "true", requiresNew: true},
+		{expiresAt: "2000-01-01T00:00:00Z", expired: "false", requiresNew: true},
+		{expiresAt: "2999-01-01T00:00:00Z", expired: "false", requiresNew: false},
+		{expiresAt: "", expired: "false", requiresNew: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expiresAt+"/"+tt.expired, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID:         "urn:vcloud:token:1",
+				Attributes: map[string]string{"id": "urn:vcloud:token:1", "name": "token1", "expires_at": tt.expiresAt, "expired": tt.expired},
+			}
+			config := map[string]interface{}{"name": "token1"}
+			if tt.expiresAt != "" {
+				config["expires_at"] = tt.expiresAt
+			}
+			diff, err := resourceVcfaApiToken().Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := diff != nil && diff.RequiresNew(); got != tt.requiresNew {
+				t.Errorf("expected replacement %t, got %t (diff: %v)", tt.requiresNew, got, diff)
+			}
+		})
+	}
+}
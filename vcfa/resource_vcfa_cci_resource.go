@@ -0,0 +1,620 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const labelVcfaCciResource = "CCI Resource"
+
+// cciResourceServerMetadata are the metadata fields set by the server, which are not part of the manifest of an
+// imported object
+var cciResourceServerMetadata = []string{"creationTimestamp", "deletionGracePeriodSeconds", "deletionTimestamp",
+	"generation", "managedFields", "resourceVersion", "selfLink", "uid"}
+
+func resourceVcfaCciResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaCciResourceCreate,
+		ReadContext:   resourceVcfaCciResourceRead,
+		UpdateContext: resourceVcfaCciResourceUpdate,
+		DeleteContext: resourceVcfaCciResourceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaCciResourceImport,
+		},
+		CustomizeDiff: resourceVcfaCciResourceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org": orgScopedSchema(labelVcfaCciResource),
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelVcfaCciResource),
+			},
+			"manifest": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Kubernetes-style manifest of the object, in YAML or JSON. It must contain 'apiVersion', 'kind' " +
+					"and 'metadata.name'. Changing any of them recreates the object",
+				ValidateFunc: func(v interface{}, key string) ([]string, []error) {
+					if _, err := parseCciManifest(v.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s: %s", key, err)}
+					}
+					return nil, nil
+				},
+				DiffSuppressFunc: func(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+					return cciManifestsEqual(oldValue, newValue)
+				},
+			},
+			"wait_for_ready": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: fmt.Sprintf("Whether to wait for the %s to report a 'Ready' condition with status 'True' "+
+					"after creating or updating it. Only objects that report such condition should enable it", labelVcfaCciResource),
+			},
+			"api_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("API version of the %s, like 'vpc.nsx.vmware.com/v1alpha1'", labelVcfaCciResource),
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Kind of the %s, like 'VPC'", labelVcfaCciResource),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the %s", labelVcfaCciResource),
+			},
+			"api_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Path of the %s in the CCI endpoint, also used as its ID and to import it", labelVcfaCciResource),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Status of the %s as reported by the server, in JSON", labelVcfaCciResource),
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Phase of the %s, if it reports one", labelVcfaCciResource),
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is in a ready status or not", labelVcfaCciResource),
+			},
+			"conditions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Detailed conditions tracking %s health and lifecycle events", labelVcfaCciResource),
+				Elem:        supervisorNamespaceConditionsSchema,
+			},
+		},
+	}
+}
+
+// cciResourceRef identifies an object of the CCI endpoint that lives within a Project
+type cciResourceRef struct {
+	group       string
+	version     string
+	plural      string
+	projectName string
+	name        string
+}
+
+// entity returns the definition of the objects of the same resource, to use the generic CCI Project entity functions
+func (r cciResourceRef) entity() cciProjectEntity {
+	return cciProjectEntity{
+		label:       fmt.Sprintf("%s '%s.%s'", labelVcfaCciResource, r.plural, r.group),
+		urlTemplate: fmt.Sprintf("/apis/%s/%s/namespaces/%%s/%s", r.group, r.version, r.plural),
+	}
+}
+
+// apiPath returns the path of the object in the CCI endpoint
+func (r cciResourceRef) apiPath() string {
+	return fmt.Sprintf("/apis/%s/%s/namespaces/%s/%s/%s", r.group, r.version, r.projectName, r.plural, r.name)
+}
+
+// parseCciResourceApiPath parses a path like '/apis/<group>/<version>/namespaces/<project>/<plural>/<name>'
+func parseCciResourceApiPath(apiPath string) (cciResourceRef, error) {
+	parts := strings.Split(strings.TrimPrefix(apiPath, "/"), "/")
+	if len(parts) != 7 || parts[0] != "apis" || parts[3] != "namespaces" {
+		return cciResourceRef{}, fmt.Errorf("path '%s' does not match /apis/<group>/<version>/namespaces/<project_name>/<resource>/<name>", apiPath)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return cciResourceRef{}, fmt.Errorf("path '%s' contains empty segments", apiPath)
+		}
+	}
+	return cciResourceRef{
+		group:       parts[1],
+		version:     parts[2],
+		projectName: parts[4],
+		plural:      parts[5],
+		name:        parts[6],
+	}, nil
+}
+
+func resourceVcfaCciResourceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName := d.Get("project_name").(string)
+
+	object, err := parseCciManifest(d.Get("manifest").(string))
+	if err != nil {
+		return diag.Errorf("error parsing %s manifest: %s", labelVcfaCciResource, err)
+	}
+	ref, err := getCciResourceRef(tmClient, object, projectName)
+	if err != nil {
+		return diag.Errorf("error creating %s: %s", labelVcfaCciResource, err)
+	}
+	if err := setCciObjectNamespace(object, projectName); err != nil {
+		return diag.Errorf("error creating %s: %s", labelVcfaCciResource, err)
+	}
+
+	if err := createCciProjectEntity(tmClient, ref.entity(), projectName, &object, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error creating %s: %s", labelVcfaCciResource, projectAccessError(tmClient, projectName, err))
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaCciResource, ref.name, "created")
+	}
+
+	d.SetId(ref.apiPath())
+
+	if d.Get("wait_for_ready").(bool) {
+		err = waitForCciProjectEntityReady(ctx, ref.entity(), projectName, ref.name, operationTimeout(d, meta, schema.TimeoutCreate),
+			cciResourceStatusFunc(tmClient, ref))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceVcfaCciResourceRead(ctx, d, meta)
+}
+
+func resourceVcfaCciResourceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ref, err := parseCciResourceApiPath(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaCciResource, d.Id(), err)
+	}
+
+	if d.HasChange("manifest") {
+		object, err := parseCciManifest(d.Get("manifest").(string))
+		if err != nil {
+			return diag.Errorf("error parsing %s manifest: %s", labelVcfaCciResource, err)
+		}
+		if err := setCciObjectNamespace(object, ref.projectName); err != nil {
+			return diag.Errorf("error updating %s: %s", labelVcfaCciResource, err)
+		}
+
+		// The latest resource version is required to update the object, and the metadata that is not in the
+		// manifest, like the finalizers set by controllers, is kept
+		liveObject, err := readCciProjectEntity[map[string]interface{}](tmClient, ref.entity(), ref.projectName, ref.name)
+		if err != nil {
+			return diag.Errorf("error reading %s: %s", labelVcfaCciResource, err)
+		}
+		updatedObject := mergeCciObjectMetadata(object, liveObject)
+
+		if err = updateCciProjectEntity(tmClient, ref.entity(), ref.projectName, ref.name, &updatedObject, dryRunParams(meta)); err != nil {
+			return diag.Errorf("error updating %s: %s", labelVcfaCciResource, err)
+		}
+		if isDryRun(meta) {
+			return dryRunDiagnostics(d, labelVcfaCciResource, ref.name, "updated")
+		}
+	}
+
+	if d.Get("wait_for_ready").(bool) {
+		err = waitForCciProjectEntityReady(ctx, ref.entity(), ref.projectName, ref.name, operationTimeout(d, meta, schema.TimeoutUpdate),
+			cciResourceStatusFunc(tmClient, ref))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceVcfaCciResourceRead(ctx, d, meta)
+}
+
+func resourceVcfaCciResourceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ref, err := parseCciResourceApiPath(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaCciResource, d.Id(), err)
+	}
+
+	liveObject, err := readCciProjectEntity[map[string]interface{}](tmClient, ref.entity(), ref.projectName, ref.name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			log.Printf("[DEBUG] %s %s in Project %s not found, removing from state", ref.entity().label, ref.name, ref.projectName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelVcfaCciResource, err)
+	}
+
+	if err := setCciResourceData(d, ref, liveObject); err != nil {
+		return diag.Errorf("error setting %s data: %s", labelVcfaCciResource, err)
+	}
+
+	return nil
+}
+
+func resourceVcfaCciResourceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ref, err := parseCciResourceApiPath(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaCciResource, d.Id(), err)
+	}
+
+	if err := deleteCciProjectEntity(tmClient, ref.entity(), ref.projectName, ref.name, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error deleting %s: %s", labelVcfaCciResource, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaCciResource, ref.name, "deleted")
+	}
+
+	err = waitForCciProjectEntityDeleted(ctx, ref.entity(), ref.projectName, ref.name, operationTimeout(d, meta, schema.TimeoutDelete),
+		cciResourceStatusFunc(tmClient, ref))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceVcfaCciResourceImport imports an object identified by [<org><sep>]<api_path>, where the API path is
+// '/apis/<group>/<version>/namespaces/<project_name>/<resource>/<name>'. The path is used because API groups
+// contain dots, which is the default import separator
+func resourceVcfaCciResourceImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	apiPath := d.Id()
+	if !strings.HasPrefix(apiPath, "/") {
+		org, path, found := strings.Cut(apiPath, ImportSeparator+"/")
+		if !found {
+			return nil, fmt.Errorf("expected import ID to be [<org>%s]/apis/<group>/<version>/namespaces/<project_name>/<resource>/<name>", ImportSeparator)
+		}
+		dSet(d, "org", decodeImportIdElement(org))
+		apiPath = "/" + path
+	}
+	ref, err := parseCciResourceApiPath(apiPath)
+	if err != nil {
+		return nil, err
+	}
+
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readCciProjectEntity[map[string]interface{}](tmClient, ref.entity(), ref.projectName, ref.name); err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", labelVcfaCciResource, err)
+	}
+
+	d.SetId(ref.apiPath())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceVcfaCciResourceCustomizeDiff recreates the object when the manifest changes its identity, as the API does
+// not allow to rename an object nor to change its kind
+func resourceVcfaCciResourceCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("manifest") || !d.NewValueKnown("manifest") {
+		return nil
+	}
+	oldManifest, newManifest := d.GetChange("manifest")
+	newObject, err := parseCciManifest(newManifest.(string))
+	if err != nil {
+		return err
+	}
+	apiVersion, kind, name := getCciObjectIdentity(newObject)
+	for key, value := range map[string]string{"api_version": apiVersion, "kind": kind, "name": name} {
+		if err := d.SetNew(key, value); err != nil {
+			return fmt.Errorf("error setting '%s': %s", key, err)
+		}
+	}
+
+	if oldManifest.(string) == "" {
+		return nil
+	}
+	oldObject, err := parseCciManifest(oldManifest.(string))
+	if err != nil {
+		return nil
+	}
+	oldApiVersion, oldKind, oldName := getCciObjectIdentity(oldObject)
+	if oldApiVersion != apiVersion || oldKind != kind || oldName != name {
+		return d.ForceNew("manifest")
+	}
+	return nil
+}
+
+func cciResourceStatusFunc(tmClient *VCDClient, ref cciResourceRef) func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+	return func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+		object, err := readCciProjectEntity[map[string]interface{}](tmClient, ref.entity(), ref.projectName, ref.name)
+		if err != nil {
+			return "", nil, err
+		}
+		return getCciObjectStatus(object)
+	}
+}
+
+// getCciResourceRef discovers the resource that serves the kind of the given object, which must live within a Project
+func getCciResourceRef(tmClient *VCDClient, object map[string]interface{}, projectName string) (cciResourceRef, error) {
+	apiVersion, kind, name := getCciObjectIdentity(object)
+	group, version, found := strings.Cut(apiVersion, "/")
+	if !found {
+		return cciResourceRef{}, fmt.Errorf("apiVersion '%s' must be '<group>/<version>', as core Kubernetes resources are not served by the CCI endpoint", apiVersion)
+	}
+
+	client := tmClient.VCDClient.Client
+	resourcesURL, err := client.GetEntityUrl("/apis/" + apiVersion)
+	if err != nil {
+		return cciResourceRef{}, fmt.Errorf("error building %s URL: %s", labelVcfaCciApiResources, err)
+	}
+	var resourceList v1.APIResourceList
+	if err := client.GetEntity(resourcesURL, nil, &resourceList, nil); err != nil {
+		return cciResourceRef{}, fmt.Errorf("error discovering the resources of API group version '%s': %s", apiVersion, err)
+	}
+	resource, err := findCciApiResource(resourceList.APIResources, kind)
+	if err != nil {
+		return cciResourceRef{}, fmt.Errorf("API group version '%s': %s", apiVersion, err)
+	}
+	if !resource.Namespaced {
+		return cciResourceRef{}, fmt.Errorf("kind '%s' of API group version '%s' does not live within a Project", kind, apiVersion)
+	}
+
+	return cciResourceRef{
+		group:       group,
+		version:     version,
+		plural:      resource.Name,
+		projectName: projectName,
+		name:        name,
+	}, nil
+}
+
+// findCciApiResource returns the resource that serves the given kind, skipping subresources like 'vpcs/status'
+func findCciApiResource(resources []v1.APIResource, kind string) (v1.APIResource, error) {
+	for _, resource := range resources {
+		if resource.Kind == kind && !strings.Contains(resource.Name, "/") {
+			return resource, nil
+		}
+	}
+	return v1.APIResource{}, fmt.Errorf("kind '%s' is not served", kind)
+}
+
+// parseCciManifest parses a YAML or JSON manifest, checking that it identifies a single object
+func parseCciManifest(manifest string) (map[string]interface{}, error) {
+	jsonManifest, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		return nil, fmt.Errorf("manifest is not valid YAML or JSON: %s", err)
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(jsonManifest, &object); err != nil {
+		return nil, fmt.Errorf("manifest must be a single object: %s", err)
+	}
+	if object == nil {
+		return nil, fmt.Errorf("manifest is empty")
+	}
+
+	apiVersion, kind, name := getCciObjectIdentity(object)
+	if apiVersion == "" || kind == "" || name == "" {
+		return nil, fmt.Errorf("manifest must contain 'apiVersion', 'kind' and 'metadata.name'")
+	}
+	return object, nil
+}
+
+// cciManifestsEqual returns whether two manifests describe the same object, regardless of their format
+func cciManifestsEqual(manifest1, manifest2 string) bool {
+	object1, err := parseCciManifest(manifest1)
+	if err != nil {
+		return false
+	}
+	object2, err := parseCciManifest(manifest2)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(object1, object2)
+}
+
+// getCciObjectIdentity returns the API version, kind and name of an object, or empty strings for the missing ones
+func getCciObjectIdentity(object map[string]interface{}) (string, string, string) {
+	apiVersion, _ := object["apiVersion"].(string)
+	kind, _ := object["kind"].(string)
+	var name string
+	if metadata, ok := object["metadata"].(map[string]interface{}); ok {
+		name, _ = metadata["name"].(string)
+	}
+	return apiVersion, kind, name
+}
+
+// setCciObjectNamespace sets the namespace of an object to the given Project, failing if it already has another one
+func setCciObjectNamespace(object map[string]interface{}, projectName string) error {
+	metadata, ok := object["metadata"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("manifest must contain 'metadata'")
+	}
+	if namespace, ok := metadata["namespace"].(string); ok && namespace != projectName {
+		return fmt.Errorf("the namespace '%s' of the manifest does not match the Project '%s'", namespace, projectName)
+	}
+	metadata["namespace"] = projectName
+	return nil
+}
+
+// mergeCciObjectMetadata returns the given object with the metadata fields of the live one that it does not set, so
+// updating it keeps the resource version and the metadata managed by the server
+func mergeCciObjectMetadata(object, liveObject map[string]interface{}) map[string]interface{} {
+	metadata := map[string]interface{}{}
+	if liveMetadata, ok := liveObject["metadata"].(map[string]interface{}); ok {
+		for key, value := range liveMetadata {
+			metadata[key] = value
+		}
+	}
+	if objectMetadata, ok := object["metadata"].(map[string]interface{}); ok {
+		for key, value := range objectMetadata {
+			metadata[key] = value
+		}
+	}
+
+	result := make(map[string]interface{}, len(object))
+	for key, value := range object {
+		result[key] = value
+	}
+	result["metadata"] = metadata
+	return result
+}
+
+// projectCciObject returns the values of the live object for the fields that are set in the manifest, so changes
+// done outside Terraform are detected while the fields defaulted by the server are ignored. Lists are compared as
+// a whole when their lengths differ
+func projectCciObject(manifest, live interface{}) interface{} {
+	switch manifestValue := manifest.(type) {
+	case map[string]interface{}:
+		liveMap, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		result := make(map[string]interface{}, len(manifestValue))
+		for key, value := range manifestValue {
+			liveValue, found := liveMap[key]
+			if !found {
+				continue
+			}
+			result[key] = projectCciObject(value, liveValue)
+		}
+		return result
+	case []interface{}:
+		liveList, ok := live.([]interface{})
+		if !ok || len(liveList) != len(manifestValue) {
+			return live
+		}
+		result := make([]interface{}, 0, len(manifestValue))
+		for i, value := range manifestValue {
+			result = append(result, projectCciObject(value, liveList[i]))
+		}
+		return result
+	default:
+		return live
+	}
+}
+
+// getCciObjectManifest returns the manifest of an imported object, without its status and server metadata
+func getCciObjectManifest(liveObject map[string]interface{}) map[string]interface{} {
+	manifest := make(map[string]interface{}, len(liveObject))
+	for key, value := range liveObject {
+		if key != "status" {
+			manifest[key] = value
+		}
+	}
+	if liveMetadata, ok := liveObject["metadata"].(map[string]interface{}); ok {
+		metadata := make(map[string]interface{}, len(liveMetadata))
+		for key, value := range liveMetadata {
+			metadata[key] = value
+		}
+		for _, key := range cciResourceServerMetadata {
+			delete(metadata, key)
+		}
+		manifest["metadata"] = metadata
+	}
+	return manifest
+}
+
+// getCciObjectStatus returns the phase and conditions of an object, if its status reports them
+func getCciObjectStatus(object map[string]interface{}) (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+	status, ok := object["status"].(map[string]interface{})
+	if !ok {
+		return "", nil, nil
+	}
+	phase, _ := status["phase"].(string)
+
+	rawConditions, ok := status["conditions"]
+	if !ok {
+		return phase, nil, nil
+	}
+	conditionsJson, err := json.Marshal(rawConditions)
+	if err != nil {
+		return "", nil, err
+	}
+	var conditions []ccitypes.SupervisorNamespaceStatusConditions
+	if err := json.Unmarshal(conditionsJson, &conditions); err != nil {
+		return "", nil, fmt.Errorf("error parsing the status conditions: %s", err)
+	}
+	return phase, conditions, nil
+}
+
+func setCciResourceData(d *schema.ResourceData, ref cciResourceRef, liveObject map[string]interface{}) error {
+	apiVersion, kind, name := getCciObjectIdentity(liveObject)
+	dSet(d, "project_name", ref.projectName)
+	dSet(d, "api_version", apiVersion)
+	dSet(d, "kind", kind)
+	dSet(d, "name", name)
+	dSet(d, "api_path", ref.apiPath())
+
+	// The manifest is only replaced when the live object differs in the fields that it sets, or when importing
+	var manifest map[string]interface{}
+	if currentManifest, err := parseCciManifest(d.Get("manifest").(string)); err == nil {
+		projectedManifest, _ := projectCciObject(currentManifest, liveObject).(map[string]interface{})
+		if !reflect.DeepEqual(projectedManifest, currentManifest) {
+			manifest = projectedManifest
+		}
+	} else {
+		manifest = getCciObjectManifest(liveObject)
+	}
+	if manifest != nil {
+		manifestJson, err := json.Marshal(manifest)
+		if err != nil {
+			return fmt.Errorf("error encoding 'manifest': %s", err)
+		}
+		dSet(d, "manifest", string(manifestJson))
+	}
+
+	status := "{}"
+	if rawStatus, ok := liveObject["status"]; ok {
+		statusJson, err := json.Marshal(rawStatus)
+		if err != nil {
+			return fmt.Errorf("error encoding 'status': %s", err)
+		}
+		status = string(statusJson)
+	}
+	dSet(d, "status", status)
+
+	phase, conditions, err := getCciObjectStatus(liveObject)
+	if err != nil {
+		return err
+	}
+	dSet(d, "phase", phase)
+	dSet(d, "ready", isCciConditionReady(conditions))
+	if err := d.Set("conditions", flattenCciConditions(conditions)); err != nil {
+		return fmt.Errorf("error setting 'conditions': %s", err)
+	}
+
+	return nil
+}
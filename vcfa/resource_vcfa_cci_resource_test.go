@@ -0,0 +1,100 @@
+//go:build cci || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+)
+
+// TestAccVcfaCciResource tests managing a DHCP Profile, which has a first-class resource, with a raw manifest
+func TestAccVcfaCciResource(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfSysAdmin(t)
+
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	var params = StringMap{
+		"ProjectName": "tf-project",
+		"RegionName":  testConfig.Cci.Region,
+		"Testname":    t.Name(),
+		"LeaseTime":   "3600",
+
+		"Tags": "cci",
+	}
+	testParamsNotEmpty(t, params)
+
+	cleanup := testutils.SetupProject(t, params["ProjectName"].(string))
+	defer cleanup()
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaCciResource, params)
+	params["FuncName"] = t.Name() + "-step2"
+	params["LeaseTime"] = "7200"
+	configText2 := templateFill(testAccVcfaCciResource, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	resourceDef := "vcfa_cci_resource.test"
+	apiPath := "/apis/vpc.nsx.vmware.com/v1alpha1/namespaces/" + params["ProjectName"].(string) + "/dhcpprofiles/tf-cci-resource"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDef, "id", apiPath),
+					resource.TestCheckResourceAttr(resourceDef, "api_path", apiPath),
+					resource.TestCheckResourceAttr(resourceDef, "api_version", "vpc.nsx.vmware.com/v1alpha1"),
+					resource.TestCheckResourceAttr(resourceDef, "kind", "DHCPProfile"),
+					resource.TestCheckResourceAttr(resourceDef, "name", "tf-cci-resource"),
+					resource.TestCheckResourceAttr(resourceDef, "ready", "true"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDef, "id", apiPath),
+					resource.TestCheckResourceAttr(resourceDef, "ready", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceDef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           apiPath,
+				ImportStateVerifyIgnore: []string{"manifest", "wait_for_ready"},
+			},
+		},
+	})
+}
+
+const testAccVcfaCciResource = `
+resource "vcfa_cci_resource" "test" {
+  project_name   = "{{.ProjectName}}"
+  wait_for_ready = true
+  manifest       = <<-EOT
+    apiVersion: vpc.nsx.vmware.com/v1alpha1
+    kind: DHCPProfile
+    metadata:
+      name: tf-cci-resource
+    spec:
+      regionName: {{.RegionName}}
+      description: Created by {{.Testname}}
+      mode: SERVER
+      leaseTime: {{.LeaseTime}}
+  EOT
+}
+`
@@ -0,0 +1,209 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseCciManifest(t *testing.T) {
+	yamlManifest := `
+apiVersion: vpc.nsx.vmware.com/v1alpha1
+kind: DHCPProfile
+metadata:
+  name: profile1
+spec:
+  mode: SERVER
+  leaseTime: 3600
+`
+	jsonManifest := `{"apiVersion":"vpc.nsx.vmware.com/v1alpha1","kind":"DHCPProfile","metadata":{"name":"profile1"},"spec":{"leaseTime":3600,"mode":"SERVER"}}`
+
+	object, err := parseCciManifest(yamlManifest)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	apiVersion, kind, name := getCciObjectIdentity(object)
+	if apiVersion != "vpc.nsx.vmware.com/v1alpha1" || kind != "DHCPProfile" || name != "profile1" {
+		t.Errorf("unexpected identity: %s, %s, %s", apiVersion, kind, name)
+	}
+	if !cciManifestsEqual(yamlManifest, jsonManifest) {
+		t.Errorf("expected the YAML and JSON manifests to be equal")
+	}
+	if cciManifestsEqual(yamlManifest, `{"apiVersion":"vpc.nsx.vmware.com/v1alpha1","kind":"DHCPProfile","metadata":{"name":"profile2"}}`) {
+		t.Errorf("expected manifests with different names to differ")
+	}
+
+	for _, invalid := range []string{"", "- a\n- b", "kind: DHCPProfile\nmetadata:\n  name: profile1", "apiVersion: v1\nkind: [", "apiVersion: v1\nkind: A\nmetadata: {}"} {
+		if _, err := parseCciManifest(invalid); err == nil {
+			t.Errorf("expected an error parsing '%s'", invalid)
+		}
+	}
+}
+
+func TestParseCciResourceApiPath(t *testing.T) {
+	ref, err := parseCciResourceApiPath("/apis/vpc.nsx.vmware.com/v1alpha1/namespaces/project1/dhcpprofiles/profile1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := cciResourceRef{group: "vpc.nsx.vmware.com", version: "v1alpha1", plural: "dhcpprofiles", projectName: "project1", name: "profile1"}
+	if ref != expected {
+		t.Errorf("unexpected reference %+v", ref)
+	}
+	if ref.apiPath() != "/apis/vpc.nsx.vmware.com/v1alpha1/namespaces/project1/dhcpprofiles/profile1" {
+		t.Errorf("unexpected path '%s'", ref.apiPath())
+	}
+	if ref.entity().urlTemplate != "/apis/vpc.nsx.vmware.com/v1alpha1/namespaces/%s/dhcpprofiles" {
+		t.Errorf("unexpected URL template '%s'", ref.entity().urlTemplate)
+	}
+
+	for _, invalid := range []string{
+		"/apis/vpc.nsx.vmware.com/v1alpha1/dhcpprofiles/profile1",
+		"/api/v1/namespaces/project1/configmaps/map1",
+		"/apis/vpc.nsx.vmware.com/v1alpha1/namespaces/project1/dhcpprofiles/",
+	} {
+		if _, err := parseCciResourceApiPath(invalid); err == nil {
+			t.Errorf("expected an error parsing '%s'", invalid)
+		}
+	}
+}
+
+func TestFindCciApiResource(t *testing.T) {
+	resources := []v1.APIResource{
+		{Name: "dhcpprofiles/status", Kind: "DHCPProfile", Namespaced: true},
+		{Name: "dhcpprofiles", Kind: "DHCPProfile", Namespaced: true},
+	}
+	resource, err := findCciApiResource(resources, "DHCPProfile")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if resource.Name != "dhcpprofiles" {
+		t.Errorf("expected the subresource to be skipped, got '%s'", resource.Name)
+	}
+	if _, err := findCciApiResource(resources, "VPC"); err == nil {
+		t.Errorf("expected an error finding an unknown kind")
+	}
+}
+
+func TestSetCciObjectNamespace(t *testing.T) {
+	object := map[string]interface{}{"metadata": map[string]interface{}{"name": "profile1"}}
+	if err := setCciObjectNamespace(object, "project1"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if object["metadata"].(map[string]interface{})["namespace"] != "project1" {
+		t.Errorf("expected the namespace to be set")
+	}
+	if err := setCciObjectNamespace(object, "project2"); err == nil {
+		t.Errorf("expected an error setting another namespace")
+	}
+}
+
+func TestMergeCciObjectMetadata(t *testing.T) {
+	object := map[string]interface{}{
+		"kind":     "DHCPProfile",
+		"metadata": map[string]interface{}{"name": "profile1", "labels": map[string]interface{}{"a": "b"}},
+		"spec":     map[string]interface{}{"mode": "RELAY"},
+	}
+	liveObject := map[string]interface{}{
+		"kind":     "DHCPProfile",
+		"metadata": map[string]interface{}{"name": "profile1", "resourceVersion": "42", "finalizers": []interface{}{"f"}},
+		"spec":     map[string]interface{}{"mode": "SERVER", "leaseTime": float64(3600)},
+		"status":   map[string]interface{}{"phase": "Ready"},
+	}
+	expected := map[string]interface{}{
+		"kind": "DHCPProfile",
+		"metadata": map[string]interface{}{
+			"name":            "profile1",
+			"resourceVersion": "42",
+			"finalizers":      []interface{}{"f"},
+			"labels":          map[string]interface{}{"a": "b"},
+		},
+		"spec": map[string]interface{}{"mode": "RELAY"},
+	}
+	if merged := mergeCciObjectMetadata(object, liveObject); !reflect.DeepEqual(merged, expected) {
+		t.Errorf("unexpected merged object %v", merged)
+	}
+}
+
+func TestProjectCciObject(t *testing.T) {
+	manifest := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "profile1"},
+		"spec": map[string]interface{}{
+			"mode":       "SERVER",
+			"dnsServers": []interface{}{"1.1.1.1"},
+			"missing":    "value",
+		},
+	}
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "profile1", "uid": "1234"},
+		"spec": map[string]interface{}{
+			"mode":       "RELAY",
+			"dnsServers": []interface{}{"1.1.1.1", "8.8.8.8"},
+			"leaseTime":  float64(3600),
+		},
+		"status": map[string]interface{}{"phase": "Ready"},
+	}
+	expected := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "profile1"},
+		"spec": map[string]interface{}{
+			"mode":       "RELAY",
+			"dnsServers": []interface{}{"1.1.1.1", "8.8.8.8"},
+		},
+	}
+	if projected := projectCciObject(manifest, live); !reflect.DeepEqual(projected, expected) {
+		t.Errorf("unexpected projected object %v", projected)
+	}
+}
+
+func TestGetCciObjectManifest(t *testing.T) {
+	liveObject := map[string]interface{}{
+		"kind": "DHCPProfile",
+		"metadata": map[string]interface{}{
+			"name":              "profile1",
+			"namespace":         "project1",
+			"uid":               "1234",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2025-01-01T00:00:00Z",
+		},
+		"status": map[string]interface{}{"phase": "Ready"},
+	}
+	expected := map[string]interface{}{
+		"kind":     "DHCPProfile",
+		"metadata": map[string]interface{}{"name": "profile1", "namespace": "project1"},
+	}
+	if manifest := getCciObjectManifest(liveObject); !reflect.DeepEqual(manifest, expected) {
+		t.Errorf("unexpected manifest %v", manifest)
+	}
+	if _, ok := liveObject["status"]; !ok {
+		t.Errorf("expected the live object not to be modified")
+	}
+}
+
+func TestGetCciObjectStatus(t *testing.T) {
+	object := map[string]interface{}{
+		"status": map[string]interface{}{
+			"phase": "Ready",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "reason": "Realized"},
+			},
+		},
+	}
+	phase, conditions, err := getCciObjectStatus(object)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if phase != "Ready" || !isCciConditionReady(conditions) || conditions[0].Reason != "Realized" {
+		t.Errorf("unexpected status: %s, %v", phase, conditions)
+	}
+
+	phase, conditions, err = getCciObjectStatus(map[string]interface{}{})
+	if err != nil || phase != "" || conditions != nil {
+		t.Errorf("expected an empty status, got %s, %v, %v", phase, conditions, err)
+	}
+}
@@ -7,7 +7,6 @@ package vcfa
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 
@@ -187,7 +186,7 @@ func resourceVcfaCertificateDelete(_ context.Context, d *schema.ResourceData, me
 }
 
 func resourceVcfaCertificateImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	resourceURI := SplitImportId(d.Id(), -1)
 	if len(resourceURI) != 2 {
 		return nil, fmt.Errorf("resource name must be specified as org-name%scertificate-name", ImportSeparator)
 	}
@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 	"github.com/vmware/go-vcloud-director/v3/types/v56"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
 )
 
 const labelVcfaContentLibrary = "Content Library"
@@ -51,6 +53,13 @@ func resourceVcfaContentLibrary() *schema.Resource {
 				Optional:    true,
 				Description: fmt.Sprintf("On deletion, deletes the %s, including its %ss, in a single operation", labelVcfaContentLibrary, labelVcfaContentLibraryItem),
 			},
+			"prevent_destroy_if_not_empty": preventDestroyIfNotEmptySchema(labelVcfaContentLibrary, labelVcfaContentLibraryItem, "delete_recursive"),
+			"sync_on_refresh": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: fmt.Sprintf("If true and the %s is subscribed, it is synchronized with its publisher every time it is refreshed. "+
+					"It is a client side setting", labelVcfaContentLibrary),
+			},
 			"storage_class_ids": {
 				Type:        schema.TypeSet,
 				Required:    true,
@@ -117,6 +126,11 @@ func resourceVcfaContentLibrary() *schema.Resource {
 							Sensitive:   true,
 							Description: "Password to use to authenticate with the publisher",
 						},
+						"need_local_copy": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether to eagerly download the content from the publisher and store it locally, instead of downloading it on demand",
+						},
 					},
 				},
 			},
@@ -168,6 +182,7 @@ func resourceVcfaContentLibrary() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Status of this %s. Can be 'READY', 'NOT_READY', 'FAILED' or 'PARTIALLY_READY'", labelVcfaContentLibrary),
 			},
+			"metadata_entry": getMetadataEntrySchema(labelVcfaContentLibrary, false),
 		},
 	}
 }
@@ -212,6 +227,9 @@ func resourceVcfaContentLibraryCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := updateMetadataEntries(tmClient, d, cl.ContentLibrary.ID); err != nil {
+		return diag.Errorf("error setting metadata of %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
 	err = setContentLibraryData(tmClient, d, cl, "resource")
 	if err != nil {
 		return diag.FromErr(err)
@@ -219,7 +237,7 @@ func resourceVcfaContentLibraryCreate(ctx context.Context, d *schema.ResourceDat
 	return resourceVcfaContentLibraryRead(ctx, d, meta)
 }
 
-func resourceVcfaContentLibraryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceVcfaContentLibraryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 	tenantContext, err := getTenantContextFromOrgId(tmClient, d.Get("org_id").(string))
 	if err != nil {
@@ -242,6 +260,12 @@ func resourceVcfaContentLibraryRead(_ context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	if d.Get("sync_on_refresh").(bool) && cl.ContentLibrary.IsSubscribed && !d.IsNewResource() && changesOnReadAllowed(meta) {
+		if err := syncContentLibraryEntity(ctx, tmClient, vcfatypes.ContentLibrarySyncEndpoint, cl.ContentLibrary.ID); err != nil {
+			return diag.Errorf("error synchronizing %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+		}
+	}
+
 	err = setContentLibraryData(tmClient, d, cl, "resource")
 	if err != nil {
 		return diag.FromErr(err)
@@ -250,6 +274,11 @@ func resourceVcfaContentLibraryRead(_ context.Context, d *schema.ResourceData, m
 }
 
 func resourceVcfaContentLibraryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// 'delete_force', 'delete_recursive' and 'prevent_destroy_if_not_empty' are client side settings only
+	if !d.HasChangesExcept("delete_force", "delete_recursive", "prevent_destroy_if_not_empty") {
+		return resourceVcfaContentLibraryRead(ctx, d, meta)
+	}
+
 	tmClient := meta.(ClientContainer).tmClient
 	tenantContext, err := getTenantContextFromOrgId(tmClient, d.Get("org_id").(string))
 	if err != nil {
@@ -263,6 +292,9 @@ func resourceVcfaContentLibraryUpdate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := updateMetadataEntries(tmClient, d, cl.ContentLibrary.ID); err != nil {
+		return diag.Errorf("error updating metadata of %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
 	return resourceVcfaContentLibraryRead(ctx, d, meta)
 }
 
@@ -278,10 +310,41 @@ func resourceVcfaContentLibraryDelete(ctx context.Context, d *schema.ResourceDat
 	}
 
 	deleteForce := d.Get("delete_force").(bool)
+	deleteRecursive := d.Get("delete_recursive").(bool)
 	if cl.ContentLibrary.LibraryType != "PROVIDER" {
 		deleteForce = false // Forcefully deletion is not available for non-PROVIDER Content Libraries
 	}
-	err = cl.Delete(deleteForce, d.Get("delete_recursive").(bool))
+
+	// Both flags delete the Content Library Items explicitly, so they skip the protection
+	err = checkDeleteProtection(d, deleteForce || deleteRecursive, labelVcfaContentLibrary, cl.ContentLibrary.Name,
+		labelVcfaContentLibraryItem, "delete_recursive", func() ([]string, error) {
+			items, err := cl.GetAllContentLibraryItems(nil)
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, len(items))
+			for i, item := range items {
+				names[i] = item.ContentLibraryItem.Name
+			}
+			return names, nil
+		})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Within the grace period, the Content Library is deleted regularly, and it is only forced if that keeps failing
+	if gracePeriod := deletionGracePeriod(meta); deleteForce && gracePeriod > 0 {
+		err = retryDuringGracePeriod(ctx, gracePeriod, 10*time.Second, func() error {
+			return cl.Delete(false, deleteRecursive)
+		})
+		if err == nil {
+			return nil
+		}
+		log.Printf("[DEBUG] %s '%s' could not be deleted within the deletion grace period of %s, forcing the deletion: %s",
+			labelVcfaContentLibrary, cl.ContentLibrary.Name, gracePeriod, err)
+	}
+
+	err = cl.Delete(deleteForce, deleteRecursive)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -291,14 +354,17 @@ func resourceVcfaContentLibraryDelete(ctx context.Context, d *schema.ResourceDat
 func resourceVcfaContentLibraryImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	idSplit := strings.Split(d.Id(), ImportSeparator)
-	if len(idSplit) != 2 {
-		return nil, fmt.Errorf("invalid import identifier '%s', should be <%s name>%s<%s name> for Tenant Content Libraries or System%s<%s name> for Provider Content Libraries", d.Id(), labelVcfaOrg, ImportSeparator, labelVcfaContentLibrary, ImportSeparator, labelVcfaContentLibrary)
+	idSplit := SplitImportId(d.Id(), -1)
+	urn, isUrn := getImportUrn(d.Id(), urnPrefixContentLibrary)
+	if len(idSplit) != 2 && !isUrn {
+		return nil, fmt.Errorf("invalid import identifier '%s', should be <%s name>%s<%s name> for Tenant Content Libraries, System%s<%s name> for Provider Content Libraries, or the %s ID", d.Id(), labelVcfaOrg, ImportSeparator, labelVcfaContentLibrary, ImportSeparator, labelVcfaContentLibrary, labelVcfaContentLibrary)
 	}
 	var cl *govcd.ContentLibrary
 	var org *govcd.TmOrg
 	var err error
-	if strings.EqualFold(idSplit[0], "system") {
+	if isUrn {
+		cl, err = tmClient.GetContentLibraryById(urn, nil)
+	} else if strings.EqualFold(idSplit[0], "system") {
 		// Provider Content Library
 		cl, err = tmClient.GetContentLibraryByName(idSplit[1], nil)
 	} else {
@@ -337,6 +403,7 @@ func getContentLibraryType(d *schema.ResourceData) *types.ContentLibrary {
 		t.SubscriptionConfig = &types.ContentLibrarySubscriptionConfig{
 			SubscriptionUrl: subsConfig["subscription_url"].(string),
 			Password:        subsConfig["password"].(string),
+			NeedLocalCopy:   subsConfig["need_local_copy"].(bool),
 		}
 	}
 	if v, ok := d.GetOk("project_permissions"); ok {
@@ -354,7 +421,7 @@ func getContentLibraryType(d *schema.ResourceData) *types.ContentLibrary {
 	return t
 }
 
-func setContentLibraryData(_ *VCDClient, d *schema.ResourceData, cl *govcd.ContentLibrary, origin string) error {
+func setContentLibraryData(tmClient *VCDClient, d *schema.ResourceData, cl *govcd.ContentLibrary, origin string) error {
 	if cl == nil || cl.ContentLibrary == nil {
 		return fmt.Errorf("provided %s is nil", labelVcfaContentLibrary)
 	}
@@ -400,6 +467,7 @@ func setContentLibraryData(_ *VCDClient, d *schema.ResourceData, cl *govcd.Conte
 		subscriptionConfig = []interface{}{
 			map[string]interface{}{
 				"subscription_url": cl.ContentLibrary.SubscriptionConfig.SubscriptionUrl,
+				"need_local_copy":  cl.ContentLibrary.SubscriptionConfig.NeedLocalCopy,
 			},
 		}
 		// Password is only available in resource
@@ -415,6 +483,9 @@ func setContentLibraryData(_ *VCDClient, d *schema.ResourceData, cl *govcd.Conte
 	if err != nil {
 		return err
 	}
+	if err := setMetadataEntries(tmClient, d, cl.ContentLibrary.ID); err != nil {
+		return err
+	}
 
 	d.SetId(cl.ContentLibrary.ID)
 	return nil
@@ -7,11 +7,15 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 	"github.com/vmware/go-vcloud-director/v3/types/v56"
 )
@@ -46,29 +50,91 @@ func resourceVcfaContentLibraryItem() *schema.Resource {
 				Description: fmt.Sprintf("ID of the %s that this %s belongs to", labelVcfaContentLibrary, labelVcfaContentLibraryItem),
 			},
 			"file_paths": {
-				Type:        schema.TypeSet,
-				Optional:    true, // Not needed when Importing
-				ForceNew:    true,
-				Description: fmt.Sprintf("A single path to an OVA/ISO, or multiple paths for an OVF and its referenced files, to create the %s", labelVcfaContentLibraryItem),
+				Type:          schema.TypeSet,
+				Optional:      true, // Not needed when Importing
+				ForceNew:      true,
+				ConflictsWith: []string{"source_url"},
+				Description:   fmt.Sprintf("A single path to an OVA/ISO, or multiple paths for an OVF and its referenced files, to create the %s", labelVcfaContentLibraryItem),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"file_paths"},
+				ValidateFunc:  validation.IsURLWithHTTPorHTTPS,
+				Description:   fmt.Sprintf("HTTP(S) URL of an OVA/ISO file to download and upload to create the %s, instead of using 'file_paths'", labelVcfaContentLibraryItem),
+			},
+			"source_url_headers": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"source_url"},
+				Description:  "HTTP headers to send when downloading the file from 'source_url', like 'Authorization'",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"source_url_checksum": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_url"},
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a SHA-256 checksum in hexadecimal format"),
+				Description:  "SHA-256 checksum, in hexadecimal format, that the file downloaded from 'source_url' must have",
+			},
+			"source_checksum": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9a-fA-F]{64}$`), "must be a SHA-256 checksum in hexadecimal format"),
+				Description: fmt.Sprintf("SHA-256 checksum, in hexadecimal format, of the uploaded OVA/ISO file or OVF descriptor, like the one "+
+					"returned by 'filesha256()'. Changing it re-creates the %s, so the new file is uploaded", labelVcfaContentLibraryItem),
+			},
 			"upload_piece_size": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Default:     1,
 				Description: fmt.Sprintf("When uploading the %s, this argument defines the size of the file chunks in which it is split on every upload request. It can possibly impact upload performance. Default 1 MB", labelVcfaContentLibraryItem),
 			},
+			"upload_parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 16),
+				Description: fmt.Sprintf("Amount of file chunks of the %s that are uploaded concurrently. Every chunk is retried if it fails. "+
+					"Higher values can reduce the upload time of big files. Default 1, which uploads the chunks one after the other", labelVcfaContentLibraryItem),
+			},
+			"remove_incomplete_upload": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: fmt.Sprintf("If true, an existing %s with the same name whose files were not fully uploaded, like the one left "+
+					"behind by an interrupted upload, is removed before creating this one", labelVcfaContentLibraryItem),
+			},
+			"ovf_properties": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Values of the OVF properties (ProductSection) to override in the OVF descriptor before uploading the %s, keyed by property key. Only for OVA/OVF files", labelVcfaContentLibraryItem),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"creation_date": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: fmt.Sprintf("The ISO-8601 timestamp representing when this %s was created", labelVcfaContentLibraryItem),
 			},
 			"item_type": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: fmt.Sprintf("The type of %s", labelVcfaContentLibraryItem),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TEMPLATE", "ISO"}, false),
+				Description:  fmt.Sprintf("The type of %s, either 'TEMPLATE' (OVA/OVF) or 'ISO'. If set, it must match the type of the uploaded files", labelVcfaContentLibraryItem),
 			},
 			"image_identifier": {
 				Type:        schema.TypeString,
@@ -105,6 +171,25 @@ func resourceVcfaContentLibraryItem() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("The version of this %s. For a subscribed library, this version is same as in publisher library", labelVcfaContentLibraryItem),
 			},
+			"delete_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: fmt.Sprintf("If true, the provider refuses to delete this %s, including replacements. It must be set to false before destroying it", labelVcfaContentLibraryItem),
+			},
+			"pinned_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  fmt.Sprintf("If set, a warning is reported every time the %s is refreshed and its version is a different one", labelVcfaContentLibraryItem),
+			},
+			"version_history": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("Versions of the %s observed by Terraform, from the oldest to the current one", labelVcfaContentLibraryItem),
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
 		},
 	}
 }
@@ -118,8 +203,10 @@ func resourceVcfaContentLibraryItemCreate(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibrary, clId, err)
 	}
 
-	if _, ok := d.GetOk("file_paths"); !ok {
-		return diag.Errorf("the argument 'file_paths' is required during creation")
+	_, hasFilePaths := d.GetOk("file_paths")
+	sourceUrl := d.Get("source_url").(string)
+	if !hasFilePaths && sourceUrl == "" {
+		return diag.Errorf("one of the arguments 'file_paths' or 'source_url' is required during creation")
 	}
 
 	uploadArgs := govcd.ContentLibraryItemUploadArguments{
@@ -127,7 +214,20 @@ func resourceVcfaContentLibraryItemCreate(ctx context.Context, d *schema.Resourc
 	}
 
 	filePaths := d.Get("file_paths").(*schema.Set).List()
-	if len(filePaths) == 1 {
+	if sourceUrl != "" {
+		// The download uses the base transport of the provider, so it honors the proxy, CA certificate and insecure
+		// settings, but the third-party host is not taken as VCFA for API warnings, metrics and clock skew. There
+		// is no API request timeout either, as files can be large
+		httpClient := &http.Client{Transport: tmClient.BaseTransport}
+		downloadedPath, cleanup, err := downloadContentLibraryItemSource(ctx, httpClient, sourceUrl,
+			convertToStringMap(d.Get("source_url_headers").(map[string]interface{})), d.Get("source_url_checksum").(string))
+		defer cleanup()
+		if err != nil {
+			return diag.Errorf("error retrieving the file for %s from 'source_url': %s", labelVcfaContentLibraryItem, err)
+		}
+		uploadArgs.FilePath = downloadedPath
+		filePaths = []interface{}{sourceUrl}
+	} else if len(filePaths) == 1 {
 		p := filepath.Clean(filePaths[0].(string))
 		if filepath.Ext(p) != ".iso" && filepath.Ext(p) != ".ova" {
 			return diag.Errorf("when uploading a single file, only ISO/OVA is supported. OVF requires multiple files")
@@ -151,21 +251,79 @@ func resourceVcfaContentLibraryItemCreate(ctx context.Context, d *schema.Resourc
 		}
 	}
 
+	isIso := filepath.Ext(uploadArgs.FilePath) == ".iso"
+	if itemType, ok := d.GetOk("item_type"); ok {
+		if itemType.(string) == "ISO" && !isIso {
+			return diag.Errorf("'item_type' is 'ISO' but 'file_paths' does not contain an ISO file: %v", filePaths)
+		}
+		if itemType.(string) == "TEMPLATE" && isIso {
+			return diag.Errorf("'item_type' is 'TEMPLATE' but 'file_paths' contains an ISO file: %v", filePaths)
+		}
+	}
+
+	// The uploaded file to validate is the original one, as the OVF descriptor may be replaced below
+	originalFilePath := uploadArgs.FilePath
+	if sourceChecksum := d.Get("source_checksum").(string); sourceChecksum != "" {
+		if err := verifyFileChecksum(originalFilePath, sourceChecksum); err != nil {
+			return diag.Errorf("error verifying 'source_checksum' of %s: %s", labelVcfaContentLibraryItem, err)
+		}
+	}
+	if ovfProperties := convertToStringMap(d.Get("ovf_properties").(map[string]interface{})); len(ovfProperties) > 0 {
+		if isIso {
+			return diag.Errorf("'ovf_properties' can only be set when uploading OVA/OVF files")
+		}
+		cleanup, err := applyOvfPropertyOverrides(&uploadArgs, ovfProperties)
+		defer cleanup()
+		if err != nil {
+			return diag.Errorf("error overriding OVF properties of %s: %s", labelVcfaContentLibraryItem, err)
+		}
+	}
+
+	// An item left behind by an interrupted upload would make the creation fail with a name conflict. The provider can't
+	// tell whether that item comes from a previous run of this resource or from another client, so it is only removed on demand
+	var diags diag.Diagnostics
+	if d.Get("remove_incomplete_upload").(bool) {
+		name := d.Get("name").(string)
+		cleanedUp, err := cleanupIncompleteContentLibraryItem(ctx, tmClient, cl, name, operationTimeout(d, meta, schema.TimeoutCreate))
+		if err != nil {
+			return diag.Errorf("error checking for an incomplete upload of %s '%s': %s", labelVcfaContentLibraryItem, name, err)
+		}
+		if cleanedUp {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Removed incomplete %s '%s'", labelVcfaContentLibraryItem, name),
+				Detail:   fmt.Sprintf("%s '%s' was not fully uploaded, so it was removed before uploading it again", labelVcfaContentLibraryItem, name),
+			})
+		}
+	}
+
 	c := crudConfig[*govcd.ContentLibraryItem, types.ContentLibraryItem]{
 		entityLabel:    labelVcfaContentLibraryItem,
 		getTypeFunc:    getContentLibraryItemType,
 		stateStoreFunc: setContentLibraryItemData,
 		createFunc: func(config *types.ContentLibraryItem) (*govcd.ContentLibraryItem, error) {
+			if parallelism := d.Get("upload_parallelism").(int); parallelism > 1 {
+				return createContentLibraryItemWithParallelUpload(ctx, tmClient, cl, config, uploadArgs, parallelism)
+			}
+			// The SDK creates the item and uploads its files in a single call, so the library is locked for the whole upload
+			unlock := lockContentLibrary(cl.ContentLibrary.ID, fmt.Sprintf("creation of %s '%s'", labelVcfaContentLibraryItem, config.Name))
+			defer unlock()
 			return cl.CreateContentLibraryItem(config, uploadArgs)
 		},
+		postCreateHooks:  []outerEntityHook[*govcd.ContentLibraryItem]{validateContentLibraryItemUploadHook(tmClient, d, originalFilePath)},
 		resourceReadFunc: resourceVcfaContentLibraryItemRead,
 	}
-	return createResource(ctx, d, meta, c)
+	return append(diags, createResource(ctx, d, meta, c)...)
 }
 
 func resourceVcfaContentLibraryItemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 
+	// 'delete_protection', 'pinned_version', 'remove_incomplete_upload' and the upload settings are client side settings only
+	if !d.HasChangesExcept("delete_protection", "pinned_version", "remove_incomplete_upload", "upload_piece_size", "upload_parallelism") {
+		return resourceVcfaContentLibraryItemRead(ctx, d, meta)
+	}
+
 	clId := d.Get("content_library_id").(string)
 	cl, err := tmClient.GetContentLibraryById(clId, nil)
 	if err != nil {
@@ -196,10 +354,42 @@ func resourceVcfaContentLibraryItemRead(ctx context.Context, d *schema.ResourceD
 		getEntityFunc:  cl.GetContentLibraryItemById,
 		stateStoreFunc: setContentLibraryItemData,
 	}
-	return readResource(ctx, d, meta, c)
+	diags := readResource(ctx, d, meta, c)
+	if diags.HasError() || d.Id() == "" {
+		return diags
+	}
+	return append(diags, setContentLibraryItemVersionHistory(d)...)
+}
+
+// setContentLibraryItemVersionHistory appends the current version of the Content Library Item to 'version_history' if it
+// changed since the last refresh, and warns if it does not match 'pinned_version'. The API does not expose the previous
+// versions of an item, so the history only contains the versions that Terraform has observed.
+func setContentLibraryItemVersionHistory(d *schema.ResourceData) diag.Diagnostics {
+	version := d.Get("version").(int)
+	history := d.Get("version_history").([]interface{})
+	if len(history) == 0 || history[len(history)-1].(int) != version {
+		history = append(history, version)
+	}
+	dSet(d, "version_history", history)
+
+	pinnedVersion := d.Get("pinned_version").(int)
+	if pinnedVersion == 0 || pinnedVersion == version {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("%s '%s' is not in the pinned version", labelVcfaContentLibraryItem, d.Get("name").(string)),
+		Detail: fmt.Sprintf("%s '%s' has version %d, but 'pinned_version' is %d. The previous versions can't be restored from "+
+			"Terraform, the item must be re-created with the expected files instead", labelVcfaContentLibraryItem, d.Get("name").(string), version, pinnedVersion),
+	}}
 }
 
 func resourceVcfaContentLibraryItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("delete_protection").(bool) {
+		return diag.Errorf("%s '%s' has 'delete_protection' enabled and can't be deleted or replaced. "+
+			"Set 'delete_protection = false' and apply it before deleting it", labelVcfaContentLibraryItem, d.Get("name").(string))
+	}
+
 	tmClient := meta.(ClientContainer).tmClient
 
 	clId := d.Get("content_library_id").(string)
@@ -213,16 +403,28 @@ func resourceVcfaContentLibraryItemDelete(ctx context.Context, d *schema.Resourc
 		getEntityFunc: cl.GetContentLibraryItemById,
 	}
 
+	unlock := lockContentLibrary(cl.ContentLibrary.ID, fmt.Sprintf("deletion of %s '%s'", labelVcfaContentLibraryItem, d.Get("name").(string)))
+	defer unlock()
 	return deleteResource(ctx, d, meta, c)
 }
 
 func resourceVcfaContentLibraryItemImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	idSplit := strings.Split(d.Id(), ImportSeparator)
+	if urn, ok := getImportUrn(d.Id(), urnPrefixContentLibraryItem); ok {
+		cli, err := tmClient.GetContentLibraryItemById(urn)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s with ID '%s': %s", labelVcfaContentLibraryItem, urn, err)
+		}
+		d.SetId(cli.ContentLibraryItem.ID)
+		dSet(d, "content_library_id", cli.ContentLibraryItem.ContentLibrary.ID)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	idSplit := SplitImportId(d.Id(), -1)
 	if len(idSplit) != 3 {
-		return nil, fmt.Errorf("ID syntax should be <%s name>%s<%s name>%s<%s name> for Tenant Content Library Items or System%s<%s name>%s<%s name> for "+
-			"Provider Content Library Items", labelVcfaOrg, ImportSeparator, labelVcfaContentLibrary, ImportSeparator, labelVcfaContentLibraryItem, ImportSeparator, labelVcfaContentLibrary, ImportSeparator, labelVcfaContentLibraryItem)
+		return nil, fmt.Errorf("ID syntax should be <%s name>%s<%s name>%s<%s name> for Tenant Content Library Items, System%s<%s name>%s<%s name> for "+
+			"Provider Content Library Items, or the %s ID", labelVcfaOrg, ImportSeparator, labelVcfaContentLibrary, ImportSeparator, labelVcfaContentLibraryItem, ImportSeparator, labelVcfaContentLibrary, ImportSeparator, labelVcfaContentLibraryItem, labelVcfaContentLibraryItem)
 	}
 
 	var tenantContext *govcd.TenantContext
@@ -297,3 +499,42 @@ func setContentLibraryItemData(_ *VCDClient, d *schema.ResourceData, cli *govcd.
 
 	return nil
 }
+
+// validateContentLibraryItemUploadHook returns a hook that checks that all the files of the freshly created
+// Content Library Item were fully transferred. For ISO items, it also checks that the size of the uploaded file
+// matches the size of the local file. If the validation fails, the ID is stored so the resource gets tainted
+// instead of leaving an untracked Content Library Item behind.
+func validateContentLibraryItemUploadHook(tmClient *VCDClient, d *schema.ResourceData, filePath string) outerEntityHook[*govcd.ContentLibraryItem] {
+	return func(cli *govcd.ContentLibraryItem) error {
+		if err := validateContentLibraryItemUpload(tmClient, cli, filePath); err != nil {
+			d.SetId(cli.ContentLibraryItem.ID)
+			return err
+		}
+		return nil
+	}
+}
+
+func validateContentLibraryItemUpload(tmClient *VCDClient, cli *govcd.ContentLibraryItem, filePath string) error {
+	files, err := getContentLibraryItemFiles(&tmClient.VCDClient.Client, cli.ContentLibraryItem)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.ExpectedSizeBytes > 0 && file.BytesTransferred != file.ExpectedSizeBytes {
+			return fmt.Errorf("file '%s' of %s '%s' is incomplete: %d of %d bytes were transferred",
+				file.Name, labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, file.BytesTransferred, file.ExpectedSizeBytes)
+		}
+		if filepath.Ext(filePath) == ".iso" && strings.HasSuffix(file.Name, ".iso") {
+			fileInfo, err := os.Stat(filePath)
+			if err != nil {
+				return err
+			}
+			if file.ExpectedSizeBytes > 0 && file.ExpectedSizeBytes != fileInfo.Size() {
+				return fmt.Errorf("uploaded ISO file '%s' of %s '%s' has %d bytes, but the local file '%s' has %d bytes",
+					file.Name, labelVcfaContentLibraryItem, cli.ContentLibraryItem.Name, file.ExpectedSizeBytes, filePath, fileInfo.Size())
+			}
+		}
+	}
+	return nil
+}
@@ -14,26 +14,23 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
 )
 
-var contentLibraryItemTestingResourcePaths = []string{
-	"../test-resources/test_vapp_template.ova",
-	"../test-resources/test.iso",
-	"../test-resources/test_vapp_template_ovf/descriptor.ovf",
-	"../test-resources/test_vapp_template_ovf/disk1.vmdk"}
-
-// getTestingResourcesAbsolutePaths returns the absolute paths to the testing resources
-// required to test. Absolute paths are required when running binary tests.
-func getTestingResourcesAbsolutePaths(t *testing.T, relativePaths []string) []string {
-	absPaths := make([]string, len(relativePaths))
-	for i, s := range relativePaths {
-		absPath, err := filepath.Abs(s)
-		if err != nil {
-			t.Fatal(err)
-		}
-		absPaths[i] = absPath
+// contentLibraryItemTestingIsoPath is the ISO used to test Content Library Items. The OVA and the OVF are not
+// checked in, but generated on the fly
+const contentLibraryItemTestingIsoPath = "../test-resources/test.iso"
+
+// getContentLibraryItemTestingPaths returns the absolute paths to the OVA, the ISO and the OVF files (descriptor
+// and disk) required to test. Absolute paths are required when running binary tests.
+func getContentLibraryItemTestingPaths(t *testing.T) []string {
+	isoPath, err := filepath.Abs(contentLibraryItemTestingIsoPath)
+	if err != nil {
+		t.Fatal(err)
 	}
-	return absPaths
+	ovaPath := testutils.CreateOvaFixture(t, "test_vapp_template", 40)
+	ovfPaths := testutils.CreateOvfFixture(t, "test_vapp_template_ovf", 40)
+	return append([]string{ovaPath, isoPath}, ovfPaths...)
 }
 
 // TestAccVcfaContentLibraryItemProvider tests Content Library Items in a "PROVIDER" type Content Library
@@ -47,7 +44,7 @@ func TestAccVcfaContentLibraryItemProvider(t *testing.T) {
 	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
 	contentLibraryHcl, contentLibraryHclRef := getContentLibraryHcl(t, regionHclRef, "")
 
-	itemPaths := getTestingResourcesAbsolutePaths(t, contentLibraryItemTestingResourcePaths)
+	itemPaths := getContentLibraryItemTestingPaths(t)
 	var params = StringMap{
 		"Name":              t.Name(),
 		"ContentLibraryRef": fmt.Sprintf("%s.id", contentLibraryHclRef),
@@ -98,6 +95,7 @@ func TestAccVcfaContentLibraryItemProvider(t *testing.T) {
 					resource.TestCheckResourceAttr(cli1, "status", "READY"),
 					resource.TestCheckResourceAttr(cli1, "last_successful_sync", ""),
 					resource.TestCheckResourceAttr(cli1, "version", "1"),
+					resource.TestCheckResourceAttrSet(cli1, "source_checksum"),
 
 					// CLI 2: ISO
 					resource.TestCheckResourceAttr(cli2, "name", t.Name()+"2"),
@@ -126,6 +124,8 @@ func TestAccVcfaContentLibraryItemProvider(t *testing.T) {
 					resource.TestCheckResourceAttr(cli3, "status", "READY"),
 					resource.TestCheckResourceAttr(cli3, "last_successful_sync", ""),
 					resource.TestCheckResourceAttr(cli3, "version", "1"),
+					resource.TestCheckResourceAttr(cli3, "version_history.#", "1"),
+					resource.TestCheckResourceAttr(cli3, "version_history.0", "1"),
 				),
 			},
 			{
@@ -148,9 +148,16 @@ func TestAccVcfaContentLibraryItemProvider(t *testing.T) {
 				Config: configText3,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// file_paths and upload_piece_size cannot be obtained during reads, that's why it does not appear in data source schema
-					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "%"}),
+					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "source_checksum", "version_history.#", "version_history.0", "%"}),
+					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "version_history.#", "version_history.0", "%"}),
+					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "upload_parallelism", "version_history.#", "version_history.0", "%"}),
+
+					// The ISO item is filtered out by type
+					resource.TestCheckResourceAttr("data.vcfa_content_library_items.templates_ds", "content_library_items.#", "2"),
+					resource.TestCheckResourceAttrPair("data.vcfa_content_library_items.templates_ds", "content_library_items.0.id", cli1, "id"),
+					resource.TestCheckResourceAttrPair("data.vcfa_content_library_items.templates_ds", "content_library_items.0.image_identifier", cli1, "image_identifier"),
+					resource.TestCheckResourceAttrPair("data.vcfa_content_library_items.templates_ds", "content_library_items.1.id", cli3, "id"),
+					resource.TestCheckResourceAttrPair("data.vcfa_content_library_items.templates_ds", "content_library_items.1.image_identifier", cli3, "image_identifier"),
 				),
 			},
 			{
@@ -158,7 +165,7 @@ func TestAccVcfaContentLibraryItemProvider(t *testing.T) {
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateId:           fmt.Sprintf("System%s%s%s%s", ImportSeparator, testConfig.Tm.ContentLibrary, ImportSeparator, params["Name"].(string)+"1"),
-				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
+				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "source_checksum", "version_history.#", "version_history.0", "%"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
 			},
 		},
 	})
@@ -170,6 +177,7 @@ resource "vcfa_content_library_item" "cli1" {
   description        = "{{.Name}}1"
   content_library_id = {{.ContentLibraryRef}}
   file_paths         = ["{{.OvaPath}}"]
+  source_checksum    = filesha256("{{.OvaPath}}")
 }
 
 resource "vcfa_content_library_item" "cli2" {
@@ -177,6 +185,8 @@ resource "vcfa_content_library_item" "cli2" {
   description        = "{{.Name}}2"
   content_library_id = {{.ContentLibraryRef}}
   file_paths         = ["{{.IsoPath}}"]
+  item_type          = "ISO"
+  upload_parallelism = 4
 }
 
 resource "vcfa_content_library_item" "cli3" {
@@ -200,6 +210,14 @@ data "vcfa_content_library_item" "cli3_ds" {
   name               = vcfa_content_library_item.cli3.name
   content_library_id = vcfa_content_library_item.cli3.content_library_id
 }
+data "vcfa_content_library_items" "templates_ds" {
+  content_library_id = vcfa_content_library_item.cli1.content_library_id
+  name_regex         = "^{{.Name}}[0-9]$"
+  item_type          = "TEMPLATE"
+  status             = "READY"
+
+  depends_on = [vcfa_content_library_item.cli1, vcfa_content_library_item.cli2, vcfa_content_library_item.cli3]
+}
 `
 
 // TestAccVcfaContentLibraryItemTenant tests Content Library Items in a "TENANT" type Content Library
@@ -216,7 +234,7 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 	// to create libraries in the Organization
 	contentLibraryHcl, contentLibraryHclRef := getContentLibraryHcl(t, regionHclRef, "vcfa_org_region_quota.test.org_id")
 
-	itemPaths := getTestingResourcesAbsolutePaths(t, contentLibraryItemTestingResourcePaths)
+	itemPaths := getContentLibraryItemTestingPaths(t)
 	var params = StringMap{
 		"Org":                 testConfig.Tm.Org,
 		"Username":            "test-user",
@@ -311,6 +329,7 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 					resource.TestCheckResourceAttr(cli1, "status", "READY"),
 					resource.TestCheckResourceAttr(cli1, "last_successful_sync", ""),
 					resource.TestCheckResourceAttr(cli1, "version", "1"),
+					resource.TestCheckResourceAttrSet(cli1, "source_checksum"),
 
 					// CLI 2: ISO
 					resource.TestCheckResourceAttr(cli2, "name", t.Name()+"2"),
@@ -358,9 +377,9 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 				Config:            configText3,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// file_paths and upload_piece_size cannot be obtained during reads, that's why it does not appear in data source schema
-					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "%"}),
+					resourceFieldsEqual(cli1, "data.vcfa_content_library_item.cli1_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "source_checksum", "version_history.#", "version_history.0", "%"}),
+					resourceFieldsEqual(cli2, "data.vcfa_content_library_item.cli2_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "version_history.#", "version_history.0", "%"}),
+					resourceFieldsEqual(cli3, "data.vcfa_content_library_item.cli3_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "upload_parallelism", "version_history.#", "version_history.0", "%"}),
 				),
 			},
 			{
@@ -369,7 +388,7 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateId:           fmt.Sprintf("%s%s%s%s%s", testConfig.Tm.Org, ImportSeparator, testConfig.Tm.ContentLibrary, ImportSeparator, t.Name()+"Updated1"),
-				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
+				ImportStateVerifyIgnore: []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "source_checksum", "version_history.#", "version_history.0", "%"}, // file_paths and upload_piece_size cannot be obtained during imports, that's why it's Optional
 			},
 			{
 				ProviderFactories: multipleFactories(),
@@ -435,9 +454,9 @@ func TestAccVcfaContentLibraryItemTenant(t *testing.T) {
 				Config:            configText6,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					// file_paths and upload_piece_size cannot be obtained during reads, that's why it does not appear in data source schema
-					resourceFieldsEqual(cli4, "data.vcfa_content_library_item.cli4_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli5, "data.vcfa_content_library_item.cli5_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "%"}),
-					resourceFieldsEqual(cli6, "data.vcfa_content_library_item.cli6_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "%"}),
+					resourceFieldsEqual(cli4, "data.vcfa_content_library_item.cli4_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "version_history.#", "version_history.0", "%"}),
+					resourceFieldsEqual(cli5, "data.vcfa_content_library_item.cli5_ds", []string{"file_paths.#", "file_paths.0", "upload_piece_size", "upload_parallelism", "version_history.#", "version_history.0", "%"}),
+					resourceFieldsEqual(cli6, "data.vcfa_content_library_item.cli6_ds", []string{"file_paths.#", "file_paths.0", "file_paths.1", "upload_piece_size", "upload_parallelism", "version_history.#", "version_history.0", "%"}),
 				),
 			},
 		},
@@ -469,6 +488,7 @@ resource "vcfa_content_library_item" "cli6" {
   description        = "{{.Name}}6"
   content_library_id = {{.ContentLibraryRef}}
   file_paths         = [{{.OvfPaths}}]
+  upload_parallelism = 4
 }
 `
 
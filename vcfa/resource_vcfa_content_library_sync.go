@@ -0,0 +1,269 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+const labelVcfaContentLibrarySync = "Content Library Synchronization"
+
+// Default time to wait for the Content Library or Content Library Item to be synchronized. It can be
+// overridden with the 'timeouts' block of the resource
+const defaultContentLibrarySyncTimeout = 30 * time.Minute
+
+func resourceVcfaContentLibrarySync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaContentLibrarySyncCreate,
+		ReadContext:   resourceVcfaContentLibrarySyncRead,
+		DeleteContext: resourceVcfaContentLibrarySyncDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultContentLibrarySyncTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"content_library_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the subscribed %s to synchronize", labelVcfaContentLibrary),
+			},
+			"content_library_item_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of a %s to synchronize. If not set, the whole %s is synchronized", labelVcfaContentLibraryItem, labelVcfaContentLibrary),
+			},
+			"force_sync": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+				Description: fmt.Sprintf("If true, a synchronization is triggered on every apply, as the %s is re-created "+
+					"every time it is refreshed", labelVcfaContentLibrarySync),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, trigger a new synchronization",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_successful_sync": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf("The ISO-8601 timestamp of the oldest successful synchronization among the synchronized %ss. "+
+					"The items that were never synchronized are ignored", labelVcfaContentLibraryItem),
+			},
+		},
+	}
+}
+
+func resourceVcfaContentLibrarySyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	clId := d.Get("content_library_id").(string)
+	cliId := d.Get("content_library_item_id").(string)
+
+	cl, err := tmClient.GetContentLibraryById(clId, nil)
+	if err != nil {
+		return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibrary, clId, err)
+	}
+	if !cl.ContentLibrary.IsSubscribed {
+		return diag.Errorf("%s '%s' is not subscribed, it can't be synchronized", labelVcfaContentLibrary, cl.ContentLibrary.Name)
+	}
+
+	if cliId != "" {
+		err = syncContentLibraryEntity(ctx, tmClient, vcfatypes.ContentLibraryItemSyncEndpoint, cliId)
+	} else {
+		err = syncContentLibraryEntity(ctx, tmClient, vcfatypes.ContentLibrarySyncEndpoint, clId)
+	}
+	if err != nil {
+		return diag.Errorf("error synchronizing %s '%s': %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+
+	// The items that are already up to date keep their last synchronization time, so the status of the synchronized
+	// library or item tells when the synchronization finished instead
+	var status string
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"SYNCING"},
+		Target:  []string{"SYNCED"},
+		Refresh: func() (any, string, error) {
+			currentStatus, err := getContentLibrarySyncStatus(tmClient, clId, cliId)
+			if err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] %s of %s '%s' has status '%s'", labelVcfaContentLibrarySync, labelVcfaContentLibrary, cl.ContentLibrary.Name, currentStatus)
+			status = currentStatus
+			state, err := contentLibrarySyncState(currentStatus)
+			return currentStatus, state, err
+		},
+		Timeout:    operationTimeout(d, meta, schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err = stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for %s '%s' to be synchronized: %s", labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+
+	var diags diag.Diagnostics
+	if strings.EqualFold(status, "PARTIALLY_READY") {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s '%s' was partially synchronized", labelVcfaContentLibrary, cl.ContentLibrary.Name),
+			Detail:   fmt.Sprintf("The synchronization finished, but some %ss are not ready. Check their 'status'", labelVcfaContentLibraryItem),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", clId, time.Now().UTC().Format(time.RFC3339)))
+	return append(diags, resourceVcfaContentLibrarySyncRead(ctx, d, meta)...)
+}
+
+func resourceVcfaContentLibrarySyncRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	clId := d.Get("content_library_id").(string)
+
+	// Removing the resource from state makes Terraform re-create it, hence synchronize again, on every apply
+	if d.Get("force_sync").(bool) && !d.IsNewResource() {
+		log.Printf("[DEBUG] %s of %s '%s' has 'force_sync' set. Removing from tfstate", labelVcfaContentLibrarySync, labelVcfaContentLibrary, clId)
+		d.SetId("")
+		return nil
+	}
+
+	cl, err := tmClient.GetContentLibraryById(clId, nil)
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] %s '%s' no longer exists. Removing %s from tfstate", labelVcfaContentLibrary, clId, labelVcfaContentLibrarySync)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibrary, clId, err)
+	}
+
+	items, err := getContentLibraryItemsToSync(cl, d.Get("content_library_item_id").(string))
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[DEBUG] %s no longer exists. Removing %s from tfstate", labelVcfaContentLibraryItem, labelVcfaContentLibrarySync)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dSet(d, "last_successful_sync", getOldestContentLibraryItemSync(items))
+	return nil
+}
+
+func resourceVcfaContentLibrarySyncDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Synchronizations can't be undone, so removing the resource from state is enough
+	d.SetId("")
+	return nil
+}
+
+// getContentLibraryItemsToSync returns the given Content Library Item, or all the items of the Content Library
+// if no Content Library Item ID is given
+func getContentLibraryItemsToSync(cl *govcd.ContentLibrary, cliId string) ([]*govcd.ContentLibraryItem, error) {
+	if cliId != "" {
+		cli, err := cl.GetContentLibraryItemById(cliId)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve %s with ID '%s': %w", labelVcfaContentLibraryItem, cliId, err)
+		}
+		return []*govcd.ContentLibraryItem{cli}, nil
+	}
+	items, err := cl.GetAllContentLibraryItems(nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve the %ss of %s '%s': %w", labelVcfaContentLibraryItem, labelVcfaContentLibrary, cl.ContentLibrary.Name, err)
+	}
+	return items, nil
+}
+
+// getContentLibrarySyncStatus returns the status of the given Content Library Item, or of the Content Library if no
+// Content Library Item ID is given
+func getContentLibrarySyncStatus(tmClient *VCDClient, clId, cliId string) (string, error) {
+	if cliId != "" {
+		cli, err := tmClient.GetContentLibraryItemById(cliId)
+		if err != nil {
+			return "", fmt.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibraryItem, cliId, err)
+		}
+		return cli.ContentLibraryItem.Status, nil
+	}
+	cl, err := tmClient.GetContentLibraryById(clId, nil)
+	if err != nil {
+		return "", fmt.Errorf("could not retrieve %s with ID '%s': %s", labelVcfaContentLibrary, clId, err)
+	}
+	return cl.ContentLibrary.Status, nil
+}
+
+// contentLibrarySyncState returns whether a synchronization finished, given the status of the synchronized Content Library
+// or Content Library Item. A 'PARTIALLY_READY' Content Library finished synchronizing, but some of its items are not ready
+func contentLibrarySyncState(status string) (string, error) {
+	switch strings.ToUpper(status) {
+	case "READY", "PARTIALLY_READY":
+		return "SYNCED", nil
+	case "FAILED":
+		return "", fmt.Errorf("the synchronization failed with status '%s'", status)
+	default:
+		return "SYNCING", nil
+	}
+}
+
+// getOldestContentLibraryItemSync returns the oldest last successful synchronization among the given Content Library
+// Items. The items that were never synchronized are ignored
+func getOldestContentLibraryItemSync(items []*govcd.ContentLibraryItem) string {
+	oldest := ""
+	for _, item := range items {
+		lastSuccessfulSync := item.ContentLibraryItem.LastSuccessfulSync
+		if lastSuccessfulSync == "" {
+			continue
+		}
+		if oldest == "" || contentLibraryItemSyncAdvanced(lastSuccessfulSync, oldest) {
+			oldest = lastSuccessfulSync
+		}
+	}
+	return oldest
+}
+
+// syncContentLibraryEntity triggers the synchronization of the Content Library or Content Library Item with the given ID,
+// using one of the synchronization endpoints, and waits for the resulting task
+func syncContentLibraryEntity(ctx context.Context, tmClient *VCDClient, endpoint, id string) error {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(endpoint, id))
+	if err != nil {
+		return err
+	}
+	task, err := client.OpenApiPostItemAsync(minVcfaApiVersion, urlRef, nil, nil)
+	if err != nil {
+		return err
+	}
+	return waitForTaskCompletion(ctx, &task)
+}
+
+// contentLibraryItemSyncAdvanced returns true if the 'current' ISO-8601 synchronization timestamp is later than 'previous'.
+// Timestamps that can't be parsed are compared as strings
+func contentLibraryItemSyncAdvanced(previous, current string) bool {
+	if current == "" {
+		return false
+	}
+	if previous == "" {
+		return true
+	}
+	previousTime, errPrevious := time.Parse(time.RFC3339, previous)
+	currentTime, errCurrent := time.Parse(time.RFC3339, current)
+	if errPrevious != nil || errCurrent != nil {
+		return current > previous
+	}
+	return currentTime.After(previousTime)
+}
@@ -0,0 +1,60 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// TestContentLibrarySyncState checks that a synchronization finishes with the status of the synchronized entity
+func TestContentLibrarySyncState(t *testing.T) {
+	tests := []struct {
+		status  string
+		want    string
+		wantErr bool
+	}{
+		{status: "READY", want: "SYNCED"},
+		{status: "PARTIALLY_READY", want: "SYNCED"},
+		{status: "NOT_READY", want: "SYNCING"},
+		{status: "", want: "SYNCING"},
+		{status: "FAILED", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			got, err := contentLibrarySyncState(tt.status)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("contentLibrarySyncState() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("contentLibrarySyncState() = '%s', want '%s'", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGetOldestContentLibraryItemSync checks that the oldest synchronization is reported, ignoring the items that were
+// never synchronized
+func TestGetOldestContentLibraryItemSync(t *testing.T) {
+	newItem := func(lastSuccessfulSync string) *govcd.ContentLibraryItem {
+		return &govcd.ContentLibraryItem{ContentLibraryItem: &types.ContentLibraryItem{LastSuccessfulSync: lastSuccessfulSync}}
+	}
+	items := []*govcd.ContentLibraryItem{
+		newItem("2026-01-02T10:00:00Z"),
+		newItem(""),
+		newItem("2026-01-01T10:00:00Z"),
+		newItem("2026-01-03T10:00:00Z"),
+	}
+	if got := getOldestContentLibraryItemSync(items); got != "2026-01-01T10:00:00Z" {
+		t.Errorf("getOldestContentLibraryItemSync() = '%s', want '2026-01-01T10:00:00Z'", got)
+	}
+	if got := getOldestContentLibraryItemSync([]*govcd.ContentLibraryItem{newItem("")}); got != "" {
+		t.Errorf("getOldestContentLibraryItemSync() = '%s', want an empty string", got)
+	}
+}
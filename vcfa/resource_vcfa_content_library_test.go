@@ -95,6 +95,8 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 					resource.TestCheckResourceAttr(dsRegionStoragePolicy, "status", "READY"),
 					resource.TestCheckResourceAttrSet(dsRegionStoragePolicy, "storage_capacity_mb"),
 					resource.TestCheckResourceAttrSet(dsRegionStoragePolicy, "storage_consumed_mb"),
+					resource.TestCheckResourceAttrSet(dsRegionStoragePolicy, "vcenter_storage_policy.0.vcenter_id"),
+					resource.TestCheckResourceAttrSet(dsRegionStoragePolicy, "vcenter_storage_policy.0.storage_policy_id"),
 
 					// Storage Class
 					resource.TestCheckResourceAttr(dsStorageClass, "name", testConfig.Tm.StorageClass),
@@ -134,6 +136,7 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceNameSubscribed, "subscription_config.0.subscription_url", "vsphere_content_library.publisher_content_library", "publication.0.publish_url"),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.password", "password"),
+					resource.TestCheckResourceAttr(resourceNameSubscribed, "subscription_config.0.need_local_copy", "false"),
 					resource.TestMatchResourceAttr(resourceNameSubscribed, "version_number", regexp.MustCompile("[0-9]")),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "is_project_scoped", "false"),
 					resource.TestCheckResourceAttr(resourceNameSubscribed, "all_projects_permission", ""),
@@ -167,6 +170,8 @@ func TestAccVcfaContentLibraryProvider(t *testing.T) {
 						"subscription_config.0.%", // Does not have password
 						"subscription_config.0.password",
 					}),
+					resource.TestCheckResourceAttrSet("vcfa_content_library_sync.sync", "id"),
+					resource.TestCheckResourceAttrPair("vcfa_content_library_sync.sync", "content_library_id", resourceNameSubscribed, "id"),
 				),
 			},
 			{
@@ -268,6 +273,10 @@ data "vcfa_content_library" "cl_subscribed_ds" {
   org_id = vcfa_content_library.cl_subscribed.org_id
   name   = vcfa_content_library.cl_subscribed.name
 }
+
+resource "vcfa_content_library_sync" "sync" {
+  content_library_id = vcfa_content_library.cl_subscribed.id
+}
 `
 
 // TestAccVcfaContentLibraryTenant tests CRUD of a Content Library of type TENANT.
@@ -428,6 +437,7 @@ func TestAccVcfaContentLibraryTenant(t *testing.T) {
 					resource.TestCheckResourceAttr(clSubscribed, "subscription_config.#", "1"),
 					resource.TestCheckResourceAttrPair(clSubscribed, "subscription_config.0.subscription_url", "vsphere_content_library.publisher_content_library", "publication.0.publish_url"),
 					resource.TestCheckResourceAttr(clSubscribed, "subscription_config.0.password", "password"),
+					resource.TestCheckResourceAttr(clSubscribed, "subscription_config.0.need_local_copy", "false"),
 					resource.TestMatchResourceAttr(clSubscribed, "version_number", regexp.MustCompile("[0-9]")),
 					resource.TestCheckResourceAttr(clSubscribed, "is_project_scoped", "false"),
 					resource.TestCheckResourceAttr(clSubscribed, "all_projects_permission", ""),
@@ -7,7 +7,6 @@ package vcfa
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -143,7 +142,7 @@ func resourceVcfaDistributedVlanConnectionDelete(ctx context.Context, d *schema.
 }
 
 func resourceVcfaDistributedVlanConnectionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	resourceURI := SplitImportId(d.Id(), -1)
 	if len(resourceURI) != 2 {
 		return nil, fmt.Errorf("resource name must be specified as region-name.distributed-vlan-connection-name")
 	}
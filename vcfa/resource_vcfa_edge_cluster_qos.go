@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -146,7 +145,7 @@ func resourceVcfaEdgeClusterQosDelete(ctx context.Context, d *schema.ResourceDat
 
 func resourceVcfaEdgeClusterQosImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
-	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	resourceURI := SplitImportId(d.Id(), -1)
 	if len(resourceURI) != 2 {
 		return nil, fmt.Errorf("resource name must be specified as region-name.edge-cluster-name")
 	}
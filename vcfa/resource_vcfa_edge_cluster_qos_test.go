@@ -88,6 +88,9 @@ func TestAccVcfaEdgeCluster(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.vcfa_edge_cluster.demo", "health_status"),
 					resource.TestCheckResourceAttrSet("data.vcfa_edge_cluster.demo", "status"),
 					resource.TestCheckResourceAttrSet("data.vcfa_edge_cluster.demo", "deployment_type"),
+					resource.TestCheckResourceAttrSet("data.vcfa_edge_cluster.demo", "backing_id"),
+					resource.TestCheckTypeSetElemAttrPair("data.vcfa_edge_clusters.demo", "edge_clusters.*.id", "data.vcfa_edge_cluster.demo", "id"),
+					resource.TestCheckTypeSetElemAttrPair("data.vcfa_edge_clusters.demo", "edge_clusters.*.backing_id", "data.vcfa_edge_cluster.demo", "backing_id"),
 
 					resource.TestCheckResourceAttrSet("data.vcfa_edge_cluster_qos.demo", "id"),
 					resource.TestCheckResourceAttrPair("data.vcfa_edge_cluster_qos.demo", "id", "data.vcfa_edge_cluster.demo", "id"),
@@ -180,6 +183,10 @@ data "vcfa_edge_cluster" "demo" {
 data "vcfa_edge_cluster_qos" "demo" {
   edge_cluster_id = data.vcfa_edge_cluster.demo.id
 }
+
+data "vcfa_edge_clusters" "demo" {
+  region_id = {{.RegionId}}
+}
 `
 
 const testAccVcfaEdgeClusterQosStep2 = testAccVcfaEdgeClusterQosStep1 + `
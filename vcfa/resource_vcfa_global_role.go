@@ -297,7 +297,13 @@ func resourceVcfaGlobalRoleDelete(_ context.Context, d *schema.ResourceData, met
 
 func resourceVcfaGlobalRoleImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
-	globalRole, err := tmClient.Client.GetGlobalRoleByName(d.Id())
+	var globalRole *govcd.GlobalRole
+	var err error
+	if urn, ok := getImportUrn(d.Id(), urnPrefixGlobalRole); ok {
+		globalRole, err = tmClient.Client.GetGlobalRoleById(urn)
+	} else {
+		globalRole, err = tmClient.Client.GetGlobalRoleByName(d.Id())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("[%s import] error retrieving %s '%s': %s", labelVcfaGlobalRole, labelVcfaGlobalRole, d.Id(), err)
 	}
@@ -0,0 +1,239 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaTenantPublication = "Tenant Publication"
+
+// tenantPublisher abstracts the publishing operations that Global Roles and Rights Bundles have in common
+type tenantPublisher interface {
+	GetTenants(queryParameters url.Values) ([]types.OpenApiReference, error)
+	ReplacePublishedTenants(tenants []types.OpenApiReference) error
+	UnpublishTenants(tenants []types.OpenApiReference) error
+	PublishAllTenants() error
+	UnpublishAllTenants() error
+}
+
+func resourceVcfaGlobalRoleTenantPublication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaGlobalRoleTenantPublicationCreateOrUpdate,
+		ReadContext:   resourceVcfaGlobalRoleTenantPublicationRead,
+		UpdateContext: resourceVcfaGlobalRoleTenantPublicationCreateOrUpdate,
+		DeleteContext: resourceVcfaGlobalRoleTenantPublicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaGlobalRoleTenantPublicationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"global_role_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"global_role_id", "rights_bundle_id"},
+				Description:  fmt.Sprintf("ID of the %s to publish", labelVcfaGlobalRole),
+			},
+			"rights_bundle_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"global_role_id", "rights_bundle_id"},
+				Description:  fmt.Sprintf("ID of the %s to publish", labelVcfaRightsBundle),
+			},
+			"publish_to_all_orgs": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"org_ids"},
+				Description:   fmt.Sprintf("When true, publishes the %s or %s to all %ss", labelVcfaGlobalRole, labelVcfaRightsBundle, labelVcfaOrg),
+			},
+			"org_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"publish_to_all_orgs"},
+				Description:   fmt.Sprintf("Set of IDs of the %ss to which the %s or %s is published", labelVcfaOrg, labelVcfaGlobalRole, labelVcfaRightsBundle),
+				Elem:          &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the published %s or %s", labelVcfaGlobalRole, labelVcfaRightsBundle),
+			},
+		},
+	}
+}
+
+// getTenantPublisher retrieves the Global Role or Rights Bundle referenced by the resource, returning its name
+// and whether it is published to all the Organizations
+func getTenantPublisher(tmClient *VCDClient, d *schema.ResourceData) (tenantPublisher, string, bool, error) {
+	if globalRoleId := d.Get("global_role_id").(string); globalRoleId != "" {
+		globalRole, err := tmClient.Client.GetGlobalRoleById(globalRoleId)
+		if err != nil {
+			return nil, "", false, err
+		}
+		return globalRole, globalRole.GlobalRole.Name, globalRole.GlobalRole.PublishAll != nil && *globalRole.GlobalRole.PublishAll, nil
+	}
+	rightsBundle, err := tmClient.Client.GetRightsBundleById(d.Get("rights_bundle_id").(string))
+	if err != nil {
+		return nil, "", false, err
+	}
+	return rightsBundle, rightsBundle.RightsBundle.Name, rightsBundle.RightsBundle.PublishAll != nil && *rightsBundle.RightsBundle.PublishAll, nil
+}
+
+func resourceVcfaGlobalRoleTenantPublicationCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	publisher, name, publishedToAll, err := getTenantPublisher(tmClient, d)
+	if err != nil {
+		return diag.Errorf("[%s] error retrieving the %s or %s to publish: %s", labelVcfaTenantPublication, labelVcfaGlobalRole, labelVcfaRightsBundle, err)
+	}
+
+	if d.Get("publish_to_all_orgs").(bool) {
+		err = publisher.PublishAllTenants()
+		if err != nil {
+			return diag.Errorf("[%s] error publishing '%s' to all %ss: %s", labelVcfaTenantPublication, name, labelVcfaOrg, err)
+		}
+	} else {
+		inputTenants, err := getOrganizations(tmClient, labelVcfaTenantPublication, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// Publishing to all Organizations is a flag of its own, that must be removed before narrowing the publication
+		if publishedToAll {
+			err = publisher.UnpublishAllTenants()
+			if err != nil {
+				return diag.Errorf("[%s] error unpublishing '%s' from all %ss: %s", labelVcfaTenantPublication, name, labelVcfaOrg, err)
+			}
+		}
+		if len(inputTenants) > 0 {
+			err = publisher.ReplacePublishedTenants(inputTenants)
+		} else if !publishedToAll {
+			err = publisher.UnpublishAllTenants()
+		}
+		if err != nil {
+			return diag.Errorf("[%s] error publishing '%s' to %ss: %s", labelVcfaTenantPublication, name, labelVcfaOrg, err)
+		}
+	}
+
+	if id := d.Get("global_role_id").(string); id != "" {
+		d.SetId(id)
+	} else {
+		d.SetId(d.Get("rights_bundle_id").(string))
+	}
+	return resourceVcfaGlobalRoleTenantPublicationRead(ctx, d, meta)
+}
+
+func resourceVcfaGlobalRoleTenantPublicationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	publisher, name, publishedToAll, err := getTenantPublisher(tmClient, d)
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[INFO] unable to find the published %s or %s '%s': %s. Removing from state", labelVcfaGlobalRole, labelVcfaRightsBundle, d.Id(), err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("[%s read] error retrieving the published %s or %s: %s", labelVcfaTenantPublication, labelVcfaGlobalRole, labelVcfaRightsBundle, err)
+	}
+	dSet(d, "name", name)
+	dSet(d, "publish_to_all_orgs", publishedToAll)
+
+	// When published to all the Organizations, the individual tenants are not relevant
+	var orgIds []string
+	if !publishedToAll {
+		tenants, err := publisher.GetTenants(nil)
+		if err != nil {
+			return diag.Errorf("[%s read] error retrieving the %ss of '%s': %s", labelVcfaTenantPublication, labelVcfaOrg, name, err)
+		}
+		for _, tenant := range tenants {
+			orgIds = append(orgIds, tenant.ID)
+		}
+	}
+	if err := d.Set("org_ids", orgIds); err != nil {
+		return diag.Errorf("[%s read] error setting org_ids: %s", labelVcfaTenantPublication, err)
+	}
+	return nil
+}
+
+func resourceVcfaGlobalRoleTenantPublicationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	publisher, name, publishedToAll, err := getTenantPublisher(tmClient, d)
+	if govcd.ContainsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("[%s delete] error retrieving the published %s or %s: %s", labelVcfaTenantPublication, labelVcfaGlobalRole, labelVcfaRightsBundle, err)
+	}
+
+	if publishedToAll {
+		err = publisher.UnpublishAllTenants()
+		if err != nil {
+			return diag.Errorf("[%s delete] error unpublishing '%s' from all %ss: %s", labelVcfaTenantPublication, name, labelVcfaOrg, err)
+		}
+		return nil
+	}
+
+	// Only the Organizations managed by this resource are unpublished. The ones that no longer exist are skipped
+	var tenants []types.OpenApiReference
+	for _, orgId := range d.Get("org_ids").(*schema.Set).List() {
+		org, err := tmClient.GetTmOrgById(orgId.(string))
+		if govcd.ContainsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return diag.Errorf("[%s delete] error retrieving %s '%s': %s", labelVcfaTenantPublication, labelVcfaOrg, orgId, err)
+		}
+		tenants = append(tenants, types.OpenApiReference{Name: org.TmOrg.Name, ID: org.TmOrg.ID})
+	}
+	if len(tenants) > 0 {
+		err = publisher.UnpublishTenants(tenants)
+		if err != nil {
+			return diag.Errorf("[%s delete] error unpublishing '%s' from %ss: %s", labelVcfaTenantPublication, name, labelVcfaOrg, err)
+		}
+	}
+	return nil
+}
+
+// resourceVcfaGlobalRoleTenantPublicationImport imports the publication of a Global Role or Rights Bundle, identified
+// by its type and name: 'global_role.<name>' or 'rights_bundle.<name>'
+func resourceVcfaGlobalRoleTenantPublicationImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	idSlice := SplitImportId(d.Id(), 2)
+	if len(idSlice) != 2 || idSlice[1] == "" {
+		return nil, fmt.Errorf("expected import ID to be global_role%s<name> or rights_bundle%s<name>", ImportSeparator, ImportSeparator)
+	}
+	kind, name := idSlice[0], idSlice[1]
+
+	switch kind {
+	case "global_role":
+		globalRole, err := tmClient.Client.GetGlobalRoleByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("[%s import] error retrieving %s '%s': %s", labelVcfaTenantPublication, labelVcfaGlobalRole, name, err)
+		}
+		dSet(d, "global_role_id", globalRole.GlobalRole.Id)
+		d.SetId(globalRole.GlobalRole.Id)
+	case "rights_bundle":
+		rightsBundle, err := tmClient.Client.GetRightsBundleByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("[%s import] error retrieving %s '%s': %s", labelVcfaTenantPublication, labelVcfaRightsBundle, name, err)
+		}
+		dSet(d, "rights_bundle_id", rightsBundle.RightsBundle.Id)
+		d.SetId(rightsBundle.RightsBundle.Id)
+	default:
+		return nil, fmt.Errorf("[%s import] unknown type '%s', expected 'global_role' or 'rights_bundle'", labelVcfaTenantPublication, kind)
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,96 @@
+//go:build role || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaGlobalRoleTenantPublication tests the publication of a Global Role, first to a given Organization
+// and then to all of them
+func TestAccVcfaGlobalRoleTenantPublication(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	var params = StringMap{
+		"Org":            t.Name(),
+		"GlobalRoleName": t.Name(),
+		"PublishSetting": "org_ids = [vcfa_org.org1.id]",
+		"Tags":           "tm role",
+	}
+	testParamsNotEmpty(t, params)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaGlobalRoleTenantPublication, params)
+	params["FuncName"] = t.Name() + "-step2"
+	params["PublishSetting"] = "publish_to_all_orgs = true"
+	configText2 := templateFill(testAccVcfaGlobalRoleTenantPublication, params)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	publicationDef := "vcfa_global_role_tenant_publication.publication"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(publicationDef, "id", "vcfa_global_role.role", "id"),
+					resource.TestCheckResourceAttr(publicationDef, "name", params["GlobalRoleName"].(string)),
+					resource.TestCheckResourceAttr(publicationDef, "publish_to_all_orgs", "false"),
+					resource.TestCheckResourceAttr(publicationDef, "org_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(publicationDef, "org_ids.*", "vcfa_org.org1", "id"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(publicationDef, "publish_to_all_orgs", "true"),
+					resource.TestCheckResourceAttr(publicationDef, "org_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      publicationDef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "global_role" + ImportSeparator + params["GlobalRoleName"].(string),
+			},
+		},
+	})
+}
+
+const testAccVcfaGlobalRoleTenantPublication = `
+resource "vcfa_org" "org1" {
+  name         = "{{.Org}}"
+  display_name = "{{.Org}}"
+  description  = "{{.Org}}"
+}
+
+# The publication is managed by the dedicated resource
+resource "vcfa_global_role" "role" {
+  name                = "{{.GlobalRoleName}}"
+  description         = "{{.GlobalRoleName}}"
+  rights              = ["Content Library: View"]
+  publish_to_all_orgs = false
+
+  lifecycle {
+    ignore_changes = [publish_to_all_orgs, org_ids]
+  }
+}
+
+resource "vcfa_global_role_tenant_publication" "publication" {
+  global_role_id = vcfa_global_role.role.id
+  {{.PublishSetting}}
+}
+`
@@ -8,7 +8,6 @@ import (
 	"context"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -231,7 +230,17 @@ func resourceVcfaIpSpaceDelete(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceVcfaIpSpaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if urn, ok := getImportUrn(d.Id(), urnPrefixIpSpace); ok {
+		ipSpace, err := meta.(ClientContainer).tmClient.GetTmIpSpaceById(urn)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving %s by ID '%s': %s", labelVcfaIpSpace, urn, err)
+		}
+		dSet(d, "region_id", ipSpace.TmIpSpace.RegionRef.ID)
+		d.SetId(ipSpace.TmIpSpace.ID)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	resourceURI := SplitImportId(d.Id(), -1)
 	if len(resourceURI) != 2 {
 		return nil, fmt.Errorf("resource name must be specified as region-name.ip-space-name")
 	}
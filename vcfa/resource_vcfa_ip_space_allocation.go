@@ -0,0 +1,322 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+const labelVcfaIpSpaceAllocation = "IP Space Allocation"
+
+var ipSpaceAllocationCustomQuotaFields = []string{"custom_quota_max_subnet_size", "custom_quota_max_cidr_count", "custom_quota_max_ip_count"}
+
+func resourceVcfaIpSpaceAllocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaIpSpaceAllocationCreate,
+		ReadContext:   resourceVcfaIpSpaceAllocationRead,
+		UpdateContext: resourceVcfaIpSpaceAllocationUpdate,
+		DeleteContext: resourceVcfaIpSpaceAllocationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaIpSpaceAllocationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip_space_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the %s to allocate", labelVcfaIpSpace),
+			},
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("ID of the %s that receives the %s", labelVcfaOrg, labelVcfaIpSpaceAllocation),
+			},
+			"custom_quota_max_subnet_size": {
+				Type:             schema.TypeString, // Values are 'ints', TypeString + validation is used to handle 0
+				Optional:         true,
+				RequiredWith:     ipSpaceAllocationCustomQuotaFields,
+				Description:      fmt.Sprintf("Maximum subnet size represented as a prefix length (e.g. 24, 28) that the %s can allocate. Overrides the default quota of the %s", labelVcfaOrg, labelVcfaIpSpace),
+				ValidateDiagFunc: IsIntAndAtLeast(-1),
+			},
+			"custom_quota_max_cidr_count": {
+				Type:             schema.TypeString, // Values are 'ints', TypeString + validation is used to handle 0
+				Optional:         true,
+				RequiredWith:     ipSpaceAllocationCustomQuotaFields,
+				Description:      fmt.Sprintf("Maximum number of subnets that the %s can allocate ('-1' for unlimited). Overrides the default quota of the %s", labelVcfaOrg, labelVcfaIpSpace),
+				ValidateDiagFunc: IsIntAndAtLeast(-1),
+			},
+			"custom_quota_max_ip_count": {
+				Type:             schema.TypeString, // Values are 'ints', TypeString + validation is used to handle 0
+				Optional:         true,
+				RequiredWith:     ipSpaceAllocationCustomQuotaFields,
+				Description:      fmt.Sprintf("Maximum number of single floating IP addresses that the %s can allocate ('-1' for unlimited). Overrides the default quota of the %s", labelVcfaOrg, labelVcfaIpSpace),
+				ValidateDiagFunc: IsIntAndAtLeast(-1),
+			},
+			"default_quota_max_subnet_size": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum subnet size of the default quota of the %s", labelVcfaIpSpace),
+			},
+			"default_quota_max_cidr_count": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum number of subnets of the default quota of the %s", labelVcfaIpSpace),
+			},
+			"default_quota_max_ip_count": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Maximum number of single floating IP addresses of the default quota of the %s", labelVcfaIpSpace),
+			},
+		},
+	}
+}
+
+func resourceVcfaIpSpaceAllocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	ipSpaceId := d.Get("ip_space_id").(string)
+	orgId := d.Get("org_id").(string)
+	unlock := tmClient.lockById(ipSpaceId)
+	defer unlock()
+
+	// The allocation may already exist, for instance when the IP Space is reachable by the Organization
+	// through its regional networking settings. In that case, it is adopted and its quota is updated
+	assignment, err := getIpSpaceOrgAssignmentByIds(tmClient, ipSpaceId, orgId)
+	if err != nil && !govcd.ContainsNotFound(err) {
+		return diag.Errorf("error retrieving %s for %s %s and %s %s: %s", labelVcfaIpSpaceAllocation, labelVcfaIpSpace, ipSpaceId, labelVcfaOrg, orgId, err)
+	}
+
+	if assignment == nil {
+		assignment, err = createIpSpaceOrgAssignment(tmClient, &vcfatypes.IpSpaceOrgAssignment{
+			IpSpaceRef:  types.OpenApiReference{ID: ipSpaceId},
+			OrgRef:      types.OpenApiReference{ID: orgId},
+			CustomQuota: getIpSpaceAllocationCustomQuota(d),
+		})
+		if err != nil {
+			return diag.Errorf("error creating %s: %s", labelVcfaIpSpaceAllocation, err)
+		}
+	} else {
+		assignment.CustomQuota = getIpSpaceAllocationCustomQuota(d)
+		updatedAssignment, err := updateIpSpaceOrgAssignment(tmClient, assignment)
+		if err != nil {
+			return diag.Errorf("error updating existing %s %s: %s", labelVcfaIpSpaceAllocation, assignment.ID, err)
+		}
+		assignment = updatedAssignment
+	}
+
+	d.SetId(assignment.ID)
+	return resourceVcfaIpSpaceAllocationRead(ctx, d, meta)
+}
+
+func resourceVcfaIpSpaceAllocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("ip_space_id").(string))
+	defer unlock()
+
+	assignment, err := getIpSpaceOrgAssignmentById(tmClient, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving %s %s: %s", labelVcfaIpSpaceAllocation, d.Id(), err)
+	}
+
+	assignment.CustomQuota = getIpSpaceAllocationCustomQuota(d)
+	if _, err := updateIpSpaceOrgAssignment(tmClient, assignment); err != nil {
+		return diag.Errorf("error updating %s %s: %s", labelVcfaIpSpaceAllocation, d.Id(), err)
+	}
+
+	return resourceVcfaIpSpaceAllocationRead(ctx, d, meta)
+}
+
+func resourceVcfaIpSpaceAllocationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	assignment, err := getIpSpaceOrgAssignmentById(tmClient, d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			log.Printf("[DEBUG] %s %s not found. Removing from state", labelVcfaIpSpaceAllocation, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving %s %s: %s", labelVcfaIpSpaceAllocation, d.Id(), err)
+	}
+
+	setIpSpaceAllocationData(d, assignment)
+	return nil
+}
+
+// resourceVcfaIpSpaceAllocationDelete removes the custom quota of the allocation, so the Organization
+// falls back to the default quota of the IP Space. The allocation itself is not removed, as it is
+// bound to the regional networking settings of the Organization.
+func resourceVcfaIpSpaceAllocationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	unlock := tmClient.lockById(d.Get("ip_space_id").(string))
+	defer unlock()
+
+	assignment, err := getIpSpaceOrgAssignmentById(tmClient, d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("error retrieving %s %s: %s", labelVcfaIpSpaceAllocation, d.Id(), err)
+	}
+
+	assignment.CustomQuota = nil
+	if _, err := updateIpSpaceOrgAssignment(tmClient, assignment); err != nil {
+		return diag.Errorf("error removing custom quota from %s %s: %s", labelVcfaIpSpaceAllocation, d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVcfaIpSpaceAllocationImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := SplitImportId(d.Id(), -1)
+	if len(resourceURI) != 3 {
+		return nil, fmt.Errorf("resource name must be specified as region-name%sip-space-name%sorg-name", ImportSeparator, ImportSeparator)
+	}
+	regionName, ipSpaceName, orgName := resourceURI[0], resourceURI[1], resourceURI[2]
+
+	tmClient := meta.(ClientContainer).tmClient
+	region, err := tmClient.GetRegionByName(regionName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s by name '%s': %s", labelVcfaRegion, regionName, err)
+	}
+
+	ipSpace, err := tmClient.GetTmIpSpaceByNameAndRegionId(ipSpaceName, region.Region.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s by name '%s': %s", labelVcfaIpSpace, ipSpaceName, err)
+	}
+
+	org, err := tmClient.GetTmOrgByName(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s by name '%s': %s", labelVcfaOrg, orgName, err)
+	}
+
+	assignment, err := getIpSpaceOrgAssignmentByIds(tmClient, ipSpace.TmIpSpace.ID, org.TmOrg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s for %s '%s' and %s '%s': %s", labelVcfaIpSpaceAllocation, labelVcfaIpSpace, ipSpaceName, labelVcfaOrg, orgName, err)
+	}
+
+	d.SetId(assignment.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getIpSpaceAllocationCustomQuota returns the custom quota set in the resource, or nil if it is not set
+func getIpSpaceAllocationCustomQuota(d *schema.ResourceData) *types.TmIpSpaceQuota {
+	if _, ok := d.GetOk("custom_quota_max_subnet_size"); !ok {
+		return nil
+	}
+
+	// error is ignored because validation is enforced in schema fields
+	maxCidrCountInt, _ := strconv.Atoi(d.Get("custom_quota_max_cidr_count").(string))
+	maxIPCountInt, _ := strconv.Atoi(d.Get("custom_quota_max_ip_count").(string))
+	maxSubnetSizeInt, _ := strconv.Atoi(d.Get("custom_quota_max_subnet_size").(string))
+	return &types.TmIpSpaceQuota{
+		MaxCidrCount:  maxCidrCountInt,
+		MaxIPCount:    maxIPCountInt,
+		MaxSubnetSize: maxSubnetSizeInt,
+	}
+}
+
+func setIpSpaceAllocationData(d *schema.ResourceData, assignment *vcfatypes.IpSpaceOrgAssignment) {
+	d.SetId(assignment.ID)
+	dSet(d, "ip_space_id", assignment.IpSpaceRef.ID)
+	dSet(d, "org_id", assignment.OrgRef.ID)
+
+	customSubnetSize, customCidrCount, customIpCount := "", "", ""
+	if assignment.CustomQuota != nil {
+		customSubnetSize = strconv.Itoa(assignment.CustomQuota.MaxSubnetSize)
+		customCidrCount = strconv.Itoa(assignment.CustomQuota.MaxCidrCount)
+		customIpCount = strconv.Itoa(assignment.CustomQuota.MaxIPCount)
+	}
+	dSet(d, "custom_quota_max_subnet_size", customSubnetSize)
+	dSet(d, "custom_quota_max_cidr_count", customCidrCount)
+	dSet(d, "custom_quota_max_ip_count", customIpCount)
+
+	defaultSubnetSize, defaultCidrCount, defaultIpCount := "", "", ""
+	if assignment.DefaultQuota != nil {
+		defaultSubnetSize = strconv.Itoa(assignment.DefaultQuota.MaxSubnetSize)
+		defaultCidrCount = strconv.Itoa(assignment.DefaultQuota.MaxCidrCount)
+		defaultIpCount = strconv.Itoa(assignment.DefaultQuota.MaxIPCount)
+	}
+	dSet(d, "default_quota_max_subnet_size", defaultSubnetSize)
+	dSet(d, "default_quota_max_cidr_count", defaultCidrCount)
+	dSet(d, "default_quota_max_ip_count", defaultIpCount)
+}
+
+// getIpSpaceOrgAssignmentByIds retrieves the allocation of the given IP Space to the given Organization
+func getIpSpaceOrgAssignmentByIds(tmClient *VCDClient, ipSpaceId, orgId string) (*vcfatypes.IpSpaceOrgAssignment, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.IpSpaceOrgAssignmentsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := url.Values{}
+	queryParams.Add("filter", fmt.Sprintf("ipSpaceRef.id==%s;orgRef.id==%s", ipSpaceId, orgId))
+
+	var assignments []*vcfatypes.IpSpaceOrgAssignment
+	if err := client.OpenApiGetAllItems(minVcfaApiVersion, urlRef, queryParams, &assignments, nil); err != nil {
+		return nil, err
+	}
+	if len(assignments) == 0 {
+		return nil, fmt.Errorf("%s: no %s found", govcd.ErrorEntityNotFound, labelVcfaIpSpaceAllocation)
+	}
+	if len(assignments) > 1 {
+		return nil, fmt.Errorf("found %d %s entries, expected one", len(assignments), labelVcfaIpSpaceAllocation)
+	}
+	return assignments[0], nil
+}
+
+// getIpSpaceOrgAssignmentById retrieves an IP Space allocation by its ID
+func getIpSpaceOrgAssignmentById(tmClient *VCDClient, id string) (*vcfatypes.IpSpaceOrgAssignment, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.IpSpaceOrgAssignmentsEndpoint, id)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := &vcfatypes.IpSpaceOrgAssignment{}
+	if err := client.OpenApiGetItem(minVcfaApiVersion, urlRef, nil, assignment, nil); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+func createIpSpaceOrgAssignment(tmClient *VCDClient, assignment *vcfatypes.IpSpaceOrgAssignment) (*vcfatypes.IpSpaceOrgAssignment, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.IpSpaceOrgAssignmentsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentOut := &vcfatypes.IpSpaceOrgAssignment{}
+	if err := client.OpenApiPostItem(minVcfaApiVersion, urlRef, nil, assignment, assignmentOut, nil); err != nil {
+		return nil, err
+	}
+	return assignmentOut, nil
+}
+
+func updateIpSpaceOrgAssignment(tmClient *VCDClient, assignment *vcfatypes.IpSpaceOrgAssignment) (*vcfatypes.IpSpaceOrgAssignment, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.IpSpaceOrgAssignmentsEndpoint, assignment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentOut := &vcfatypes.IpSpaceOrgAssignment{}
+	if err := client.OpenApiPutItem(minVcfaApiVersion, urlRef, nil, assignment, assignmentOut, nil); err != nil {
+		return nil, err
+	}
+	return assignmentOut, nil
+}
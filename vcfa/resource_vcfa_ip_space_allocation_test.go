@@ -0,0 +1,159 @@
+//go:build tm || org || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVcfaIpSpaceAllocation(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	ipSpaceHcl, ipSpaceHclRef := getIpSpaceHcl(t, regionHclRef, "1", "1")
+	providerGatewayHcl, providerGatewayHclRef := getProviderGatewayHcl(t, regionHclRef, ipSpaceHclRef)
+
+	var params = StringMap{
+		"Testname":          t.Name(),
+		"RegionId":          fmt.Sprintf("%s.id", regionHclRef),
+		"IpSpaceId":         fmt.Sprintf("%s.id", ipSpaceHclRef),
+		"ProviderGatewayId": fmt.Sprintf("%s.id", providerGatewayHclRef),
+		"Tags":              "tm org",
+	}
+	testParamsNotEmpty(t, params)
+
+	skipBinaryTest := "# skip-binary-test: prerequisite buildup for acceptance tests"
+	configText0 := templateFill(vCenterHcl+nsxManagerHcl+skipBinaryTest, params)
+	params["FuncName"] = t.Name() + "-step0"
+
+	preRequisites := vCenterHcl + nsxManagerHcl + regionHcl + ipSpaceHcl + providerGatewayHcl
+	configText1 := templateFill(preRequisites+testAccVcfaIpSpaceAllocationStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(preRequisites+testAccVcfaIpSpaceAllocationStep2, params)
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(preRequisites+testAccVcfaIpSpaceAllocationStep3DS, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	debugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcfa_ip_space_allocation.test"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText0,
+			},
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_space_id", ipSpaceHclRef, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "org_id", "vcfa_org.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "custom_quota_max_subnet_size", "26"),
+					resource.TestCheckResourceAttr(resourceName, "custom_quota_max_cidr_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_quota_max_ip_count", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_quota_max_subnet_size", ipSpaceHclRef, "default_quota_max_subnet_size"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_quota_max_cidr_count", ipSpaceHclRef, "default_quota_max_cidr_count"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_quota_max_ip_count", ipSpaceHclRef, "default_quota_max_ip_count"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_quota_max_subnet_size", "28"),
+					resource.TestCheckResourceAttr(resourceName, "custom_quota_max_cidr_count", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_quota_max_ip_count", "0"),
+				),
+			},
+			{
+				Config: configText3,
+				Check: resource.ComposeTestCheckFunc(
+					resourceFieldsEqual(resourceName, "data.vcfa_ip_space_allocation.test", nil),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					regionName := s.RootModule().Resources[regionHclRef].Primary.Attributes["name"]
+					ipSpaceName := s.RootModule().Resources[ipSpaceHclRef].Primary.Attributes["name"]
+					return regionName + ImportSeparator + ipSpaceName + ImportSeparator + params["Testname"].(string), nil
+				},
+			},
+		},
+	})
+}
+
+const testAccVcfaIpSpaceAllocationPrerequisites = `
+resource "vcfa_org" "test" {
+  name         = "{{.Testname}}"
+  display_name = "terraform-test"
+  description  = "terraform test"
+  is_enabled   = true
+}
+
+resource "vcfa_org_networking" "test" {
+  org_id   = vcfa_org.test.id
+  log_name = "tftest"
+}
+
+resource "vcfa_org_regional_networking" "test" {
+  name                = "{{.Testname}}"
+  org_id              = vcfa_org.test.id
+  provider_gateway_id = {{.ProviderGatewayId}}
+  region_id           = {{.RegionId}}
+
+  depends_on = [vcfa_org_networking.test]
+}
+`
+
+const testAccVcfaIpSpaceAllocationStep1 = testAccVcfaIpSpaceAllocationPrerequisites + `
+resource "vcfa_ip_space_allocation" "test" {
+  ip_space_id = {{.IpSpaceId}}
+  org_id      = vcfa_org.test.id
+
+  custom_quota_max_subnet_size = 26
+  custom_quota_max_cidr_count  = 2
+  custom_quota_max_ip_count    = 3
+
+  depends_on = [vcfa_org_regional_networking.test]
+}
+`
+
+const testAccVcfaIpSpaceAllocationStep2 = testAccVcfaIpSpaceAllocationPrerequisites + `
+resource "vcfa_ip_space_allocation" "test" {
+  ip_space_id = {{.IpSpaceId}}
+  org_id      = vcfa_org.test.id
+
+  custom_quota_max_subnet_size = 28
+  custom_quota_max_cidr_count  = -1
+  custom_quota_max_ip_count    = 0
+
+  depends_on = [vcfa_org_regional_networking.test]
+}
+`
+
+const testAccVcfaIpSpaceAllocationStep3DS = testAccVcfaIpSpaceAllocationStep2 + `
+data "vcfa_ip_space_allocation" "test" {
+  ip_space_id = vcfa_ip_space_allocation.test.ip_space_id
+  org_id      = vcfa_ip_space_allocation.test.org_id
+}
+`
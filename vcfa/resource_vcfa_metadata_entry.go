@@ -0,0 +1,212 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func resourceVcfaMetadataEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaMetadataEntryCreate,
+		ReadContext:   resourceVcfaMetadataEntryRead,
+		UpdateContext: resourceVcfaMetadataEntryUpdate,
+		DeleteContext: resourceVcfaMetadataEntryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaMetadataEntryImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("URN of the entity that holds the %s, like the ID of an %s, a %s or a %s", labelVcfaMetadataEntry, labelVcfaOrg, labelVcfaRegion, labelVcfaContentLibrary),
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: metadataEntryFields["key"],
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: metadataEntryFields["value"],
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      types.OpenApiMetadataStringEntry,
+				ValidateFunc: validation.StringInSlice(metadataEntryTypes, false),
+				Description:  metadataEntryFields["type"],
+			},
+			"domain": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "TENANT",
+				ValidateFunc: validation.StringInSlice(metadataEntryDomains, false),
+				Description:  metadataEntryFields["domain"],
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: metadataEntryFields["namespace"],
+			},
+			"readonly": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: metadataEntryFields["readonly"],
+			},
+			"persistent": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: metadataEntryFields["persistent"],
+			},
+		},
+	}
+}
+
+func resourceVcfaMetadataEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	entityId := d.Get("entity_id").(string)
+
+	entry, err := getMetadataEntryType(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	created, err := addEntityMetadataEntry(tmClient, entityId, entry)
+	if err != nil {
+		return diag.Errorf("error creating %s '%s' in '%s': %s", labelVcfaMetadataEntry, entry.KeyValue.Key, entityId, err)
+	}
+	d.SetId(created.ID)
+
+	return resourceVcfaMetadataEntryRead(ctx, d, meta)
+}
+
+func resourceVcfaMetadataEntryRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	entityId := d.Get("entity_id").(string)
+
+	entry, _, err := getEntityMetadataEntry(tmClient, entityId, d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving %s '%s' of '%s': %s", labelVcfaMetadataEntry, d.Id(), entityId, err)
+	}
+	setMetadataEntryData(d, entry)
+
+	return nil
+}
+
+func resourceVcfaMetadataEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	entityId := d.Get("entity_id").(string)
+
+	entry, err := getMetadataEntryType(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entry.ID = d.Id()
+	if err := updateEntityMetadataEntry(tmClient, entityId, entry); err != nil {
+		return diag.Errorf("error updating %s '%s' of '%s': %s", labelVcfaMetadataEntry, entry.KeyValue.Key, entityId, err)
+	}
+
+	return resourceVcfaMetadataEntryRead(ctx, d, meta)
+}
+
+func resourceVcfaMetadataEntryDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	entityId := d.Get("entity_id").(string)
+
+	err := deleteEntityMetadataEntry(tmClient, entityId, d.Id())
+	if err != nil && !govcd.ContainsNotFound(err) {
+		return diag.Errorf("error deleting %s '%s' of '%s': %s", labelVcfaMetadataEntry, d.Get("key").(string), entityId, err)
+	}
+	return nil
+}
+
+// resourceVcfaMetadataEntryImport imports a metadata entry by the URN of its entity and its key, optionally preceded
+// by its namespace when there are several entries with the same key: <entity_id>.[<namespace>.]<key>
+func resourceVcfaMetadataEntryImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	idSplit := SplitImportId(d.Id(), 3)
+	if len(idSplit) < 2 {
+		return nil, fmt.Errorf("invalid import identifier '%s', should be <entity URN>%s[<namespace>%s]<key>", d.Id(), ImportSeparator, ImportSeparator)
+	}
+	entityId := idSplit[0]
+	namespace, key := "", idSplit[1]
+	if len(idSplit) == 3 {
+		namespace, key = idSplit[1], idSplit[2]
+	}
+
+	entries, err := getEntityMetadata(tmClient, entityId)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := findMetadataEntry(entries, namespace, key)
+	if err != nil && namespace != "" {
+		// The key itself may contain the separator
+		entry, err = findMetadataEntry(entries, "", namespace+ImportSeparator+key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error importing %s of '%s': %s", labelVcfaMetadataEntry, entityId, err)
+	}
+
+	d.SetId(entry.ID)
+	dSet(d, "entity_id", entityId)
+	setMetadataEntryData(d, entry)
+	return []*schema.ResourceData{d}, nil
+}
+
+// findMetadataEntry returns the only metadata entry with the given namespace and key. When the namespace is empty,
+// the key must be unique among all the namespaces and domains
+func findMetadataEntry(entries []*types.OpenApiMetadataEntry, namespace, key string) (*types.OpenApiMetadataEntry, error) {
+	var found []*types.OpenApiMetadataEntry
+	for _, entry := range entries {
+		if entry.KeyValue.Key == key && (namespace == "" || entry.KeyValue.Namespace == namespace) {
+			found = append(found, entry)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, fmt.Errorf("no metadata entry found with key '%s'", key)
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("found %d metadata entries with key '%s', the namespace is required to identify one", len(found), key)
+	}
+}
+
+func getMetadataEntryType(d *schema.ResourceData) (*types.OpenApiMetadataEntry, error) {
+	return buildMetadataEntry(d.Get("key").(string), d.Get("value").(string), d.Get("type").(string),
+		d.Get("domain").(string), d.Get("namespace").(string), d.Get("readonly").(bool), d.Get("persistent").(bool))
+}
+
+func setMetadataEntryData(d *schema.ResourceData, entry *types.OpenApiMetadataEntry) {
+	dSet(d, "key", entry.KeyValue.Key)
+	dSet(d, "value", metadataValueString(entry))
+	dSet(d, "type", entry.KeyValue.Value.Type)
+	dSet(d, "domain", entry.KeyValue.Domain)
+	dSet(d, "namespace", entry.KeyValue.Namespace)
+	dSet(d, "readonly", entry.IsReadOnly)
+	dSet(d, "persistent", entry.IsPersistent)
+}
@@ -0,0 +1,154 @@
+//go:build tm || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccVcfaMetadataEntry tests the 'metadata_entry' blocks of an Organization and the standalone
+// 'vcfa_metadata_entry' resource on another one
+func TestAccVcfaMetadataEntry(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	var params = StringMap{
+		"Testname":   t.Name(),
+		"CostCenter": "1234",
+		"Tier":       "2",
+
+		"Tags": "tm org",
+	}
+	testParamsNotEmpty(t, params)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaMetadataEntry, params)
+	params["FuncName"] = t.Name() + "-step2"
+	params["CostCenter"] = "5678"
+	params["Tier"] = "3"
+	configText2 := templateFill(testAccVcfaMetadataEntry, params)
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccVcfaMetadataEntry+testAccVcfaMetadataEntryDS, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	debugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_org.blocks", "metadata_entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_org.blocks", "metadata_entry.*", map[string]string{
+						"key": "cost-center", "value": "1234", "type": "StringEntry", "domain": "TENANT",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_org.blocks", "metadata_entry.*", map[string]string{
+						"key": "billable", "value": "true", "type": "BoolEntry", "readonly": "true",
+					}),
+					resource.TestCheckResourceAttrPair("vcfa_metadata_entry.tier", "entity_id", "vcfa_org.standalone", "id"),
+					resource.TestCheckResourceAttr("vcfa_metadata_entry.tier", "value", "2"),
+					resource.TestCheckResourceAttr("vcfa_metadata_entry.tier", "type", "NumberEntry"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vcfa_org.blocks", "metadata_entry.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_org.blocks", "metadata_entry.*", map[string]string{
+						"key": "cost-center", "value": "5678",
+					}),
+					resource.TestCheckResourceAttr("vcfa_metadata_entry.tier", "value", "3"),
+				),
+			},
+			{
+				Config: configText3,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vcfa_org.blocks", "metadata_entry.#", "2"),
+					resource.TestCheckResourceAttr("data.vcfa_orgs.tier3", "names.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vcfa_orgs.tier3", "orgs.0.id", "vcfa_org.standalone", "id"),
+				),
+			},
+			{
+				ResourceName:      "vcfa_org.blocks",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     params["Testname"].(string) + "-blocks",
+			},
+			{
+				ResourceName:      "vcfa_metadata_entry.tier",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					org, ok := s.RootModule().Resources["vcfa_org.standalone"]
+					if !ok {
+						return "", fmt.Errorf("vcfa_org.standalone not found in state")
+					}
+					return org.Primary.ID + ImportSeparator + "tier", nil
+				},
+			},
+		},
+	})
+}
+
+const testAccVcfaMetadataEntry = `
+resource "vcfa_org" "blocks" {
+  name         = "{{.Testname}}-blocks"
+  display_name = "{{.Testname}}-blocks"
+
+  metadata_entry {
+    key   = "cost-center"
+    value = "{{.CostCenter}}"
+  }
+
+  metadata_entry {
+    key      = "billable"
+    value    = "true"
+    type     = "BoolEntry"
+    readonly = true
+  }
+}
+
+resource "vcfa_org" "standalone" {
+  name         = "{{.Testname}}-standalone"
+  display_name = "{{.Testname}}-standalone"
+}
+
+resource "vcfa_metadata_entry" "tier" {
+  entity_id = vcfa_org.standalone.id
+  key       = "tier"
+  value     = "{{.Tier}}"
+  type      = "NumberEntry"
+}
+`
+
+const testAccVcfaMetadataEntryDS = `
+data "vcfa_org" "blocks" {
+  name = vcfa_org.blocks.name
+}
+
+data "vcfa_orgs" "tier3" {
+  name_regex = "^{{.Testname}}-"
+
+  metadata_filter {
+    key   = "tier"
+    value = "3"
+  }
+
+  depends_on = [vcfa_metadata_entry.tier]
+}
+`
@@ -7,15 +7,21 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/vmware/go-vcloud-director/v3/govcd"
 	"github.com/vmware/go-vcloud-director/v3/types/v56"
+	"github.com/vmware/go-vcloud-director/v3/util"
 )
 
 const labelVcfaNsxManager = "NSX Manager"
 
+// defaultNsxManagerRealizationTimeout is the default time to wait for the NSX Manager to report
+// 'REALIZED' status. It can be overridden with the 'timeouts' block of the resource
+const defaultNsxManagerRealizationTimeout = 10 * time.Minute
+
 func resourceVcfaNsxManager() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceVcfaNsxManagerCreate,
@@ -25,6 +31,10 @@ func resourceVcfaNsxManager() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceVcfaNsxManagerImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultNsxManagerRealizationTimeout),
+			Update: schema.DefaultTimeout(defaultNsxManagerRealizationTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -86,6 +96,11 @@ func resourceVcfaNsxManager() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("HREF of %s", labelVcfaNsxManager),
 			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Version of %s", labelVcfaNsxManager),
+			},
 		},
 	}
 }
@@ -93,14 +108,22 @@ func resourceVcfaNsxManager() *schema.Resource {
 func resourceVcfaNsxManagerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
 	c := crudConfig[*govcd.NsxtManagerOpenApi, types.NsxtManagerOpenApi]{
-		entityLabel:      labelVcfaNsxManager,
-		getTypeFunc:      getNsxManagerType,
-		stateStoreFunc:   setNsxManagerData,
-		createFunc:       tmClient.CreateNsxtManagerOpenApi,
-		resourceReadFunc: resourceVcfaNsxManagerRead,
-		preCreateHooks:   []schemaHook{autoTrustHostCertificate("url", "auto_trust_certificate")},
+		entityLabel:    labelVcfaNsxManager,
+		getTypeFunc:    getNsxManagerType,
+		stateStoreFunc: setNsxManagerData,
+		createFunc:     tmClient.CreateNsxtManagerOpenApi,
+		preCreateHooks: []schemaHook{autoTrustHostCertificate("url", "auto_trust_certificate")},
+	}
+	if diags := createResource(ctx, d, meta, c); diags.HasError() {
+		return diags
+	}
+
+	// Dependent resources (e.g. Regions) can only use the NSX Manager once it is realized. The ID is already set, so
+	// a wait that fails or is interrupted leaves the NSX Manager in the state as tainted
+	if err := waitForNsxManagerRealizedById(ctx, tmClient, d.Id(), operationTimeout(d, meta, schema.TimeoutCreate)); err != nil {
+		return interruptedCreationDiagnostics(labelVcfaNsxManager, d.Get("name").(string), err)
 	}
-	return createResource(ctx, d, meta, c)
+	return resourceVcfaNsxManagerRead(ctx, d, meta)
 }
 
 func resourceVcfaNsxManagerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -110,6 +133,7 @@ func resourceVcfaNsxManagerUpdate(ctx context.Context, d *schema.ResourceData, m
 		getTypeFunc:      getNsxManagerType,
 		getEntityFunc:    tmClient.GetNsxtManagerOpenApiById,
 		resourceReadFunc: resourceVcfaNsxManagerRead,
+		postUpdateHooks:  []outerEntityHook[*govcd.NsxtManagerOpenApi]{waitForNsxManagerRealized(ctx, tmClient, operationTimeout(d, meta, schema.TimeoutUpdate))},
 	}
 
 	return updateResource(ctx, d, meta, c)
@@ -139,7 +163,13 @@ func resourceVcfaNsxManagerDelete(ctx context.Context, d *schema.ResourceData, m
 func resourceVcfaNsxManagerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	nsxManager, err := tmClient.GetNsxtManagerOpenApiByName(d.Id())
+	var nsxManager *govcd.NsxtManagerOpenApi
+	var err error
+	if urn, ok := getImportUrn(d.Id(), urnPrefixNsxManager); ok {
+		nsxManager, err = tmClient.GetNsxtManagerOpenApiById(urn)
+	} else {
+		nsxManager, err = tmClient.GetNsxtManagerOpenApiByName(d.Id())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaNsxManager, d.Id(), err)
 	}
@@ -159,7 +189,7 @@ func getNsxManagerType(_ *VCDClient, d *schema.ResourceData) (*types.NsxtManager
 	return t, nil
 }
 
-func setNsxManagerData(_ *VCDClient, d *schema.ResourceData, t *govcd.NsxtManagerOpenApi) error {
+func setNsxManagerData(tmClient *VCDClient, d *schema.ResourceData, t *govcd.NsxtManagerOpenApi) error {
 	if t == nil || t.NsxtManagerOpenApi == nil {
 		return fmt.Errorf("nil object for %s", labelVcfaNsxManager)
 	}
@@ -177,5 +207,50 @@ func setNsxManagerData(_ *VCDClient, d *schema.ResourceData, t *govcd.NsxtManage
 	dSet(d, "status", n.Status)
 	dSet(d, "href", t.BuildHref())
 
+	// Version is not part of the OpenAPI NSX Manager structure and is retrieved from the
+	// extension endpoint. It is informative only, therefore a failure does not stop the operation
+	nsxtManager, err := tmClient.GetNsxtManagerByName(n.Name)
+	available, err := handleOptionalEndpointError(d, fmt.Sprintf("The version of %s '%s'", labelVcfaNsxManager, n.Name), err, "version")
+	if err != nil {
+		util.Logger.Printf("[DEBUG] unable to retrieve version of %s '%s': %s", labelVcfaNsxManager, n.Name, err)
+		dSet(d, "version", "")
+	} else if available {
+		dSet(d, "version", nsxtManager.NsxtManager.Version)
+	}
+
 	return nil
 }
+
+// waitForNsxManagerRealized waits up to 'timeout' for the NSX Manager to report 'REALIZED' status
+func waitForNsxManagerRealized(ctx context.Context, tmClient *VCDClient, timeout time.Duration) outerEntityHook[*govcd.NsxtManagerOpenApi] {
+	return func(t *govcd.NsxtManagerOpenApi) error {
+		return waitForNsxManagerRealizedById(ctx, tmClient, t.NsxtManagerOpenApi.ID, timeout)
+	}
+}
+
+// waitForNsxManagerRealizedById waits up to 'timeout' for the NSX Manager with the given ID to report 'REALIZED' status
+func waitForNsxManagerRealizedById(ctx context.Context, tmClient *VCDClient, id string, timeout time.Duration) error {
+	endTime := time.Now().Add(timeout)
+	for {
+		nsxManager, err := tmClient.GetNsxtManagerOpenApiById(id)
+		if err != nil {
+			return fmt.Errorf("error retrieving %s: %s", labelVcfaNsxManager, err)
+		}
+
+		status := nsxManager.NsxtManagerOpenApi.Status
+		switch status {
+		case "REALIZED":
+			return nil
+		case "REALIZATION_FAILED":
+			return fmt.Errorf("%s '%s' realization failed", labelVcfaNsxManager, nsxManager.NsxtManagerOpenApi.Name)
+		}
+
+		if time.Now().After(endTime) {
+			return fmt.Errorf("failed waiting %s for %s status to become 'REALIZED', got '%s'", timeout, labelVcfaNsxManager, status)
+		}
+		util.Logger.Printf("[DEBUG] %s '%s' status is '%s', waiting for 'REALIZED'", labelVcfaNsxManager, nsxManager.NsxtManagerOpenApi.Name, status)
+		if err := sleepWithContext(ctx, 5*time.Second); err != nil {
+			return fmt.Errorf("error waiting for %s status to become 'REALIZED': %s", labelVcfaNsxManager, err)
+		}
+	}
+}
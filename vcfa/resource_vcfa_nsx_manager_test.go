@@ -64,7 +64,7 @@ func TestAccVcfaNsxManager(t *testing.T) {
 					resource.TestMatchResourceAttr("vcfa_nsx_manager.test", "href", regexp.MustCompile(`api/admin/extension/nsxtManagers/`)),
 					resource.TestCheckResourceAttr("vcfa_nsx_manager.test", "name", params["Testname"].(string)),
 					resource.TestCheckResourceAttr("vcfa_nsx_manager.test", "description", "terraform test"),
-					resource.TestCheckResourceAttrSet("vcfa_nsx_manager.test", "status"),
+					resource.TestCheckResourceAttr("vcfa_nsx_manager.test", "status", "REALIZED"),
 					resource.TestCheckResourceAttr("vcfa_nsx_manager.test", "url", params["Url"].(string)),
 				),
 			},
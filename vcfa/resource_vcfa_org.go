@@ -7,6 +7,7 @@ package vcfa
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -104,6 +105,9 @@ func resourceVcfaOrg() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Number of directly managed %ss", labelVcfaOrg),
 			},
+			"metadata_entry":               getMetadataEntrySchema(labelVcfaOrg, false),
+			"prevent_destroy_if_not_empty": preventDestroyIfNotEmptySchema(labelVcfaOrg, labelVcfaOrgRegionQuota, "force_delete"),
+			"force_delete":                 forceDeleteSchema(labelVcfaOrg, labelVcfaOrgRegionQuota),
 		},
 	}
 }
@@ -116,11 +120,20 @@ func resourceVcfaOrgCreate(ctx context.Context, d *schema.ResourceData, meta int
 		stateStoreFunc:   setOrgData,
 		createFunc:       tmClient.CreateTmOrg,
 		resourceReadFunc: resourceVcfaOrgRead,
+
+		postCreateHooks: []outerEntityHook[*govcd.TmOrg]{
+			func(o *govcd.TmOrg) error { return updateMetadataEntries(tmClient, d, o.TmOrg.ID) },
+		},
 	}
 	return createResource(ctx, d, meta, c)
 }
 
 func resourceVcfaOrgUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// 'prevent_destroy_if_not_empty' and 'force_delete' are client side settings only
+	if !d.HasChangesExcept("prevent_destroy_if_not_empty", "force_delete") {
+		return resourceVcfaOrgRead(ctx, d, meta)
+	}
+
 	tmClient := meta.(ClientContainer).tmClient
 	c := crudConfig[*govcd.TmOrg, types.TmOrg]{
 		entityLabel:      labelVcfaOrg,
@@ -132,6 +145,9 @@ func resourceVcfaOrgUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			validateRenameOrgDisabled,    // 'name' can only be changed when 'is_enabled=false'
 			resubmitIdAndManagedByFields, // TODO: TM: review if ID and ManagedBy should always be submitted on update
 		},
+		postUpdateHooks: []outerEntityHook[*govcd.TmOrg]{
+			func(o *govcd.TmOrg) error { return updateMetadataEntries(tmClient, d, o.TmOrg.ID) },
+		},
 	}
 
 	return updateResource(ctx, d, meta, c)
@@ -151,14 +167,37 @@ func resourceVcfaOrgDelete(ctx context.Context, d *schema.ResourceData, meta int
 	tmClient := meta.(ClientContainer).tmClient
 
 	c := crudConfig[*govcd.TmOrg, types.TmOrg]{
-		entityLabel:    labelVcfaOrg,
-		getEntityFunc:  tmClient.GetTmOrgById,
-		preDeleteHooks: []outerEntityHook[*govcd.TmOrg]{disableTmOrg}, // Org must be disabled before deletion
+		entityLabel:   labelVcfaOrg,
+		getEntityFunc: tmClient.GetTmOrgById,
+		preDeleteHooks: []outerEntityHook[*govcd.TmOrg]{
+			func(o *govcd.TmOrg) error { return checkOrgDeleteProtection(d, tmClient, o) },
+			disableTmOrg, // Org must be disabled before deletion
+		},
 	}
 
 	return deleteResource(ctx, d, meta, c)
 }
 
+// checkOrgDeleteProtection prevents the deletion of an Organization that still has Region Quotas, when
+// 'prevent_destroy_if_not_empty' is set. It runs before disabling the Organization, so a refused deletion leaves it
+// untouched
+func checkOrgDeleteProtection(d *schema.ResourceData, tmClient *VCDClient, o *govcd.TmOrg) error {
+	return checkDeleteProtection(d, d.Get("force_delete").(bool), labelVcfaOrg, o.TmOrg.Name, labelVcfaOrgRegionQuota,
+		"force_delete", func() ([]string, error) {
+			queryParams := url.Values{}
+			queryParams.Add("filter", "org.id=="+o.TmOrg.ID)
+			regionQuotas, err := tmClient.GetAllRegionQuotas(queryParams)
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, len(regionQuotas))
+			for i, regionQuota := range regionQuotas {
+				names[i] = regionQuota.TmVdc.Name
+			}
+			return names, nil
+		})
+}
+
 // disableTmOrg disables Org which is useful before deletion as a non-disabled Org cannot be
 // removed
 func disableTmOrg(t *govcd.TmOrg) error {
@@ -192,7 +231,13 @@ func validateRenameOrgDisabled(d *schema.ResourceData, oldCfg *govcd.TmOrg, newC
 func resourceVcfaOrgImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	o, err := tmClient.GetTmOrgByName(d.Id())
+	var o *govcd.TmOrg
+	var err error
+	if urn, ok := getImportUrn(d.Id(), urnPrefixOrg); ok {
+		o, err = tmClient.GetTmOrgById(urn)
+	} else {
+		o, err = tmClient.GetTmOrgByName(d.Id())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error getting Org: %s", err)
 	}
@@ -213,7 +258,7 @@ func getOrgType(_ *VCDClient, d *schema.ResourceData) (*types.TmOrg, error) {
 	return t, nil
 }
 
-func setOrgData(_ *VCDClient, d *schema.ResourceData, org *govcd.TmOrg) error {
+func setOrgData(tmClient *VCDClient, d *schema.ResourceData, org *govcd.TmOrg) error {
 	if org == nil || org.TmOrg == nil {
 		return fmt.Errorf("cannot save state for nil Org")
 	}
@@ -243,5 +288,5 @@ func setOrgData(_ *VCDClient, d *schema.ResourceData, org *govcd.TmOrg) error {
 	dSet(d, "directly_managed_org_count", org.TmOrg.DirectlyManagedOrgCount)
 	dSet(d, "is_classic_tenant", org.TmOrg.IsClassicTenant)
 
-	return nil
+	return setMetadataEntries(tmClient, d, org.TmOrg.ID)
 }
@@ -0,0 +1,456 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+const labelVcfaOrgBranding = "Organization Branding"
+
+// orgBrandingBuiltInThemes are the themes shipped with VCFA. Any other theme name refers to a custom theme
+var orgBrandingBuiltInThemes = []string{"Default", "Dark"}
+
+// orgBrandingLogoContentTypes are the content types of the supported logo files, by extension
+var orgBrandingLogoContentTypes = map[string]string{
+	".png":  "image/png",
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".gif":  "image/gif",
+	".svg":  "image/svg+xml",
+}
+
+const orgBrandingLogoExtensions = ".png, .jpg, .jpeg, .gif, .svg"
+
+var orgBrandingColorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+var orgBrandingCustomLinkSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "link",
+			ValidateFunc: validation.StringInSlice([]string{"link", "section", "separator", "override"}, false),
+			Description:  "Type of the menu item. One of 'link', 'section', 'separator' or 'override'",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Text of the menu item. Required for 'link' and 'section' items",
+		},
+		"url": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			Description:  "Target of the menu item. Required for 'link' and 'override' items",
+		},
+	},
+}
+
+func resourceVcfaOrgBranding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaOrgBrandingCreateUpdate,
+		ReadContext:   resourceVcfaOrgBrandingRead,
+		UpdateContext: resourceVcfaOrgBrandingCreateUpdate,
+		DeleteContext: resourceVcfaOrgBrandingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaOrgBrandingImport, // The same as importing the Org
+		},
+		CustomizeDiff: resourceVcfaOrgBrandingCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Parent %s for %s", labelVcfaOrg, labelVcfaOrgBranding),
+			},
+			"portal_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name shown in the header and the title of the tenant portal",
+			},
+			"portal_color": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(orgBrandingColorRegex, "must be a color in '#RRGGBB' format"),
+				Description:  "Background color of the tenant portal header, in '#RRGGBB' format",
+			},
+			"theme": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Default",
+				Description: fmt.Sprintf("Name of the theme of the tenant portal. The built-in themes are %s. Any other name "+
+					"refers to a custom theme uploaded by the provider", strings.Join(orgBrandingBuiltInThemes, " and ")),
+			},
+			"custom_link": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Entries added to the help and user menus of the tenant portal, in order",
+				Elem:        orgBrandingCustomLinkSchema,
+			},
+			"logo_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to the local logo file of the tenant portal, with one of the extensions " + orgBrandingLogoExtensions,
+			},
+			"logo_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 checksum of the logo of the tenant portal, used to detect changes of the logo file",
+			},
+		},
+	}
+}
+
+func resourceVcfaOrgBrandingCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+
+	org, err := tmClient.GetTmOrgById(orgId)
+	if err != nil {
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgId, err)
+	}
+	orgName := org.TmOrg.Name
+
+	branding, err := getOrgBrandingType(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateOrgBranding(tmClient, orgName, branding); err != nil {
+		return diag.Errorf("error updating %s of %s '%s': %s", labelVcfaOrgBranding, labelVcfaOrg, orgName, err)
+	}
+
+	if d.IsNewResource() || d.HasChanges("logo_path", "logo_sha256") {
+		if logoPath := d.Get("logo_path").(string); logoPath != "" {
+			err = uploadOrgBrandingLogo(tmClient, orgName, logoPath)
+		} else {
+			err = deleteOrgBrandingLogo(tmClient, orgName)
+		}
+		if err != nil {
+			return diag.Errorf("error updating %s logo of %s '%s': %s", labelVcfaOrgBranding, labelVcfaOrg, orgName, err)
+		}
+	}
+
+	d.SetId(org.TmOrg.ID)
+
+	return resourceVcfaOrgBrandingRead(ctx, d, meta)
+}
+
+func resourceVcfaOrgBrandingRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	org, err := getCachedTmOrgById(meta, d.Get("org_id").(string))
+	if err != nil {
+		if govcd.ContainsNotFound(err) { // Org no longer present, removing from state
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
+	}
+	orgName := org.TmOrg.Name
+
+	branding, err := getOrgBranding(tmClient, orgName)
+	if err != nil {
+		return diag.Errorf("error retrieving %s of %s '%s': %s", labelVcfaOrgBranding, labelVcfaOrg, orgName, err)
+	}
+	logo, err := getOrgBrandingLogo(tmClient, orgName)
+	if err != nil {
+		return diag.Errorf("error retrieving %s logo of %s '%s': %s", labelVcfaOrgBranding, labelVcfaOrg, orgName, err)
+	}
+
+	d.SetId(org.TmOrg.ID)
+	dSet(d, "org_id", org.TmOrg.ID)
+	if err := setOrgBrandingData(d, branding, logo); err != nil {
+		return diag.Errorf("error storing read %s: %s", labelVcfaOrgBranding, err)
+	}
+
+	return nil
+}
+
+func resourceVcfaOrgBrandingDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+
+	org, err := tmClient.GetTmOrgById(orgId)
+	if err != nil {
+		if govcd.ContainsNotFound(err) { // The branding is gone with the Org
+			return nil
+		}
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgId, err)
+	}
+	orgName := org.TmOrg.Name
+
+	// Removing the branding of the Organization makes its tenant portal use the branding of the system again
+	if err := deleteOrgBrandingLogo(tmClient, orgName); err != nil {
+		return diag.Errorf("error removing %s logo of %s '%s': %s", labelVcfaOrgBranding, labelVcfaOrg, orgName, err)
+	}
+	if err := deleteOrgBranding(tmClient, orgName); err != nil {
+		return diag.Errorf("error removing %s of %s '%s': %s", labelVcfaOrgBranding, labelVcfaOrg, orgName, err)
+	}
+
+	return nil
+}
+
+func resourceVcfaOrgBrandingImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	o, err := tmClient.GetTmOrgByName(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error getting Org: %s", err)
+	}
+
+	dSet(d, "org_id", o.TmOrg.ID)
+	d.SetId(o.TmOrg.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceVcfaOrgBrandingCustomizeDiff plans a new logo checksum when the content of the logo file changes, as the
+// path alone does not tell whether the logo must be uploaded again
+func resourceVcfaOrgBrandingCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("logo_path") {
+		return d.SetNewComputed("logo_sha256")
+	}
+	logoPath := d.Get("logo_path").(string)
+	if logoPath == "" {
+		if d.Get("logo_sha256").(string) != "" {
+			return d.SetNew("logo_sha256", "")
+		}
+		return nil
+	}
+
+	logo, err := os.ReadFile(filepath.Clean(logoPath))
+	if err != nil {
+		return fmt.Errorf("error reading logo file '%s': %s", logoPath, err)
+	}
+	if checksum := sha256Hex(logo); checksum != d.Get("logo_sha256").(string) {
+		return d.SetNew("logo_sha256", checksum)
+	}
+	return nil
+}
+
+func getOrgBrandingType(d *schema.ResourceData) (*vcfatypes.UiBranding, error) {
+	theme := d.Get("theme").(string)
+	themeType := vcfatypes.BrandingThemeTypeCustom
+	if contains(orgBrandingBuiltInThemes, theme) {
+		themeType = vcfatypes.BrandingThemeTypeBuiltIn
+	}
+
+	customLinks := make([]vcfatypes.UiBrandingMenuItem, 0)
+	for i, item := range d.Get("custom_link").([]interface{}) {
+		linkMap := item.(map[string]interface{})
+		link := vcfatypes.UiBrandingMenuItem{
+			MenuItemType: linkMap["type"].(string),
+			Name:         linkMap["name"].(string),
+			Url:          linkMap["url"].(string),
+		}
+		if err := validateOrgBrandingCustomLink(link); err != nil {
+			return nil, fmt.Errorf("custom_link %d: %s", i, err)
+		}
+		customLinks = append(customLinks, link)
+	}
+
+	return &vcfatypes.UiBranding{
+		PortalName:    d.Get("portal_name").(string),
+		PortalColor:   d.Get("portal_color").(string),
+		SelectedTheme: &vcfatypes.UiBrandingTheme{ThemeType: themeType, Name: theme},
+		CustomLinks:   customLinks,
+	}, nil
+}
+
+// validateOrgBrandingCustomLink checks that a menu item has the fields that its type requires
+func validateOrgBrandingCustomLink(link vcfatypes.UiBrandingMenuItem) error {
+	switch link.MenuItemType {
+	case "link":
+		if link.Name == "" || link.Url == "" {
+			return fmt.Errorf("'link' items require 'name' and 'url'")
+		}
+	case "section":
+		if link.Name == "" || link.Url != "" {
+			return fmt.Errorf("'section' items require 'name' and don't accept 'url'")
+		}
+	case "override":
+		if link.Url == "" {
+			return fmt.Errorf("'override' items require 'url'")
+		}
+	case "separator":
+		if link.Name != "" || link.Url != "" {
+			return fmt.Errorf("'separator' items don't accept 'name' nor 'url'")
+		}
+	}
+	return nil
+}
+
+func setOrgBrandingData(d *schema.ResourceData, branding *vcfatypes.UiBranding, logo []byte) error {
+	dSet(d, "portal_name", branding.PortalName)
+	dSet(d, "portal_color", branding.PortalColor)
+	if branding.SelectedTheme != nil {
+		dSet(d, "theme", branding.SelectedTheme.Name)
+	}
+
+	customLinks := make([]interface{}, 0, len(branding.CustomLinks))
+	for _, link := range branding.CustomLinks {
+		customLinks = append(customLinks, map[string]interface{}{
+			"type": link.MenuItemType,
+			"name": link.Name,
+			"url":  link.Url,
+		})
+	}
+	if err := d.Set("custom_link", customLinks); err != nil {
+		return fmt.Errorf("error setting 'custom_link': %s", err)
+	}
+
+	checksum := ""
+	if len(logo) > 0 {
+		checksum = sha256Hex(logo)
+	}
+	dSet(d, "logo_sha256", checksum)
+	return nil
+}
+
+// getOrgBranding retrieves the portal branding of the given Organization
+func getOrgBranding(tmClient *VCDClient, orgName string) (*vcfatypes.UiBranding, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.TenantBrandingEndpoint, url.PathEscape(orgName)))
+	if err != nil {
+		return nil, err
+	}
+
+	branding := &vcfatypes.UiBranding{}
+	if err := client.OpenApiGetItem(minVcfaApiVersion, urlRef, nil, branding, nil); err != nil {
+		return nil, err
+	}
+	return branding, nil
+}
+
+// updateOrgBranding sends the given portal branding of an Organization
+func updateOrgBranding(tmClient *VCDClient, orgName string, branding *vcfatypes.UiBranding) error {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.TenantBrandingEndpoint, url.PathEscape(orgName)))
+	if err != nil {
+		return err
+	}
+
+	return client.OpenApiPutItem(minVcfaApiVersion, urlRef, nil, branding, nil, nil)
+}
+
+// deleteOrgBranding removes the portal branding of an Organization, which then uses the one of the system
+func deleteOrgBranding(tmClient *VCDClient, orgName string) error {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.TenantBrandingEndpoint, url.PathEscape(orgName)))
+	if err != nil {
+		return err
+	}
+
+	err = client.OpenApiDeleteItem(minVcfaApiVersion, urlRef, nil, nil)
+	if err != nil && !govcd.ContainsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getOrgBrandingLogo retrieves the raw portal logo of an Organization, or nil if it has none
+func getOrgBrandingLogo(tmClient *VCDClient, orgName string) ([]byte, error) {
+	response, err := sendOrgBrandingLogoRequest(tmClient, orgName, http.MethodGet, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	defer closeOrgBrandingLogoResponse(response)
+
+	switch {
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusNoContent:
+		return nil, nil
+	case response.StatusCode < 200 || response.StatusCode > 299:
+		return nil, orgBrandingLogoResponseError(response)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// uploadOrgBrandingLogo sends the given local logo file as the portal logo of an Organization
+func uploadOrgBrandingLogo(tmClient *VCDClient, orgName, logoPath string) error {
+	contentType, ok := orgBrandingLogoContentTypes[strings.ToLower(filepath.Ext(logoPath))]
+	if !ok {
+		return fmt.Errorf("logo file '%s' must have one of the extensions %s", logoPath, orgBrandingLogoExtensions)
+	}
+	logo, err := os.ReadFile(filepath.Clean(logoPath))
+	if err != nil {
+		return fmt.Errorf("error reading logo file '%s': %s", logoPath, err)
+	}
+
+	response, err := sendOrgBrandingLogoRequest(tmClient, orgName, http.MethodPut, logo, contentType)
+	if err != nil {
+		return err
+	}
+	defer closeOrgBrandingLogoResponse(response)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return orgBrandingLogoResponseError(response)
+	}
+	return nil
+}
+
+// deleteOrgBrandingLogo removes the portal logo of an Organization, which then uses the one of the system
+func deleteOrgBrandingLogo(tmClient *VCDClient, orgName string) error {
+	response, err := sendOrgBrandingLogoRequest(tmClient, orgName, http.MethodDelete, nil, "")
+	if err != nil {
+		return err
+	}
+	defer closeOrgBrandingLogoResponse(response)
+
+	if response.StatusCode != http.StatusNotFound && (response.StatusCode < 200 || response.StatusCode > 299) {
+		return orgBrandingLogoResponseError(response)
+	}
+	return nil
+}
+
+// sendOrgBrandingLogoRequest sends a request to the logo endpoint, which, unlike the rest of the OpenAPI, exchanges
+// raw images instead of JSON
+func sendOrgBrandingLogoRequest(tmClient *VCDClient, orgName, method string, body []byte, contentType string) (*http.Response, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(fmt.Sprintf(vcfatypes.TenantBrandingLogoEndpoint, url.PathEscape(orgName)))
+	if err != nil {
+		return nil, err
+	}
+
+	request := client.NewRequestWitNotEncodedParamsWithApiVersion(nil, nil, method, *urlRef, bytes.NewReader(body), minVcfaApiVersion)
+	request.Header.Set("Accept", "*/*;version="+minVcfaApiVersion)
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+	return client.Http.Do(request)
+}
+
+func closeOrgBrandingLogoResponse(response *http.Response) {
+	if err := response.Body.Close(); err != nil {
+		log.Printf("[DEBUG] could not close response body: %s", err)
+	}
+}
+
+func orgBrandingLogoResponseError(response *http.Response) error {
+	body, _ := io.ReadAll(response.Body)
+	return fmt.Errorf("unexpected status %s: %s", response.Status, strings.TrimSpace(string(body)))
+}
+
+func sha256Hex(data []byte) string {
+	checksum := sha256.Sum256(data)
+	return hex.EncodeToString(checksum[:])
+}
@@ -0,0 +1,146 @@
+//go:build tm || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaOrgBranding tests the tenant portal branding of an Organization, including its logo
+func TestAccVcfaOrgBranding(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	logoPath := filepath.Join(t.TempDir(), "logo.png")
+	if err := writeTestPng(logoPath, color.RGBA{R: 0x1d, G: 0x42, B: 0x8a, A: 0xff}); err != nil {
+		t.Fatalf("error writing logo file: %s", err)
+	}
+
+	var params = StringMap{
+		"Testname": t.Name(),
+		"LogoPath": logoPath,
+		"Tags":     "tm",
+	}
+	testParamsNotEmpty(t, params)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaOrgBrandingStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccVcfaOrgBrandingStep2, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	brandingDef := "vcfa_org_branding.test"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(brandingDef, "id", "vcfa_org.test", "id"),
+					resource.TestCheckResourceAttr(brandingDef, "portal_name", "Terraform Cloud"),
+					resource.TestCheckResourceAttr(brandingDef, "portal_color", "#1D428A"),
+					resource.TestCheckResourceAttr(brandingDef, "theme", "Default"),
+					resource.TestCheckResourceAttr(brandingDef, "custom_link.#", "3"),
+					resource.TestCheckResourceAttr(brandingDef, "custom_link.1.type", "separator"),
+					resource.TestCheckResourceAttr(brandingDef, "logo_sha256", ""),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(brandingDef, "portal_name", "Terraform Cloud updated"),
+					resource.TestCheckResourceAttr(brandingDef, "theme", "Dark"),
+					resource.TestCheckResourceAttr(brandingDef, "custom_link.#", "1"),
+					resource.TestMatchResourceAttr(brandingDef, "logo_sha256", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				ResourceName:            brandingDef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           params["Testname"].(string),
+				ImportStateVerifyIgnore: []string{"logo_path"},
+			},
+		},
+	})
+}
+
+// writeTestPng writes a small PNG image of a single color to the given path
+func writeTestPng(path string, fill color.Color) error {
+	img := image.NewRGBA(image.Rect(0, 0, 16, 16))
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 16; y++ {
+			img.Set(x, y, fill)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	return os.WriteFile(path, buf.Bytes(), 0600)
+}
+
+const testAccVcfaOrgBrandingOrg = `
+resource "vcfa_org" "test" {
+  name         = "{{.Testname}}"
+  display_name = "terraform-test"
+  description  = "terraform test"
+  is_enabled   = true
+}
+`
+
+const testAccVcfaOrgBrandingStep1 = testAccVcfaOrgBrandingOrg + `
+resource "vcfa_org_branding" "test" {
+  org_id       = vcfa_org.test.id
+  portal_name  = "Terraform Cloud"
+  portal_color = "#1D428A"
+
+  custom_link {
+    name = "Support"
+    url  = "https://support.example.com"
+  }
+
+  custom_link {
+    type = "separator"
+  }
+
+  custom_link {
+    type = "section"
+    name = "Documentation"
+  }
+}
+`
+
+const testAccVcfaOrgBrandingStep2 = testAccVcfaOrgBrandingOrg + `
+resource "vcfa_org_branding" "test" {
+  org_id      = vcfa_org.test.id
+  portal_name = "Terraform Cloud updated"
+  theme       = "Dark"
+  logo_path   = "{{.LogoPath}}"
+
+  custom_link {
+    name = "Support"
+    url  = "https://support.example.com"
+  }
+}
+`
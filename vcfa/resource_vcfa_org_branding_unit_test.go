@@ -0,0 +1,52 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+func TestValidateOrgBrandingCustomLink(t *testing.T) {
+	tests := []struct {
+		link    vcfatypes.UiBrandingMenuItem
+		wantErr bool
+	}{
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "link", Name: "Support", Url: "https://example.com"}},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "link", Name: "Support"}, wantErr: true},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "link", Url: "https://example.com"}, wantErr: true},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "section", Name: "Docs"}},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "section", Name: "Docs", Url: "https://example.com"}, wantErr: true},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "separator"}},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "separator", Name: "Docs"}, wantErr: true},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "override", Url: "https://example.com/about"}},
+		{link: vcfatypes.UiBrandingMenuItem{MenuItemType: "override", Name: "About"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		err := validateOrgBrandingCustomLink(tt.link)
+		if tt.wantErr && err == nil {
+			t.Errorf("expected an error validating %+v", tt.link)
+		}
+		if !tt.wantErr && err != nil {
+			t.Errorf("unexpected error validating %+v: %s", tt.link, err)
+		}
+	}
+}
+
+func TestOrgBrandingColorRegex(t *testing.T) {
+	for _, valid := range []string{"#1D428A", "#ffffff", "#000000"} {
+		if !orgBrandingColorRegex.MatchString(valid) {
+			t.Errorf("expected '%s' to be valid", valid)
+		}
+	}
+	for _, invalid := range []string{"1D428A", "#FFF", "#GGGGGG", "#1D428A0"} {
+		if orgBrandingColorRegex.MatchString(invalid) {
+			t.Errorf("expected '%s' to be invalid", invalid)
+		}
+	}
+}
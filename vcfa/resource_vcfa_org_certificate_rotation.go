@@ -0,0 +1,413 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaOrgCertificateRotation = "Organization Certificate Rotation"
+
+func resourceVcfaOrgCertificateRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaOrgCertificateRotationCreate,
+		ReadContext:   resourceVcfaOrgCertificateRotationRead,
+		UpdateContext: resourceVcfaOrgCertificateRotationUpdate,
+		DeleteContext: resourceVcfaOrgCertificateRotationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaOrgCertificateRotationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The ID of the %s that owns the certificate", labelVcfaOrg),
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Alias of the active certificate in the certificate library. It doesn't change when the certificate is rotated",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the active certificate",
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "PEM encoded certificate, optionally followed by its chain. Changing it rotates the certificate: " +
+					"the new one is uploaded and verified before it replaces the active one",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the certificate",
+			},
+			"private_key_passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Passphrase of the private key, if it is encrypted",
+			},
+			"min_validity_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Certificates that expire in fewer days than this are rejected before they are uploaded",
+			},
+			"rotate_before_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of days before the expiration of the active certificate when 'rotation_due' becomes true",
+			},
+			"keep_previous_certificate": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the replaced certificate is kept in the certificate library with the alias " +
+					"'<alias>-replaced-<timestamp>' instead of being deleted",
+			},
+			"certificate_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the active certificate in the certificate library",
+			},
+			"previous_certificate_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the certificate replaced by the last rotation, if it was kept",
+			},
+			"fingerprint_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 fingerprint of the active certificate",
+			},
+			"not_before": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Start of the validity period of the active certificate (RFC3339)",
+			},
+			"not_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration of the active certificate (RFC3339)",
+			},
+			"days_until_expiry": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of whole days until the active certificate expires, as of the last refresh",
+			},
+			"rotation_due": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the active certificate expires within 'rotate_before_days', as of the last refresh",
+			},
+			"rotated_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last rotation (RFC3339)",
+			},
+		},
+	}
+}
+
+func resourceVcfaOrgCertificateRotationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+	alias := d.Get("alias").(string)
+
+	if _, err := checkRotationCertificate(d.Get("certificate").(string), d.Get("private_key").(string),
+		d.Get("private_key_passphrase").(string), d.Get("min_validity_days").(int), time.Now()); err != nil {
+		return diag.Errorf("error validating certificate for %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+
+	certificate, err := addCertificateToOrgLibrary(tmClient, orgId, getRotationCertificateConfig(d, alias))
+	if err != nil {
+		return diag.Errorf("error creating %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+	d.SetId(certificate.CertificateLibrary.Id)
+	if err := verifyRotationCertificate(tmClient, orgId, certificate.CertificateLibrary.Id, d.Get("certificate").(string)); err != nil {
+		return diag.Errorf("error verifying %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+	dSet(d, "previous_certificate_id", "")
+	dSet(d, "rotated_on", time.Now().Format(time.RFC3339))
+
+	return resourceVcfaOrgCertificateRotationRead(ctx, d, meta)
+}
+
+func resourceVcfaOrgCertificateRotationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	certificate, err := getCertificateType(tmClient, d.Get("org_id").(string), d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			log.Printf("[DEBUG] %s '%s' not found, removing it from state", labelVcfaOrgCertificateRotation, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelVcfaOrgCertificateRotation, err)
+	}
+
+	dSet(d, "alias", certificate.CertificateLibrary.Alias)
+	dSet(d, "description", certificate.CertificateLibrary.Description)
+	dSet(d, "certificate", certificate.CertificateLibrary.Certificate)
+	dSet(d, "certificate_id", certificate.CertificateLibrary.Id)
+
+	leaf, err := parseLeafCertificate(certificate.CertificateLibrary.Certificate)
+	if err != nil {
+		return diag.Errorf("error parsing %s '%s': %s", labelVcfaOrgCertificateRotation, certificate.CertificateLibrary.Alias, err)
+	}
+	daysUntilExpiry := int(time.Until(leaf.NotAfter).Hours() / 24)
+	dSet(d, "fingerprint_sha256", certificateFingerprint(leaf))
+	dSet(d, "not_before", leaf.NotBefore.UTC().Format(time.RFC3339))
+	dSet(d, "not_after", leaf.NotAfter.UTC().Format(time.RFC3339))
+	dSet(d, "days_until_expiry", daysUntilExpiry)
+	dSet(d, "rotation_due", daysUntilExpiry <= d.Get("rotate_before_days").(int))
+
+	return nil
+}
+
+func resourceVcfaOrgCertificateRotationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+	alias := d.Get("alias").(string)
+
+	if !d.HasChanges("certificate", "private_key", "private_key_passphrase") {
+		if d.HasChange("description") {
+			certificate, err := getCertificateType(tmClient, orgId, d.Id())
+			if err != nil {
+				return diag.Errorf("error retrieving %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+			}
+			certificate.CertificateLibrary.Description = d.Get("description").(string)
+			if _, err := certificate.Update(); err != nil {
+				return diag.Errorf("error updating %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+			}
+		}
+		return resourceVcfaOrgCertificateRotationRead(ctx, d, meta)
+	}
+
+	newCertificatePem := d.Get("certificate").(string)
+	if _, err := checkRotationCertificate(newCertificatePem, d.Get("private_key").(string),
+		d.Get("private_key_passphrase").(string), d.Get("min_validity_days").(int), time.Now()); err != nil {
+		return diag.Errorf("error validating the new certificate of %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+
+	previous, err := getCertificateType(tmClient, orgId, d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the active certificate of %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+
+	// 1. The new certificate is uploaded with a temporary alias, so the active one keeps working until it is verified
+	rotatingAlias := alias + "-rotating"
+	newCertificate, err := addCertificateToOrgLibrary(tmClient, orgId, getRotationCertificateConfig(d, rotatingAlias))
+	if err != nil {
+		return diag.Errorf("error uploading the new certificate of %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+	newCertificateId := newCertificate.CertificateLibrary.Id
+
+	// 2. Verify that VCFA stored the new certificate as it was given. Otherwise, it is discarded
+	if err := verifyRotationCertificate(tmClient, orgId, newCertificateId, newCertificatePem); err != nil {
+		if deleteErr := newCertificate.Delete(); deleteErr != nil {
+			log.Printf("[WARN] could not remove unverified certificate '%s': %s", rotatingAlias, deleteErr)
+		}
+		return diag.Errorf("error verifying the new certificate of %s '%s', the active certificate was not changed: %s",
+			labelVcfaOrgCertificateRotation, alias, err)
+	}
+
+	// 3. Switch the aliases: the active certificate is renamed first, as aliases must be unique
+	now := time.Now()
+	previous.CertificateLibrary.Alias = fmt.Sprintf("%s-replaced-%s", alias, now.UTC().Format("20060102150405"))
+	if _, err := previous.Update(); err != nil {
+		if deleteErr := newCertificate.Delete(); deleteErr != nil {
+			log.Printf("[WARN] could not remove certificate '%s': %s", rotatingAlias, deleteErr)
+		}
+		return diag.Errorf("error renaming the active certificate of %s '%s', the active certificate was not changed: %s",
+			labelVcfaOrgCertificateRotation, alias, err)
+	}
+	newCertificate.CertificateLibrary.Alias = alias
+	newCertificate.CertificateLibrary.Description = d.Get("description").(string)
+	if _, err := newCertificate.Update(); err != nil {
+		previous.CertificateLibrary.Alias = alias
+		if _, rollbackErr := previous.Update(); rollbackErr != nil {
+			log.Printf("[WARN] could not restore the alias of the active certificate '%s': %s", previous.CertificateLibrary.Id, rollbackErr)
+		}
+		return diag.Errorf("error activating the new certificate of %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+
+	// From here on, the new certificate is the active one
+	d.SetId(newCertificateId)
+	dSet(d, "rotated_on", now.Format(time.RFC3339))
+	dSet(d, "previous_certificate_id", "")
+	if d.Get("keep_previous_certificate").(bool) {
+		dSet(d, "previous_certificate_id", previous.CertificateLibrary.Id)
+	} else if err := previous.Delete(); err != nil {
+		return diag.Errorf("the certificate of %s '%s' was rotated, but the replaced certificate '%s' could not be deleted: %s",
+			labelVcfaOrgCertificateRotation, alias, previous.CertificateLibrary.Alias, err)
+	}
+	log.Printf("[INFO] rotated %s '%s': certificate '%s' replaced by '%s'", labelVcfaOrgCertificateRotation, alias,
+		previous.CertificateLibrary.Id, newCertificateId)
+
+	return resourceVcfaOrgCertificateRotationRead(ctx, d, meta)
+}
+
+func resourceVcfaOrgCertificateRotationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	certificate, err := getCertificateType(tmClient, d.Get("org_id").(string), d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrgCertificateRotation, err)
+	}
+	if err := certificate.Delete(); err != nil {
+		return diag.Errorf("error deleting %s '%s': %s", labelVcfaOrgCertificateRotation, certificate.CertificateLibrary.Alias, err)
+	}
+	return nil
+}
+
+func resourceVcfaOrgCertificateRotationImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	resourceURI := SplitImportId(d.Id(), -1)
+	if len(resourceURI) != 2 {
+		return nil, fmt.Errorf("resource name must be specified as org-name%scertificate-alias", ImportSeparator)
+	}
+	orgName, alias := resourceURI[0], resourceURI[1]
+
+	tmClient := meta.(ClientContainer).tmClient
+	org, err := tmClient.GetTmOrgByName(orgName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, orgName, err)
+	}
+
+	var certificate *govcd.Certificate
+	if isSystem(org) {
+		certificate, err = tmClient.Client.GetCertificateFromLibraryByName(alias)
+	} else {
+		var adminOrg *govcd.AdminOrg
+		adminOrg, err = tmClient.GetAdminOrgById(org.TmOrg.ID)
+		if err != nil {
+			return nil, err
+		}
+		certificate, err = adminOrg.GetCertificateFromLibraryByName(alias)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error importing %s '%s': %s", labelVcfaOrgCertificateRotation, alias, err)
+	}
+
+	d.SetId(certificate.CertificateLibrary.Id)
+	dSet(d, "org_id", org.TmOrg.ID)
+	dSet(d, "min_validity_days", 0)
+	dSet(d, "rotate_before_days", 30)
+	dSet(d, "keep_previous_certificate", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getRotationCertificateConfig(d *schema.ResourceData, alias string) *types.CertificateLibraryItem {
+	config := getCertificateConfigurationType(d)
+	config.Alias = alias
+	return config
+}
+
+// addCertificateToOrgLibrary adds the given certificate to the library of the given Organization, which can be System
+func addCertificateToOrgLibrary(tmClient *VCDClient, orgId string, config *types.CertificateLibraryItem) (*govcd.Certificate, error) {
+	org, err := tmClient.GetTmOrgById(orgId)
+	if err != nil {
+		return nil, err
+	}
+	if isSystem(org) {
+		return tmClient.Client.AddCertificateToLibrary(config)
+	}
+	adminOrg, err := tmClient.GetAdminOrgById(org.TmOrg.ID)
+	if err != nil {
+		return nil, err
+	}
+	return adminOrg.AddCertificateToLibrary(config)
+}
+
+// verifyRotationCertificate checks that the certificate library item with the given ID holds the expected certificate
+func verifyRotationCertificate(tmClient *VCDClient, orgId, certificateId, expectedPem string) error {
+	certificate, err := getCertificateType(tmClient, orgId, certificateId)
+	if err != nil {
+		return fmt.Errorf("could not retrieve the uploaded certificate: %s", err)
+	}
+	expected, err := parseLeafCertificate(expectedPem)
+	if err != nil {
+		return err
+	}
+	stored, err := parseLeafCertificate(certificate.CertificateLibrary.Certificate)
+	if err != nil {
+		return fmt.Errorf("the uploaded certificate could not be parsed: %s", err)
+	}
+	if certificateFingerprint(stored) != certificateFingerprint(expected) {
+		return fmt.Errorf("the uploaded certificate has fingerprint %s, but %s was expected",
+			certificateFingerprint(stored), certificateFingerprint(expected))
+	}
+	return nil
+}
+
+// checkRotationCertificate performs the health checks of a certificate before it is uploaded: it must be parseable,
+// valid now and for at least 'minValidityDays', and it must match its private key, if one is given unencrypted
+func checkRotationCertificate(certificatePem, privateKeyPem, passphrase string, minValidityDays int, now time.Time) (*x509.Certificate, error) {
+	leaf, err := parseLeafCertificate(certificatePem)
+	if err != nil {
+		return nil, err
+	}
+	if now.Before(leaf.NotBefore) {
+		return nil, fmt.Errorf("certificate is not valid until %s", leaf.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if !now.Before(leaf.NotAfter) {
+		return nil, fmt.Errorf("certificate expired on %s", leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+	if minValidityDays > 0 && leaf.NotAfter.Before(now.AddDate(0, 0, minValidityDays)) {
+		return nil, fmt.Errorf("certificate expires on %s, which is less than %d days from now",
+			leaf.NotAfter.UTC().Format(time.RFC3339), minValidityDays)
+	}
+	// Encrypted private keys can only be checked by VCFA
+	if privateKeyPem != "" && passphrase == "" {
+		if _, err := tls.X509KeyPair([]byte(certificatePem), []byte(privateKeyPem)); err != nil {
+			return nil, fmt.Errorf("private key does not match the certificate: %s", err)
+		}
+	}
+	return leaf, nil
+}
+
+// parseLeafCertificate returns the first certificate of the given PEM, which is the leaf of the chain
+func parseLeafCertificate(certificatePem string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(certificatePem)))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// certificateFingerprint returns the SHA-256 fingerprint of the given certificate, as colon separated hex bytes
+func certificateFingerprint(certificate *x509.Certificate) string {
+	sum := sha256.Sum256(certificate.Raw)
+	parts := make([]string, len(sum))
+	for i, b := range sum {
+		parts[i] = fmt.Sprintf("%02X", b)
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,124 @@
+//go:build certificate || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccVcfaOrgCertificateRotation tests the rotation of a certificate in the System certificate library. At least
+// two certificates must be provided in the testing configuration
+func TestAccVcfaOrgCertificateRotation(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+
+	skipIfNotSysAdmin(t)
+
+	if len(testConfig.Tm.Certificates) < 2 {
+		t.Skip("there must be at least two certificates in tm.certificates from test configuration")
+	}
+
+	var params = StringMap{
+		"Alias":            t.Name(),
+		"Description":      "Rotated by Terraform",
+		"Certificate1Path": testConfig.Tm.Certificates[0].Path,
+		"Certificate2Path": testConfig.Tm.Certificates[1].Path,
+		"PrivateKey2":      testConfig.Tm.Certificates[1].PrivateKeyPath,
+		"PassPhrase":       testConfig.Tm.Certificates[1].Password,
+	}
+	testParamsNotEmpty(t, params)
+
+	configText1 := templateFill(testAccVcfaOrgCertificateRotationStep1, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 1: %s", configText1)
+
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccVcfaOrgCertificateRotationStep2, params)
+	debugPrintf("#[DEBUG] CONFIGURATION for step 2: %s", configText2)
+
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	resourceName := "vcfa_org_certificate_rotation.test"
+	cachedId := &testCachedFieldValue{}
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.cacheTestResourceFieldValue(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "alias", params["Alias"].(string)),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_id", resourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "fingerprint_sha256", regexp.MustCompile(`^([0-9A-F]{2}:){31}[0-9A-F]{2}$`)),
+					resource.TestCheckResourceAttrSet(resourceName, "not_after"),
+					resource.TestCheckResourceAttrSet(resourceName, "days_until_expiry"),
+					resource.TestCheckResourceAttrSet(resourceName, "rotated_on"),
+				),
+			},
+			{
+				// Rotating the certificate replaces the library item, but keeps the alias
+				Config: configText2,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					cachedId.testCheckCachedResourceFieldValueChanged(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "alias", params["Alias"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "description", params["Description"].(string)),
+					resource.TestCheckResourceAttrPair(resourceName, "certificate_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "previous_certificate_id", ""),
+					resource.TestCheckResourceAttrPair("data.vcfa_certificate.active", "id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(*terraform.State) (string, error) {
+					return fmt.Sprintf("System%s%s", ImportSeparator, params["Alias"].(string)), nil
+				},
+				ImportStateVerifyIgnore: []string{"private_key", "private_key_passphrase", "rotated_on", "previous_certificate_id"},
+			},
+		},
+	})
+}
+
+const testAccVcfaOrgCertificateRotationStep1 = `
+data "vcfa_org" "system" {
+  name = "System"
+}
+
+resource "vcfa_org_certificate_rotation" "test" {
+  org_id      = data.vcfa_org.system.id
+  alias       = "{{.Alias}}"
+  certificate = file("{{.Certificate1Path}}")
+}
+`
+
+const testAccVcfaOrgCertificateRotationStep2 = `
+data "vcfa_org" "system" {
+  name = "System"
+}
+
+resource "vcfa_org_certificate_rotation" "test" {
+  org_id                 = data.vcfa_org.system.id
+  alias                  = "{{.Alias}}"
+  description            = "{{.Description}}"
+  certificate            = file("{{.Certificate2Path}}")
+  private_key            = file("{{.PrivateKey2}}")
+  private_key_passphrase = "{{.PassPhrase}}"
+}
+
+data "vcfa_certificate" "active" {
+  org_id = data.vcfa_org.system.id
+  alias  = vcfa_org_certificate_rotation.test.alias
+}
+`
@@ -0,0 +1,95 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// generateTestCertificate returns a self-signed PEM certificate valid between the given times, and its private key
+func generateTestCertificate(t *testing.T, notBefore, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "vcfa.example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
+
+// TestCheckRotationCertificate checks the health checks performed on certificates before they are rotated
+func TestCheckRotationCertificate(t *testing.T) {
+	now := time.Now()
+	validCert, validKey := generateTestCertificate(t, now.Add(-time.Hour), now.AddDate(0, 0, 90))
+	_, otherKey := generateTestCertificate(t, now.Add(-time.Hour), now.AddDate(0, 0, 90))
+	expiredCert, _ := generateTestCertificate(t, now.AddDate(0, 0, -90), now.Add(-time.Hour))
+	futureCert, _ := generateTestCertificate(t, now.Add(time.Hour), now.AddDate(0, 0, 90))
+
+	tests := []struct {
+		name            string
+		certificate     string
+		privateKey      string
+		passphrase      string
+		minValidityDays int
+		wantErr         string
+	}{
+		{name: "Valid", certificate: validCert},
+		{name: "ValidWithKey", certificate: validCert, privateKey: validKey, minValidityDays: 60},
+		{name: "EncryptedKeyNotChecked", certificate: validCert, privateKey: otherKey, passphrase: "secret"},
+		{name: "NotPem", certificate: "not a certificate", wantErr: "no PEM encoded certificate"},
+		{name: "Expired", certificate: expiredCert, wantErr: "expired"},
+		{name: "NotYetValid", certificate: futureCert, wantErr: "not valid until"},
+		{name: "ShortValidity", certificate: validCert, minValidityDays: 120, wantErr: "less than 120 days"},
+		{name: "WrongKey", certificate: validCert, privateKey: otherKey, wantErr: "does not match"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := checkRotationCertificate(tt.certificate, tt.privateKey, tt.passphrase, tt.minValidityDays, now)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("checkRotationCertificate() unexpected error: %s", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("checkRotationCertificate() error = %v, want an error containing '%s'", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCertificateFingerprint checks the format of certificate fingerprints
+func TestCertificateFingerprint(t *testing.T) {
+	certificatePem, _ := generateTestCertificate(t, time.Now(), time.Now().Add(time.Hour))
+	certificate, err := parseLeafCertificate(certificatePem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fingerprint := certificateFingerprint(certificate)
+	if len(fingerprint) != 95 || strings.Count(fingerprint, ":") != 31 || strings.ToUpper(fingerprint) != fingerprint {
+		t.Errorf("unexpected fingerprint format: %s", fingerprint)
+	}
+}
@@ -0,0 +1,233 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaOrgGroup = "Org Group"
+
+func resourceVcfaOrgGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaOrgGroupCreate,
+		ReadContext:   resourceVcfaOrgGroupRead,
+		UpdateContext: resourceVcfaOrgGroupUpdate,
+		DeleteContext: resourceVcfaOrgGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaOrgGroupImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Parent %s ID for %s", labelVcfaOrg, labelVcfaOrgGroup),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Name of the %s, as it is known by the identity provider", labelVcfaOrgGroup),
+			},
+			"provider_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{govcd.OrgUserProviderIntegrated, govcd.OrgUserProviderSAML, govcd.OrgUserProviderOAUTH}, false),
+				Description:  fmt.Sprintf("Identity provider of the %s. One of 'INTEGRATED' (LDAP), 'SAML' or 'OAUTH' (OIDC)", labelVcfaOrgGroup),
+			},
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("ID of the %s granted to the members of the %s", labelVcfaRole, labelVcfaOrgGroup),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Description of the %s", labelVcfaOrgGroup),
+			},
+			"user_names": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: fmt.Sprintf("Names of the users that logged in as members of the %s", labelVcfaOrgGroup),
+			},
+		},
+	}
+}
+
+func resourceVcfaOrgGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	adminOrg, err := tmClient.GetAdminOrgById(d.Get("org_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
+	}
+
+	roleReference, err := getOrgGroupRoleReference(adminOrg, d.Get("role_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group, err := adminOrg.CreateGroup(&types.Group{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		ProviderType: d.Get("provider_type").(string),
+		Role:         roleReference,
+	})
+	if err != nil {
+		return diag.Errorf("error creating %s '%s': %s", labelVcfaOrgGroup, d.Get("name").(string), err)
+	}
+	d.SetId(group.Group.ID)
+
+	return resourceVcfaOrgGroupRead(ctx, d, meta)
+}
+
+func resourceVcfaOrgGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	adminOrg, err := tmClient.GetAdminOrgById(d.Get("org_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
+	}
+
+	group, err := adminOrg.GetGroupById(d.Id(), true)
+	if err != nil {
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaOrgGroup, d.Get("name").(string), err)
+	}
+
+	group.Group.Description = d.Get("description").(string)
+	if d.HasChange("role_id") {
+		group.Group.Role, err = getOrgGroupRoleReference(adminOrg, d.Get("role_id").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := group.Update(); err != nil {
+		return diag.Errorf("error updating %s '%s': %s", labelVcfaOrgGroup, group.Group.Name, err)
+	}
+
+	return resourceVcfaOrgGroupRead(ctx, d, meta)
+}
+
+func resourceVcfaOrgGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	adminOrg, err := tmClient.GetAdminOrgById(d.Get("org_id").(string))
+	if err != nil {
+		if govcd.ContainsNotFound(err) { // Org no longer exists, therefore the group is also gone
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
+	}
+
+	group, err := adminOrg.GetGroupById(d.Id(), false)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaOrgGroup, d.Get("name").(string), err)
+	}
+
+	if err := setOrgGroupData(adminOrg, d, group.Group); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceVcfaOrgGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	adminOrg, err := tmClient.GetAdminOrgById(d.Get("org_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
+	}
+
+	group, err := adminOrg.GetGroupById(d.Id(), false)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaOrgGroup, d.Get("name").(string), err)
+	}
+
+	if err := group.Delete(); err != nil {
+		return diag.Errorf("error deleting %s '%s': %s", labelVcfaOrgGroup, group.Group.Name, err)
+	}
+	return nil
+}
+
+func resourceVcfaOrgGroupImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	idSlice := SplitImportId(d.Id(), -1)
+	if len(idSlice) != 2 {
+		return nil, fmt.Errorf("expected import ID to be <org name>%s<group name>", ImportSeparator)
+	}
+
+	adminOrg, err := tmClient.GetAdminOrgByName(idSlice[0])
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s: %s", labelVcfaOrg, err)
+	}
+
+	group, err := adminOrg.GetGroupByName(idSlice[1], false)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaOrgGroup, idSlice[1], err)
+	}
+
+	d.SetId(group.Group.ID)
+	dSet(d, "org_id", adminOrg.AdminOrg.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getOrgGroupRoleReference returns the reference to the given Role that groups require, as they refer to it by HREF
+func getOrgGroupRoleReference(adminOrg *govcd.AdminOrg, roleId string) (*types.Reference, error) {
+	role, err := adminOrg.GetRoleById(roleId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaRole, roleId, err)
+	}
+	reference, err := adminOrg.GetRoleReference(role.Role.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving reference of %s '%s': %s", labelVcfaRole, role.Role.Name, err)
+	}
+	return reference, nil
+}
+
+func setOrgGroupData(adminOrg *govcd.AdminOrg, d *schema.ResourceData, group *types.Group) error {
+	dSet(d, "name", group.Name)
+	dSet(d, "description", group.Description)
+	dSet(d, "provider_type", group.ProviderType)
+
+	// The group refers to its Role by HREF, so the ID of the Role is retrieved by name
+	roleId := ""
+	if group.Role != nil && group.Role.Name != "" {
+		role, err := adminOrg.GetRoleByName(group.Role.Name)
+		if err != nil {
+			return fmt.Errorf("error retrieving %s '%s' of %s '%s': %s", labelVcfaRole, group.Role.Name, labelVcfaOrgGroup, group.Name, err)
+		}
+		roleId = role.Role.ID
+	}
+	dSet(d, "role_id", roleId)
+
+	var userNames []string
+	if group.UsersList != nil {
+		for _, user := range group.UsersList.UserReference {
+			if user != nil {
+				userNames = append(userNames, user.Name)
+			}
+		}
+	}
+	if err := d.Set("user_names", userNames); err != nil {
+		return fmt.Errorf("error storing 'user_names': %s", err)
+	}
+	return nil
+}
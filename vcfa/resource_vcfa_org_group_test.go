@@ -0,0 +1,103 @@
+//go:build ldap || org || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaOrgGroup tests the import of an LDAP group into an Organization, and the update of its Role
+func TestAccVcfaOrgGroup(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	if testConfig.Ldap.Host == "" || testConfig.Ldap.Username == "" || testConfig.Ldap.Password == "" || testConfig.Ldap.Type == "" ||
+		testConfig.Ldap.Port == 0 || testConfig.Ldap.BaseDistinguishedName == "" || testConfig.Ldap.GroupName == "" {
+		t.Skip("LDAP testing configuration with a group name is required")
+	}
+
+	var params = StringMap{
+		"Org":                       testConfig.Tm.Org,
+		"LdapServer":                testConfig.Ldap.Host,
+		"LdapPort":                  testConfig.Ldap.Port,
+		"LdapIsSsl":                 testConfig.Ldap.IsSsl,
+		"LdapUsername":              testConfig.Ldap.Username,
+		"LdapPassword":              testConfig.Ldap.Password,
+		"LdapType":                  testConfig.Ldap.Type,
+		"LdapBaseDistinguishedName": testConfig.Ldap.BaseDistinguishedName,
+		"CustomUiLabel":             " ",
+		"GroupName":                 testConfig.Ldap.GroupName,
+		"RoleName":                  "Organization User",
+		"Description":               "Imported from LDAP",
+		"Tags":                      "ldap org",
+	}
+	testParamsNotEmpty(t, params)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaOrgGroup, params)
+
+	params["FuncName"] = t.Name() + "-step2"
+	params["RoleName"] = "Organization Administrator"
+	params["Description"] = "Imported from LDAP - updated"
+	configText2 := templateFill(testAccVcfaOrgGroup, params)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	groupDef := "vcfa_org_group.group"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(groupDef, "org_id", "vcfa_org.org1", "id"),
+					resource.TestCheckResourceAttr(groupDef, "name", params["GroupName"].(string)),
+					resource.TestCheckResourceAttr(groupDef, "provider_type", "INTEGRATED"),
+					resource.TestCheckResourceAttr(groupDef, "description", "Imported from LDAP"),
+					resource.TestCheckResourceAttrPair(groupDef, "role_id", "data.vcfa_role.role", "id"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(groupDef, "description", "Imported from LDAP - updated"),
+					resource.TestCheckResourceAttrPair(groupDef, "role_id", "data.vcfa_role.role", "id"),
+				),
+			},
+			{
+				ResourceName:      groupDef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     params["Org"].(string) + ImportSeparator + params["GroupName"].(string),
+			},
+		},
+	})
+}
+
+const testAccVcfaOrgGroup = testAccVcfaOrgLdap + `
+data "vcfa_role" "role" {
+  org_id = vcfa_org.org1.id
+  name   = "{{.RoleName}}"
+}
+
+resource "vcfa_org_group" "group" {
+  org_id        = vcfa_org.org1.id
+  name          = "{{.GroupName}}"
+  provider_type = "INTEGRATED"
+  role_id       = data.vcfa_role.role.id
+  description   = "{{.Description}}"
+
+  depends_on = [vcfa_org_ldap.ldap]
+}
+`
@@ -231,6 +231,8 @@ func resourceVcfaOrgLdap() *schema.Resource {
 								`It is inspected on create and modify. ` +
 								`On modify, the absence of this element indicates that the password should not be changed`,
 						},
+						"password_wo":         writeOnlySecretSchema("custom_settings.0.password", "Password for the user identified by UserName"),
+						"password_wo_version": writeOnlySecretVersionSchema("custom_settings.0.password"),
 						"custom_ui_button_label": { // CustomUiButtonLabel
 							Type:        schema.TypeString,
 							Optional:    true,
@@ -278,6 +280,11 @@ func resourceVcfaOrgLdapCreateOrUpdate(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("[Org LDAP %s] error setting org '%s' LDAP configuration: %s", origin, orgId, err)
 	}
 
+	// The write-only password must not be saved in the state
+	if settings.CustomOrgLdapSettings != nil && isWriteOnlySecretSet(d, "custom_settings.0.password") {
+		settings.CustomOrgLdapSettings.Password = ""
+	}
+
 	return genericVcfaOrgLdapRead(ctx, d, meta, origin, settings)
 }
 
@@ -416,7 +423,7 @@ func fillOrgLdapSettings(d *schema.ResourceData) (*types.OrgLdapSettingsType, er
 		IsSsl:                   customSettingsMap["is_ssl"].(bool),
 		SearchBase:              customSettingsMap["base_distinguished_name"].(string),
 		Username:                customSettingsMap["username"].(string),
-		Password:                customSettingsMap["password"].(string),
+		Password:                getSecretArgument(d, "custom_settings.0.password"),
 		AuthenticationMechanism: "SIMPLE", // Only SIMPLE is allowed in UI
 		ConnectorType:           customSettingsMap["connector_type"].(string),
 	}
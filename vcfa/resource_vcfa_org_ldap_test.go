@@ -106,7 +106,7 @@ func TestAccVcfaOrgLdap(t *testing.T) {
 				Config: configTextDS,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckOrgLdapExists(ldapResourceDef),
-					resourceFieldsEqual(ldapResourceDef, ldapDatasourceDef, []string{"%", "auto_trust_certificate", "custom_settings.0.%", "custom_settings.0.password"}),
+					resourceFieldsEqual(ldapResourceDef, ldapDatasourceDef, []string{"%", "auto_trust_certificate", "custom_settings.0.%", "custom_settings.0.password", "custom_settings.0.password_wo_version"}),
 				),
 			},
 			{
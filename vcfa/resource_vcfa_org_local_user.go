@@ -7,7 +7,6 @@ package vcfa
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -46,10 +45,19 @@ func resourceVcfaLocalUser() *schema.Resource {
 				Description: fmt.Sprintf("%s username", labelLocalUser),
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: fmt.Sprintf("Password for %s", labelLocalUser),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"password", "password_wo"},
+				Description:  fmt.Sprintf("Password for %s", labelLocalUser),
+			},
+			"password_wo":         writeOnlySecretSchema("password", fmt.Sprintf("Password for %s", labelLocalUser)),
+			"password_wo_version": writeOnlySecretVersionSchema("password"),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: fmt.Sprintf("Whether the %s can log in", labelLocalUser),
 			},
 		},
 	}
@@ -142,7 +150,7 @@ func resourceVcfaLocalUserDelete(ctx context.Context, d *schema.ResourceData, me
 func resourceVcfaLocalUserImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	idSlice := strings.Split(d.Id(), ImportSeparator)
+	idSlice := SplitImportId(d.Id(), -1)
 	if len(idSlice) != 2 {
 		return nil, fmt.Errorf("expected import ID to be <org name>%s<user name>", ImportSeparator)
 	}
@@ -178,9 +186,10 @@ func getLocalUserType(tmClient *VCDClient, d *schema.ResourceData) (*types.OpenA
 	t := &types.OpenApiUser{
 		OrgEntityRef:   &types.OpenApiReference{ID: d.Get("org_id").(string), Name: org.TmOrg.Name},
 		Username:       d.Get("username").(string),
-		Password:       d.Get("password").(string),
+		Password:       getSecretArgument(d, "password"),
 		ProviderType:   "LOCAL",
 		RoleEntityRefs: convertSliceOfStringsToOpenApiReferenceIds(roleSet),
+		Enabled:        addrOf(d.Get("enabled").(bool)),
 		Locked:         addrOf(false),
 	}
 
@@ -195,7 +204,7 @@ func getLocalUserType(tmClient *VCDClient, d *schema.ResourceData) (*types.OpenA
 		t.NameInSource = user.User.Username
 
 		// if password has not changed - send exactly '******' to prevent updating password just like UI
-		if !d.HasChange("password") {
+		if !hasSecretArgumentChange(d, "password") {
 			t.Password = "******"
 		}
 	}
@@ -216,6 +225,9 @@ func setLocalUserData(_ *VCDClient, d *schema.ResourceData, user *govcd.OpenApiU
 		return fmt.Errorf("error storing 'role_ids': %s", err)
 	}
 
+	// Users are enabled unless the opposite is stated
+	dSet(d, "enabled", user.User.Enabled == nil || *user.User.Enabled)
+
 	dSet(d, "org_id", "")
 	if user.User.OrgEntityRef != nil {
 		dSet(d, "org_id", user.User.OrgEntityRef.ID)
@@ -49,6 +49,7 @@ func TestAccVcfaOrgLocalUser(t *testing.T) {
 					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "username", "new-"+params["Username"].(string)),
 					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "password", "long-change-ME1"),
 					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "role_ids.#", "1"),
+					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "enabled", "true"),
 					resource.TestCheckResourceAttrPair("vcfa_org_local_user.test", "org_id", "vcfa_org.test", "id"),
 				),
 			},
@@ -58,13 +59,14 @@ func TestAccVcfaOrgLocalUser(t *testing.T) {
 					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "username", params["Username"].(string)),
 					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "password", "long-change-ME1-MORE"),
 					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "role_ids.#", "2"),
+					resource.TestCheckResourceAttr("vcfa_org_local_user.test", "enabled", "false"),
 					resource.TestCheckResourceAttrPair("vcfa_org_local_user.test", "org_id", "vcfa_org.test", "id"),
 				),
 			},
 			{
 				Config: configText3,
 				Check: resource.ComposeTestCheckFunc(
-					resourceFieldsEqual("vcfa_org_local_user.test", "data.vcfa_org_local_user.test", []string{"%", "password"}),
+					resourceFieldsEqual("vcfa_org_local_user.test", "data.vcfa_org_local_user.test", []string{"%", "password", "password_wo_version"}),
 				),
 			},
 			{
@@ -112,6 +114,7 @@ resource "vcfa_org_local_user" "test" {
   role_ids  = [data.vcfa_role.org-user.id, data.vcfa_role.org-admin.id]
   username  = "{{.Username}}"
   password  = "long-change-ME1-MORE"
+  enabled   = false
 }
 `
 
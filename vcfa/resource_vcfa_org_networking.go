@@ -41,9 +41,11 @@ func resourceVcfaOrgNetworking() *schema.Resource {
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, 8)),
 			},
 			"networking_tenancy_enabled": {
-				Type:        schema.TypeBool,
-				Computed:    true,
-				Description: fmt.Sprintf("Whether this %s has tenancy for the network domain in the backing network provider", labelVcfaOrg),
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+				Description: fmt.Sprintf("Whether this %s has tenancy for the network domain in the backing network provider. "+
+					"If not set, the current value is kept", labelVcfaOrg),
 			},
 		},
 	}
@@ -75,7 +77,7 @@ func resourceVcfaOrgNetworkingCreateUpdate(ctx context.Context, d *schema.Resour
 
 func resourceVcfaOrgNetworkingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
-	org, err := tmClient.GetTmOrgById(d.Get("org_id").(string))
+	org, err := getCachedTmOrgById(meta, d.Get("org_id").(string))
 	if err != nil {
 		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
 	}
@@ -107,7 +109,7 @@ func resourceVcfaOrgNetworkingDelete(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
 	}
 
-	// reset settings
+	// reset settings. Networking tenancy is left untouched, as it can't be disabled while there are networks using it
 	resetSettings := &types.TmOrgNetworkingSettings{
 		OrgNameForLogs: "",
 	}
@@ -136,8 +138,10 @@ func resourceVcfaOrgNetworkingImport(ctx context.Context, d *schema.ResourceData
 func getOrgNetworkingSettingsType(_ *VCDClient, d *schema.ResourceData) (*types.TmOrgNetworkingSettings, error) {
 	t := &types.TmOrgNetworkingSettings{
 		OrgNameForLogs: d.Get("log_name").(string),
-		// No setting for it in UI
-		// NetworkingTenancyEnabled: addrOf(d.Get("networking_tenancy_enabled").(bool)),
+	}
+	// There is no setting for it in UI, so it is only sent when it is explicitly set, and the current value is kept otherwise
+	if v := d.GetRawConfig().GetAttr("networking_tenancy_enabled"); !v.IsNull() {
+		t.NetworkingTenancyEnabled = addrOf(v.True())
 	}
 
 	return t, nil
@@ -65,6 +65,7 @@ func TestAccVcfaOrgNetworking(t *testing.T) {
 					resource.TestCheckResourceAttr("vcfa_org.test", "name", t.Name()+""),
 					resource.TestCheckResourceAttrPair("vcfa_org.test", "id", "vcfa_org_networking.test", "id"),
 					resource.TestCheckResourceAttr("vcfa_org_networking.test", "log_name", "l-one-u"),
+					resource.TestCheckResourceAttr("vcfa_org_networking.test", "networking_tenancy_enabled", "true"),
 				),
 			},
 			{
@@ -120,8 +121,9 @@ resource "vcfa_org" "test" {
 }
 
 resource "vcfa_org_networking" "test" {
-  org_id   = vcfa_org.test.id
-  log_name = "l-one-u"
+  org_id                     = vcfa_org.test.id
+  log_name                   = "l-one-u"
+  networking_tenancy_enabled = true
 }
 `
 
@@ -44,11 +44,15 @@ func resourceVcfaOrgOidc() *schema.Resource {
 				Description: fmt.Sprintf("Client ID to use when talking to the %s Identity Provider", labelVcfaOidc),
 			},
 			"client_secret": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: fmt.Sprintf("Client Secret to use when talking to the %s Identity Provider", labelVcfaOidc),
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"client_secret", "client_secret_wo"},
+				Description:  fmt.Sprintf("Client Secret to use when talking to the %s Identity Provider", labelVcfaOidc),
 			},
+			"client_secret_wo": writeOnlySecretSchema("client_secret",
+				fmt.Sprintf("Client Secret to use when talking to the %s Identity Provider", labelVcfaOidc)),
+			"client_secret_wo_version": writeOnlySecretVersionSchema("client_secret"),
 			"enabled": {
 				Type:        schema.TypeBool,
 				Required:    true,
@@ -286,7 +290,7 @@ func resourceVcfaOrgOidcCreateOrUpdate(ctx context.Context, d *schema.ResourceDa
 		IssuerId:                   d.Get("issuer_id").(string),
 		Enabled:                    d.Get("enabled").(bool),
 		ClientId:                   d.Get("client_id").(string),
-		ClientSecret:               d.Get("client_secret").(string),
+		ClientSecret:               getSecretArgument(d, "client_secret"),
 		UserAuthorizationEndpoint:  d.Get("user_authorization_endpoint").(string),
 		AccessTokenEndpoint:        d.Get("access_token_endpoint").(string),
 		UserInfoEndpoint:           d.Get("userinfo_endpoint").(string),
@@ -350,7 +354,7 @@ func resourceVcfaOrgOidcCreateOrUpdate(ctx context.Context, d *schema.ResourceDa
 		settings.CustomUiButtonLabel = addrOf(v.(string))
 	}
 
-	_, err = setOIDCSettings(org, settings)
+	_, err = setOIDCSettings(ctx, org, settings)
 	if err != nil {
 		return diag.Errorf("[%s %s] Could not set OIDC settings: %s", labelVcfaOidc, operation, err)
 	}
@@ -388,7 +392,10 @@ func genericVcfaOrgOidcRead(_ context.Context, d *schema.ResourceData, meta inte
 	}
 
 	dSet(d, "client_id", settings.ClientId)
-	dSet(d, "client_secret", settings.ClientSecret)
+	// The secret is not saved when it is set with the write-only argument
+	if origin != "resource" || d.Get("client_secret_wo_version").(int) == 0 {
+		dSet(d, "client_secret", settings.ClientSecret)
+	}
 	dSet(d, "enabled", settings.Enabled)
 	dSet(d, "wellknown_endpoint", settings.WellKnownEndpoint)
 	dSet(d, "issuer_id", settings.IssuerId)
@@ -492,7 +499,7 @@ func resourceVcfaOrgOidcImport(_ context.Context, d *schema.ResourceData, meta i
 
 // setOIDCSettings sets the given OIDC settings for the given Organization. It does this operation
 // with some tries to avoid failures due to network glitches.
-func setOIDCSettings(adminOrg *govcd.AdminOrg, settings types.OrgOAuthSettings) (*types.OrgOAuthSettings, error) {
+func setOIDCSettings(ctx context.Context, adminOrg *govcd.AdminOrg, settings types.OrgOAuthSettings) (*types.OrgOAuthSettings, error) {
 	tries := 0
 	var newSettings *types.OrgOAuthSettings
 	var err error
@@ -503,7 +510,9 @@ func setOIDCSettings(adminOrg *govcd.AdminOrg, settings types.OrgOAuthSettings)
 			break
 		}
 		if strings.Contains(err.Error(), "could not establish a connection") || strings.Contains(err.Error(), "connect timed out") {
-			time.Sleep(10 * time.Second)
+			if sleepErr := sleepWithContext(ctx, 10*time.Second); sleepErr != nil {
+				return nil, sleepErr
+			}
 		}
 	}
 	if err != nil {
@@ -128,7 +128,7 @@ func TestAccVcfaOrgOidc(t *testing.T) {
 				Config:   step3,
 				SkipFunc: skipFunc,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resourceFieldsEqual(oidcResource1, oidcData, nil),
+					resourceFieldsEqual(oidcResource1, oidcData, []string{"client_secret_wo_version"}),
 				),
 			},
 			{
@@ -7,7 +7,6 @@ package vcfa
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -280,6 +279,9 @@ func saveRegionStoragePoliciesInState(d *schema.ResourceData, regionQuota *govcd
 		spAttr["storage_limit_mib"] = int(sp.VirtualDatacenterStoragePolicy.StorageLimitMiB)
 		spAttr["name"] = sp.VirtualDatacenterStoragePolicy.Name
 		spAttr["storage_used_mib"] = int(sp.VirtualDatacenterStoragePolicy.StorageUsedMiB)
+		if origin != "resource" {
+			spAttr["storage_remaining_mib"] = remainingCapacity(spAttr["storage_limit_mib"].(int), spAttr["storage_used_mib"].(int))
+		}
 		spsAttr[i] = spAttr
 	}
 
@@ -383,7 +385,7 @@ func resourceVcfaOrgRegionQuotaDelete(ctx context.Context, d *schema.ResourceDat
 func resourceVcfaOrgRegionQuotaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	idSlice := strings.Split(d.Id(), ImportSeparator)
+	idSlice := SplitImportId(d.Id(), -1)
 	if len(idSlice) != 2 {
 		return nil, fmt.Errorf("expected import ID to be <org name>%s<region name>", ImportSeparator)
 	}
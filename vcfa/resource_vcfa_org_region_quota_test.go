@@ -121,6 +121,15 @@ func TestAccVcfaOrgRegionQuota(t *testing.T) {
 				Config: configText3,
 				Check: resource.ComposeTestCheckFunc(
 					resourceFieldsEqual("vcfa_org_region_quota.test", "data.vcfa_org_region_quota.test", nil),
+					resource.TestCheckResourceAttrSet("data.vcfa_org_region_quota.test", "zone_resource_allocations.0.cpu_limit_remaining_mhz"),
+					resource.TestCheckResourceAttrSet("data.vcfa_org_region_quota.test", "zone_resource_allocations.0.memory_limit_remaining_mib"),
+					resource.TestCheckResourceAttrSet("data.vcfa_org_region_quota.test", "region_storage_policy.0.storage_remaining_mib"),
+					resource.TestCheckTypeSetElemAttrPair("data.vcfa_region_storage_policies.all", "names.*", "data.vcfa_region_storage_policy.sp", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcfa_region_storage_policies.all", "storage_policies.*", map[string]string{
+						"name":   params["StorageClass"].(string),
+						"status": "READY",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.vcfa_region_vm_classes.all", "vm_classes.*.id", "vcfa_org_region_quota.test", "region_vm_class_ids.0"),
 				),
 			},
 			{
@@ -227,4 +236,12 @@ data "vcfa_org_region_quota" "test" {
   org_id    = vcfa_org_region_quota.test.org_id
   region_id = vcfa_org_region_quota.test.region_id
 }
+
+data "vcfa_region_storage_policies" "all" {
+  region_id = {{.RegionId}}
+}
+
+data "vcfa_region_vm_classes" "all" {
+  region_id = {{.RegionId}}
+}
 `
@@ -7,7 +7,6 @@ package vcfa
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -115,7 +114,7 @@ func resourceVcfaOrgRegionalNetworkingDelete(ctx context.Context, d *schema.Reso
 func resourceVcfaOrgRegionalNetworkingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	id := strings.Split(d.Id(), ImportSeparator)
+	id := SplitImportId(d.Id(), -1)
 	if len(id) != 2 {
 		return nil, fmt.Errorf("ID syntax should be <%s name>%s<%s name>", labelVcfaOrg, ImportSeparator, labelVcfaRegionalNetworkingSetting)
 	}
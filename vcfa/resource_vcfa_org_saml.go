@@ -0,0 +1,207 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaSaml = "SAML"
+
+// orgSamlAttributes maps the arguments of the SAML attribute names to the fields of the federation settings
+var orgSamlAttributes = []struct {
+	key         string
+	description string
+	field       func(settings *types.OrgFederationSettings) *string
+}{
+	{"email_attribute", "email address", func(s *types.OrgFederationSettings) *string { return &s.SamlAttributeMapping.EmailAttributeName }},
+	{"user_name_attribute", "user name", func(s *types.OrgFederationSettings) *string { return &s.SamlAttributeMapping.UserNameAttributeName }},
+	{"first_name_attribute", "first name", func(s *types.OrgFederationSettings) *string { return &s.SamlAttributeMapping.FirstNameAttributeName }},
+	{"surname_attribute", "surname", func(s *types.OrgFederationSettings) *string { return &s.SamlAttributeMapping.SurnameAttributeName }},
+	{"full_name_attribute", "full name", func(s *types.OrgFederationSettings) *string { return &s.SamlAttributeMapping.FullNameAttributeName }},
+	{"group_attribute", "groups", func(s *types.OrgFederationSettings) *string { return &s.SamlAttributeMapping.GroupAttributeName }},
+	{"role_attribute", "roles", func(s *types.OrgFederationSettings) *string { return &s.SamlAttributeMapping.RoleAttributeName }},
+}
+
+// resourceVcfaOrgSaml defines the resource that manages the SAML identity provider of an Organization
+func resourceVcfaOrgSaml() *schema.Resource {
+	samlSchema := map[string]*schema.Schema{
+		"org_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: fmt.Sprintf("ID of the %s that uses the %s identity provider", labelVcfaOrg, labelVcfaSaml),
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: fmt.Sprintf("Enables or disables the %s identity provider", labelVcfaSaml),
+		},
+		"entity_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("Service provider entity ID of the %s, by which the identity provider knows it", labelVcfaOrg),
+		},
+		"identity_provider_metadata_file": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"identity_provider_metadata_file", "identity_provider_metadata_text"},
+			Description:  fmt.Sprintf("Name of the file with the metadata XML of the %s identity provider", labelVcfaSaml),
+		},
+		"identity_provider_metadata_text": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"identity_provider_metadata_file", "identity_provider_metadata_text"},
+			// VCFA does not keep the surrounding blank lines of the metadata
+			DiffSuppressFunc: func(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+				return strings.TrimSpace(oldValue) == strings.TrimSpace(newValue)
+			},
+			Description: fmt.Sprintf("Metadata XML of the %s identity provider", labelVcfaSaml),
+		},
+		"service_provider_metadata": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("Metadata XML of the %s as service provider, to register it in the identity provider", labelVcfaOrg),
+		},
+	}
+	for _, attribute := range orgSamlAttributes {
+		samlSchema[attribute.key] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Name of the %s attribute that contains the %s of the users", labelVcfaSaml, attribute.description),
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceVcfaOrgSamlCreateOrUpdate,
+		ReadContext:   resourceVcfaOrgSamlRead,
+		UpdateContext: resourceVcfaOrgSamlCreateOrUpdate,
+		DeleteContext: resourceVcfaOrgSamlDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaOrgSamlImport,
+		},
+		Schema: samlSchema,
+	}
+}
+
+func resourceVcfaOrgSamlCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+
+	adminOrg, err := tmClient.GetAdminOrgById(orgId)
+	if err != nil {
+		return diag.Errorf("[%s] error searching for Organization '%s': %s", labelVcfaSaml, orgId, err)
+	}
+
+	metadata := d.Get("identity_provider_metadata_text").(string)
+	if fileName := d.Get("identity_provider_metadata_file").(string); fileName != "" {
+		contents, err := os.ReadFile(fileName) // #nosec G304 -- the file is provided by the user
+		if err != nil {
+			return diag.Errorf("[%s] error reading identity provider metadata file '%s': %s", labelVcfaSaml, fileName, err)
+		}
+		metadata = string(contents)
+	}
+
+	settings, err := adminOrg.GetFederationSettings()
+	if err != nil {
+		return diag.Errorf("[%s] error retrieving settings of Organization '%s': %s", labelVcfaSaml, adminOrg.AdminOrg.Name, err)
+	}
+	settings.Enabled = d.Get("enabled").(bool)
+	settings.SAMLMetadata = metadata
+	if entityId := d.Get("entity_id").(string); entityId != "" {
+		settings.SamlSPEntityID = entityId
+	}
+	for _, attribute := range orgSamlAttributes {
+		*attribute.field(settings) = d.Get(attribute.key).(string)
+	}
+
+	if _, err := adminOrg.SetFederationSettings(settings); err != nil {
+		return diag.Errorf("[%s] error setting the identity provider of Organization '%s': %s", labelVcfaSaml, adminOrg.AdminOrg.Name, err)
+	}
+	d.SetId(adminOrg.AdminOrg.ID)
+
+	return resourceVcfaOrgSamlRead(ctx, d, meta)
+}
+
+func resourceVcfaOrgSamlRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+
+	adminOrg, err := tmClient.GetAdminOrgById(orgId)
+	if govcd.ContainsNotFound(err) {
+		log.Printf("[INFO] unable to find Organization '%s' %s settings: %s. Removing from state", orgId, labelVcfaSaml, err)
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("[%s read] error searching for Organization '%s': %s", labelVcfaSaml, orgId, err)
+	}
+
+	settings, err := adminOrg.GetFederationSettings()
+	if err != nil {
+		return diag.Errorf("[%s read] error retrieving settings of Organization '%s': %s", labelVcfaSaml, adminOrg.AdminOrg.Name, err)
+	}
+
+	dSet(d, "enabled", settings.Enabled)
+	dSet(d, "entity_id", settings.SamlSPEntityID)
+	// When the metadata comes from a file, the file name is kept instead
+	if d.Get("identity_provider_metadata_file").(string) == "" {
+		dSet(d, "identity_provider_metadata_text", settings.SAMLMetadata)
+	}
+	for _, attribute := range orgSamlAttributes {
+		dSet(d, attribute.key, *attribute.field(settings))
+	}
+
+	serviceProviderMetadata, err := adminOrg.RetrieveServiceProviderSamlMetadata()
+	if err != nil {
+		return diag.Errorf("[%s read] error retrieving service provider metadata of Organization '%s': %s", labelVcfaSaml, adminOrg.AdminOrg.Name, err)
+	}
+	dSet(d, "service_provider_metadata", serviceProviderMetadata)
+
+	d.SetId(adminOrg.AdminOrg.ID)
+	return nil
+}
+
+func resourceVcfaOrgSamlDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	orgId := d.Get("org_id").(string)
+
+	adminOrg, err := tmClient.GetAdminOrgById(orgId)
+	if err != nil {
+		return diag.Errorf("[%s delete] error searching for Organization '%s': %s", labelVcfaSaml, orgId, err)
+	}
+
+	if err := adminOrg.UnsetFederationSettings(); err != nil {
+		return diag.Errorf("[%s delete] error removing the identity provider of Organization '%s': %s", labelVcfaSaml, adminOrg.AdminOrg.Name, err)
+	}
+	return nil
+}
+
+// resourceVcfaOrgSamlImport is responsible for importing the resource.
+// The only parameter needed is the Org identifier, which could be either the Org name or its ID
+func resourceVcfaOrgSamlImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	orgNameOrId := d.Id()
+
+	tmClient := meta.(ClientContainer).tmClient
+	adminOrg, err := tmClient.GetAdminOrgByNameOrId(orgNameOrId)
+	if err != nil {
+		return nil, fmt.Errorf("[%s import] error searching for Organization '%s': %s", labelVcfaSaml, orgNameOrId, err)
+	}
+
+	dSet(d, "org_id", adminOrg.AdminOrg.ID)
+	d.SetId(adminOrg.AdminOrg.ID)
+	return []*schema.ResourceData{d}, nil
+}
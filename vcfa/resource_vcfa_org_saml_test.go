@@ -0,0 +1,108 @@
+//go:build tm || org || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaOrgSaml tests the SAML identity provider of an Organization. As the test doesn't need a working identity
+// provider, the service provider metadata of the System Organization is used as identity provider metadata
+func TestAccVcfaOrgSaml(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	var params = StringMap{
+		"OrgName":        t.Name(),
+		"MetadataFile":   filepath.Join(t.TempDir(), "idp-metadata.xml"),
+		"EmailAttribute": "email",
+		"GroupAttribute": "groups",
+		"Enabled":        "true",
+		"Tags":           "tm org",
+	}
+	testParamsNotEmpty(t, params)
+
+	params["FuncName"] = t.Name() + "-Step1"
+	configText1 := templateFill(testAccVcfaOrgSaml, params)
+	params["FuncName"] = t.Name() + "-Step2"
+	params["GroupAttribute"] = "memberOf"
+	params["Enabled"] = "false"
+	configText2 := templateFill(testAccVcfaOrgSaml, params)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION Step 1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION Step 2: %s\n", configText2)
+
+	tmClient := createTemporaryVCFAConnection(false)
+	systemOrg, err := tmClient.GetAdminOrgByName("System")
+	if err != nil {
+		t.Fatalf("error retrieving System Organization: %s", err)
+	}
+	metadata, err := systemOrg.RetrieveServiceProviderSamlMetadata()
+	if err != nil {
+		t.Fatalf("error retrieving service provider metadata of System Organization: %s", err)
+	}
+	if err := os.WriteFile(params["MetadataFile"].(string), []byte(metadata), 0600); err != nil {
+		t.Fatalf("error writing metadata file: %s", err)
+	}
+
+	samlDef := "vcfa_org_saml.saml"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(samlDef, "id", "vcfa_org.org", "id"),
+					resource.TestCheckResourceAttr(samlDef, "enabled", "true"),
+					resource.TestCheckResourceAttr(samlDef, "email_attribute", "email"),
+					resource.TestCheckResourceAttr(samlDef, "group_attribute", "groups"),
+					resource.TestCheckResourceAttrSet(samlDef, "entity_id"),
+					resource.TestMatchResourceAttr(samlDef, "service_provider_metadata", regexp.MustCompile(`EntityDescriptor`)),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(samlDef, "enabled", "false"),
+					resource.TestCheckResourceAttr(samlDef, "group_attribute", "memberOf"),
+				),
+			},
+			{
+				ResourceName:            samlDef,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           params["OrgName"].(string),
+				ImportStateVerifyIgnore: []string{"identity_provider_metadata_file", "identity_provider_metadata_text"},
+			},
+		},
+	})
+}
+
+const testAccVcfaOrgSaml = `
+resource "vcfa_org" "org" {
+  name         = "{{.OrgName}}"
+  display_name = "{{.OrgName}}"
+  description  = "{{.OrgName}}"
+}
+
+resource "vcfa_org_saml" "saml" {
+  org_id                          = vcfa_org.org.id
+  enabled                         = {{.Enabled}}
+  identity_provider_metadata_file = "{{.MetadataFile}}"
+  email_attribute                 = "{{.EmailAttribute}}"
+  group_attribute                 = "{{.GroupAttribute}}"
+}
+`
@@ -85,7 +85,7 @@ func resourceVcfaOrgSettingsCreateUpdate(ctx context.Context, d *schema.Resource
 
 func resourceVcfaOrgSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tmClient := meta.(ClientContainer).tmClient
-	org, err := tmClient.GetTmOrgById(d.Get("org_id").(string))
+	org, err := getCachedTmOrgById(meta, d.Get("org_id").(string))
 	if err != nil {
 		if govcd.ContainsNotFound(err) { // Org no longer present, removing from state
 			d.SetId("")
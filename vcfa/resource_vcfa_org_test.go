@@ -9,6 +9,7 @@ package vcfa
 import (
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -21,6 +22,8 @@ func TestAccVcfaOrg(t *testing.T) {
 	var params = StringMap{
 		"Testname": t.Name(),
 		"Tags":     "tm org",
+		// Audit Trail events of the last hour, so the ones of this test are included
+		"AuditFrom": time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
 	}
 	testParamsNotEmpty(t, params)
 
@@ -73,7 +76,17 @@ func TestAccVcfaOrg(t *testing.T) {
 			{
 				Config: configText3,
 				Check: resource.ComposeTestCheckFunc(
-					resourceFieldsEqual("vcfa_org.test", "data.vcfa_org.test", nil),
+					resourceFieldsEqual("vcfa_org.test", "data.vcfa_org.test", []string{"%", "normalized_name"}),
+					resource.TestMatchResourceAttr("data.vcfa_org.test", "normalized_name", regexp.MustCompile(`^testaccvcfaorg[a-z0-9-]*$`)),
+					resource.TestCheckResourceAttr("data.vcfa_orgs.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.vcfa_orgs.test", "names.0", params["Testname"].(string)),
+					resource.TestCheckResourceAttrPair("data.vcfa_orgs.test", "orgs.0.id", "vcfa_org.test", "id"),
+					resource.TestCheckResourceAttrPair("data.vcfa_orgs.test", "orgs.0.normalized_name", "data.vcfa_org.test", "normalized_name"),
+					resource.TestMatchResourceAttr("data.vcfa_audit_trail.test", "events.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttr("data.vcfa_audit_trail.test", "events.0.entity_type", "org"),
+					resource.TestCheckResourceAttr("data.vcfa_audit_trail.test", "events.0.status", "SUCCESS"),
+					resource.TestCheckTypeSetElemAttr("data.vcfa_resource_list.test", "import_ids.*", params["Testname"].(string)),
+					resource.TestMatchResourceAttr("data.vcfa_resource_list.test", "import_blocks", regexp.MustCompile(`to = vcfa_org\.`+params["Testname"].(string))),
 
 					// Settings are destroyed
 					resource.TestCheckResourceAttr("data.vcfa_org_settings.allow_ds", "can_create_subscribed_libraries", "false"),
@@ -87,6 +100,12 @@ func TestAccVcfaOrg(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateId:     params["Testname"].(string),
 			},
+			{
+				// Importing by URN, which is the ID of the resource
+				ResourceName:      "vcfa_org.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -124,6 +143,24 @@ data "vcfa_org" "test" {
 data "vcfa_org_settings" "allow_ds" {
   org_id = vcfa_org.test.id
 }
+
+data "vcfa_orgs" "test" {
+  name_regex = "^${vcfa_org.test.name}$"
+}
+
+data "vcfa_audit_trail" "test" {
+  from        = "{{.AuditFrom}}"
+  entity_type = "org"
+  status      = "SUCCESS"
+
+  depends_on = [vcfa_org.test]
+}
+
+data "vcfa_resource_list" "test" {
+  resource_type = "vcfa_org"
+
+  depends_on = [vcfa_org.test]
+}
 `
 
 // TestAccVcfaOrgClassicTenant tests an Organization configured as "Classic Tenant"
@@ -167,7 +204,8 @@ func TestAccVcfaOrgClassicTenant(t *testing.T) {
 			{
 				Config: configText2,
 				Check: resource.ComposeTestCheckFunc(
-					resourceFieldsEqual("vcfa_org.test", "data.vcfa_org.test", nil),
+					resourceFieldsEqual("vcfa_org.test", "data.vcfa_org.test", []string{"%", "normalized_name"}),
+					resource.TestMatchResourceAttr("data.vcfa_org.test", "normalized_name", regexp.MustCompile(`^testaccvcfaorg[a-z0-9-]*$`)),
 				),
 			},
 			{
@@ -7,7 +7,6 @@ package vcfa
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -162,7 +161,8 @@ func resourceVcfaProviderGatewayUpdate(ctx context.Context, d *schema.ResourceDa
 		return updateResource(ctx, d, meta, c)
 	}
 
-	return nil
+	// Only IP Space associations were changed, reading the Provider Gateway to store their current state
+	return resourceVcfaProviderGatewayRead(ctx, d, meta)
 }
 
 func resourceVcfaProviderGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -188,7 +188,16 @@ func resourceVcfaProviderGatewayDelete(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceVcfaProviderGatewayImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	resourceURI := strings.Split(d.Id(), ImportSeparator)
+	if urn, ok := getImportUrn(d.Id(), urnPrefixProviderGateway); ok {
+		providerGateway, err := meta.(ClientContainer).tmClient.GetTmProviderGatewayById(urn)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving Provider Gateway: %s", err)
+		}
+		d.SetId(providerGateway.TmProviderGateway.ID)
+		return []*schema.ResourceData{d}, nil
+	}
+
+	resourceURI := SplitImportId(d.Id(), -1)
 	if len(resourceURI) != 2 {
 		return nil, fmt.Errorf("resource name must be specified as region-name.provider-gateway-name")
 	}
@@ -276,7 +285,7 @@ func setProviderGatewayData(tmClient *VCDClient, d *schema.ResourceData, p *govc
 	// IP Space Associations have to be read separatelly after creation (more details at the top of file)
 	associations, err := tmClient.GetAllTmIpSpaceAssociationsByProviderGatewayId(p.TmProviderGateway.ID)
 	if err != nil {
-		return fmt.Errorf("error retrieving %s for %s", labelVcfaProviderGatewayIpSpaceAssociations, labelVcfaProviderGateway)
+		return fmt.Errorf("error retrieving %s for %s: %s", labelVcfaProviderGatewayIpSpaceAssociations, labelVcfaProviderGateway, err)
 	}
 	associationIds := make([]string, len(associations))
 	for index, singleAssociation := range associations {
@@ -317,7 +326,8 @@ func removeIpSpaceAssociations(tmClient *VCDClient, providerGatewayId string, re
 		for _, singleAssociation := range existingIpSpaceAssociations {
 			if singleAssociation.TmIpSpaceAssociation.IPSpaceRef.ID == singleIpSpaceId {
 				err = singleAssociation.Delete()
-				if err != nil {
+				// The association might have been removed already, together with its IP Space
+				if err != nil && !govcd.ContainsNotFound(err) {
 					return fmt.Errorf("error removing %s '%s' for %s '%s': %s",
 						labelVcfaProviderGatewayIpSpaceAssociations, singleAssociation.TmIpSpaceAssociation.ID, labelVcfaIpSpace, singleIpSpaceId, err)
 				}
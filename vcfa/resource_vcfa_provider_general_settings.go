@@ -0,0 +1,209 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+const labelVcfaProviderGeneralSettings = "Provider General Settings"
+
+// providerGeneralSettingsId is the static ID of the singleton general settings
+const providerGeneralSettingsId = "Provider General Settings"
+
+func resourceVcfaProviderGeneralSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaProviderGeneralSettingsCreateUpdate,
+		ReadContext:   resourceVcfaProviderGeneralSettingsRead,
+		UpdateContext: resourceVcfaProviderGeneralSettingsCreateUpdate,
+		DeleteContext: resourceVcfaProviderGeneralSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaProviderGeneralSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"session_timeout_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Idle time, in minutes, after which a session is terminated",
+			},
+			"absolute_session_timeout_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum lifetime, in minutes, of a session regardless of activity",
+			},
+			"api_explorer_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the API Explorer is available to users",
+			},
+			"show_stack_traces": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether error responses include stack traces",
+			},
+		},
+	}
+}
+
+func resourceVcfaProviderGeneralSettingsCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	// Settings that are not specified in the configuration must be preserved, hence the
+	// current payload, including the settings that this resource doesn't manage, is retrieved
+	// and only the configured ones are overridden
+	payload, err := getProviderGeneralSettingsPayload(tmClient)
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaProviderGeneralSettings, err)
+	}
+
+	settings := &vcfatypes.GeneralSettings{}
+	setProviderGeneralSettingsType(d, settings)
+	err = mergeProviderGeneralSettings(payload, settings)
+	if err != nil {
+		return diag.Errorf("error updating %s: %s", labelVcfaProviderGeneralSettings, err)
+	}
+
+	err = updateProviderGeneralSettings(tmClient, payload)
+	if err != nil {
+		return diag.Errorf("error updating %s: %s", labelVcfaProviderGeneralSettings, err)
+	}
+
+	return resourceVcfaProviderGeneralSettingsRead(ctx, d, meta)
+}
+
+func resourceVcfaProviderGeneralSettingsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	settings, err := getProviderGeneralSettings(tmClient)
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaProviderGeneralSettings, err)
+	}
+
+	setProviderGeneralSettingsData(d, settings)
+	return nil
+}
+
+func resourceVcfaProviderGeneralSettingsDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// General settings can't be removed and there are no safe defaults to go back to, hence
+	// the current values are kept in the system and the resource is only removed from state
+	log.Printf("[DEBUG] %s are kept in the system after removal from Terraform state", labelVcfaProviderGeneralSettings)
+	return nil
+}
+
+func resourceVcfaProviderGeneralSettingsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	// This is a no-op as read comes after and nothing is needed
+	d.SetId(providerGeneralSettingsId)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getProviderGeneralSettings retrieves the system-wide general settings
+func getProviderGeneralSettings(tmClient *VCDClient) (*vcfatypes.GeneralSettings, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.GeneralSettingsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &vcfatypes.GeneralSettings{}
+	err = client.OpenApiGetItem(minVcfaApiVersion, urlRef, nil, settings, nil)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// getProviderGeneralSettingsPayload retrieves the system-wide general settings with all the fields
+// returned by VCFA, so they can be sent back without losing the ones that are not in
+// vcfatypes.GeneralSettings
+func getProviderGeneralSettingsPayload(tmClient *VCDClient) (map[string]json.RawMessage, error) {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.GeneralSettingsEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]json.RawMessage{}
+	err = client.OpenApiGetItem(minVcfaApiVersion, urlRef, nil, &payload, nil)
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// updateProviderGeneralSettings sends the given payload of system-wide general settings
+func updateProviderGeneralSettings(tmClient *VCDClient, payload map[string]json.RawMessage) error {
+	client := tmClient.VCDClient.Client
+	urlRef, err := client.OpenApiBuildEndpoint(vcfatypes.GeneralSettingsEndpoint)
+	if err != nil {
+		return err
+	}
+
+	return client.OpenApiPutItem(minVcfaApiVersion, urlRef, nil, payload, nil, nil)
+}
+
+// mergeProviderGeneralSettings overrides the fields of the payload that are set in the given
+// settings, keeping any other field as it is
+func mergeProviderGeneralSettings(payload map[string]json.RawMessage, settings *vcfatypes.GeneralSettings) error {
+	// Unset fields are omitted when marshalling, so only the configured ones are overridden
+	settingsJson, err := json.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("error marshalling %s: %s", labelVcfaProviderGeneralSettings, err)
+	}
+	overrides := map[string]json.RawMessage{}
+	err = json.Unmarshal(settingsJson, &overrides)
+	if err != nil {
+		return fmt.Errorf("error unmarshalling %s: %s", labelVcfaProviderGeneralSettings, err)
+	}
+	for key, value := range overrides {
+		payload[key] = value
+	}
+	return nil
+}
+
+func setProviderGeneralSettingsType(d *schema.ResourceData, settings *vcfatypes.GeneralSettings) {
+	if v, ok := d.GetOk("session_timeout_minutes"); ok {
+		settings.SessionTimeoutMinutes = addrOf(v.(int))
+	}
+	if v, ok := d.GetOk("absolute_session_timeout_minutes"); ok {
+		settings.AbsoluteSessionTimeoutMinutes = addrOf(v.(int))
+	}
+	// GetOk can't be used with booleans, as 'false' is considered empty
+	if v := d.GetRawConfig().GetAttr("api_explorer_enabled"); !v.IsNull() {
+		settings.ApiExplorerEnabled = addrOf(v.True())
+	}
+	if v := d.GetRawConfig().GetAttr("show_stack_traces"); !v.IsNull() {
+		settings.ShowStackTraces = addrOf(v.True())
+	}
+}
+
+func setProviderGeneralSettingsData(d *schema.ResourceData, settings *vcfatypes.GeneralSettings) {
+	d.SetId(providerGeneralSettingsId) // We don't need an ID
+	if settings.SessionTimeoutMinutes != nil {
+		dSet(d, "session_timeout_minutes", *settings.SessionTimeoutMinutes)
+	}
+	if settings.AbsoluteSessionTimeoutMinutes != nil {
+		dSet(d, "absolute_session_timeout_minutes", *settings.AbsoluteSessionTimeoutMinutes)
+	}
+	if settings.ApiExplorerEnabled != nil {
+		dSet(d, "api_explorer_enabled", *settings.ApiExplorerEnabled)
+	}
+	if settings.ShowStackTraces != nil {
+		dSet(d, "show_stack_traces", *settings.ShowStackTraces)
+	}
+}
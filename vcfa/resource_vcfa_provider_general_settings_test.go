@@ -0,0 +1,112 @@
+//go:build tm || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVcfaProviderGeneralSettings(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	var params = StringMap{
+		"Tags": "tm",
+	}
+	testParamsNotEmpty(t, params)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaProviderGeneralSettingsStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(testAccVcfaProviderGeneralSettingsStep2, params)
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(testAccVcfaProviderGeneralSettingsStep3DS, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	debugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	// Settings are not reverted on removal, so the original ones are restored once the test finishes
+	tmClient := createTemporaryVCFAConnection(false)
+	originalSettings, err := getProviderGeneralSettingsPayload(tmClient)
+	if err != nil {
+		t.Fatalf("error retrieving original %s: %s", labelVcfaProviderGeneralSettings, err)
+	}
+	defer func() {
+		err := updateProviderGeneralSettings(tmClient, originalSettings)
+		if err != nil {
+			t.Errorf("error restoring original %s: %s", labelVcfaProviderGeneralSettings, err)
+		}
+	}()
+
+	resourceName := "vcfa_provider_general_settings.settings"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", providerGeneralSettingsId),
+					resource.TestCheckResourceAttr(resourceName, "session_timeout_minutes", "45"),
+					resource.TestCheckResourceAttr(resourceName, "api_explorer_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "absolute_session_timeout_minutes"),
+					resource.TestCheckResourceAttrSet(resourceName, "show_stack_traces"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "session_timeout_minutes", "30"),
+					resource.TestCheckResourceAttr(resourceName, "absolute_session_timeout_minutes", "600"),
+					resource.TestCheckResourceAttr(resourceName, "api_explorer_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "show_stack_traces", "false"),
+				),
+			},
+			{
+				Config: configText3,
+				Check: resource.ComposeTestCheckFunc(
+					resourceFieldsEqual(resourceName, "data.vcfa_provider_general_settings.settings-ds", nil),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "settings",
+			},
+		},
+	})
+}
+
+const testAccVcfaProviderGeneralSettingsStep1 = `
+resource "vcfa_provider_general_settings" "settings" {
+  session_timeout_minutes = 45
+  api_explorer_enabled    = false
+}
+`
+
+const testAccVcfaProviderGeneralSettingsStep2 = `
+resource "vcfa_provider_general_settings" "settings" {
+  session_timeout_minutes          = 30
+  absolute_session_timeout_minutes = 600
+  api_explorer_enabled             = true
+  show_stack_traces                = false
+}
+`
+
+const testAccVcfaProviderGeneralSettingsStep3DS = testAccVcfaProviderGeneralSettingsStep2 + `
+data "vcfa_provider_general_settings" "settings-ds" {
+  depends_on = [vcfa_provider_general_settings.settings]
+}
+`
@@ -0,0 +1,50 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// TestMergeProviderGeneralSettings checks that only the configured settings are overridden, and that the
+// settings that are not managed by the provider are sent back unchanged
+func TestMergeProviderGeneralSettings(t *testing.T) {
+	payload := map[string]json.RawMessage{}
+	err := json.Unmarshal([]byte(`{"sessionTimeoutMinutes":30,"absoluteSessionTimeoutMinutes":480,`+
+		`"apiExplorerEnabled":true,"showStackTraces":true,"syslogServerSettings":{"syslogServerIp1":"10.0.0.1"},"hostCheckDelayInSeconds":300}`), &payload)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = mergeProviderGeneralSettings(payload, &vcfatypes.GeneralSettings{
+		SessionTimeoutMinutes: addrOf(15),
+		ShowStackTraces:       addrOf(false),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := map[string]string{
+		"sessionTimeoutMinutes":         `15`,
+		"absoluteSessionTimeoutMinutes": `480`,
+		"apiExplorerEnabled":            `true`,
+		"showStackTraces":               `false`,
+		"syslogServerSettings":          `{"syslogServerIp1":"10.0.0.1"}`,
+		"hostCheckDelayInSeconds":       `300`,
+	}
+	if len(payload) != len(want) {
+		t.Errorf("expected %d settings, got %d: %v", len(want), len(payload), payload)
+	}
+	for key, value := range want {
+		if string(payload[key]) != value {
+			t.Errorf("expected %s to be %s, got %s", key, value, payload[key])
+		}
+	}
+}
@@ -71,8 +71,10 @@ func resourceVcfaProviderLdap() *schema.Resource {
 					`It is inspected on create and modify. ` +
 					`On modify, the absence of this element indicates that the password should not be changed`,
 			},
-			"user_attributes":  ldapUserAttributes(false),  // UserAttributes
-			"group_attributes": ldapGroupAttributes(false), // GroupAttributes
+			"password_wo":         writeOnlySecretSchema("password", "Password for the user identified by UserName"),
+			"password_wo_version": writeOnlySecretVersionSchema("password"),
+			"user_attributes":     ldapUserAttributes(false),  // UserAttributes
+			"group_attributes":    ldapGroupAttributes(false), // GroupAttributes
 			"custom_ui_button_label": { // CustomUiButtonLabel
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -145,7 +147,7 @@ func getTmLdapSettingsType(d *schema.ResourceData) (*types.TmLdapSettings, error
 		IsSsl:                   d.Get("is_ssl").(bool),
 		SearchBase:              d.Get("base_distinguished_name").(string),
 		UserName:                d.Get("username").(string),
-		Password:                d.Get("password").(string),
+		Password:                getSecretArgument(d, "password"),
 		AuthenticationMechanism: "SIMPLE", // Only SIMPLE is allowed in UI
 		ConnectorType:           d.Get("connector_type").(string),
 
@@ -86,7 +86,7 @@ func TestAccVcfaProviderLdap(t *testing.T) {
 			{
 				Config: configTextDS,
 				Check: resource.ComposeTestCheckFunc(
-					resourceFieldsEqual(ldapResourceDef, ldapDatasourceDef, []string{"%", "auto_trust_certificate", "password"}),
+					resourceFieldsEqual(ldapResourceDef, ldapDatasourceDef, []string{"%", "auto_trust_certificate", "password", "password_wo_version"}),
 				),
 			},
 			{
@@ -95,6 +95,7 @@ func resourceVcfaRegion() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Status of the %s", labelVcfaRegion),
 			},
+			"metadata_entry": getMetadataEntrySchema(labelVcfaRegion, false),
 		},
 	}
 }
@@ -108,6 +109,10 @@ func resourceVcfaRegionCreate(ctx context.Context, d *schema.ResourceData, meta
 		createAsyncFunc:  tmClient.CreateRegionAsync,
 		getEntityFunc:    tmClient.GetRegionById,
 		resourceReadFunc: resourceVcfaRegionRead,
+
+		postCreateHooks: []outerEntityHook[*govcd.Region]{
+			func(r *govcd.Region) error { return updateMetadataEntries(tmClient, d, r.Region.ID) },
+		},
 	}
 	return createResource(ctx, d, meta, c)
 }
@@ -119,6 +124,10 @@ func resourceVcfaRegionUpdate(ctx context.Context, d *schema.ResourceData, meta
 		getTypeFunc:      getRegionType,
 		getEntityFunc:    tmClient.GetRegionById,
 		resourceReadFunc: resourceVcfaRegionRead,
+
+		postUpdateHooks: []outerEntityHook[*govcd.Region]{
+			func(r *govcd.Region) error { return updateMetadataEntries(tmClient, d, r.Region.ID) },
+		},
 	}
 	return updateResource(ctx, d, meta, c)
 }
@@ -146,7 +155,13 @@ func resourceVcfaRegionDelete(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceVcfaRegionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
-	region, err := tmClient.GetRegionByName(d.Id())
+	var region *govcd.Region
+	var err error
+	if urn, ok := getImportUrn(d.Id(), urnPrefixRegion); ok {
+		region, err = tmClient.GetRegionById(urn)
+	} else {
+		region, err = tmClient.GetRegionByName(d.Id())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving Region: %s", err)
 	}
@@ -186,7 +201,7 @@ func getRegionType(tmClient *VCDClient, d *schema.ResourceData) (*types.Region,
 	return t, nil
 }
 
-func setRegionData(_ *VCDClient, d *schema.ResourceData, r *govcd.Region) error {
+func setRegionData(tmClient *VCDClient, d *schema.ResourceData, r *govcd.Region) error {
 	if r == nil || r.Region == nil {
 		return fmt.Errorf("nil Region entity")
 	}
@@ -212,5 +227,5 @@ func setRegionData(_ *VCDClient, d *schema.ResourceData, r *govcd.Region) error
 		return fmt.Errorf("error storing 'storage_policy_names': %s", err)
 	}
 
-	return nil
+	return setMetadataEntries(tmClient, d, r.Region.ID)
 }
@@ -0,0 +1,310 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+func resourceVcfaRegionStoragePolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaRegionStoragePolicyCreate,
+		ReadContext:   resourceVcfaRegionStoragePolicyRead,
+		DeleteContext: resourceVcfaRegionStoragePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaRegionStoragePolicyImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The %s that this %s belongs to", labelVcfaRegion, labelVcfaRegionStoragePolicy),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: fmt.Sprintf("Name of the vCenter storage policy that backs the %s. It must exist in all the "+
+					"vCenters of the %s", labelVcfaRegionStoragePolicy, labelVcfaRegion),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Description of the %s", labelVcfaRegionStoragePolicy),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The creation status of the %s. Can be [NOT_READY, READY]", labelVcfaRegionStoragePolicy),
+			},
+			"storage_capacity_mb": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage capacity in megabytes for this %s", labelVcfaRegionStoragePolicy),
+			},
+			"storage_consumed_mb": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("Consumed storage in megabytes for this %s", labelVcfaRegionStoragePolicy),
+			},
+			"storage_class_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("ID of the %s created for this %s", labelVcfaStorageClass, labelVcfaRegionStoragePolicy),
+			},
+			"vcenter_storage_policy": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: fmt.Sprintf("vCenter storage policies backing this %s, which can be used with the vSphere provider", labelVcfaRegionStoragePolicy),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vcenter_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: fmt.Sprintf("ID of the %s that contains the storage policy", labelVcfaVirtualCenter),
+						},
+						"storage_policy_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the storage policy in vCenter",
+						},
+						"storage_policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the storage policy in vCenter",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceVcfaRegionStoragePolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	regionId := d.Get("region_id").(string)
+	name := d.Get("name").(string)
+
+	unlock := lockRegion(regionId)
+	defer unlock()
+
+	region, err := tmClient.GetRegionById(regionId)
+	if err != nil {
+		return diag.Errorf("error retrieving %s with ID '%s': %s", labelVcfaRegion, regionId, err)
+	}
+	if indexOfRegionStoragePolicyName(region.Region.StoragePolicies, name, "") == -1 {
+		updatedRegion := *region.Region
+		updatedRegion.StoragePolicies = append(append([]string{}, region.Region.StoragePolicies...), name)
+		_, err = region.Update(&updatedRegion)
+		invalidateCachedReference(meta, regionId)
+		if err != nil {
+			return diag.Errorf("error adding storage policy '%s' to %s '%s': %s", name, labelVcfaRegion, region.Region.Name, err)
+		}
+	} else {
+		log.Printf("[DEBUG] storage policy '%s' is already in %s '%s', waiting for its %s", name, labelVcfaRegion, region.Region.Name, labelVcfaRegionStoragePolicy)
+	}
+
+	var rsp *govcd.RegionStoragePolicy
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"CREATED"},
+		Refresh: func() (any, string, error) {
+			found, err := findRegionStoragePolicy(tmClient, regionId, name)
+			if err != nil {
+				return nil, "", err
+			}
+			if found == nil {
+				return "", "PENDING", nil
+			}
+			rsp = found
+			return found, "CREATED", nil
+		},
+		Timeout:    operationTimeout(d, meta, schema.TimeoutCreate),
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for %s '%s' in %s '%s': %s", labelVcfaRegionStoragePolicy, name, labelVcfaRegion, region.Region.Name, err)
+	}
+
+	d.SetId(rsp.RegionStoragePolicy.ID)
+	return resourceVcfaRegionStoragePolicyRead(ctx, d, meta)
+}
+
+func resourceVcfaRegionStoragePolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+
+	rsp, err := tmClient.GetRegionStoragePolicyById(d.Id())
+	if govcd.ContainsNotFound(err) {
+		d.SetId("")
+		log.Printf("[DEBUG] %s no longer exists. Removing from tfstate", labelVcfaRegionStoragePolicy)
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaRegionStoragePolicy, err)
+	}
+
+	// VCFA can normalize the name of the vCenter storage policy, so the configured one is kept when it matches
+	configuredName := d.Get("name").(string)
+	if err := setRegionStoragePolicyData(tmClient, d, rsp); err != nil {
+		return diag.FromErr(err)
+	}
+	if configuredName != "" && regionStoragePolicyNameMatches(configuredName, rsp.RegionStoragePolicy.Name) {
+		dSet(d, "name", configuredName)
+	}
+	return nil
+}
+
+func resourceVcfaRegionStoragePolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	regionId := d.Get("region_id").(string)
+	name := d.Get("name").(string)
+
+	unlock := lockRegion(regionId)
+	defer unlock()
+
+	rsp, err := tmClient.GetRegionStoragePolicyById(d.Id())
+	if govcd.ContainsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaRegionStoragePolicy, err)
+	}
+	region, err := tmClient.GetRegionById(regionId)
+	if err != nil {
+		return diag.Errorf("error retrieving %s with ID '%s': %s", labelVcfaRegion, regionId, err)
+	}
+
+	index := indexOfRegionStoragePolicyName(region.Region.StoragePolicies, name, rsp.RegionStoragePolicy.Name)
+	if index == -1 {
+		return nil
+	}
+	updatedRegion := *region.Region
+	updatedRegion.StoragePolicies = append(append([]string{}, region.Region.StoragePolicies[:index]...), region.Region.StoragePolicies[index+1:]...)
+	_, err = region.Update(&updatedRegion)
+	invalidateCachedReference(meta, regionId)
+	if err != nil {
+		return diag.Errorf("error removing storage policy '%s' from %s '%s': %s", name, labelVcfaRegion, region.Region.Name, err)
+	}
+
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (any, string, error) {
+			_, err := tmClient.GetRegionStoragePolicyById(d.Id())
+			if govcd.ContainsNotFound(err) {
+				return "", "DELETED", nil
+			}
+			if err != nil {
+				return nil, "", err
+			}
+			return d.Id(), "DELETING", nil
+		},
+		Timeout:    operationTimeout(d, meta, schema.TimeoutDelete),
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for %s '%s' to be removed from %s '%s': %s", labelVcfaRegionStoragePolicy, name, labelVcfaRegion, region.Region.Name, err)
+	}
+	return nil
+}
+
+func resourceVcfaRegionStoragePolicyImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	idSlice := SplitImportId(d.Id(), -1)
+	if len(idSlice) != 2 {
+		return nil, fmt.Errorf("expected import ID to be <%s name>%s<storage policy name>", labelVcfaRegion, ImportSeparator)
+	}
+	region, err := tmClient.GetRegionByName(idSlice[0])
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaRegion, idSlice[0], err)
+	}
+	rsp, err := findRegionStoragePolicy(tmClient, region.Region.ID, idSlice[1])
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaRegionStoragePolicy, idSlice[1], err)
+	}
+	if rsp == nil {
+		return nil, fmt.Errorf("%s '%s' not found in %s '%s'", labelVcfaRegionStoragePolicy, idSlice[1], labelVcfaRegion, idSlice[0])
+	}
+
+	dSet(d, "region_id", region.Region.ID)
+	dSet(d, "name", idSlice[1])
+	d.SetId(rsp.RegionStoragePolicy.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// lockRegion serializes the changes of the storage policies of the given Region, as each of them sends the whole list
+// of the Region. It returns the function that releases the lock
+func lockRegion(regionId string) func() {
+	key := "region:" + regionId
+	vcfa.kvLock(key)
+	return func() {
+		vcfa.kvUnlock(key)
+	}
+}
+
+// findRegionStoragePolicy returns the Region Storage Policy of the given Region that is backed by the given vCenter
+// storage policy, or nil if there is none
+func findRegionStoragePolicy(tmClient *VCDClient, regionId, name string) (*govcd.RegionStoragePolicy, error) {
+	queryParams := url.Values{}
+	queryParams.Add("filter", "region.id=="+regionId)
+	policies, err := tmClient.GetAllRegionStoragePolicies(queryParams)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		if regionStoragePolicyNameMatches(name, policy.RegionStoragePolicy.Name) {
+			return policy, nil
+		}
+	}
+	return nil, nil
+}
+
+// indexOfRegionStoragePolicyName returns the position of a storage policy in the 'storagePolicies' list of a Region,
+// given the vCenter name and, optionally, the name of its Region Storage Policy. It returns -1 if it's not there
+func indexOfRegionStoragePolicyName(storagePolicies []string, name, regionPolicyName string) int {
+	for i, storagePolicy := range storagePolicies {
+		if storagePolicy == name || regionStoragePolicyNameMatches(storagePolicy, name) ||
+			(regionPolicyName != "" && regionStoragePolicyNameMatches(storagePolicy, regionPolicyName)) {
+			return i
+		}
+	}
+	return -1
+}
+
+// getRegionStoragePolicyStorageClassId returns the ID of the Storage Class that VCFA creates for a Region Storage
+// Policy. The lookup is best-effort, as this information is only informative, and any error is logged instead
+func getRegionStoragePolicyStorageClassId(tmClient *VCDClient, rsp *types.RegionStoragePolicy) string {
+	if rsp.Region == nil {
+		return ""
+	}
+	queryParams := url.Values{}
+	queryParams.Add("filter", "region.id=="+rsp.Region.ID)
+	storageClasses, err := tmClient.GetAllStorageClasses(queryParams)
+	if err != nil {
+		log.Printf("[DEBUG] could not retrieve the %ss of %s '%s': %s", labelVcfaStorageClass, labelVcfaRegion, rsp.Region.ID, err)
+		return ""
+	}
+	for _, storageClass := range storageClasses {
+		if regionStoragePolicyNameMatches(rsp.Name, storageClass.StorageClass.Name) {
+			return storageClass.StorageClass.ID
+		}
+	}
+	return ""
+}
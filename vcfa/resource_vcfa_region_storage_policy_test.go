@@ -0,0 +1,99 @@
+//go:build tm || region || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaRegionStoragePolicy tests adding a vCenter storage policy to an existing Region
+func TestAccVcfaRegionStoragePolicy(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	if testConfig.Tm.VcenterStorageProfile2 == "" {
+		t.Skip("tm.vcenterStorageProfile2 must be set in the test configuration")
+	}
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	// A Region created by the test lists its storage policies in 'storage_policy_names', which would remove the added one
+	if !strings.HasPrefix(regionHclRef, "data.") {
+		t.Skip("this test needs an existing Region")
+	}
+
+	var params = StringMap{
+		"Testname":      t.Name(),
+		"RegionId":      regionHclRef + ".id",
+		"StoragePolicy": testConfig.Tm.VcenterStorageProfile2,
+		"Tags":          "tm region",
+	}
+	testParamsNotEmpty(t, params)
+
+	preRequisites := nsxManagerHcl + vCenterHcl + regionHcl
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(preRequisites+testAccVcfaRegionStoragePolicyStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(preRequisites+testAccVcfaRegionStoragePolicyStep2, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	policyDef := "vcfa_region_storage_policy.test"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(policyDef, "id", regexp.MustCompile(`^urn:vcloud:regionStoragePolicy:`)),
+					resource.TestCheckResourceAttr(policyDef, "name", testConfig.Tm.VcenterStorageProfile2),
+					resource.TestCheckResourceAttrPair(policyDef, "region_id", regionHclRef, "id"),
+					resource.TestCheckResourceAttrSet(policyDef, "status"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(policyDef, "id", "data.vcfa_region_storage_policy.test", "id"),
+					resource.TestCheckResourceAttrPair(policyDef, "storage_class_id", "data.vcfa_region_storage_policy.test", "storage_class_id"),
+					resource.TestCheckResourceAttrPair(policyDef, "storage_capacity_mb", "data.vcfa_region_storage_policy.test", "storage_capacity_mb"),
+				),
+			},
+			{
+				ResourceName:      policyDef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     testConfig.Tm.Region + ImportSeparator + testConfig.Tm.VcenterStorageProfile2,
+			},
+		},
+	})
+}
+
+const testAccVcfaRegionStoragePolicyStep1 = `
+resource "vcfa_region_storage_policy" "test" {
+  region_id = {{.RegionId}}
+  name      = "{{.StoragePolicy}}"
+}
+`
+
+const testAccVcfaRegionStoragePolicyStep2 = testAccVcfaRegionStoragePolicyStep1 + `
+data "vcfa_region_storage_policy" "test" {
+  region_id = vcfa_region_storage_policy.test.region_id
+  name      = vcfa_region_storage_policy.test.name
+}
+`
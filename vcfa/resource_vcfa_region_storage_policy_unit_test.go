@@ -0,0 +1,34 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import "testing"
+
+// TestIndexOfRegionStoragePolicyName checks that storage policies are found in the list of a Region by their vCenter
+// name or by the normalized name of their Region Storage Policy
+func TestIndexOfRegionStoragePolicyName(t *testing.T) {
+	storagePolicies := []string{"vSAN Default Storage Policy", "Gold_Policy"}
+
+	tests := []struct {
+		name             string
+		policyName       string
+		regionPolicyName string
+		want             int
+	}{
+		{name: "ExactName", policyName: "Gold_Policy", want: 1},
+		{name: "NormalizedName", policyName: "vsan-default-storage-policy", want: 0},
+		{name: "RegionPolicyName", policyName: "Renamed Policy", regionPolicyName: "gold-policy", want: 1},
+		{name: "Missing", policyName: "Silver", want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := indexOfRegionStoragePolicyName(storagePolicies, tt.policyName, tt.regionPolicyName); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
@@ -82,11 +82,18 @@ func TestAccVcfaRegion(t *testing.T) {
 					resource.TestCheckTypeSetElemAttr("vcfa_region.test", "storage_policy_names.*", testConfig.Tm.VcenterStorageProfile),
 
 					resource.TestCheckResourceAttrSet("data.vcfa_supervisor.test", "id"),
+					resource.TestCheckResourceAttrPair("data.vcfa_supervisor.test", "supervisor_id", "data.vcfa_supervisor.test", "id"),
 					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_zone.test", "id"),
 					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_zone.test", "cpu_capacity_mhz"),
 					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_zone.test", "cpu_used_mhz"),
 					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_zone.test", "memory_capacity_mib"),
 					resource.TestCheckResourceAttrSet("data.vcfa_supervisor_zone.test", "memory_used_mib"),
+
+					resource.TestCheckResourceAttr("data.vcfa_supervisors.test", "supervisors.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vcfa_supervisors.test", "supervisors.0.id", "data.vcfa_supervisor.test", "id"),
+					resource.TestCheckResourceAttrPair("data.vcfa_supervisors.test", "supervisors.0.vcenter_id", "data.vcfa_supervisor.test", "vcenter_id"),
+					resource.TestCheckTypeSetElemAttrPair("data.vcfa_supervisor_zones.test", "names.*", "data.vcfa_supervisor_zone.test", "name"),
+					resource.TestCheckTypeSetElemAttrPair("data.vcfa_supervisor_zones.test", "zones.*.id", "data.vcfa_supervisor_zone.test", "id"),
 				),
 			},
 			{
@@ -117,8 +124,11 @@ func TestAccVcfaRegion(t *testing.T) {
 				Config: configText3,
 				Check: resource.ComposeTestCheckFunc(
 					resourceFieldsEqual("vcfa_region.test", "data.vcfa_region.test",
-						[]string{"memory_reservation_capacity_mib", "memory_capacity_mib"}, // these values fluctuate
+						// normalized_name is only in the data source, and the memory values fluctuate
+						[]string{"%", "normalized_name", "memory_reservation_capacity_mib", "memory_capacity_mib"},
 					),
+					// Region names are already RFC 1123 Label Names
+					resource.TestCheckResourceAttrPair("data.vcfa_region.test", "normalized_name", "vcfa_region.test", "name"),
 				),
 			},
 			{
@@ -145,6 +155,15 @@ data "vcfa_supervisor_zone" "test" {
   supervisor_id = data.vcfa_supervisor.test.id
   name          = "{{.VcenterSupervisorZone}}"
 }
+
+data "vcfa_supervisors" "test" {
+  vcenter_id = {{.VcenterRefId}}
+  name_regex = "^{{.VcenterSupervisor}}$"
+}
+
+data "vcfa_supervisor_zones" "test" {
+  supervisor_id = data.vcfa_supervisor.test.id
+}
 `
 
 const testAccVcfaRegionStep1 = testAccVcfaRegionPrerequisites + `
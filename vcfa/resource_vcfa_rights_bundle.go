@@ -7,7 +7,6 @@ package vcfa
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -289,19 +288,27 @@ func resourceVcfaRightsBundleDelete(_ context.Context, d *schema.ResourceData, m
 }
 
 func resourceVcfaRightsBundleImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	resourceVcfaURI := strings.Split(d.Id(), ImportSeparator)
-	if len(resourceVcfaURI) != 1 {
-		return nil, fmt.Errorf("resource name must be specified as rightsBundle-name")
+	urn, isUrn := getImportUrn(d.Id(), urnPrefixRightsBundle)
+	resourceVcfaURI := SplitImportId(d.Id(), -1)
+	if len(resourceVcfaURI) != 1 && !isUrn {
+		return nil, fmt.Errorf("resource name must be specified as rightsBundle-name or rightsBundle-id")
 	}
 	rightsBundleName := resourceVcfaURI[0]
 
 	tmClient := meta.(ClientContainer).tmClient
 
-	rightsBundle, err := tmClient.Client.GetRightsBundleByName(rightsBundleName)
+	var rightsBundle *govcd.RightsBundle
+	var err error
+	if isUrn {
+		rightsBundleName = urn
+		rightsBundle, err = tmClient.Client.GetRightsBundleById(urn)
+	} else {
+		rightsBundle, err = tmClient.Client.GetRightsBundleByName(rightsBundleName)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("[%s import] error retrieving %s %s: %s", labelVcfaRightsBundle, labelVcfaRightsBundle, rightsBundleName, err)
 	}
-	dSet(d, "name", rightsBundleName)
+	dSet(d, "name", rightsBundle.RightsBundle.Name)
 	dSet(d, "description", rightsBundle.RightsBundle.Description)
 	dSet(d, "bundle_key", rightsBundle.RightsBundle.BundleKey)
 
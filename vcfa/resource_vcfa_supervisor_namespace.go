@@ -6,13 +6,17 @@ package vcfa
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/url"
+	"regexp"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -216,6 +220,36 @@ var supervisorNamespaceZonesClassConfigOverridesSchema = &schema.Resource{
 	},
 }
 
+var supervisorNamespaceZonesEffectiveClassConfigOverridesSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"cpu_limit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CPU limit (format: `<number><unit>`, where `<unit>` can be `M` or `G`)",
+		},
+		"cpu_reservation": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CPU reservation (format: `<number><unit>`, where `<unit>` can be `M` or `G`)",
+		},
+		"memory_limit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Memory limit (format: `<number><unit>`, where `<unit>` can be `Mi`, `Gi`, or `Ti`)",
+		},
+		"memory_reservation": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Memory reservation (format: `<number><unit>`, where `<unit>` can be `Mi`, `Gi`, or `Ti`)",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the Zone",
+		},
+	},
+}
+
 func resourceVcfaSupervisorNamespace() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceVcfaSupervisorNamespaceCreate,
@@ -227,6 +261,22 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"adopt_if_exists": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: fmt.Sprintf("If true, an existing %s of the same class whose name is 'name_prefix' followed by a generated suffix, "+
+					"like one left behind by a failed run, is adopted instead of creating a new one", labelSupervisorNamespace),
+			},
+			"delete_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: fmt.Sprintf("How the %s is deleted: '%s' deletes it with all its workloads, '%s' waits for its workloads "+
+					"to terminate before deleting it and '%s' refuses to delete it while it has workloads. Defaults to '%s'. "+
+					"It is a client side setting", labelSupervisorNamespace, supervisorNamespaceDeleteStrategyForce,
+					supervisorNamespaceDeleteStrategyDrain, supervisorNamespaceDeleteStrategyFail, supervisorNamespaceDeleteStrategyForce),
+				ValidateFunc: validation.StringInSlice([]string{supervisorNamespaceDeleteStrategyForce, supervisorNamespaceDeleteStrategyDrain,
+					supervisorNamespaceDeleteStrategyFail}, false),
+			},
 			"name_prefix": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -241,12 +291,18 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("Name of the %s", labelSupervisorNamespace),
 			},
+			"org": orgScopedSchema(labelSupervisorNamespace),
 			"project_name": {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true, // Update not supported
 				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelSupervisorNamespace),
 			},
+			"api_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Project scoped API path of the %s, relative to the CCI Kubernetes endpoint '<url>/cci/kubernetes'", labelSupervisorNamespace),
+			},
 			"class_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -271,6 +327,12 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: "Class Config Overrides for Content Sources",
 				Elem:        supervisorNamespaceContentSourcesClassConfigOverridesSchema,
 			},
+			"content_sources_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for Content Sources as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceStatusContentLibrariesSchema,
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -288,6 +350,12 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: fmt.Sprintf("List of Non-mandatory Infra Policies to be associated with the %s", labelSupervisorNamespace),
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"kubernetes_namespace": {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: fmt.Sprintf("Name of the Kubernetes namespace backing the %s, to be used as 'metadata.namespace' of the "+
+					"resources created inside it", labelSupervisorNamespace),
+			},
 			"phase": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -315,6 +383,27 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: fmt.Sprintf("Shared subnets associated with the %s", labelSupervisorNamespace),
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"default_storage_class": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage Class used by the volumes of the %s that don't request any. Empty if it could not be determined", labelSupervisorNamespace),
+			},
+			"storage_class_usage": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Storage used versus limit for each Storage Class of the %s", labelSupervisorNamespace),
+				Elem:        supervisorNamespaceStorageClassUsageSchema,
+			},
+			"cpu_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("CPU requested by the workloads of the %s across all its Zones. Empty if the usage could not be retrieved", labelSupervisorNamespace),
+			},
+			"memory_used": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Memory requested by the workloads of the %s across all its Zones. Empty if the usage could not be retrieved", labelSupervisorNamespace),
+			},
 			"storage_classes": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -340,6 +429,12 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Elem:         supervisorNamespaceStorageClassesClassConfigOverridesSchema,
 				ExactlyOneOf: []string{"storage_classes_class_config_overrides", "storage_classes_initial_class_config_overrides"},
 			},
+			"storage_classes_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for Storage Classes as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceStorageClassesSchema,
+			},
 			"vm_classes": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -352,12 +447,72 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Description: fmt.Sprintf("%s VM Classes", labelSupervisorNamespace),
 				Elem:        supervisorNamespaceVMClassesClassConfigOverridesSchema,
 			},
+			"vm_classes_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for VM Classes as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceVMClassesSchema,
+			},
+			"ttl": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: validation.ToDiagFunc(func(i interface{}, k string) ([]string, []error) {
+					if _, err := parseSupervisorNamespaceTtl(i.(string)); err != nil {
+						return nil, []error{err}
+					}
+					return nil, nil
+				}),
+				Description: fmt.Sprintf("Time to live (e.g. '72h') of the %s, counted from its creation. It is stored in its metadata, "+
+					"so the expired %ss can be found and removed", labelSupervisorNamespace, labelSupervisorNamespace),
+			},
+			"audit_trail": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: fmt.Sprintf("When true, the time, session user and provider version of the last operation are recorded "+
+					"in the metadata of the %s, so it can be identified as managed by Terraform", labelSupervisorNamespace),
+			},
+			"audit_trail_workspace": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"audit_trail"},
+				Description:  "Name of the Terraform workspace recorded in the audit trail, like 'terraform.workspace'",
+			},
+			"audit_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: fmt.Sprintf("Audit trail annotations recorded in the metadata of the %s", labelSupervisorNamespace),
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Time when the %s expires, in RFC 3339 format. Empty if it does not have a 'ttl'", labelSupervisorNamespace),
+			},
+			"expired": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the 'ttl' of the %s is over", labelSupervisorNamespace),
+			},
+			"wait_for_vm_classes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: fmt.Sprintf("List of VM Class names to wait for in the %s status after it is created or updated", labelSupervisorNamespace),
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"vpc_name": {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true, // Update not supported
 				Description: "Name of the VPC",
 			},
+			"vpc_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: fmt.Sprintf("Names of the VPCs attached to the %s. The first one is the primary VPC, "+
+					"equal to 'vpc_name'", labelSupervisorNamespace),
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"zones": {
 				Type:        schema.TypeSet,
 				Computed:    true,
@@ -383,12 +538,21 @@ func resourceVcfaSupervisorNamespace() *schema.Resource {
 				Elem:         supervisorNamespaceZonesClassConfigOverridesSchema,
 				ExactlyOneOf: []string{"zones_class_config_overrides", "zones_initial_class_config_overrides"},
 			},
+			"zones_effective_class_config_overrides": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "Class Config Overrides for Zones as reported by the API, including the ones added from the Supervisor Namespace Class",
+				Elem:        supervisorNamespaceZonesEffectiveClassConfigOverridesSchema,
+			},
 		},
 	}
 }
 
 func resourceVcfaSupervisorNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tmClient := meta.(ClientContainer).tmClient
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	namePrefix, oknamePrefix := d.GetOk("name_prefix")
 	if !oknamePrefix {
 		return diag.Errorf("name_prefix not specified")
@@ -398,17 +562,51 @@ func resourceVcfaSupervisorNamespaceCreate(ctx context.Context, d *schema.Resour
 		return diag.Errorf("project_name not specified")
 	}
 
+	if d.Get("adopt_if_exists").(bool) {
+		adoptedName, err := findAdoptableSupervisorNamespace(tmClient, projectName.(string), namePrefix.(string), d.Get("class_name").(string))
+		if err != nil {
+			return diag.Errorf("error looking for an existing %s to adopt: %s", labelSupervisorNamespace, projectAccessError(tmClient, projectName.(string), err))
+		}
+		if adoptedName != "" {
+			// The adopted Supervisor Namespace is updated, so it matches the configuration
+			log.Printf("[INFO] adopting existing %s %s in Project %s", labelSupervisorNamespace, adoptedName, projectName)
+			d.SetId(buildResourceId(projectName.(string), adoptedName))
+			diags := resourceVcfaSupervisorNamespaceUpdate(ctx, d, meta)
+			if diags.HasError() {
+				// The Supervisor Namespace existed before, so it is not saved in the state as tainted when the update
+				// fails or is a dry run, as the next apply would delete it
+				d.SetId("")
+			}
+			return diags
+		}
+	}
+
 	supervisorNamespace := supervisorNamespaceFromResourceData(d, projectName.(string), namePrefix.(string), "")
-	supervisorNamespaceOut, err := createSupervisorNamespace(tmClient, projectName.(string), supervisorNamespace)
+	supervisorNamespace.Labels = map[string]string{supervisorNamespaceRequestIdLabel: id.UniqueId()}
+	if err := setSupervisorNamespaceExpiration(&supervisorNamespace.ObjectMeta, d.Get("ttl").(string), time.Now()); err != nil {
+		return diag.FromErr(err)
+	}
+	setAuditTrail(&supervisorNamespace.ObjectMeta, d.Get("audit_trail").(bool), newAuditTrail(tmClient, "create", d.Get("audit_trail_workspace").(string)))
+	supervisorNamespaceOut, err := createSupervisorNamespaceIdempotent(ctx, tmClient, projectName.(string), supervisorNamespace, dryRunParams(meta))
 	if err != nil {
-		return diag.Errorf("error creating %s: %s", labelSupervisorNamespace, err)
+		if quotaErr := supervisorNamespaceQuotaError(tmClient, projectName.(string), err); quotaErr != nil {
+			return diag.Errorf("error creating %s: %s", labelSupervisorNamespace, quotaErr)
+		}
+		return diag.Errorf("error creating %s: %s", labelSupervisorNamespace, projectAccessError(tmClient, projectName.(string), err))
 	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespace, supervisorNamespaceOut.GetName(), "created")
+	}
+
+	// The ID is set before waiting, so a wait that is interrupted, like with Ctrl-C, or that fails leaves the Supervisor
+	// Namespace in the state as tainted, instead of leaving it behind in VCFA
+	d.SetId(buildResourceId(projectName.(string), supervisorNamespaceOut.GetName()))
 
 	stateChangeFunc := retry.StateChangeConf{
 		Pending: []string{"CREATING", "WAITING"},
 		Target:  []string{"CREATED"},
 		Refresh: func() (any, string, error) {
-			supervisorNamespace, err := readSupervisorNamespace(tmClient, projectName.(string), supervisorNamespaceOut.GetName())
+			supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName.(string), supervisorNamespaceOut.GetName())
 			if err != nil {
 				return nil, "", err
 			}
@@ -420,99 +618,152 @@ func resourceVcfaSupervisorNamespaceCreate(ctx context.Context, d *schema.Resour
 
 			return supervisorNamespace, strings.ToUpper(supervisorNamespace.Status.Phase), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    operationTimeout(d, meta, schema.TimeoutDelete),
 		Delay:      5 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
 	if _, err = stateChangeFunc.WaitForStateContext(ctx); err != nil {
-		return diag.Errorf("error waiting for %s %s in Project %s to be created: %s", labelSupervisorNamespace, supervisorNamespaceOut.GetName(), projectName, err)
+		return interruptedCreationDiagnostics(labelSupervisorNamespace, supervisorNamespaceOut.GetName(),
+			fmt.Errorf("error waiting for %s %s in Project %s to be created: %s", labelSupervisorNamespace, supervisorNamespaceOut.GetName(), projectName, err))
 	}
 
-	d.SetId(buildResourceId(projectName.(string), supervisorNamespaceOut.GetName()))
+	if err := waitForSupervisorNamespaceVmClasses(ctx, tmClient, d, projectName.(string), supervisorNamespaceOut.GetName(), operationTimeout(d, meta, schema.TimeoutCreate)); err != nil {
+		return interruptedCreationDiagnostics(labelSupervisorNamespace, supervisorNamespaceOut.GetName(), err)
+	}
 
-	return resourceVcfaSupervisorNamespaceRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if err := markSupervisorNamespaceTracked(ctx, tmClient, projectName.(string), supervisorNamespaceOut.GetName()); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s %s could not be labeled as tracked", labelSupervisorNamespace, supervisorNamespaceOut.GetName()),
+			Detail: fmt.Sprintf("Without the '%s' label, another resource with 'adopt_if_exists' and the same 'name_prefix' could adopt it: %s",
+				supervisorNamespaceTrackedLabel, err),
+		})
+	}
+	return append(diags, resourceVcfaSupervisorNamespaceRead(ctx, d, meta)...)
 }
 
 func resourceVcfaSupervisorNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tmClient := meta.(ClientContainer).tmClient
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	projectName, name, err := parseResourceId(d.Id())
 	if err != nil {
 		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespace, d.Id(), err)
 	}
 
+	// 'wait_for_vm_classes', 'adopt_if_exists' and 'delete_strategy' are client side settings only
+	if !d.HasChangesExcept("wait_for_vm_classes", "adopt_if_exists", "delete_strategy") {
+		return resourceVcfaSupervisorNamespaceRead(ctx, d, meta)
+	}
+
 	supervisorNamespace := supervisorNamespaceFromResourceData(d, projectName, "", name)
-	if _, err = updateSupervisorNamespace(tmClient, projectName, name, supervisorNamespace); err != nil {
+	// The labels and annotations are not managed by Terraform, so the current ones are sent back, with the expiration
+	current, err := readSupervisorNamespace(ctx, tmClient, projectName, name)
+	if err != nil {
+		return diag.Errorf("error reading %s: %s", labelSupervisorNamespace, err)
+	}
+	supervisorNamespace.Labels = current.Labels
+	supervisorNamespace.Annotations = current.Annotations
+	// Updated Supervisor Namespaces are in the state, like the adopted and imported ones, so they can't be adopted anymore
+	setSupervisorNamespaceTrackedLabel(&supervisorNamespace.ObjectMeta)
+	// Storage Classes bound with 'vcfa_supervisor_namespace_storage_class_binding' are not in this configuration, so
+	// they are sent back too
+	supervisorNamespace.Spec.ClassConfigOverrides.StorageClasses = append(supervisorNamespace.Spec.ClassConfigOverrides.StorageClasses,
+		getUnmanagedStorageClassOverrides(d, current.Spec.ClassConfigOverrides.StorageClasses)...)
+	if err := setSupervisorNamespaceExpiration(&supervisorNamespace.ObjectMeta, d.Get("ttl").(string), current.CreationTimestamp.Time); err != nil {
+		return diag.FromErr(err)
+	}
+	setAuditTrail(&supervisorNamespace.ObjectMeta, d.Get("audit_trail").(bool), newAuditTrail(tmClient, "update", d.Get("audit_trail_workspace").(string)))
+	if _, err = updateSupervisorNamespace(tmClient, projectName, name, supervisorNamespace, dryRunParams(meta)); err != nil {
 		return diag.Errorf("error updating %s: %s", labelSupervisorNamespace, err)
 	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespace, name, "updated")
+	}
 
-	stateChangeFunc := retry.StateChangeConf{
-		Pending: []string{"UPDATING", "WAITING"},
-		Target:  []string{"REALIZED"},
-		Refresh: func() (any, string, error) {
-			supervisorNamespace, err := readSupervisorNamespace(tmClient, projectName, name)
-			if err != nil {
-				return nil, "", err
-			}
-			if strings.ToUpper(supervisorNamespace.Status.Phase) == "ERROR" {
-				return nil, "", fmt.Errorf("%s %s is in an ERROR state", labelSupervisorNamespace, name)
-			}
-			for _, c := range supervisorNamespace.Status.Conditions {
-				if strings.EqualFold(c.Type, "Realized") {
-					log.Printf("[DEBUG] %s %s current Realized condition is %s", labelSupervisorNamespace, name, c.Status)
-					if strings.EqualFold(c.Status, "True") {
-						return supervisorNamespace, "REALIZED", nil
-					}
-					return supervisorNamespace, "UPDATING", nil
-				}
-			}
-			return supervisorNamespace, "WAITING", nil
-		},
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
-		Delay:      5 * time.Second,
-		MinTimeout: 5 * time.Second,
+	if err := waitForSupervisorNamespaceRealized(ctx, tmClient, projectName, name, operationTimeout(d, meta, schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
 	}
-	if _, err = stateChangeFunc.WaitForStateContext(ctx); err != nil {
-		return diag.Errorf("error waiting for %s %s in Project %s to be realized after update: %s", labelSupervisorNamespace, name, projectName, err)
+
+	if err := waitForSupervisorNamespaceVmClasses(ctx, tmClient, d, projectName, name, operationTimeout(d, meta, schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceVcfaSupervisorNamespaceRead(ctx, d, meta)
 }
 
 func resourceVcfaSupervisorNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tmClient := meta.(ClientContainer).tmClient
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	projectName, name, err := parseResourceId(d.Id())
 	if err != nil {
 		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespace, d.Id(), err)
 	}
 
-	supervisorNamespace, err := readSupervisorNamespace(tmClient, projectName, name)
+	supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, name)
 	if err != nil {
 		return diag.Errorf("error reading %s: %s", labelSupervisorNamespace, err)
 	}
 
-	if err := setSupervisorNamespaceData(tmClient, d, projectName, name, supervisorNamespace); err != nil {
+	// When the resource is imported there is no configuration to compare with, so all the overrides are kept
+	_, isManaged := d.GetOk("name_prefix")
+	if err := setSupervisorNamespaceData(tmClient, d, projectName, name, supervisorNamespace, isManaged); err != nil {
 		return diag.Errorf("error setting %s data: %s", labelSupervisorNamespace, err)
 	}
-
+	if err := setSupervisorNamespaceUsage(ctx, tmClient, d, supervisorNamespace); err != nil {
+		return diag.Errorf("error setting %s usage: %s", labelSupervisorNamespace, err)
+	}
+	dSet(d, "ttl", supervisorNamespace.Annotations[supervisorNamespaceTtlAnnotation])
+	dSet(d, "audit_trail", supervisorNamespace.Labels[auditTrailManagedLabel] == "true")
+	dSet(d, "audit_trail_workspace", supervisorNamespace.Annotations[auditTrailWorkspaceAnnotation])
+
+	if d.Get("expired").(bool) {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("%s %s in Project %s has expired", labelSupervisorNamespace, name, projectName),
+			Detail: fmt.Sprintf("The 'ttl' of %s %s expired at %s. Destroy it, or extend its 'ttl' to keep it",
+				labelSupervisorNamespace, name, d.Get("expires_at").(string)),
+		}}
+	}
 	return nil
 }
 
 func resourceVcfaSupervisorNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	tmClient := meta.(ClientContainer).tmClient
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	projectName, name, err := parseResourceId(d.Id())
 	if err != nil {
 		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespace, d.Id(), err)
 	}
 
-	if err := deleteSupervisorNamespace(tmClient, projectName, name); err != nil {
+	// Dry runs don't wait for the workloads to terminate, as nothing is deleted afterwards
+	strategy := d.Get("delete_strategy").(string)
+	if isDryRun(meta) && strategy == supervisorNamespaceDeleteStrategyDrain {
+		log.Printf("[DEBUG] dry run: not waiting for the workloads of %s %s to terminate", labelSupervisorNamespace, name)
+		strategy = supervisorNamespaceDeleteStrategyForce
+	}
+	if err := drainSupervisorNamespace(ctx, tmClient, projectName, name, strategy, operationTimeout(d, meta, schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := deleteSupervisorNamespace(tmClient, projectName, name, dryRunParams(meta)); err != nil {
 		return diag.Errorf("error deleting %s: %s", labelSupervisorNamespace, err)
 	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespace, name, "deleted")
+	}
 
 	stateChangeFunc := retry.StateChangeConf{
 		Pending: []string{"DELETING", "WAITING"},
 		Target:  []string{"DELETED"},
 		Refresh: func() (any, string, error) {
-			supervisorNamespace, err := readSupervisorNamespace(tmClient, projectName, name)
+			supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, name)
 			if err != nil {
 				if strings.Contains(err.Error(), "not found") {
 					return "", "DELETED", nil
@@ -527,10 +778,33 @@ func resourceVcfaSupervisorNamespaceDelete(ctx context.Context, d *schema.Resour
 
 			return supervisorNamespace, strings.ToUpper(supervisorNamespace.Status.Phase), nil
 		},
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    operationTimeout(d, meta, schema.TimeoutDelete),
 		Delay:      5 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
+
+	// If the Supervisor Namespace is not gone after the grace period, the deletion request is sent again, as it
+	// may have been dropped while the Supervisor Namespace was busy
+	if gracePeriod := deletionGracePeriod(meta); gracePeriod > 0 && gracePeriod < stateChangeFunc.Timeout {
+		remainingTimeout := stateChangeFunc.Timeout - gracePeriod
+		stateChangeFunc.Timeout = gracePeriod
+		_, err = stateChangeFunc.WaitForStateContext(ctx)
+		if err == nil {
+			d.SetId("")
+			return nil
+		}
+		var timeoutErr *retry.TimeoutError
+		if !errors.As(err, &timeoutErr) {
+			return diag.Errorf("error waiting for %s %s in Project %s to be deleted: %s", labelSupervisorNamespace, name, projectName, err)
+		}
+		log.Printf("[DEBUG] %s %s was not deleted within the deletion grace period of %s, sending the deletion request again",
+			labelSupervisorNamespace, name, gracePeriod)
+		if err := deleteSupervisorNamespace(tmClient, projectName, name, nil); err != nil && !strings.Contains(err.Error(), "not found") {
+			return diag.Errorf("error deleting %s: %s", labelSupervisorNamespace, err)
+		}
+		stateChangeFunc.Timeout = remainingTimeout
+	}
+
 	if _, err = stateChangeFunc.WaitForStateContext(ctx); err != nil {
 		return diag.Errorf("error waiting for %s %s in Project %s to be deleted: %s", labelSupervisorNamespace, name, projectName, err)
 	}
@@ -541,14 +815,15 @@ func resourceVcfaSupervisorNamespaceDelete(ctx context.Context, d *schema.Resour
 }
 
 func resourceVcfaSupervisorNamespaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	tmClient := meta.(ClientContainer).tmClient
-	idSlice := strings.Split(d.Id(), ImportSeparator)
-	if len(idSlice) != 2 {
-		return nil, fmt.Errorf("expected import ID to be <project_name>%s<supervisor_namespace_name>", ImportSeparator)
-	}
-	projectName := idSlice[0]
-	name := idSlice[1]
-	if _, err := readSupervisorNamespace(tmClient, projectName, name); err != nil {
+	projectName, name, ok := splitOrgScopedImportId(d)
+	if !ok {
+		return nil, fmt.Errorf("expected import ID to be [<org>%s]<project_name>%s<supervisor_namespace_name>", ImportSeparator, ImportSeparator)
+	}
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readSupervisorNamespace(ctx, tmClient, projectName, name); err != nil {
 		return nil, fmt.Errorf("error reading %s: %s", labelSupervisorNamespace, err)
 	}
 
@@ -557,60 +832,387 @@ func resourceVcfaSupervisorNamespaceImport(ctx context.Context, d *schema.Resour
 	return []*schema.ResourceData{d}, nil
 }
 
-func createSupervisorNamespace(tmClient *VCDClient, projectName string, supervisorNamespace ccitypes.SupervisorNamespace) (ccitypes.SupervisorNamespace, error) {
+// waitForSupervisorNamespaceVmClasses waits until all the VM Classes set in 'wait_for_vm_classes' are
+// reported in the Supervisor Namespace status. VM Classes are propagated asynchronously, and they may
+// show up in the status some minutes after the Supervisor Namespace is ready.
+func waitForSupervisorNamespaceVmClasses(ctx context.Context, tmClient *VCDClient, d *schema.ResourceData, projectName, name string, timeout time.Duration) error {
+	vmClassNames := convertSchemaSetToSliceOfStrings(d.Get("wait_for_vm_classes").(*schema.Set))
+	if len(vmClassNames) == 0 {
+		return nil
+	}
+
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"WAITING"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (any, string, error) {
+			supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			missingVmClasses := getMissingSupervisorNamespaceVmClasses(supervisorNamespace, vmClassNames)
+			if len(missingVmClasses) > 0 {
+				log.Printf("[DEBUG] %s %s is still missing VM Classes %v", labelSupervisorNamespace, name, missingVmClasses)
+				return supervisorNamespace, "WAITING", nil
+			}
+
+			return supervisorNamespace, "AVAILABLE", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for VM Classes %v to be available in %s %s in Project %s: %s", vmClassNames, labelSupervisorNamespace, name, projectName, err)
+	}
+
+	return nil
+}
+
+// waitForSupervisorNamespaceRealized waits until the given Supervisor Namespace reports that its last update is realized
+func waitForSupervisorNamespaceRealized(ctx context.Context, tmClient *VCDClient, projectName, name string, timeout time.Duration) error {
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"UPDATING", "WAITING"},
+		Target:  []string{"REALIZED"},
+		Refresh: func() (any, string, error) {
+			supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, name)
+			if err != nil {
+				return nil, "", err
+			}
+			if strings.ToUpper(supervisorNamespace.Status.Phase) == "ERROR" {
+				return nil, "", fmt.Errorf("%s %s is in an ERROR state", labelSupervisorNamespace, name)
+			}
+			for _, c := range supervisorNamespace.Status.Conditions {
+				if strings.EqualFold(c.Type, "Realized") {
+					log.Printf("[DEBUG] %s %s current Realized condition is %s", labelSupervisorNamespace, name, c.Status)
+					if strings.EqualFold(c.Status, "True") {
+						return supervisorNamespace, "REALIZED", nil
+					}
+					return supervisorNamespace, "UPDATING", nil
+				}
+			}
+			return supervisorNamespace, "WAITING", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in Project %s to be realized after update: %s", labelSupervisorNamespace, name, projectName, err)
+	}
+	return nil
+}
+
+// getMissingSupervisorNamespaceVmClasses returns the VM Class names from the given list that are not
+// yet present in the Supervisor Namespace status
+func getMissingSupervisorNamespaceVmClasses(supervisorNamespace ccitypes.SupervisorNamespace, vmClassNames []string) []string {
+	availableVmClasses := make([]string, 0, len(supervisorNamespace.Status.VMClasses))
+	for _, vmClass := range supervisorNamespace.Status.VMClasses {
+		availableVmClasses = append(availableVmClasses, vmClass.Name)
+	}
+
+	var missingVmClasses []string
+	for _, vmClassName := range vmClassNames {
+		if !contains(availableVmClasses, vmClassName) {
+			missingVmClasses = append(missingVmClasses, vmClassName)
+		}
+	}
+	return missingVmClasses
+}
+
+// findAdoptableSupervisorNamespace returns the name of the Supervisor Namespace in the given Project that was generated
+// from 'namePrefix' and has the given class, or an empty string if there is none. If several of them exist, an error is
+// returned, as it is not possible to know which one should be adopted
+func findAdoptableSupervisorNamespace(tmClient *VCDClient, projectName, namePrefix, className string) (string, error) {
+	supervisorNamespaces, err := listSupervisorNamespaces(tmClient, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	candidates := filterAdoptableSupervisorNamespaces(supervisorNamespaces, namePrefix, className)
+	switch len(candidates) {
+	case 0:
+		return "", nil
+	case 1:
+		return candidates[0], nil
+	default:
+		return "", fmt.Errorf("found several %ss with prefix '%s' and class '%s' in Project %s: %v. Import the one to manage instead",
+			labelSupervisorNamespace, namePrefix, className, projectName, candidates)
+	}
+}
+
+// filterAdoptableSupervisorNamespaces returns the sorted names of the Supervisor Namespaces with the given class, that are not
+// being deleted nor tracked by another resource, and whose name is 'namePrefix' followed by the 5 characters suffix that
+// Kubernetes adds to generated names
+func filterAdoptableSupervisorNamespaces(supervisorNamespaces []ccitypes.SupervisorNamespace, namePrefix, className string) []string {
+	generatedName := regexp.MustCompile("^" + regexp.QuoteMeta(namePrefix) + "[a-z0-9]{5}$")
+	var names []string
+	for _, supervisorNamespace := range supervisorNamespaces {
+		if !generatedName.MatchString(supervisorNamespace.GetName()) || supervisorNamespace.Spec.ClassName != className {
+			continue
+		}
+		if supervisorNamespace.GetDeletionTimestamp() != nil || supervisorNamespace.GetLabels()[supervisorNamespaceTrackedLabel] == "true" {
+			continue
+		}
+		names = append(names, supervisorNamespace.GetName())
+	}
+	sort.Strings(names)
+	return names
+}
+
+// setSupervisorNamespaceTrackedLabel adds the label that marks a Supervisor Namespace as saved in the state of a resource
+func setSupervisorNamespaceTrackedLabel(objectMeta *v1.ObjectMeta) {
+	if objectMeta.Labels == nil {
+		objectMeta.Labels = map[string]string{}
+	}
+	objectMeta.Labels[supervisorNamespaceTrackedLabel] = "true"
+}
+
+// markSupervisorNamespaceTracked labels a Supervisor Namespace that was created successfully. The label is not sent in the
+// create request, so the Supervisor Namespaces left behind by a run that failed before saving them in the state can
+// still be adopted
+func markSupervisorNamespaceTracked(ctx context.Context, tmClient *VCDClient, projectName, name string) error {
+	current, err := readSupervisorNamespace(ctx, tmClient, projectName, name)
+	if err != nil {
+		return err
+	}
+	if current.GetLabels()[supervisorNamespaceTrackedLabel] == "true" {
+		return nil
+	}
+	setSupervisorNamespaceTrackedLabel(&current.ObjectMeta)
+	_, err = updateSupervisorNamespace(tmClient, projectName, name, current, nil)
+	return err
+}
+
+// listSupervisorNamespaces returns all the Supervisor Namespaces of the given Project
+func listSupervisorNamespaces(tmClient *VCDClient, projectName string) ([]ccitypes.SupervisorNamespace, error) {
+	supervisorNamespacesURL, err := buildSupervisorNamespaceURL(tmClient, projectName, "")
+	if err != nil {
+		return nil, fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)
+	}
+	supervisorNamespaces, err := getAllCciItems[ccitypes.SupervisorNamespace](tmClient, supervisorNamespacesURL)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %ss in Project %s: %s", labelSupervisorNamespace, projectName, err)
+	}
+	return supervisorNamespaces, nil
+}
+
+// supervisorNamespaceQuotaRegex matches the message of the Kubernetes errors returned when a quota would be exceeded,
+// like "exceeded quota: project-quota, requested: count/supervisornamespaces=1, used: count/supervisornamespaces=5,
+// limited: count/supervisornamespaces=5"
+var supervisorNamespaceQuotaRegex = regexp.MustCompile(`exceeded quota: ([^,]+), requested: (\S+), used: (\S+), limited: ([^\s"\\]+)`)
+
+// supervisorNamespaceQuota is the usage of a resource limited by a quota
+type supervisorNamespaceQuota struct {
+	quotaName string
+	resource  string
+	used      string
+	limit     string
+}
+
+// parseSupervisorNamespaceQuotaError returns the usage of the resources of a quota error that would be exceeded.
+// It returns false if the message is not a quota error that can be parsed
+func parseSupervisorNamespaceQuotaError(message string) ([]supervisorNamespaceQuota, bool) {
+	matches := supervisorNamespaceQuotaRegex.FindStringSubmatch(message)
+	if matches == nil {
+		return nil, false
+	}
+	toMap := func(list string) map[string]string {
+		values := make(map[string]string)
+		for _, item := range strings.Split(strings.TrimRight(list, ",."), ",") {
+			if key, value, found := strings.Cut(item, "="); found {
+				values[key] = value
+			}
+		}
+		return values
+	}
+	used := toMap(matches[3])
+	limited := toMap(matches[4])
+
+	var result []supervisorNamespaceQuota
+	for resource := range toMap(matches[2]) {
+		limit, ok := limited[resource]
+		if !ok {
+			continue
+		}
+		result = append(result, supervisorNamespaceQuota{quotaName: matches[1], resource: resource, used: used[resource], limit: limit})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].resource < result[j].resource
+	})
+	return result, len(result) > 0
+}
+
+// supervisorNamespaceQuotaError returns an error that explains which quota of the Project prevented the creation of a
+// Supervisor Namespace, with its current and maximum usage. It returns nil if the given error is not a quota error
+func supervisorNamespaceQuotaError(tmClient *VCDClient, projectName string, err error) error {
+	if err == nil || !strings.Contains(strings.ToLower(err.Error()), "quota") {
+		return nil
+	}
+
+	quotas, ok := parseSupervisorNamespaceQuotaError(err.Error())
+	if !ok {
+		// The error doesn't state the usage, so the current number of Supervisor Namespaces is reported instead
+		supervisorNamespaces, listErr := listSupervisorNamespaces(tmClient, projectName)
+		if listErr != nil {
+			log.Printf("[DEBUG] could not list %ss to diagnose error: %s", labelSupervisorNamespace, listErr)
+			return nil
+		}
+		return fmt.Errorf("a quota of the Project '%s' would be exceeded, which currently has %d %ss. Delete the unused ones, "+
+			"or ask the provider administrator to increase the quota. Original error: %s", projectName, len(supervisorNamespaces), labelSupervisorNamespace, err)
+	}
+
+	var usages []string
+	for _, quota := range quotas {
+		if strings.HasPrefix(quota.resource, "count/") {
+			usages = append(usages, fmt.Sprintf("%s: %s used out of a maximum of %s %ss", quota.resource, quota.used, quota.limit, labelSupervisorNamespace))
+		} else {
+			usages = append(usages, fmt.Sprintf("%s: %s used out of a maximum of %s", quota.resource, quota.used, quota.limit))
+		}
+	}
+	return fmt.Errorf("the Project '%s' reached the limits of its quota '%s' (%s). Delete unused %ss, or ask the provider "+
+		"administrator to increase the quota. Original error: %s", projectName, quotas[0].quotaName, strings.Join(usages, "; "), labelSupervisorNamespace, err)
+}
+
+// createSupervisorNamespace sends the create request of a Supervisor Namespace. The request is not cancelled with the
+// given context, as VCFA could create the Supervisor Namespace anyway and Terraform would not track it. It always
+// finishes, so the caller can save the Supervisor Namespace in the state before noticing the cancellation
+func createSupervisorNamespace(ctx context.Context, tmClient *VCDClient, projectName string, supervisorNamespace ccitypes.SupervisorNamespace, params url.Values) (ccitypes.SupervisorNamespace, error) {
 	var supervisorNamespaceOut ccitypes.SupervisorNamespace
 	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, "")
 	if err != nil {
 		return supervisorNamespace, fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)
 	}
-	if err := tmClient.VCDClient.Client.PostEntity(supervisorNamespaceURL, nil, &supervisorNamespace, &supervisorNamespaceOut, nil); err != nil {
+	client := tmClient.WithContext(context.WithoutCancel(ctx))
+	if err := client.VCDClient.Client.PostEntity(supervisorNamespaceURL, params, &supervisorNamespace, &supervisorNamespaceOut, nil); err != nil {
 		return supervisorNamespace, fmt.Errorf("error creating %s in Project %s: %s", labelSupervisorNamespace, projectName, err)
 	}
 	return supervisorNamespaceOut, nil
 }
 
-func updateSupervisorNamespace(tmClient *VCDClient, projectName string, supervisorNamespaceName string, supervisorNamespace ccitypes.SupervisorNamespace) (ccitypes.SupervisorNamespace, error) {
+// supervisorNamespaceRequestIdLabel is the label that identifies the create request of a Supervisor Namespace, so a
+// retried request does not create a duplicate with a different generated name
+const supervisorNamespaceRequestIdLabel = "terraform.vcfa.vmware.com/request-id"
+
+// supervisorNamespaceTrackedLabel is the label of the Supervisor Namespaces that are saved in the state of a resource,
+// so 'adopt_if_exists' does not adopt them
+const supervisorNamespaceTrackedLabel = "terraform.vcfa.vmware.com/tracked"
+
+// supervisorNamespaceCreateRetryTimeout is the time during which a create request that failed because of a transient
+// error is retried
+const supervisorNamespaceCreateRetryTimeout = 2 * time.Minute
+
+// supervisorNamespaceTransientErrorRegex matches the errors after which it is unknown whether VCFA received the create
+// request, like network timeouts and gateway errors
+var supervisorNamespaceTransientErrorRegex = regexp.MustCompile(`(?i)timeout|timed out|connection reset|connection refused|EOF|` +
+	`bad gateway|service unavailable|gateway timeout|\b50[234]\b`)
+
+// createSupervisorNamespaceIdempotent creates a Supervisor Namespace that has a request ID label, retrying the request
+// after transient errors. As the request may have reached VCFA even if its response did not, the Supervisor Namespace
+// with the same request ID is looked for before every retry, and returned if it exists. It is also looked for when the
+// retries are cancelled, so one that a previous attempt created is returned and saved in the state
+func createSupervisorNamespaceIdempotent(ctx context.Context, tmClient *VCDClient, projectName string, supervisorNamespace ccitypes.SupervisorNamespace, params url.Values) (ccitypes.SupervisorNamespace, error) {
+	requestId := supervisorNamespace.Labels[supervisorNamespaceRequestIdLabel]
+	var supervisorNamespaceOut ccitypes.SupervisorNamespace
+	attempt := 0
+	err := runWithRetry(ctx, func() error {
+		attempt++
+		if attempt > 1 {
+			existing, err := findSupervisorNamespaceByRequestId(tmClient, projectName, requestId)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				log.Printf("[INFO] %s %s was created by a previous attempt of request %s", labelSupervisorNamespace, existing.GetName(), requestId)
+				supervisorNamespaceOut = *existing
+				return nil
+			}
+		}
+		var err error
+		supervisorNamespaceOut, err = createSupervisorNamespace(ctx, tmClient, projectName, supervisorNamespace, params)
+		return err
+	}, supervisorNamespaceTransientErrorRegex, supervisorNamespaceCreateRetryTimeout)
+	if err != nil && isContextCancellation(err) {
+		existing, findErr := findSupervisorNamespaceByRequestId(tmClient.WithContext(context.WithoutCancel(ctx)), projectName, requestId)
+		if findErr != nil {
+			log.Printf("[DEBUG] could not look for the %s of the cancelled request %s: %s", labelSupervisorNamespace, requestId, findErr)
+		}
+		if existing != nil {
+			log.Printf("[INFO] %s %s was created by request %s before it was cancelled", labelSupervisorNamespace, existing.GetName(), requestId)
+			return *existing, nil
+		}
+	}
+	return supervisorNamespaceOut, err
+}
+
+// findSupervisorNamespaceByRequestId returns the Supervisor Namespace of the given Project that was created with the
+// given request ID, or nil if there is none
+func findSupervisorNamespaceByRequestId(tmClient *VCDClient, projectName, requestId string) (*ccitypes.SupervisorNamespace, error) {
+	supervisorNamespaces, err := listSupervisorNamespaces(tmClient, projectName)
+	if err != nil {
+		return nil, err
+	}
+	return filterSupervisorNamespaceByRequestId(supervisorNamespaces, requestId), nil
+}
+
+// filterSupervisorNamespaceByRequestId returns the Supervisor Namespace that has the given request ID label and is not
+// being deleted, or nil if there is none
+func filterSupervisorNamespaceByRequestId(supervisorNamespaces []ccitypes.SupervisorNamespace, requestId string) *ccitypes.SupervisorNamespace {
+	for i := range supervisorNamespaces {
+		if supervisorNamespaces[i].GetLabels()[supervisorNamespaceRequestIdLabel] == requestId && supervisorNamespaces[i].GetDeletionTimestamp() == nil {
+			return &supervisorNamespaces[i]
+		}
+	}
+	return nil
+}
+
+func updateSupervisorNamespace(tmClient *VCDClient, projectName string, supervisorNamespaceName string, supervisorNamespace ccitypes.SupervisorNamespace, params url.Values) (ccitypes.SupervisorNamespace, error) {
 	var supervisorNamespaceOut ccitypes.SupervisorNamespace
 	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, supervisorNamespaceName)
 	if err != nil {
 		return supervisorNamespaceOut, fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)
 	}
-	if err := tmClient.VCDClient.Client.PutEntity(supervisorNamespaceURL, nil, &supervisorNamespace, &supervisorNamespaceOut, nil); err != nil {
+	if err := tmClient.VCDClient.Client.PutEntity(supervisorNamespaceURL, params, &supervisorNamespace, &supervisorNamespaceOut, nil); err != nil {
 		return supervisorNamespaceOut, fmt.Errorf("error updating %s %s in Project %s: %s", labelSupervisorNamespace, supervisorNamespaceName, projectName, err)
 	}
 	return supervisorNamespaceOut, nil
 }
 
-func readSupervisorNamespace(tmClient *VCDClient, projectName string, supervisorNamespaceName string) (ccitypes.SupervisorNamespace, error) {
+func readSupervisorNamespace(ctx context.Context, tmClient *VCDClient, projectName string, supervisorNamespaceName string) (ccitypes.SupervisorNamespace, error) {
 	var supervisorNamespace ccitypes.SupervisorNamespace
 	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, supervisorNamespaceName)
 	if err != nil {
 		return supervisorNamespace, fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)
 	}
-	if err := tmClient.VCDClient.Client.GetEntity(supervisorNamespaceURL, nil, &supervisorNamespace, nil); err != nil {
+	if err := tmClient.WithContext(ctx).VCDClient.Client.GetEntity(supervisorNamespaceURL, nil, &supervisorNamespace, nil); err != nil {
 		return supervisorNamespace, fmt.Errorf("error reading %s %s in Project %s: %s", labelSupervisorNamespace, supervisorNamespaceName, projectName, err)
 	}
 	return supervisorNamespace, nil
 }
 
-func deleteSupervisorNamespace(tmClient *VCDClient, projectName string, supervisorNamespaceName string) error {
+func deleteSupervisorNamespace(tmClient *VCDClient, projectName string, supervisorNamespaceName string, params url.Values) error {
 	supervisorNamespaceURL, err := buildSupervisorNamespaceURL(tmClient, projectName, supervisorNamespaceName)
 	if err != nil {
 		return fmt.Errorf("error building %s URL: %s", labelSupervisorNamespace, err)
 	}
-	if err := tmClient.VCDClient.Client.DeleteEntity(supervisorNamespaceURL, nil, nil); err != nil {
+	if err := tmClient.VCDClient.Client.DeleteEntity(supervisorNamespaceURL, params, nil); err != nil {
 		return fmt.Errorf("error deleting %s %s in Project %s: %s", labelSupervisorNamespace, supervisorNamespaceName, projectName, err)
 	}
 	return nil
 }
 
 func buildSupervisorNamespaceURL(tmClient *VCDClient, projectName string, supervisorNamespaceName string) (*url.URL, error) {
-	supervisorNamespaceRawURL := fmt.Sprintf(ccitypes.SupervisorNamespacesURL, projectName)
+	return tmClient.VCDClient.Client.GetEntityUrl(buildSupervisorNamespaceApiPath(projectName, supervisorNamespaceName))
+}
+
+// buildSupervisorNamespaceApiPath returns the Project scoped API path of the given Supervisor Namespace, relative to
+// the CCI Kubernetes endpoint. When no name is given, the path of the Supervisor Namespaces list is returned
+func buildSupervisorNamespaceApiPath(projectName string, supervisorNamespaceName string) string {
+	apiPath := fmt.Sprintf(ccitypes.SupervisorNamespacesURL, projectName)
 	if supervisorNamespaceName != "" {
-		supervisorNamespaceRawURL = supervisorNamespaceRawURL + "/" + supervisorNamespaceName
+		apiPath = apiPath + "/" + supervisorNamespaceName
 	}
-
-	return tmClient.VCDClient.Client.GetEntityUrl(supervisorNamespaceRawURL)
+	return apiPath
 }
 
 func buildResourceId(projectName string, supervisorNamespaceName string) string {
@@ -719,16 +1321,32 @@ func supervisorNamespaceFromResourceData(d *schema.ResourceData, projectName, na
 	return supervisorNamespace
 }
 
-func setSupervisorNamespaceData(_ *VCDClient, d *schema.ResourceData, projectName string, supervisorNamespaceName string, supervisorNamespace ccitypes.SupervisorNamespace) error {
+// setSupervisorNamespaceData sets the Supervisor Namespace attributes. When 'userOverridesOnly' is true, the
+// '*_class_config_overrides' attributes only keep the entries that were already present in the configuration or state,
+// as the API back-fills the overrides with the defaults of the Supervisor Namespace Class. The complete list of
+// overrides is always available in the '*_effective_class_config_overrides' attributes.
+func setSupervisorNamespaceData(_ *VCDClient, d *schema.ResourceData, projectName string, supervisorNamespaceName string, supervisorNamespace ccitypes.SupervisorNamespace, userOverridesOnly bool) error {
 	d.SetId(buildResourceId(projectName, supervisorNamespaceName))
 	dSet(d, "name", supervisorNamespaceName)
 	dSet(d, "project_name", projectName)
+	dSet(d, "api_path", buildSupervisorNamespaceApiPath(projectName, supervisorNamespaceName))
+	// Supervisor Namespaces are backed by a Kubernetes namespace with the same name
+	dSet(d, "kubernetes_namespace", supervisorNamespaceName)
 	dSet(d, "class_name", supervisorNamespace.Spec.ClassName)
 	dSet(d, "description", supervisorNamespace.Spec.Description)
 	dSet(d, "phase", supervisorNamespace.Status.Phase)
 	dSet(d, "region_name", supervisorNamespace.Spec.RegionName)
 	dSet(d, "seg_name", supervisorNamespace.Spec.SegName)
 	dSet(d, "vpc_name", supervisorNamespace.Spec.VpcName)
+	if err := d.Set("vpc_names", getSupervisorNamespaceVpcNames(supervisorNamespace)); err != nil {
+		return fmt.Errorf("error setting vpc_names: %s", err)
+	}
+	expiresAt := supervisorNamespace.Annotations[supervisorNamespaceExpiresAtAnnotation]
+	dSet(d, "expires_at", expiresAt)
+	dSet(d, "expired", isSupervisorNamespaceExpired(expiresAt, time.Now()))
+	if err := d.Set("audit_annotations", getAuditTrailAnnotations(supervisorNamespace.Annotations)); err != nil {
+		return fmt.Errorf("error setting audit_annotations: %s", err)
+	}
 
 	d.Set("ready", false)
 	for _, condition := range supervisorNamespace.Status.Conditions {
@@ -775,6 +1393,10 @@ func setSupervisorNamespaceData(_ *VCDClient, d *schema.ResourceData, projectNam
 
 		contentSourcesClassConfigOverrides = append(contentSourcesClassConfigOverrides, cs)
 	}
+	d.Set("content_sources_effective_class_config_overrides", contentSourcesClassConfigOverrides)
+	if userOverridesOnly {
+		contentSourcesClassConfigOverrides = filterClassConfigOverrides(d, contentSourcesClassConfigOverrides, false, "content_sources_class_config_overrides")
+	}
 	d.Set("content_sources_class_config_overrides", contentSourcesClassConfigOverrides)
 
 	infraPolicies := make([]interface{}, 0, len(supervisorNamespace.Status.InfraPolicies))
@@ -820,6 +1442,10 @@ func setSupervisorNamespaceData(_ *VCDClient, d *schema.ResourceData, projectNam
 
 		storageClassesClassConfigOverrides = append(storageClassesClassConfigOverrides, storageClassClassConfigOverride)
 	}
+	d.Set("storage_classes_effective_class_config_overrides", storageClassesClassConfigOverrides)
+	if userOverridesOnly {
+		storageClassesClassConfigOverrides = filterClassConfigOverrides(d, storageClassesClassConfigOverrides, true, "storage_classes_class_config_overrides", "storage_classes_initial_class_config_overrides")
+	}
 	d.Set("storage_classes_class_config_overrides", storageClassesClassConfigOverrides)
 	d.Set("storage_classes_initial_class_config_overrides", storageClassesClassConfigOverrides)
 
@@ -841,6 +1467,10 @@ func setSupervisorNamespaceData(_ *VCDClient, d *schema.ResourceData, projectNam
 
 		vmClassesClassConfigOverrides = append(vmClassesClassConfigOverrides, vmClassClassConfigOverride)
 	}
+	d.Set("vm_classes_effective_class_config_overrides", vmClassesClassConfigOverrides)
+	if userOverridesOnly {
+		vmClassesClassConfigOverrides = filterClassConfigOverrides(d, vmClassesClassConfigOverrides, false, "vm_classes_class_config_overrides")
+	}
 	d.Set("vm_classes_class_config_overrides", vmClassesClassConfigOverrides)
 
 	zones := make([]interface{}, 0, len(supervisorNamespace.Status.Zones))
@@ -870,8 +1500,83 @@ func setSupervisorNamespaceData(_ *VCDClient, d *schema.ResourceData, projectNam
 
 		zonesClassConfigOverrides = append(zonesClassConfigOverrides, zoneClassConfigOverride)
 	}
+	d.Set("zones_effective_class_config_overrides", zonesClassConfigOverrides)
+	if userOverridesOnly {
+		zonesClassConfigOverrides = filterClassConfigOverrides(d, zonesClassConfigOverrides, true, "zones_class_config_overrides", "zones_initial_class_config_overrides")
+	}
 	d.Set("zones_class_config_overrides", zonesClassConfigOverrides)
 	d.Set("zones_initial_class_config_overrides", zonesClassConfigOverrides)
 
 	return nil
 }
+
+// getSupervisorNamespaceVpcNames returns the names of the VPCs attached to the given Supervisor Namespace, starting
+// with the primary one. The API only supports a single VPC attachment today, so the list has at most one entry, taken
+// from the spec or, when it is not set there, from the status
+func getSupervisorNamespaceVpcNames(supervisorNamespace ccitypes.SupervisorNamespace) []string {
+	primary := supervisorNamespace.Spec.VpcName
+	if primary == "" && supervisorNamespace.Status != nil {
+		primary = supervisorNamespace.Status.VpcName
+	}
+	if primary == "" {
+		return []string{}
+	}
+	return []string{primary}
+}
+
+// getUnmanagedStorageClassOverrides returns the Storage Class overrides of the current Supervisor Namespace that are
+// neither in the configuration nor in the state of the resource, like the ones bound with
+// 'vcfa_supervisor_namespace_storage_class_binding'
+func getUnmanagedStorageClassOverrides(d *schema.ResourceData, current []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass) []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass {
+	managedNames := map[string]bool{}
+	for _, attribute := range []string{"storage_classes_class_config_overrides", "storage_classes_initial_class_config_overrides"} {
+		oldValue, newValue := d.GetChange(attribute)
+		for _, value := range []interface{}{oldValue, newValue} {
+			set, ok := value.(*schema.Set)
+			if !ok {
+				continue
+			}
+			for _, item := range set.List() {
+				managedNames[item.(map[string]interface{})["name"].(string)] = true
+			}
+		}
+	}
+	return filterUnmanagedStorageClassOverrides(current, managedNames)
+}
+
+// filterUnmanagedStorageClassOverrides returns the Storage Class overrides whose name is not in the given set
+func filterUnmanagedStorageClassOverrides(overrides []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, managedNames map[string]bool) []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass {
+	var unmanaged []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass
+	for _, override := range overrides {
+		if !managedNames[override.Name] {
+			unmanaged = append(unmanaged, override)
+		}
+	}
+	return unmanaged
+}
+
+// filterClassConfigOverrides returns the Class Config Overrides retrieved from the API whose name is present
+// in any of the given attributes. If none of the attributes has entries, all the overrides are returned when
+// 'keepAllIfUnset' is true (the attribute is Computed), and none otherwise.
+func filterClassConfigOverrides(d *schema.ResourceData, overrides []interface{}, keepAllIfUnset bool, attributes ...string) []interface{} {
+	names := map[string]bool{}
+	for _, attribute := range attributes {
+		for _, entry := range d.Get(attribute).(*schema.Set).List() {
+			names[entry.(map[string]interface{})["name"].(string)] = true
+		}
+	}
+	if len(names) == 0 {
+		if keepAllIfUnset {
+			return overrides
+		}
+		return []interface{}{}
+	}
+
+	result := make([]interface{}, 0, len(overrides))
+	for _, override := range overrides {
+		if names[override.(map[string]interface{})["name"].(string)] {
+			result = append(result, override)
+		}
+	}
+	return result
+}
@@ -0,0 +1,311 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const labelSupervisorNamespaceRoleBinding = "Supervisor Namespace Role Binding"
+
+const (
+	// roleBindingNamePrefix is the prefix of the names of the RoleBindings managed by Terraform, which are
+	// '<prefix><subject_type>:<subject_name>', so every subject has a single binding in each Supervisor Namespace
+	roleBindingNamePrefix = "terraform:"
+	// roleBindingManagedByLabel marks the RoleBindings managed by Terraform
+	roleBindingManagedByLabel = "app.kubernetes.io/managed-by"
+)
+
+// supervisorNamespaceRoles maps the roles that can be granted in a Supervisor Namespace to the ClusterRoles that
+// implement them, like the permissions of vSphere Namespaces
+var supervisorNamespaceRoles = map[string]string{
+	"view":  "view",
+	"edit":  "edit",
+	"owner": "admin",
+}
+
+// roleBindingSubjectKinds maps the subject types to the kinds of the RoleBinding subjects
+var roleBindingSubjectKinds = map[string]string{
+	"user":  rbacv1.UserKind,
+	"group": rbacv1.GroupKind,
+}
+
+func resourceVcfaSupervisorNamespaceRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaSupervisorNamespaceRoleBindingCreate,
+		ReadContext:   resourceVcfaSupervisorNamespaceRoleBindingRead,
+		DeleteContext: resourceVcfaSupervisorNamespaceRoleBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaSupervisorNamespaceRoleBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": orgScopedSchema(labelSupervisorNamespaceRoleBinding),
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelSupervisorNamespace),
+			},
+			"supervisor_namespace_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Name of the %s to grant access to", labelSupervisorNamespace),
+			},
+			"subject_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"user", "group"}, false),
+				Description:  "Type of the subject that gets the role: 'user' or 'group'",
+			},
+			"subject_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of the VCFA user or group that gets the role",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"view", "edit", "owner"}, false),
+				Description:  fmt.Sprintf("Role granted in the %s: 'view', 'edit' or 'owner'", labelSupervisorNamespace),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Kubernetes RoleBinding",
+			},
+		},
+	}
+}
+
+func resourceVcfaSupervisorNamespaceRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName := d.Get("project_name").(string)
+	supervisorNamespaceName := d.Get("supervisor_namespace_name").(string)
+	subjectType := d.Get("subject_type").(string)
+	subjectName := d.Get("subject_name").(string)
+
+	client, err := getSupervisorNamespaceKubernetesClientByName(ctx, tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		return diag.Errorf("error creating %s: %s", labelSupervisorNamespaceRoleBinding, projectAccessError(tmClient, projectName, err))
+	}
+
+	roleBinding := buildSupervisorNamespaceRoleBinding(supervisorNamespaceName, subjectType, subjectName, d.Get("role").(string))
+	createOptions := v1.CreateOptions{}
+	if isDryRun(meta) {
+		createOptions.DryRun = []string{v1.DryRunAll}
+	}
+	if _, err := client.RbacV1().RoleBindings(supervisorNamespaceName).Create(ctx, roleBinding, createOptions); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return diag.Errorf("error creating %s: %s %s already has a role in %s %s", labelSupervisorNamespaceRoleBinding, subjectType, subjectName, labelSupervisorNamespace, supervisorNamespaceName)
+		}
+		return diag.Errorf("error creating %s: %s", labelSupervisorNamespaceRoleBinding, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespaceRoleBinding, roleBinding.Name, "created")
+	}
+
+	d.SetId(buildRoleBindingId(projectName, supervisorNamespaceName, subjectType, subjectName))
+
+	return resourceVcfaSupervisorNamespaceRoleBindingRead(ctx, d, meta)
+}
+
+func resourceVcfaSupervisorNamespaceRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, supervisorNamespaceName, subjectType, subjectName, err := parseRoleBindingId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespaceRoleBinding, d.Id(), err)
+	}
+
+	client, err := getSupervisorNamespaceKubernetesClientByName(ctx, tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			log.Printf("[DEBUG] %s %s in Project %s not found, removing %s from state", labelSupervisorNamespace, supervisorNamespaceName, projectName, labelSupervisorNamespaceRoleBinding)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelSupervisorNamespaceRoleBinding, err)
+	}
+
+	name := buildRoleBindingName(subjectType, subjectName)
+	roleBinding, err := client.RbacV1().RoleBindings(supervisorNamespaceName).Get(ctx, name, v1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Printf("[DEBUG] %s %s not found in %s %s, removing from state", labelSupervisorNamespaceRoleBinding, name, labelSupervisorNamespace, supervisorNamespaceName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s %s: %s", labelSupervisorNamespaceRoleBinding, name, err)
+	}
+
+	dSet(d, "project_name", projectName)
+	dSet(d, "supervisor_namespace_name", supervisorNamespaceName)
+	dSet(d, "subject_type", subjectType)
+	dSet(d, "subject_name", subjectName)
+	dSet(d, "role", getSupervisorNamespaceRole(roleBinding.RoleRef))
+	dSet(d, "name", roleBinding.Name)
+
+	return nil
+}
+
+func resourceVcfaSupervisorNamespaceRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, supervisorNamespaceName, subjectType, subjectName, err := parseRoleBindingId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespaceRoleBinding, d.Id(), err)
+	}
+
+	client, err := getSupervisorNamespaceKubernetesClientByName(ctx, tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		// The RoleBinding is gone with its Supervisor Namespace
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return diag.Errorf("error deleting %s: %s", labelSupervisorNamespaceRoleBinding, err)
+	}
+
+	name := buildRoleBindingName(subjectType, subjectName)
+	deleteOptions := v1.DeleteOptions{}
+	if isDryRun(meta) {
+		deleteOptions.DryRun = []string{v1.DryRunAll}
+	}
+	if err := client.RbacV1().RoleBindings(supervisorNamespaceName).Delete(ctx, name, deleteOptions); err != nil && !apierrors.IsNotFound(err) {
+		return diag.Errorf("error deleting %s %s: %s", labelSupervisorNamespaceRoleBinding, name, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespaceRoleBinding, name, "deleted")
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceVcfaSupervisorNamespaceRoleBindingImport imports the role of a subject in a Supervisor Namespace, identified
+// by [<org><sep>]<project_name><sep><supervisor_namespace_name><sep><subject_type><sep><subject_name>. The subject name
+// is the last part, so it can contain the separator, like the dots of e-mail addresses
+func resourceVcfaSupervisorNamespaceRoleBindingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	org, projectName, supervisorNamespaceName, subjectType, subjectName, err := splitRoleBindingImportId(d.Id(), ImportSeparator)
+	if err != nil {
+		return nil, err
+	}
+	if org != "" {
+		dSet(d, "org", org)
+	}
+
+	d.SetId(buildRoleBindingId(projectName, supervisorNamespaceName, subjectType, subjectName))
+	if diags := resourceVcfaSupervisorNamespaceRoleBindingRead(ctx, d, meta); diags.HasError() {
+		return nil, fmt.Errorf("error importing %s: %s", labelSupervisorNamespaceRoleBinding, diags[0].Summary)
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("%s %s has no role in %s %s", subjectType, subjectName, labelSupervisorNamespace, supervisorNamespaceName)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// getSupervisorNamespaceKubernetesClientByName returns a Kubernetes client for the namespace endpoint of the given
+// Supervisor Namespace
+func getSupervisorNamespaceKubernetesClientByName(ctx context.Context, tmClient *VCDClient, projectName, supervisorNamespaceName string) (kubernetes.Interface, error) {
+	supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		return nil, err
+	}
+	return getSupervisorNamespaceKubernetesClient(tmClient, supervisorNamespace)
+}
+
+// buildSupervisorNamespaceRoleBinding returns the RoleBinding that grants the given role to a subject
+func buildSupervisorNamespaceRoleBinding(supervisorNamespaceName, subjectType, subjectName, role string) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      buildRoleBindingName(subjectType, subjectName),
+			Namespace: supervisorNamespaceName,
+			Labels:    map[string]string{roleBindingManagedByLabel: "terraform"},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     supervisorNamespaceRoles[role],
+		},
+		Subjects: []rbacv1.Subject{{
+			APIGroup: rbacv1.GroupName,
+			Kind:     roleBindingSubjectKinds[subjectType],
+			Name:     subjectName,
+		}},
+	}
+}
+
+// getSupervisorNamespaceRole returns the role implemented by the ClusterRole of a RoleBinding. RoleBindings modified
+// outside of Terraform may refer to other roles, which are returned as they are, so the difference is planned
+func getSupervisorNamespaceRole(roleRef rbacv1.RoleRef) string {
+	if roleRef.Kind == "ClusterRole" {
+		for role, clusterRole := range supervisorNamespaceRoles {
+			if clusterRole == roleRef.Name {
+				return role
+			}
+		}
+	}
+	return roleRef.Name
+}
+
+func buildRoleBindingName(subjectType, subjectName string) string {
+	return roleBindingNamePrefix + subjectType + ":" + subjectName
+}
+
+func buildRoleBindingId(projectName, supervisorNamespaceName, subjectType, subjectName string) string {
+	return fmt.Sprintf("%s:%s:%s", buildResourceId(projectName, supervisorNamespaceName), subjectType, subjectName)
+}
+
+func parseRoleBindingId(id string) (string, string, string, string, error) {
+	// The subject name is the last part, as it may contain colons
+	idParts := strings.SplitN(id, ":", 4)
+	if len(idParts) != 4 || roleBindingSubjectKinds[idParts[2]] == "" {
+		return "", "", "", "", fmt.Errorf("id %s does not contain four parts", id)
+	}
+	return idParts[0], idParts[1], idParts[2], idParts[3], nil
+}
+
+// splitRoleBindingImportId splits the import ID of a role binding, locating the subject type to know whether the
+// Organization is given and where the subject name, which may contain the separator, starts
+func splitRoleBindingImportId(id, separator string) (org, projectName, supervisorNamespaceName, subjectType, subjectName string, err error) {
+	idSlice := strings.Split(id, separator)
+	for i, part := range idSlice {
+		if roleBindingSubjectKinds[part] == "" || (i != 2 && i != 3) || i == len(idSlice)-1 {
+			continue
+		}
+		if i == 3 {
+			org = decodeImportIdElement(idSlice[0])
+		}
+		return org, decodeImportIdElement(idSlice[i-2]), decodeImportIdElement(idSlice[i-1]), part,
+			decodeImportIdElement(strings.Join(idSlice[i+1:], separator)), nil
+	}
+	return "", "", "", "", "", fmt.Errorf("expected import ID to be [<org>%s]<project_name>%s<supervisor_namespace_name>%s<user|group>%s<subject_name>",
+		separator, separator, separator, separator)
+}
@@ -0,0 +1,127 @@
+//go:build cci || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+)
+
+// TestAccVcfaSupervisorNamespaceRoleBinding tests granting a role in a Supervisor Namespace to the Organization user
+// of the test configuration, and replacing it with another role
+func TestAccVcfaSupervisorNamespaceRoleBinding(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfSysAdmin(t)
+
+	if testConfig.Org.User == "" {
+		t.Skip("an Organization user is required in org.user from test configuration")
+	}
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	var params = StringMap{
+		"ProjectName":        "tf-project",
+		"RegionName":         testConfig.Cci.Region,
+		"VpcName":            testConfig.Cci.Vpc,
+		"StorageClassName":   testConfig.Cci.StoragePolicy,
+		"SupervisorZoneName": testConfig.Cci.SupervisorZone,
+		"VmClass":            testConfig.Cci.VmClass1,
+		"UserName":           testConfig.Org.User,
+		"Role":               "view",
+
+		"Tags": "cci",
+	}
+	testParamsNotEmpty(t, params)
+
+	cleanup := testutils.SetupProject(t, params["ProjectName"].(string))
+	defer cleanup()
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaSupervisorNamespaceRoleBinding, params)
+	params["FuncName"] = t.Name() + "-step2"
+	params["Role"] = "edit"
+	configText2 := templateFill(testAccVcfaSupervisorNamespaceRoleBinding, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	bindingDef := "vcfa_supervisor_namespace_role_binding.test"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(bindingDef, "supervisor_namespace_name", "vcfa_supervisor_namespace.test", "name"),
+					resource.TestCheckResourceAttr(bindingDef, "subject_type", "user"),
+					resource.TestCheckResourceAttr(bindingDef, "subject_name", params["UserName"].(string)),
+					resource.TestCheckResourceAttr(bindingDef, "role", "view"),
+					resource.TestCheckResourceAttr(bindingDef, "name", "terraform:user:"+params["UserName"].(string)),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(bindingDef, "role", "edit"),
+				),
+			},
+			{
+				ResourceName:      bindingDef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[bindingDef]
+					return rs.Primary.Attributes["project_name"] + ImportSeparator + rs.Primary.Attributes["supervisor_namespace_name"] +
+						ImportSeparator + "user" + ImportSeparator + rs.Primary.Attributes["subject_name"], nil
+				},
+			},
+		},
+	})
+}
+
+const testAccVcfaSupervisorNamespaceRoleBinding = `
+resource "vcfa_supervisor_namespace" "test" {
+  name_prefix  = "terraform-test"
+  project_name = "{{.ProjectName}}"
+  class_name   = "small"
+  description  = "Supervisor Namespace created by Terraform"
+  region_name  = "{{.RegionName}}"
+  vpc_name     = "{{.VpcName}}"
+
+  storage_classes_class_config_overrides {
+    limit = "100Mi"
+    name  = "{{.StorageClassName}}"
+  }
+
+  vm_classes_class_config_overrides {
+    name = "{{.VmClass}}"
+  }
+
+  zones_class_config_overrides {
+    cpu_limit          = "100M"
+    cpu_reservation    = "0M"
+    memory_limit       = "200Mi"
+    memory_reservation = "0Mi"
+    name               = "{{.SupervisorZoneName}}"
+  }
+}
+
+resource "vcfa_supervisor_namespace_role_binding" "test" {
+  project_name              = vcfa_supervisor_namespace.test.project_name
+  supervisor_namespace_name = vcfa_supervisor_namespace.test.name
+  subject_type              = "user"
+  subject_name              = "{{.UserName}}"
+  role                      = "{{.Role}}"
+}
+`
@@ -0,0 +1,90 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestSupervisorNamespaceRoleBindingId(t *testing.T) {
+	id := buildRoleBindingId("project1", "ns1", "user", "org1:alice@example.com")
+	if id != "project1:ns1:user:org1:alice@example.com" {
+		t.Fatalf("unexpected id '%s'", id)
+	}
+	projectName, supervisorNamespaceName, subjectType, subjectName, err := parseRoleBindingId(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if projectName != "project1" || supervisorNamespaceName != "ns1" || subjectType != "user" || subjectName != "org1:alice@example.com" {
+		t.Errorf("unexpected parts: %s, %s, %s, %s", projectName, supervisorNamespaceName, subjectType, subjectName)
+	}
+
+	for _, invalid := range []string{"project1:ns1:user", "project1:ns1:robot:alice", "project1"} {
+		if _, _, _, _, err := parseRoleBindingId(invalid); err == nil {
+			t.Errorf("expected an error parsing '%s'", invalid)
+		}
+	}
+}
+
+func TestSplitRoleBindingImportId(t *testing.T) {
+	tests := []struct {
+		id                                                                  string
+		org, projectName, supervisorNamespaceName, subjectType, subjectName string
+		wantErr                                                             bool
+	}{
+		{id: "project1.ns1.user.alice", projectName: "project1", supervisorNamespaceName: "ns1", subjectType: "user", subjectName: "alice"},
+		{id: "org1.project1.ns1.group.admins", org: "org1", projectName: "project1", supervisorNamespaceName: "ns1", subjectType: "group", subjectName: "admins"},
+		{id: "project1.ns1.user.alice@example.com", projectName: "project1", supervisorNamespaceName: "ns1", subjectType: "user", subjectName: "alice@example.com"},
+		{id: "org1.user.ns1.user.bob", org: "org1", projectName: "user", supervisorNamespaceName: "ns1", subjectType: "user", subjectName: "bob"},
+		{id: "my%2Eorg.project1.ns1.user.alice%25", org: "my.org", projectName: "project1", supervisorNamespaceName: "ns1", subjectType: "user", subjectName: "alice%"},
+		{id: "project1.ns1.user", wantErr: true},
+		{id: "project1.ns1.alice", wantErr: true},
+		{id: "org1.project1.ns1.extra.user.alice", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			org, projectName, supervisorNamespaceName, subjectType, subjectName, err := splitRoleBindingImportId(tt.id, ".")
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if org != tt.org || projectName != tt.projectName || supervisorNamespaceName != tt.supervisorNamespaceName ||
+				subjectType != tt.subjectType || subjectName != tt.subjectName {
+				t.Errorf("unexpected parts: '%s', '%s', '%s', '%s', '%s'", org, projectName, supervisorNamespaceName, subjectType, subjectName)
+			}
+		})
+	}
+}
+
+func TestBuildSupervisorNamespaceRoleBinding(t *testing.T) {
+	roleBinding := buildSupervisorNamespaceRoleBinding("ns1", "group", "admins", "owner")
+	if roleBinding.Name != "terraform:group:admins" || roleBinding.Namespace != "ns1" {
+		t.Errorf("unexpected metadata: %+v", roleBinding.ObjectMeta)
+	}
+	if roleBinding.RoleRef.Kind != "ClusterRole" || roleBinding.RoleRef.Name != "admin" {
+		t.Errorf("expected the owner role to be the admin ClusterRole, got %+v", roleBinding.RoleRef)
+	}
+	if len(roleBinding.Subjects) != 1 || roleBinding.Subjects[0].Kind != rbacv1.GroupKind || roleBinding.Subjects[0].Name != "admins" {
+		t.Errorf("unexpected subjects: %+v", roleBinding.Subjects)
+	}
+
+	for role := range supervisorNamespaceRoles {
+		if got := getSupervisorNamespaceRole(buildSupervisorNamespaceRoleBinding("ns1", "user", "alice", role).RoleRef); got != role {
+			t.Errorf("expected role '%s', got '%s'", role, got)
+		}
+	}
+	if got := getSupervisorNamespaceRole(rbacv1.RoleRef{Kind: "ClusterRole", Name: "cluster-admin"}); got != "cluster-admin" {
+		t.Errorf("expected unknown roles to be returned as they are, got '%s'", got)
+	}
+}
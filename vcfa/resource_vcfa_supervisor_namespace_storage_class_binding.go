@@ -0,0 +1,280 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+)
+
+const labelSupervisorNamespaceStorageClassBinding = "Supervisor Namespace Storage Class Binding"
+
+// supervisorNamespaceConflictErrorRegex matches the errors returned when a Supervisor Namespace was modified between
+// reading and updating it
+var supervisorNamespaceConflictErrorRegex = regexp.MustCompile(`(?i)code: 409|reason: Conflict`)
+
+// supervisorNamespaceConflictRetryTimeout is the maximum time during which updates that conflict with other ones are
+// retried
+const supervisorNamespaceConflictRetryTimeout = 2 * time.Minute
+
+func resourceVcfaSupervisorNamespaceStorageClassBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaSupervisorNamespaceStorageClassBindingCreate,
+		ReadContext:   resourceVcfaSupervisorNamespaceStorageClassBindingRead,
+		UpdateContext: resourceVcfaSupervisorNamespaceStorageClassBindingUpdate,
+		DeleteContext: resourceVcfaSupervisorNamespaceStorageClassBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaSupervisorNamespaceStorageClassBindingImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"org": orgScopedSchema(labelSupervisorNamespaceStorageClassBinding),
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelSupervisorNamespace),
+			},
+			"supervisor_namespace_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Name of the %s to bind the Storage Class to", labelSupervisorNamespace),
+			},
+			"storage_class_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("Name of the Storage Class to bind to the %s", labelSupervisorNamespace),
+			},
+			"limit": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Limit (format: `<number><unit>`, where `<unit>` can be `Mi`, `Gi`, or `Ti`)",
+			},
+		},
+	}
+}
+
+func resourceVcfaSupervisorNamespaceStorageClassBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName := d.Get("project_name").(string)
+	supervisorNamespaceName := d.Get("supervisor_namespace_name").(string)
+	storageClassName := d.Get("storage_class_name").(string)
+
+	err = updateSupervisorNamespaceStorageClasses(ctx, tmClient, projectName, supervisorNamespaceName, dryRunParams(meta),
+		func(storageClasses []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass) ([]ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, error) {
+			if findStorageClassOverride(storageClasses, storageClassName) != nil {
+				return nil, fmt.Errorf("Storage Class %s is already bound to %s %s", storageClassName, labelSupervisorNamespace, supervisorNamespaceName)
+			}
+			return append(storageClasses, ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{
+				Name:  storageClassName,
+				Limit: d.Get("limit").(string),
+			}), nil
+		})
+	if err != nil {
+		return diag.Errorf("error creating %s: %s", labelSupervisorNamespaceStorageClassBinding, projectAccessError(tmClient, projectName, err))
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespaceStorageClassBinding, storageClassName, "created")
+	}
+
+	d.SetId(buildStorageClassBindingId(projectName, supervisorNamespaceName, storageClassName))
+
+	if err := waitForSupervisorNamespaceRealized(ctx, tmClient, projectName, supervisorNamespaceName, operationTimeout(d, meta, schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaSupervisorNamespaceStorageClassBindingRead(ctx, d, meta)
+}
+
+func resourceVcfaSupervisorNamespaceStorageClassBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, supervisorNamespaceName, storageClassName, err := parseStorageClassBindingId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespaceStorageClassBinding, d.Id(), err)
+	}
+
+	err = updateSupervisorNamespaceStorageClasses(ctx, tmClient, projectName, supervisorNamespaceName, dryRunParams(meta),
+		func(storageClasses []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass) ([]ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, error) {
+			storageClass := findStorageClassOverride(storageClasses, storageClassName)
+			if storageClass == nil {
+				return nil, fmt.Errorf("Storage Class %s is no longer bound to %s %s", storageClassName, labelSupervisorNamespace, supervisorNamespaceName)
+			}
+			storageClass.Limit = d.Get("limit").(string)
+			return storageClasses, nil
+		})
+	if err != nil {
+		return diag.Errorf("error updating %s: %s", labelSupervisorNamespaceStorageClassBinding, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespaceStorageClassBinding, storageClassName, "updated")
+	}
+
+	if err := waitForSupervisorNamespaceRealized(ctx, tmClient, projectName, supervisorNamespaceName, operationTimeout(d, meta, schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaSupervisorNamespaceStorageClassBindingRead(ctx, d, meta)
+}
+
+func resourceVcfaSupervisorNamespaceStorageClassBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, supervisorNamespaceName, storageClassName, err := parseStorageClassBindingId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespaceStorageClassBinding, d.Id(), err)
+	}
+
+	supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			log.Printf("[DEBUG] %s %s in Project %s not found, removing %s from state", labelSupervisorNamespace, supervisorNamespaceName, projectName, labelSupervisorNamespaceStorageClassBinding)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelSupervisorNamespace, err)
+	}
+	storageClass := findStorageClassOverride(supervisorNamespace.Spec.ClassConfigOverrides.StorageClasses, storageClassName)
+	if storageClass == nil {
+		log.Printf("[DEBUG] Storage Class %s is not bound to %s %s, removing from state", storageClassName, labelSupervisorNamespace, supervisorNamespaceName)
+		d.SetId("")
+		return nil
+	}
+
+	dSet(d, "project_name", projectName)
+	dSet(d, "supervisor_namespace_name", supervisorNamespaceName)
+	dSet(d, "storage_class_name", storageClassName)
+	dSet(d, "limit", storageClass.Limit)
+
+	return nil
+}
+
+func resourceVcfaSupervisorNamespaceStorageClassBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, supervisorNamespaceName, storageClassName, err := parseStorageClassBindingId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelSupervisorNamespaceStorageClassBinding, d.Id(), err)
+	}
+
+	err = updateSupervisorNamespaceStorageClasses(ctx, tmClient, projectName, supervisorNamespaceName, dryRunParams(meta),
+		func(storageClasses []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass) ([]ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, error) {
+			return filterUnmanagedStorageClassOverrides(storageClasses, map[string]bool{storageClassName: true}), nil
+		})
+	if err != nil {
+		// The Storage Class is gone with its Supervisor Namespace
+		if strings.Contains(err.Error(), "not found") {
+			return nil
+		}
+		return diag.Errorf("error deleting %s: %s", labelSupervisorNamespaceStorageClassBinding, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelSupervisorNamespaceStorageClassBinding, storageClassName, "deleted")
+	}
+
+	if err := waitForSupervisorNamespaceRealized(ctx, tmClient, projectName, supervisorNamespaceName, operationTimeout(d, meta, schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceVcfaSupervisorNamespaceStorageClassBindingImport imports a Storage Class bound to a Supervisor Namespace,
+// identified by [<org><sep>]<project_name><sep><supervisor_namespace_name><sep><storage_class_name>
+func resourceVcfaSupervisorNamespaceStorageClassBindingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idSlice := SplitImportId(d.Id(), -1)
+	switch len(idSlice) {
+	case 3:
+	case 4:
+		dSet(d, "org", idSlice[0])
+		idSlice = idSlice[1:]
+	default:
+		return nil, fmt.Errorf("expected import ID to be [<org>%s]<project_name>%s<supervisor_namespace_name>%s<storage_class_name>",
+			ImportSeparator, ImportSeparator, ImportSeparator)
+	}
+	projectName, supervisorNamespaceName, storageClassName := idSlice[0], idSlice[1], idSlice[2]
+
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, supervisorNamespaceName)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", labelSupervisorNamespace, err)
+	}
+	if findStorageClassOverride(supervisorNamespace.Spec.ClassConfigOverrides.StorageClasses, storageClassName) == nil {
+		return nil, fmt.Errorf("Storage Class %s is not bound to %s %s", storageClassName, labelSupervisorNamespace, supervisorNamespaceName)
+	}
+
+	d.SetId(buildStorageClassBindingId(projectName, supervisorNamespaceName, storageClassName))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateSupervisorNamespaceStorageClasses replaces the Storage Class overrides of the given Supervisor Namespace with
+// the ones returned by 'update', which receives the current ones. The rest of the Supervisor Namespace is sent back as
+// read, so its resource version detects concurrent updates, like the ones of other bindings, which are retried
+func updateSupervisorNamespaceStorageClasses(ctx context.Context, tmClient *VCDClient, projectName, supervisorNamespaceName string, params url.Values,
+	update func([]ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass) ([]ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, error)) error {
+	return runWithRetry(ctx, func() error {
+		supervisorNamespace, err := readSupervisorNamespace(ctx, tmClient, projectName, supervisorNamespaceName)
+		if err != nil {
+			return err
+		}
+		storageClasses, err := update(supervisorNamespace.Spec.ClassConfigOverrides.StorageClasses)
+		if err != nil {
+			return err
+		}
+		supervisorNamespace.Spec.ClassConfigOverrides.StorageClasses = storageClasses
+		// The status is owned by VCFA
+		supervisorNamespace.Status = nil
+		_, err = updateSupervisorNamespace(tmClient, projectName, supervisorNamespaceName, supervisorNamespace, params)
+		return err
+	}, supervisorNamespaceConflictErrorRegex, supervisorNamespaceConflictRetryTimeout)
+}
+
+// findStorageClassOverride returns the Storage Class override with the given name, or nil if there is none
+func findStorageClassOverride(storageClasses []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass, name string) *ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass {
+	for i := range storageClasses {
+		if storageClasses[i].Name == name {
+			return &storageClasses[i]
+		}
+	}
+	return nil
+}
+
+func buildStorageClassBindingId(projectName, supervisorNamespaceName, storageClassName string) string {
+	return fmt.Sprintf("%s:%s", buildResourceId(projectName, supervisorNamespaceName), storageClassName)
+}
+
+func parseStorageClassBindingId(id string) (string, string, string, error) {
+	idParts := strings.Split(id, ":")
+	if len(idParts) != 3 {
+		return "", "", "", fmt.Errorf("id %s does not contain three parts", id)
+	}
+	return idParts[0], idParts[1], idParts[2], nil
+}
@@ -0,0 +1,130 @@
+//go:build cci || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/testutils"
+)
+
+// TestAccVcfaSupervisorNamespaceStorageClassBinding tests binding a second Storage Class to a Supervisor Namespace,
+// updating its limit, and that updating the Supervisor Namespace keeps the binding
+func TestAccVcfaSupervisorNamespaceStorageClassBinding(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfSysAdmin(t)
+
+	if testConfig.Cci.StoragePolicy2 == "" {
+		t.Skip("a second storage policy is required in cci.storagePolicy2 from test configuration")
+	}
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	var params = StringMap{
+		"ProjectName":        "tf-project",
+		"RegionName":         testConfig.Cci.Region,
+		"VpcName":            testConfig.Cci.Vpc,
+		"StorageClassName":   testConfig.Cci.StoragePolicy,
+		"StorageClassName2":  testConfig.Cci.StoragePolicy2,
+		"SupervisorZoneName": testConfig.Cci.SupervisorZone,
+		"VmClass":            testConfig.Cci.VmClass1,
+		"Description":        "Supervisor Namespace created by Terraform",
+		"BindingLimit":       "100Mi",
+
+		"Tags": "cci",
+	}
+	testParamsNotEmpty(t, params)
+
+	cleanup := testutils.SetupProject(t, params["ProjectName"].(string))
+	defer cleanup()
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaSupervisorNamespaceStorageClassBinding, params)
+	params["FuncName"] = t.Name() + "-step2"
+	params["BindingLimit"] = "120Mi"
+	params["Description"] = "Supervisor Namespace updated by Terraform"
+	configText2 := templateFill(testAccVcfaSupervisorNamespaceStorageClassBinding, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	bindingDef := "vcfa_supervisor_namespace_storage_class_binding.test"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(bindingDef, "supervisor_namespace_name", "vcfa_supervisor_namespace.test", "name"),
+					resource.TestCheckResourceAttr(bindingDef, "storage_class_name", params["StorageClassName2"].(string)),
+					resource.TestCheckResourceAttr(bindingDef, "limit", "100Mi"),
+				),
+			},
+			{
+				// Updating both resources at once checks that the Supervisor Namespace keeps the bound Storage Class
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(bindingDef, "limit", "120Mi"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "storage_classes_class_config_overrides.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_supervisor_namespace.test", "storage_classes_effective_class_config_overrides.*",
+						map[string]string{"name": params["StorageClassName2"].(string), "limit": "120Mi"}),
+				),
+			},
+			{
+				ResourceName:      bindingDef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[bindingDef]
+					return rs.Primary.Attributes["project_name"] + ImportSeparator + rs.Primary.Attributes["supervisor_namespace_name"] +
+						ImportSeparator + rs.Primary.Attributes["storage_class_name"], nil
+				},
+			},
+		},
+	})
+}
+
+const testAccVcfaSupervisorNamespaceStorageClassBinding = `
+resource "vcfa_supervisor_namespace" "test" {
+  name_prefix  = "terraform-test"
+  project_name = "{{.ProjectName}}"
+  class_name   = "small"
+  description  = "{{.Description}}"
+  region_name  = "{{.RegionName}}"
+  vpc_name     = "{{.VpcName}}"
+
+  storage_classes_class_config_overrides {
+    limit = "100Mi"
+    name  = "{{.StorageClassName}}"
+  }
+
+  vm_classes_class_config_overrides {
+    name = "{{.VmClass}}"
+  }
+
+  zones_class_config_overrides {
+    cpu_limit          = "100M"
+    cpu_reservation    = "0M"
+    memory_limit       = "200Mi"
+    memory_reservation = "0Mi"
+    name               = "{{.SupervisorZoneName}}"
+  }
+}
+
+resource "vcfa_supervisor_namespace_storage_class_binding" "test" {
+  project_name              = vcfa_supervisor_namespace.test.project_name
+  supervisor_namespace_name = vcfa_supervisor_namespace.test.name
+  storage_class_name        = "{{.StorageClassName2}}"
+  limit                     = "{{.BindingLimit}}"
+}
+`
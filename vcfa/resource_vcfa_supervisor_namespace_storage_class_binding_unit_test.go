@@ -0,0 +1,66 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+)
+
+func TestStorageClassBindingId(t *testing.T) {
+	id := buildStorageClassBindingId("project1", "ns-abcde", "vSAN Default Storage Policy")
+	if id != "project1:ns-abcde:vSAN Default Storage Policy" {
+		t.Fatalf("unexpected id '%s'", id)
+	}
+	projectName, supervisorNamespaceName, storageClassName, err := parseStorageClassBindingId(id)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if projectName != "project1" || supervisorNamespaceName != "ns-abcde" || storageClassName != "vSAN Default Storage Policy" {
+		t.Errorf("unexpected parts '%s', '%s', '%s'", projectName, supervisorNamespaceName, storageClassName)
+	}
+
+	for _, invalidId := range []string{"project1:ns-abcde", "project1", "a:b:c:d"} {
+		if _, _, _, err := parseStorageClassBindingId(invalidId); err == nil {
+			t.Errorf("expected an error parsing '%s'", invalidId)
+		}
+	}
+}
+
+// TestStorageClassOverrides checks the helpers that find a bound Storage Class, and that keep the Storage Classes that
+// the Supervisor Namespace resource doesn't manage
+func TestStorageClassOverrides(t *testing.T) {
+	overrides := []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{
+		{Name: "default", Limit: "10Gi"},
+		{Name: "gold", Limit: "50Gi"},
+		{Name: "silver", Limit: "20Gi"},
+	}
+
+	found := findStorageClassOverride(overrides, "gold")
+	if found == nil || found.Limit != "50Gi" {
+		t.Fatalf("expected to find 'gold', got %+v", found)
+	}
+	// The returned override can be modified in place
+	found.Limit = "60Gi"
+	if overrides[1].Limit != "60Gi" {
+		t.Errorf("expected the override to be updated in place, got %+v", overrides[1])
+	}
+	if findStorageClassOverride(overrides, "bronze") != nil {
+		t.Errorf("expected not to find 'bronze'")
+	}
+
+	unmanaged := filterUnmanagedStorageClassOverrides(overrides, map[string]bool{"default": true, "silver": true})
+	expected := []ccitypes.SupervisorNamespaceSpecClassConfigOverridesStorageClass{{Name: "gold", Limit: "60Gi"}}
+	if !reflect.DeepEqual(unmanaged, expected) {
+		t.Errorf("expected %v, got %v", expected, unmanaged)
+	}
+	if unmanaged := filterUnmanagedStorageClassOverrides(overrides, map[string]bool{"default": true, "gold": true, "silver": true}); len(unmanaged) != 0 {
+		t.Errorf("expected no unmanaged overrides, got %v", unmanaged)
+	}
+}
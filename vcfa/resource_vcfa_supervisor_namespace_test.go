@@ -138,15 +138,23 @@ func TestAccVcfaSupervisorNamespace(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestMatchResourceAttr("vcfa_supervisor_namespace.test", "id", regexp.MustCompile(fmt.Sprintf(`^%s:terraform-test`, params["ProjectName"].(string)))),
 					resource.TestMatchResourceAttr("vcfa_supervisor_namespace.test", "name", regexp.MustCompile(`^terraform-test`)),
+					resource.TestCheckResourceAttrPair("vcfa_supervisor_namespace.test", "kubernetes_namespace", "vcfa_supervisor_namespace.test", "name"),
+					resource.TestMatchResourceAttr("vcfa_supervisor_namespace.test", "api_path", regexp.MustCompile(fmt.Sprintf(`^/apis/.+/namespaces/%s/supervisornamespaces/terraform-test`, params["ProjectName"].(string)))),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "description", params["Description"].(string)),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "region_name", params["RegionName"].(string)),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "vpc_name", params["VpcName"].(string)),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "vpc_names.#", "1"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "vpc_names.0", params["VpcName"].(string)),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "storage_classes_class_config_overrides.#", "1"),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "storage_classes_class_config_overrides.0.limit", params["StorageLimit"].(string)),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "storage_classes_initial_class_config_overrides.#", "1"),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "vm_classes_class_config_overrides.#", "1"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "wait_for_vm_classes.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_supervisor_namespace.test", "vm_classes.*", map[string]string{"name": params["RegionVmClass"].(string)}),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "zones_class_config_overrides.#", "1"),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "zones_initial_class_config_overrides.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_supervisor_namespace.test", "storage_classes_effective_class_config_overrides.*", map[string]string{"limit": params["StorageLimit"].(string)}),
+					resource.TestCheckResourceAttrSet("vcfa_supervisor_namespace.test", "zones_effective_class_config_overrides.#"),
 					cachedNamespaceName.cacheTestResourceFieldValue("vcfa_supervisor_namespace.test", "name"), // capturing computed 'name' to use for other test steps
 				),
 			},
@@ -163,6 +171,14 @@ func TestAccVcfaSupervisorNamespace(t *testing.T) {
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "vm_classes_class_config_overrides.#", "1"),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "zones_class_config_overrides.#", "1"),
 					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "zones_initial_class_config_overrides.#", "1"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "ttl", "72h"),
+					resource.TestCheckResourceAttrSet("vcfa_supervisor_namespace.test", "expires_at"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "expired", "false"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "audit_annotations.terraform.vcfa.vmware.com/last-operation", "update"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "audit_annotations.terraform.vcfa.vmware.com/last-workspace", params["Testname"].(string)),
+					resource.TestCheckResourceAttrSet("vcfa_supervisor_namespace.test", "audit_annotations.terraform.vcfa.vmware.com/last-operation-user"),
+					resource.TestCheckResourceAttr("vcfa_supervisor_namespace.test", "storage_class_usage.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("vcfa_supervisor_namespace.test", "storage_class_usage.*", map[string]string{"limit": params["StorageLimitUpdated"].(string)}),
 				),
 			},
 			{
@@ -171,6 +187,12 @@ func TestAccVcfaSupervisorNamespace(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					// Data source does not have 'name_prefix' therefore field count (%) differs
 					resourceFieldsEqual("data.vcfa_supervisor_namespace.test", "vcfa_supervisor_namespace.test", []string{"%"}),
+					resource.TestCheckTypeSetElemAttrPair("data.vcfa_supervisor_namespaces.test", "names.*", "vcfa_supervisor_namespace.test", "name"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vcfa_supervisor_namespaces.test", "supervisor_namespaces.*", map[string]string{
+						"project_name": params["ProjectName"].(string),
+						"region_name":  params["RegionName"].(string),
+						"ready":        "true",
+					}),
 				),
 			},
 			{
@@ -178,7 +200,7 @@ func TestAccVcfaSupervisorNamespace(t *testing.T) {
 				ResourceName:            "vcfa_supervisor_namespace.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"name_prefix"},
+				ImportStateVerifyIgnore: []string{"name_prefix", "wait_for_vm_classes", "adopt_if_exists"},
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					return params["ProjectName"].(string) + ImportSeparator + cachedNamespaceName.FieldValue(), nil
 				},
@@ -308,6 +330,8 @@ resource "vcfa_supervisor_namespace" "test" {
     name = "{{.RegionVmClass}}"
   }
 
+  wait_for_vm_classes = ["{{.RegionVmClass}}"]
+
   zones_class_config_overrides {
     cpu_limit          = "100M"
     cpu_reservation    = "1M"
@@ -328,6 +352,10 @@ resource "vcfa_supervisor_namespace" "test" {
   description  = "{{.DescriptionUpdated}}"
   region_name  = "{{.RegionName}}"
   vpc_name     = "{{.VpcName}}"
+  ttl          = "72h"
+
+  audit_trail           = true
+  audit_trail_workspace = "{{.Testname}}"
 
   storage_classes_class_config_overrides {
     limit     = "{{.StorageLimitUpdated}}"
@@ -355,6 +383,14 @@ data "vcfa_supervisor_namespace" "test" {
   name         = vcfa_supervisor_namespace.test.name
   project_name = vcfa_supervisor_namespace.test.project_name
 }
+
+data "vcfa_supervisor_namespaces" "test" {
+  provider = vcfatenant
+
+  project_name = vcfa_supervisor_namespace.test.project_name
+  region_name  = vcfa_supervisor_namespace.test.region_name
+  phase        = vcfa_supervisor_namespace.test.phase
+}
 `
 
 const testAccVcfaSupervisorNamespaceStep6 = testAccVcfaSupervisorNamespaceStep3Update + `
@@ -0,0 +1,246 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestFilterAdoptableSupervisorNamespaces checks that only the Supervisor Namespaces generated from the given
+// prefix and with the given class, that are not tracked by another resource, can be adopted
+func TestFilterAdoptableSupervisorNamespaces(t *testing.T) {
+	now := v1.Now()
+	newSupervisorNamespace := func(name, className string, deletionTimestamp *v1.Time) ccitypes.SupervisorNamespace {
+		return ccitypes.SupervisorNamespace{
+			ObjectMeta: v1.ObjectMeta{Name: name, DeletionTimestamp: deletionTimestamp},
+			Spec:       ccitypes.SupervisorNamespaceSpec{ClassName: className},
+		}
+	}
+	supervisorNamespaces := []ccitypes.SupervisorNamespace{
+		newSupervisorNamespace("test-x7k2p", "small", nil),
+		newSupervisorNamespace("test-a1b2c", "large", nil),
+		newSupervisorNamespace("test-deleting-q1w2e", "small", &now),
+		newSupervisorNamespace("test-other-q1w2e", "small", nil),
+		newSupervisorNamespace("test-abc", "small", nil),
+		newSupervisorNamespace("prod-x7k2p", "small", nil),
+		{
+			ObjectMeta: v1.ObjectMeta{Name: "tracked-m1n2o", Labels: map[string]string{supervisorNamespaceTrackedLabel: "true"}},
+			Spec:       ccitypes.SupervisorNamespaceSpec{ClassName: "small"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		prefix    string
+		className string
+		want      []string
+	}{
+		{name: "SingleMatch", prefix: "test-", className: "small", want: []string{"test-x7k2p"}},
+		{name: "OtherClass", prefix: "test-", className: "large", want: []string{"test-a1b2c"}},
+		{name: "BeingDeleted", prefix: "test-deleting-", className: "small", want: nil},
+		{name: "NoMatch", prefix: "dev-", className: "small", want: nil},
+		{name: "Tracked", prefix: "tracked-", className: "small", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterAdoptableSupervisorNamespaces(supervisorNamespaces, tt.prefix, tt.className)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterAdoptableSupervisorNamespaces() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestFilterSupervisorNamespaceByRequestId checks that a retried create request finds the Supervisor Namespace that a
+// previous attempt created, but not the ones of other requests nor the ones being deleted
+func TestFilterSupervisorNamespaceByRequestId(t *testing.T) {
+	now := v1.Now()
+	newSupervisorNamespace := func(name, requestId string, deletionTimestamp *v1.Time) ccitypes.SupervisorNamespace {
+		return ccitypes.SupervisorNamespace{ObjectMeta: v1.ObjectMeta{
+			Name:              name,
+			Labels:            map[string]string{supervisorNamespaceRequestIdLabel: requestId},
+			DeletionTimestamp: deletionTimestamp,
+		}}
+	}
+	supervisorNamespaces := []ccitypes.SupervisorNamespace{
+		{ObjectMeta: v1.ObjectMeta{Name: "test-nolbl"}},
+		newSupervisorNamespace("test-a1b2c", "terraform-1", nil),
+		newSupervisorNamespace("test-d3e4f", "terraform-2", &now),
+	}
+
+	tests := []struct {
+		name      string
+		requestId string
+		want      string
+	}{
+		{name: "Created", requestId: "terraform-1", want: "test-a1b2c"},
+		{name: "BeingDeleted", requestId: "terraform-2"},
+		{name: "NotCreated", requestId: "terraform-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ""
+			if supervisorNamespace := filterSupervisorNamespaceByRequestId(supervisorNamespaces, tt.requestId); supervisorNamespace != nil {
+				got = supervisorNamespace.GetName()
+			}
+			if got != tt.want {
+				t.Errorf("filterSupervisorNamespaceByRequestId() = '%s', want '%s'", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSupervisorNamespaceTransientErrorRegex checks that only the errors after which the outcome of a create request is
+// unknown are retried
+func TestSupervisorNamespaceTransientErrorRegex(t *testing.T) {
+	tests := map[string]bool{
+		"Post \"https://vcfa/cci/kubernetes/apis\": net/http: TLS handshake timeout":                 true,
+		"Post \"https://vcfa/cci/kubernetes/apis\": read tcp 10.0.0.1:443: connection reset by peer": true,
+		"Post \"https://vcfa/cci/kubernetes/apis\": EOF":                                             true,
+		"API Error: 503: Service Unavailable":                                                        true,
+		"API Error: 409: supervisornamespaces \"test-a1b2c\" already exists":                         false,
+		"exceeded quota: project-quota, requested: count/supervisornamespaces=1":                     false,
+		"API Error: 403: Forbidden":                                                                  false,
+	}
+	for message, want := range tests {
+		if got := supervisorNamespaceTransientErrorRegex.MatchString(message); got != want {
+			t.Errorf("supervisorNamespaceTransientErrorRegex.MatchString(%q) = %t, want %t", message, got, want)
+		}
+	}
+}
+
+// TestBuildSupervisorNamespaceApiPath checks the Project scoped API paths of Supervisor Namespaces
+func TestBuildSupervisorNamespaceApiPath(t *testing.T) {
+	want := "/apis/" + ccitypes.SupervisorNamespaceAPI + "/" + ccitypes.SupervisorNamespaceVersion + "/namespaces/project1/supervisornamespaces"
+	if got := buildSupervisorNamespaceApiPath("project1", ""); got != want {
+		t.Errorf("buildSupervisorNamespaceApiPath() = %s, want %s", got, want)
+	}
+	if got := buildSupervisorNamespaceApiPath("project1", "ns-abcde"); got != want+"/ns-abcde" {
+		t.Errorf("buildSupervisorNamespaceApiPath() = %s, want %s", got, want+"/ns-abcde")
+	}
+}
+
+// TestGetSupervisorNamespaceServices checks that the services are detected from the conditions, when reported, or from
+// the resources available in the Supervisor Namespace
+func TestGetSupervisorNamespaceServices(t *testing.T) {
+	vmClasses := []ccitypes.SupervisorNamespaceStatusVMClasses{{Name: "best-effort-small"}}
+	tests := []struct {
+		name   string
+		status *ccitypes.SupervisorNamespaceStatus
+		want   map[string]bool
+	}{
+		{
+			name: "no status",
+			want: map[string]bool{"vm_service_enabled": false, "tkg_service_enabled": false, "registry_enabled": false},
+		},
+		{
+			name: "resources only",
+			status: &ccitypes.SupervisorNamespaceStatus{
+				VMClasses:        vmClasses,
+				ContentLibraries: []ccitypes.SupervisorNamespaceStatusContentLibraries{{Name: "images", Type: "ContentLibrary"}},
+			},
+			want: map[string]bool{"vm_service_enabled": true, "tkg_service_enabled": false, "registry_enabled": false},
+		},
+		{
+			name: "conditions take precedence",
+			status: &ccitypes.SupervisorNamespaceStatus{
+				VMClasses:      vmClasses,
+				StorageClasses: []ccitypes.SupervisorNamespaceStatusStorageClasses{{Name: "vsan-default"}},
+				Conditions: []ccitypes.SupervisorNamespaceStatusConditions{
+					{Type: "TKGServiceReady", Status: "False"},
+					{Type: "RegistryReady", Status: "True"},
+				},
+			},
+			want: map[string]bool{"vm_service_enabled": false, "tkg_service_enabled": false, "registry_enabled": true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getSupervisorNamespaceServices(ccitypes.SupervisorNamespace{Status: tt.status})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getSupervisorNamespaceServices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestParseSupervisorNamespaceQuotaError checks that the current and maximum usage are extracted from the quota errors
+func TestParseSupervisorNamespaceQuotaError(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []supervisorNamespaceQuota
+		wantOk  bool
+	}{
+		{
+			name: "NamespaceCount",
+			message: `error creating Supervisor Namespace in Project project1: API Error: 403: {"kind":"Status","message":"supervisornamespaces.infrastructure.cci.vmware.com \"test-\" is forbidden: ` +
+				`exceeded quota: project-quota, requested: count/supervisornamespaces.infrastructure.cci.vmware.com=1, used: count/supervisornamespaces.infrastructure.cci.vmware.com=5, limited: count/supervisornamespaces.infrastructure.cci.vmware.com=5"}`,
+			want:   []supervisorNamespaceQuota{{quotaName: "project-quota", resource: "count/supervisornamespaces.infrastructure.cci.vmware.com", used: "5", limit: "5"}},
+			wantOk: true,
+		},
+		{
+			name:    "SeveralResources",
+			message: "is forbidden: exceeded quota: q1, requested: limits.cpu=2,count/supervisornamespaces=1, used: limits.cpu=9,count/supervisornamespaces=2, limited: limits.cpu=10,count/supervisornamespaces=3",
+			want: []supervisorNamespaceQuota{
+				{quotaName: "q1", resource: "count/supervisornamespaces", used: "2", limit: "3"},
+				{quotaName: "q1", resource: "limits.cpu", used: "9", limit: "10"},
+			},
+			wantOk: true,
+		},
+		{name: "OpaqueQuotaError", message: "API Error: 403: namespace quota exceeded"},
+		{name: "OtherError", message: "API Error: 403: forbidden"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseSupervisorNamespaceQuotaError(tt.message)
+			if ok != tt.wantOk || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseSupervisorNamespaceQuotaError() = %+v, %t, want %+v, %t", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestGetSupervisorNamespaceVpcNames(t *testing.T) {
+	tests := []struct {
+		name                string
+		supervisorNamespace ccitypes.SupervisorNamespace
+		expected            []string
+	}{
+		{
+			name: "from spec",
+			supervisorNamespace: ccitypes.SupervisorNamespace{
+				Spec:   ccitypes.SupervisorNamespaceSpec{VpcName: "vpc1"},
+				Status: &ccitypes.SupervisorNamespaceStatus{VpcName: "vpc1"},
+			},
+			expected: []string{"vpc1"},
+		},
+		{
+			name: "from status",
+			supervisorNamespace: ccitypes.SupervisorNamespace{
+				Status: &ccitypes.SupervisorNamespaceStatus{VpcName: "default-vpc"},
+			},
+			expected: []string{"default-vpc"},
+		},
+		{
+			name:                "without status",
+			supervisorNamespace: ccitypes.SupervisorNamespace{},
+			expected:            []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getSupervisorNamespaceVpcNames(tt.supervisorNamespace); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaTrustedCertificate = "Trusted Certificate"
+
+func resourceVcfaTrustedCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaTrustedCertificateCreate,
+		ReadContext:   resourceVcfaTrustedCertificateRead,
+		UpdateContext: resourceVcfaTrustedCertificateUpdate,
+		DeleteContext: resourceVcfaTrustedCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaTrustedCertificateImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The ID of the %s that trusts the certificate. Use the System %s to trust it in the whole provider", labelVcfaOrg, labelVcfaOrg),
+			},
+			"alias": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Alias of the %s", labelVcfaTrustedCertificate),
+			},
+			"certificate": {
+				Type:     schema.TypeString,
+				Required: true,
+				// VCFA removes the extraneous whitespace of the PEM content
+				DiffSuppressFunc: func(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+					return strings.TrimSpace(oldValue) == strings.TrimSpace(newValue)
+				},
+				Description: fmt.Sprintf("PEM encoded content of the %s", labelVcfaTrustedCertificate),
+			},
+		},
+	}
+}
+
+func resourceVcfaTrustedCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	tenantContext, err := getTenantContextFromOrgId(tmClient, d.Get("org_id").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving %s: %s", labelVcfaOrg, err)
+	}
+
+	certificate, err := tmClient.CreateTrustedCertificate(&types.TrustedCertificate{
+		Alias:       d.Get("alias").(string),
+		Certificate: d.Get("certificate").(string),
+	}, tenantContext)
+	if err != nil {
+		return diag.Errorf("error creating %s '%s': %s", labelVcfaTrustedCertificate, d.Get("alias").(string), err)
+	}
+	d.SetId(certificate.TrustedCertificate.ID)
+
+	return resourceVcfaTrustedCertificateRead(ctx, d, meta)
+}
+
+func resourceVcfaTrustedCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	certificate, err := getTrustedCertificateById(tmClient, d.Get("org_id").(string), d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaTrustedCertificate, d.Get("alias").(string), err)
+	}
+
+	_, err = certificate.Update(&types.TrustedCertificate{
+		ID:          certificate.TrustedCertificate.ID,
+		Alias:       d.Get("alias").(string),
+		Certificate: d.Get("certificate").(string),
+	})
+	if err != nil {
+		return diag.Errorf("error updating %s '%s': %s", labelVcfaTrustedCertificate, d.Get("alias").(string), err)
+	}
+
+	return resourceVcfaTrustedCertificateRead(ctx, d, meta)
+}
+
+func resourceVcfaTrustedCertificateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	certificate, err := getTrustedCertificateById(tmClient, d.Get("org_id").(string), d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaTrustedCertificate, d.Get("alias").(string), err)
+	}
+
+	setTrustedCertificateData(d, certificate.TrustedCertificate)
+	return nil
+}
+
+func resourceVcfaTrustedCertificateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient := meta.(ClientContainer).tmClient
+	certificate, err := getTrustedCertificateById(tmClient, d.Get("org_id").(string), d.Id())
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil
+		}
+		return diag.Errorf("error retrieving %s '%s': %s", labelVcfaTrustedCertificate, d.Get("alias").(string), err)
+	}
+
+	if err := certificate.Delete(); err != nil {
+		return diag.Errorf("error deleting %s '%s': %s", labelVcfaTrustedCertificate, certificate.TrustedCertificate.Alias, err)
+	}
+	return nil
+}
+
+// resourceVcfaTrustedCertificateImport imports a Trusted Certificate with the ID <org name>.<certificate alias>
+func resourceVcfaTrustedCertificateImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	tmClient := meta.(ClientContainer).tmClient
+
+	idSlice := SplitImportId(d.Id(), -1)
+	if len(idSlice) != 2 {
+		return nil, fmt.Errorf("expected import ID to be <org name>%s<certificate alias>", ImportSeparator)
+	}
+
+	org, err := tmClient.GetTmOrgByName(idSlice[0])
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaOrg, idSlice[0], err)
+	}
+
+	certificate, err := tmClient.GetTrustedCertificateByAlias(idSlice[1], &govcd.TenantContext{OrgId: org.TmOrg.ID, OrgName: org.TmOrg.Name})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaTrustedCertificate, idSlice[1], err)
+	}
+
+	d.SetId(certificate.TrustedCertificate.ID)
+	dSet(d, "org_id", org.TmOrg.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getTrustedCertificateById retrieves a Trusted Certificate from the trusted certificates of the given Organization
+func getTrustedCertificateById(tmClient *VCDClient, orgId, id string) (*govcd.TrustedCertificate, error) {
+	tenantContext, err := getTenantContextFromOrgId(tmClient, orgId)
+	if err != nil {
+		return nil, err
+	}
+	return tmClient.GetTrustedCertificateById(id, tenantContext)
+}
+
+func setTrustedCertificateData(d *schema.ResourceData, certificate *types.TrustedCertificate) {
+	dSet(d, "alias", certificate.Alias)
+	dSet(d, "certificate", certificate.Certificate)
+}
@@ -0,0 +1,124 @@
+//go:build certificate || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccVcfaTrustedCertificate tests the Trusted Certificates of an Organization and of the System Organization
+func TestAccVcfaTrustedCertificate(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	if len(testConfig.Tm.Certificates) < 2 {
+		t.Skip("there must be at least two certificates in tm.certificates from test configuration")
+	}
+
+	var params = StringMap{
+		"Org":              testConfig.Tm.Org,
+		"Alias":            t.Name(),
+		"AliasSystem":      t.Name() + "Sys",
+		"Certificate1Path": testConfig.Tm.Certificates[0].Path,
+		"Certificate2Path": testConfig.Tm.Certificates[1].Path,
+		"Tags":             "certificate",
+	}
+	testParamsNotEmpty(t, params)
+
+	params["FuncName"] = t.Name() + "-step1"
+	configText1 := templateFill(testAccVcfaTrustedCertificate, params)
+
+	params["FuncName"] = t.Name() + "-step2"
+	params["Alias"] = t.Name() + "Updated"
+	params["Certificate1Path"] = testConfig.Tm.Certificates[1].Path
+	configText2 := templateFill(testAccVcfaTrustedCertificateDatasource, params)
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+
+	orgCertDef := "vcfa_trusted_certificate.org"
+	sysCertDef := "vcfa_trusted_certificate.system"
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: configText1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(orgCertDef, "alias", t.Name()),
+					resource.TestCheckResourceAttrPair(orgCertDef, "org_id", "vcfa_org.org1", "id"),
+					resource.TestMatchResourceAttr(orgCertDef, "certificate", regexp.MustCompile(`BEGIN CERTIFICATE`)),
+					resource.TestCheckResourceAttr(sysCertDef, "alias", t.Name()+"Sys"),
+					resource.TestCheckResourceAttrPair(sysCertDef, "org_id", "data.vcfa_org.system", "id"),
+				),
+			},
+			{
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(orgCertDef, "alias", t.Name()+"Updated"),
+					resource.TestCheckResourceAttrPair(orgCertDef, "certificate", sysCertDef, "certificate"),
+					resourceFieldsEqual(orgCertDef, "data.vcfa_trusted_certificate.org", nil),
+					resourceFieldsEqual(sysCertDef, "data.vcfa_trusted_certificate.system", nil),
+				),
+			},
+			{
+				ResourceName:      orgCertDef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     params["Org"].(string) + ImportSeparator + params["Alias"].(string),
+			},
+			{
+				ResourceName:      sysCertDef,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     "System" + ImportSeparator + params["AliasSystem"].(string),
+			},
+		},
+	})
+}
+
+const testAccVcfaTrustedCertificate = `
+resource "vcfa_org" "org1" {
+  name         = "{{.Org}}"
+  display_name = "{{.Org}}"
+  description  = "{{.Org}}"
+}
+
+data "vcfa_org" "system" {
+  name = "System"
+}
+
+resource "vcfa_trusted_certificate" "org" {
+  org_id      = vcfa_org.org1.id
+  alias       = "{{.Alias}}"
+  certificate = file("{{.Certificate1Path}}")
+}
+
+resource "vcfa_trusted_certificate" "system" {
+  org_id      = data.vcfa_org.system.id
+  alias       = "{{.AliasSystem}}"
+  certificate = file("{{.Certificate2Path}}")
+}
+`
+
+const testAccVcfaTrustedCertificateDatasource = testAccVcfaTrustedCertificate + `
+data "vcfa_trusted_certificate" "org" {
+  org_id = vcfa_org.org1.id
+  alias  = vcfa_trusted_certificate.org.alias
+}
+
+data "vcfa_trusted_certificate" "system" {
+  org_id = data.vcfa_org.system.id
+  alias  = vcfa_trusted_certificate.system.alias
+}
+`
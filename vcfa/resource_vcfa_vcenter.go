@@ -21,6 +21,11 @@ import (
 const labelVcfaVirtualCenter = "vCenter Server"
 const extraSleepAfterListenerConnected = 3 * time.Second
 
+// Default times to wait for the vCenter listener state to become 'CONNECTED'. They can be
+// overridden with the 'timeouts' block of the resource
+const defaultVcenterConnectionTimeout = 10 * time.Minute
+const defaultVcenterReadConnectionTimeout = 1 * time.Minute
+
 // vCenter task is sometimes unreliable and trying to refresh it immediately after it becomes
 // connected causes a "BUSY_ENTITY" error (which has a few different messages)
 var maximumVcenterRetryTime = 120 * time.Second                                         // The maximum time a single operation will be retried before giving up
@@ -35,6 +40,11 @@ func resourceVcfaVcenter() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceVcfaVcenterImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(defaultVcenterConnectionTimeout),
+			Read:   schema.DefaultTimeout(defaultVcenterReadConnectionTimeout),
+			Update: schema.DefaultTimeout(defaultVcenterConnectionTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -79,6 +89,16 @@ func resourceVcfaVcenter() *schema.Resource {
 				Optional:    true,
 				Description: fmt.Sprintf("Defines if the %s should refresh Policies on every read operation", labelVcfaVirtualCenter),
 			},
+			"refresh_vcenter_on_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: fmt.Sprintf("Defines if the %s should be refreshed after an update operation", labelVcfaVirtualCenter),
+			},
+			"refresh_policies_on_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: fmt.Sprintf("Defines if the %s should refresh Policies after an update operation", labelVcfaVirtualCenter),
+			},
 			"username": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -141,6 +161,11 @@ func resourceVcfaVcenter() *schema.Resource {
 				Computed:    true,
 				Description: fmt.Sprintf("%s hostname", labelVcfaVirtualCenter),
 			},
+			"vsphere_web_client_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("URL of the vSphere Client of the %s", labelVcfaVirtualCenter),
+			},
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -187,6 +212,7 @@ func setVcenterData(_ *VCDClient, d *schema.ResourceData, v *govcd.VCenter) erro
 		return fmt.Errorf("error parsing URL for storing 'vcenter_host': %s", err)
 	}
 	dSet(d, "vcenter_host", host.Host)
+	dSet(d, "vsphere_web_client_url", v.VSphereVCenter.VsphereWebClientServerUrl)
 
 	// Status is a derivative value that was present in XML Query API, but is no longer maintained
 	// The value was derived from multiple fields based on a complex logic. Instead, evaluating if
@@ -224,10 +250,10 @@ func resourceVcfaVcenterCreate(ctx context.Context, d *schema.ResourceData, meta
 			// refresh as it will fail otherwise. At the moment it has a delay before it becomes
 			// CONNECTED after creation task succeeds. It should not be needed once vCenter creation
 			// task ensures that the listener is connected.
-			shouldWaitForListenerStatusConnected(shouldWaitForListenerStatus),
+			shouldWaitForListenerStatusConnected(ctx, shouldWaitForListenerStatus, operationTimeout(d, meta, schema.TimeoutCreate)),
 
-			refreshVcenter(shouldRefresh),               // vCenter read can optionally trigger "refresh" operation
-			refreshVcenterPolicy(shouldRefreshPolicies), // vCenter read can optionally trigger "refresh policies" operation
+			refreshVcenter(ctx, shouldRefresh),               // vCenter read can optionally trigger "refresh" operation
+			refreshVcenterPolicy(ctx, shouldRefreshPolicies), // vCenter read can optionally trigger "refresh policies" operation
 		},
 	}
 	return createResource(ctx, d, meta, c)
@@ -235,16 +261,29 @@ func resourceVcfaVcenterCreate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceVcfaVcenterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// return immediately if only flags are updated
-	if !d.HasChangesExcept("refresh_vcenter_on_read", "refresh_policies_on_read") {
+	if !d.HasChangesExcept("refresh_vcenter_on_read", "refresh_policies_on_read", "refresh_vcenter_on_update", "refresh_policies_on_update") {
 		return nil
 	}
 
+	// A disabled vCenter does not become connected, hence there is no point in waiting for the
+	// listener or triggering refreshes
+	isEnabled := d.Get("is_enabled").(bool)
+	shouldRefresh := isEnabled && d.Get("refresh_vcenter_on_update").(bool)
+	shouldRefreshPolicies := isEnabled && d.Get("refresh_policies_on_update").(bool)
+	shouldWaitForListenerStatus := isEnabled && (shouldRefresh || shouldRefreshPolicies)
+
 	tmClient := meta.(ClientContainer).tmClient
 	c := crudConfig[*govcd.VCenter, types.VSphereVirtualCenter]{
 		entityLabel:      labelVcfaVirtualCenter,
 		getTypeFunc:      getVcenterType,
 		getEntityFunc:    tmClient.GetVCenterById,
 		resourceReadFunc: resourceVcfaVcenterRead,
+		postUpdateHooks: []outerEntityHook[*govcd.VCenter]{
+			shouldWaitForListenerStatusConnected(ctx, shouldWaitForListenerStatus, operationTimeout(d, meta, schema.TimeoutUpdate)),
+
+			refreshVcenter(ctx, shouldRefresh),               // vCenter update can optionally trigger "refresh" operation
+			refreshVcenterPolicy(ctx, shouldRefreshPolicies), // vCenter update can optionally trigger "refresh policies" operation
+		},
 	}
 
 	return updateResource(ctx, d, meta, c)
@@ -285,6 +324,11 @@ func resourceVcfaVcenterRead(ctx context.Context, d *schema.ResourceData, meta i
 		shouldRefreshPolicies = false
 		shouldWaitForListenerStatus = false
 	}
+	// Refreshing starts tasks in VCFA, which 'read_only' and 'dry_run' must not do
+	if !changesOnReadAllowed(meta) {
+		shouldRefresh = false
+		shouldRefreshPolicies = false
+	}
 	c := crudConfig[*govcd.VCenter, types.VSphereVirtualCenter]{
 		entityLabel: labelVcfaVirtualCenter,
 		// getEntityFunc:  tmClient.GetVCenterById,// TODO: TM: use this function
@@ -295,10 +339,10 @@ func resourceVcfaVcenterRead(ctx context.Context, d *schema.ResourceData, meta i
 			// refresh as it will fail otherwise. At the moment it has a delay before it becomes
 			// CONNECTED after creation task succeeds. It should not be needed once vCenter creation
 			// task ensures that the listener is connected.
-			shouldWaitForListenerStatusConnected(shouldWaitForListenerStatus),
+			shouldWaitForListenerStatusConnected(ctx, shouldWaitForListenerStatus, operationTimeout(d, meta, schema.TimeoutRead)),
 
-			refreshVcenter(shouldRefresh),               // vCenter read can optionally trigger "refresh" operation
-			refreshVcenterPolicy(shouldRefreshPolicies), // vCenter read can optionally trigger "refresh policies" operation
+			refreshVcenter(ctx, shouldRefresh),               // vCenter read can optionally trigger "refresh" operation
+			refreshVcenterPolicy(ctx, shouldRefreshPolicies), // vCenter read can optionally trigger "refresh policies" operation
 		},
 	}
 	return readResource(ctx, d, meta, c)
@@ -319,7 +363,13 @@ func resourceVcfaVcenterDelete(ctx context.Context, d *schema.ResourceData, meta
 func resourceVcfaVcenterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	tmClient := meta.(ClientContainer).tmClient
 
-	v, err := tmClient.GetVCenterByName(d.Id())
+	var v *govcd.VCenter
+	var err error
+	if urn, ok := getImportUrn(d.Id(), urnPrefixVcenter); ok {
+		v, err = tmClient.GetVCenterById(urn)
+	} else {
+		v, err = tmClient.GetVCenterByName(d.Id())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error retrieving %s by name: %s", labelVcfaVirtualCenter, err)
 	}
@@ -339,10 +389,10 @@ func disableVcenter(v *govcd.VCenter) error {
 
 // refreshVcenter triggers refresh on vCenter which is useful for reloading some of the vCenter
 // components like Supervisors
-func refreshVcenter(execute bool) outerEntityHook[*govcd.VCenter] {
+func refreshVcenter(ctx context.Context, execute bool) outerEntityHook[*govcd.VCenter] {
 	return func(v *govcd.VCenter) error {
 		if execute {
-			err := runWithRetry(v.RefreshVcenter, vCenterEntityBusyRegexp, maximumVcenterRetryTime)
+			err := runWithRetry(ctx, v.RefreshVcenter, vCenterEntityBusyRegexp, maximumVcenterRetryTime)
 			if err != nil {
 				return fmt.Errorf("error refreshing vCenter: %s", err)
 			}
@@ -353,10 +403,10 @@ func refreshVcenter(execute bool) outerEntityHook[*govcd.VCenter] {
 
 // refreshVcenterPolicy triggers refresh on vCenter which is useful for reloading some of the
 // vCenter components like Supervisors
-func refreshVcenterPolicy(execute bool) outerEntityHook[*govcd.VCenter] {
+func refreshVcenterPolicy(ctx context.Context, execute bool) outerEntityHook[*govcd.VCenter] {
 	return func(v *govcd.VCenter) error {
 		if execute {
-			err := runWithRetry(v.RefreshStorageProfiles, vCenterEntityBusyRegexp, maximumVcenterRetryTime)
+			err := runWithRetry(ctx, v.RefreshStorageProfiles, vCenterEntityBusyRegexp, maximumVcenterRetryTime)
 			if err != nil {
 				return fmt.Errorf("error refreshing vCenter Storage Policies: %s", err)
 			}
@@ -365,13 +415,16 @@ func refreshVcenterPolicy(execute bool) outerEntityHook[*govcd.VCenter] {
 	}
 }
 
+// shouldWaitForListenerStatusConnected waits up to 'timeout' for the vCenter listener state to become
+// 'CONNECTED'
 // TODO: TM: should not be required because a successful vCenter creation task should work
-func shouldWaitForListenerStatusConnected(shouldWait bool) func(v *govcd.VCenter) error {
+func shouldWaitForListenerStatusConnected(ctx context.Context, shouldWait bool, timeout time.Duration) func(v *govcd.VCenter) error {
 	return func(v *govcd.VCenter) error {
 		if !shouldWait {
 			return nil
 		}
-		for c := 0; c < 20; c++ {
+		endTime := time.Now().Add(timeout)
+		for {
 			err := v.Refresh()
 			if err != nil {
 				return fmt.Errorf("error refreshing vCenter: %s", err)
@@ -379,15 +432,18 @@ func shouldWaitForListenerStatusConnected(shouldWait bool) func(v *govcd.VCenter
 
 			if v.VSphereVCenter.ListenerState == "CONNECTED" {
 				// TODO: TM: put an extra sleep to be sure the entity is released
-				time.Sleep(extraSleepAfterListenerConnected)
-
-				return nil
+				return sleepWithContext(ctx, extraSleepAfterListenerConnected)
 			}
 
-			time.Sleep(2 * time.Second)
+			if time.Now().After(endTime) {
+				break
+			}
+			if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+				return fmt.Errorf("error waiting for listener state to become 'CONNECTED': %s", err)
+			}
 		}
 
-		return fmt.Errorf("failed waiting for listener state to become 'CONNECTED', got '%s'", v.VSphereVCenter.ListenerState)
+		return fmt.Errorf("failed waiting %s for listener state to become 'CONNECTED', got '%s'", timeout, v.VSphereVCenter.ListenerState)
 	}
 }
 
@@ -417,7 +473,7 @@ func autoTrustHostCertificate(urlSchemaFieldName, trustSchemaFieldName string) s
 	}
 }
 
-func runWithRetry(runOperation func() error, errRegexp *regexp.Regexp, duration time.Duration) error {
+func runWithRetry(ctx context.Context, runOperation func() error, errRegexp *regexp.Regexp, duration time.Duration) error {
 	startTime := time.Now()
 	endTime := startTime.Add(duration)
 	util.Logger.Printf("[DEBUG] runWithRetry - running with retry for %f seconds if error contains '%s' ", duration.Seconds(), errRegexp)
@@ -428,24 +484,30 @@ func runWithRetry(runOperation func() error, errRegexp *regexp.Regexp, duration
 		// Operation had no error - it succeeded
 		if err == nil {
 			util.Logger.Printf("[DEBUG] runWithRetry - no error occurred after attempt %d, got error: %s ", count, err)
+			providerMetrics.addRetries("operation", count-1)
 			return nil
 		}
 		// If there is an error, but it doesn't contain the retryIfErrContains value - exit it
 		if !errRegexp.MatchString(err.Error()) {
 			util.Logger.Printf("[DEBUG] runWithRetry - returning error after attempt %d, got error: %s ", count, err)
+			providerMetrics.addRetries("operation", count-1)
 			return err
 		}
 
 		// If time limit is exceeded - return error containing statistics and original error
 		if time.Now().After(endTime) {
 			util.Logger.Printf("[DEBUG] runWithRetry - exceeded time after attempt %d, got error: %s ", count, err)
+			providerMetrics.addRetries("operation", count-1)
 			return fmt.Errorf("error attempting to wait until error does not contain '%s' after %f seconds: %s", errRegexp, duration.Seconds(), err)
 		}
 
 		// Sleep and continue
 		util.Logger.Printf("[DEBUG] runWithRetry - sleeping after attempt %d, will retry", count)
 		// Sleep 2 seconds and attempt once more if the timeout is not excdeeded
-		time.Sleep(2 * time.Second)
+		if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+			providerMetrics.addRetries("operation", count-1)
+			return fmt.Errorf("error retrying operation: %s", err)
+		}
 		count++
 	}
 }
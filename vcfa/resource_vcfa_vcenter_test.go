@@ -115,6 +115,8 @@ func TestAccVcfaVcenter(t *testing.T) {
 					resource.TestCheckResourceAttrSet("vcfa_vcenter.test", "mode"),
 					resource.TestCheckResourceAttrSet("vcfa_vcenter.test", "uuid"),
 					resource.TestCheckResourceAttrSet("vcfa_vcenter.test", "vcenter_version"),
+					resource.TestCheckResourceAttr("vcfa_vcenter.test", "connection_status", "CONNECTED"),
+					resource.TestCheckResourceAttr("vcfa_vcenter.test", "status", "READY"),
 				),
 			},
 			{
@@ -122,7 +124,7 @@ func TestAccVcfaVcenter(t *testing.T) {
 				ImportState:             true,
 				ImportStateVerify:       true,
 				ImportStateId:           params["Testname"].(string),
-				ImportStateVerifyIgnore: []string{"password", "auto_trust_certificate", "refresh_vcenter_on_read", "refresh_policies_on_read", "refresh_vcenter_on_create", "refresh_policies_on_create", "nsx_manager_id"},
+				ImportStateVerifyIgnore: []string{"password", "auto_trust_certificate", "refresh_vcenter_on_read", "refresh_policies_on_read", "refresh_vcenter_on_create", "refresh_policies_on_create", "refresh_vcenter_on_update", "refresh_policies_on_update", "nsx_manager_id", "timeouts"},
 			},
 			{
 				Config: configText4,
@@ -175,13 +177,19 @@ resource "vcfa_vcenter" "test" {
 
 const testAccVcfaVcenterStep3 = testAccVcfaVcenterPrerequisites + `
 resource "vcfa_vcenter" "test" {
-  name                   = "{{.Testname}}"
-  url                    = "{{.VcenterUrl}}"
-  auto_trust_certificate = true
-  username               = "{{.VcenterUsername}}"
-  password               = "{{.VcenterPassword}}"
-  is_enabled             = true
-  nsx_manager_id         = vcfa_nsx_manager.test.id
+  name                       = "{{.Testname}}"
+  url                        = "{{.VcenterUrl}}"
+  auto_trust_certificate     = true
+  refresh_vcenter_on_update  = true
+  refresh_policies_on_update = true
+  username                   = "{{.VcenterUsername}}"
+  password                   = "{{.VcenterPassword}}"
+  is_enabled                 = true
+  nsx_manager_id             = vcfa_nsx_manager.test.id
+
+  timeouts {
+    update = "15m"
+  }
 }
 `
 
@@ -0,0 +1,448 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const labelVcfaVpc = "VPC"
+
+func resourceVcfaVpc() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaVpcCreate,
+		ReadContext:   resourceVcfaVpcRead,
+		UpdateContext: resourceVcfaVpcUpdate,
+		DeleteContext: resourceVcfaVpcDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaVpcImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // VPC names cannot be changed
+				Description: fmt.Sprintf("Name of the %s", labelVcfaVpc),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(rfc1123LabelNameRegex, "Name must match RFC 1123 Label name (lower case alphabet, 0-9 and hyphen -)"),
+				),
+			},
+			"org": orgScopedSchema(labelVcfaVpc),
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelVcfaVpc),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("Name of the Region where the %s is created", labelVcfaVpc),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Description of the %s", labelVcfaVpc),
+			},
+			"private_ips": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: fmt.Sprintf("Set of private CIDR blocks that can be used by the Subnets of the %s", labelVcfaVpc),
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDR,
+				},
+			},
+			"connectivity_profile_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Name of the VPC Connectivity Profile that defines the external connectivity of the %s. The Region default is used if not set", labelVcfaVpc),
+			},
+			"dhcp_profile_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Name of the %s used by the Subnets of the %s", labelVcfaVpcDhcpProfile, labelVcfaVpc),
+			},
+			"external_connectivity_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: fmt.Sprintf("Whether the %s is connected to the outside through its Service Gateway", labelVcfaVpc),
+			},
+			"default_snat_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: fmt.Sprintf("Whether a default SNAT rule is created for the private CIDR blocks of the %s. Requires 'external_connectivity_enabled'", labelVcfaVpc),
+			},
+			"prevent_destroy_if_not_empty": preventDestroyIfNotEmptySchema(labelVcfaVpc, labelVcfaVpcSubnet, "force_delete"),
+			"force_delete":                 forceDeleteSchema(labelVcfaVpc, labelVcfaVpcSubnet),
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Phase of the %s", labelVcfaVpc),
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is in a ready status or not", labelVcfaVpc),
+			},
+			"conditions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Detailed conditions tracking %s health and lifecycle events", labelVcfaVpc),
+				Elem:        supervisorNamespaceConditionsSchema,
+			},
+		},
+	}
+}
+
+func resourceVcfaVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName := d.Get("project_name").(string)
+	name := d.Get("name").(string)
+
+	if _, err := createVpc(tmClient, projectName, vpcFromResourceData(d, projectName, name), dryRunParams(meta)); err != nil {
+		return diag.Errorf("error creating %s: %s", labelVcfaVpc, projectAccessError(tmClient, projectName, err))
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpc, name, "created")
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	if err := waitForVpcReady(ctx, tmClient, projectName, name, operationTimeout(d, meta, schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpc, d.Id(), err)
+	}
+
+	// 'prevent_destroy_if_not_empty' and 'force_delete' are client side settings only
+	if !d.HasChangesExcept("prevent_destroy_if_not_empty", "force_delete") {
+		return resourceVcfaVpcRead(ctx, d, meta)
+	}
+
+	// The latest resource version is required to update the object
+	vpc, err := readVpc(tmClient, projectName, name)
+	if err != nil {
+		return diag.Errorf("error reading %s: %s", labelVcfaVpc, err)
+	}
+	updatedVpc := vpcFromResourceData(d, projectName, name)
+	updatedVpc.ResourceVersion = vpc.ResourceVersion
+
+	if _, err = updateVpc(tmClient, projectName, name, updatedVpc, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error updating %s: %s", labelVcfaVpc, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpc, name, "updated")
+	}
+
+	if err := waitForVpcReady(ctx, tmClient, projectName, name, operationTimeout(d, meta, schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpc, d.Id(), err)
+	}
+
+	vpc, err := readVpc(tmClient, projectName, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			log.Printf("[DEBUG] %s %s in Project %s not found, removing from state", labelVcfaVpc, name, projectName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelVcfaVpc, err)
+	}
+
+	if err := setVpcData(tmClient, d, projectName, name, vpc); err != nil {
+		return diag.Errorf("error setting %s data: %s", labelVcfaVpc, err)
+	}
+
+	return nil
+}
+
+func resourceVcfaVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpc, d.Id(), err)
+	}
+
+	err = checkDeleteProtection(d, d.Get("force_delete").(bool), labelVcfaVpc, name, labelVcfaVpcSubnet, "force_delete",
+		func() ([]string, error) { return listVpcSubnetNames(tmClient, projectName, name) })
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := deleteVpc(tmClient, projectName, name, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error deleting %s: %s", labelVcfaVpc, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpc, name, "deleted")
+	}
+
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"DELETING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (any, string, error) {
+			vpc, err := readVpc(tmClient, projectName, name)
+			if err != nil {
+				if strings.Contains(err.Error(), "not found") {
+					return "", "DELETED", nil
+				}
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] %s %s current phase is %s", labelVcfaVpc, name, vpc.Status.Phase)
+			return vpc, "DELETING", nil
+		},
+		Timeout:    operationTimeout(d, meta, schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err = stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for %s %s in Project %s to be deleted: %s", labelVcfaVpc, name, projectName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVcfaVpcImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	projectName, name, ok := splitOrgScopedImportId(d)
+	if !ok {
+		return nil, fmt.Errorf("expected import ID to be [<org>%s]<project_name>%s<vpc_name>", ImportSeparator, ImportSeparator)
+	}
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readVpc(tmClient, projectName, name); err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", labelVcfaVpc, err)
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// waitForVpcReady waits until the VPC reports a 'Ready' condition with status 'True'
+func waitForVpcReady(ctx context.Context, tmClient *VCDClient, projectName, name string, timeout time.Duration) error {
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: []string{"WAITING"},
+		Target:  []string{"READY"},
+		Refresh: func() (any, string, error) {
+			vpc, err := readVpc(tmClient, projectName, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			log.Printf("[DEBUG] %s %s current phase is %s", labelVcfaVpc, name, vpc.Status.Phase)
+			if strings.ToUpper(vpc.Status.Phase) == "ERROR" {
+				return nil, "", fmt.Errorf("%s %s is in an ERROR state", labelVcfaVpc, name)
+			}
+			if isVpcReady(vpc) {
+				return vpc, "READY", nil
+			}
+			return vpc, "WAITING", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateChangeFunc.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for %s %s in Project %s to be ready: %s", labelVcfaVpc, name, projectName, err)
+	}
+	return nil
+}
+
+func isVpcReady(vpc vcfatypes.Vpc) bool {
+	for _, condition := range vpc.Status.Conditions {
+		if strings.EqualFold(condition.Type, "Ready") {
+			return strings.EqualFold(condition.Status, "True")
+		}
+	}
+	return false
+}
+
+func createVpc(tmClient *VCDClient, projectName string, vpc vcfatypes.Vpc, params url.Values) (vcfatypes.Vpc, error) {
+	var vpcOut vcfatypes.Vpc
+	vpcURL, err := buildVpcURL(tmClient, projectName, "")
+	if err != nil {
+		return vpcOut, fmt.Errorf("error building %s URL: %s", labelVcfaVpc, err)
+	}
+	if err := tmClient.VCDClient.Client.PostEntity(vpcURL, params, &vpc, &vpcOut, nil); err != nil {
+		return vpcOut, fmt.Errorf("error creating %s in Project %s: %s", labelVcfaVpc, projectName, err)
+	}
+	return vpcOut, nil
+}
+
+func updateVpc(tmClient *VCDClient, projectName string, vpcName string, vpc vcfatypes.Vpc, params url.Values) (vcfatypes.Vpc, error) {
+	var vpcOut vcfatypes.Vpc
+	vpcURL, err := buildVpcURL(tmClient, projectName, vpcName)
+	if err != nil {
+		return vpcOut, fmt.Errorf("error building %s URL: %s", labelVcfaVpc, err)
+	}
+	if err := tmClient.VCDClient.Client.PutEntity(vpcURL, params, &vpc, &vpcOut, nil); err != nil {
+		return vpcOut, fmt.Errorf("error updating %s %s in Project %s: %s", labelVcfaVpc, vpcName, projectName, err)
+	}
+	return vpcOut, nil
+}
+
+func readVpc(tmClient *VCDClient, projectName string, vpcName string) (vcfatypes.Vpc, error) {
+	var vpc vcfatypes.Vpc
+	vpcURL, err := buildVpcURL(tmClient, projectName, vpcName)
+	if err != nil {
+		return vpc, fmt.Errorf("error building %s URL: %s", labelVcfaVpc, err)
+	}
+	if err := tmClient.VCDClient.Client.GetEntity(vpcURL, nil, &vpc, nil); err != nil {
+		return vpc, fmt.Errorf("error reading %s %s in Project %s: %s", labelVcfaVpc, vpcName, projectName, err)
+	}
+	return vpc, nil
+}
+
+func deleteVpc(tmClient *VCDClient, projectName string, vpcName string, params url.Values) error {
+	vpcURL, err := buildVpcURL(tmClient, projectName, vpcName)
+	if err != nil {
+		return fmt.Errorf("error building %s URL: %s", labelVcfaVpc, err)
+	}
+	if err := tmClient.VCDClient.Client.DeleteEntity(vpcURL, params, nil); err != nil {
+		return fmt.Errorf("error deleting %s %s in Project %s: %s", labelVcfaVpc, vpcName, projectName, err)
+	}
+	return nil
+}
+
+// listVpcSubnetNames returns the names of the Subnets of the given VPC
+func listVpcSubnetNames(tmClient *VCDClient, projectName, vpcName string) ([]string, error) {
+	subnetsURL, err := vpcSubnetEntity.buildURL(tmClient, projectName, "")
+	if err != nil {
+		return nil, fmt.Errorf("error building %s URL: %s", labelVcfaVpcSubnet, err)
+	}
+	subnets, err := getAllCciItems[vcfatypes.Subnet](tmClient, subnetsURL)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %ss in Project %s: %s", labelVcfaVpcSubnet, projectName, err)
+	}
+	var names []string
+	for _, subnet := range subnets {
+		if subnet.Spec.VpcName == vpcName {
+			names = append(names, subnet.Name)
+		}
+	}
+	return names, nil
+}
+
+func buildVpcURL(tmClient *VCDClient, projectName string, vpcName string) (*url.URL, error) {
+	vpcRawURL := fmt.Sprintf(vcfatypes.VpcsURL, projectName)
+	if vpcName != "" {
+		vpcRawURL = vpcRawURL + "/" + vpcName
+	}
+
+	return tmClient.VCDClient.Client.GetEntityUrl(vpcRawURL)
+}
+
+func vpcFromResourceData(d *schema.ResourceData, projectName, name string) vcfatypes.Vpc {
+	return vcfatypes.Vpc{
+		TypeMeta: v1.TypeMeta{
+			Kind:       vcfatypes.VpcKind,
+			APIVersion: vcfatypes.VpcAPI + "/" + vcfatypes.VpcVersion,
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: projectName,
+		},
+		Spec: vcfatypes.VpcSpec{
+			RegionName:              d.Get("region_name").(string),
+			Description:             d.Get("description").(string),
+			PrivateIPs:              convertSchemaSetToSliceOfStrings(d.Get("private_ips").(*schema.Set)),
+			ConnectivityProfileName: d.Get("connectivity_profile_name").(string),
+			DhcpProfileName:         d.Get("dhcp_profile_name").(string),
+			ServiceGateway: &vcfatypes.VpcServiceGateway{
+				Enabled:  d.Get("external_connectivity_enabled").(bool),
+				AutoSnat: d.Get("default_snat_enabled").(bool),
+			},
+		},
+	}
+}
+
+func setVpcData(_ *VCDClient, d *schema.ResourceData, projectName, name string, vpc vcfatypes.Vpc) error {
+	d.SetId(buildResourceId(projectName, name))
+	dSet(d, "name", name)
+	dSet(d, "project_name", projectName)
+	dSet(d, "region_name", vpc.Spec.RegionName)
+	dSet(d, "description", vpc.Spec.Description)
+	dSet(d, "connectivity_profile_name", vpc.Spec.ConnectivityProfileName)
+	dSet(d, "dhcp_profile_name", vpc.Spec.DhcpProfileName)
+	dSet(d, "phase", vpc.Status.Phase)
+	dSet(d, "ready", isVpcReady(vpc))
+
+	if vpc.Spec.ServiceGateway != nil {
+		dSet(d, "external_connectivity_enabled", vpc.Spec.ServiceGateway.Enabled)
+		dSet(d, "default_snat_enabled", vpc.Spec.ServiceGateway.AutoSnat)
+	}
+
+	if err := d.Set("private_ips", vpc.Spec.PrivateIPs); err != nil {
+		return fmt.Errorf("error setting 'private_ips': %s", err)
+	}
+
+	conditions := make([]interface{}, 0, len(vpc.Status.Conditions))
+	for _, condition := range vpc.Status.Conditions {
+		conditions = append(conditions, map[string]interface{}{
+			"last_transition_time": condition.LastTransitionTime,
+			"message":              condition.Message,
+			"reason":               condition.Reason,
+			"severity":             condition.Severity,
+			"status":               condition.Status,
+			"type":                 condition.Type,
+		})
+	}
+	if err := d.Set("conditions", conditions); err != nil {
+		return fmt.Errorf("error setting 'conditions': %s", err)
+	}
+
+	return nil
+}
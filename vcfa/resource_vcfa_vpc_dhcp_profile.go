@@ -0,0 +1,323 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const labelVcfaVpcDhcpProfile = "VPC DHCP Profile"
+
+var vpcDhcpProfileEntity = cciProjectEntity{label: labelVcfaVpcDhcpProfile, urlTemplate: vcfatypes.DhcpProfilesURL}
+
+func resourceVcfaVpcDhcpProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaVpcDhcpProfileCreate,
+		ReadContext:   resourceVcfaVpcDhcpProfileRead,
+		UpdateContext: resourceVcfaVpcDhcpProfileUpdate,
+		DeleteContext: resourceVcfaVpcDhcpProfileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaVpcDhcpProfileImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Names cannot be changed
+				Description: fmt.Sprintf("Name of the %s", labelVcfaVpcDhcpProfile),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(rfc1123LabelNameRegex, "Name must match RFC 1123 Label name (lower case alphabet, 0-9 and hyphen -)"),
+				),
+			},
+			"org": orgScopedSchema(labelVcfaVpcDhcpProfile),
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelVcfaVpcDhcpProfile),
+			},
+			"region_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("Name of the Region where the %s is created", labelVcfaVpcDhcpProfile),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Description of the %s", labelVcfaVpcDhcpProfile),
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"SERVER", "RELAY"}, false),
+				Description:  "Either 'SERVER', to serve DHCP from the VPC, or 'RELAY', to relay DHCP requests to external servers",
+			},
+			"server_addresses": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IP addresses of the external DHCP servers that requests are relayed to. Required when 'mode' is 'RELAY'",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"lease_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(60),
+				Description:  "Lease time, in seconds, of the served addresses. Only used when 'mode' is 'SERVER'",
+			},
+			"dns_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "IP addresses of the DNS servers advertised to the DHCP clients. Only used when 'mode' is 'SERVER'",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Phase of the %s", labelVcfaVpcDhcpProfile),
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is in a ready status or not", labelVcfaVpcDhcpProfile),
+			},
+			"conditions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Detailed conditions tracking %s health and lifecycle events", labelVcfaVpcDhcpProfile),
+				Elem:        supervisorNamespaceConditionsSchema,
+			},
+		},
+		CustomizeDiff: validateVpcDhcpProfileMode,
+	}
+}
+
+// validateVpcDhcpProfileMode checks that the arguments are consistent with the chosen DHCP mode
+func validateVpcDhcpProfileMode(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	mode := d.Get("mode").(string)
+	serverAddresses := d.Get("server_addresses").([]interface{})
+	dnsServers := d.Get("dns_servers").([]interface{})
+	switch mode {
+	case "RELAY":
+		if len(serverAddresses) == 0 && d.NewValueKnown("server_addresses") {
+			return fmt.Errorf("'server_addresses' must be set when 'mode' is 'RELAY'")
+		}
+		if len(dnsServers) > 0 {
+			return fmt.Errorf("'dns_servers' can only be set when 'mode' is 'SERVER'")
+		}
+	case "SERVER":
+		if len(serverAddresses) > 0 {
+			return fmt.Errorf("'server_addresses' can only be set when 'mode' is 'RELAY'")
+		}
+	}
+	return nil
+}
+
+func resourceVcfaVpcDhcpProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName := d.Get("project_name").(string)
+	name := d.Get("name").(string)
+
+	dhcpProfile := vpcDhcpProfileFromResourceData(d, projectName, name)
+	if err := createCciProjectEntity(tmClient, vpcDhcpProfileEntity, projectName, &dhcpProfile, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error creating %s: %s", labelVcfaVpcDhcpProfile, projectAccessError(tmClient, projectName, err))
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcDhcpProfile, name, "created")
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	err = waitForCciProjectEntityReady(ctx, vpcDhcpProfileEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutCreate),
+		vpcDhcpProfileStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcDhcpProfileRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcDhcpProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcDhcpProfile, d.Id(), err)
+	}
+
+	// The latest resource version is required to update the object
+	dhcpProfile, err := readCciProjectEntity[vcfatypes.DhcpProfile](tmClient, vpcDhcpProfileEntity, projectName, name)
+	if err != nil {
+		return diag.Errorf("error reading %s: %s", labelVcfaVpcDhcpProfile, err)
+	}
+	updatedDhcpProfile := vpcDhcpProfileFromResourceData(d, projectName, name)
+	updatedDhcpProfile.ResourceVersion = dhcpProfile.ResourceVersion
+
+	if err = updateCciProjectEntity(tmClient, vpcDhcpProfileEntity, projectName, name, &updatedDhcpProfile, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error updating %s: %s", labelVcfaVpcDhcpProfile, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcDhcpProfile, name, "updated")
+	}
+
+	err = waitForCciProjectEntityReady(ctx, vpcDhcpProfileEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutUpdate),
+		vpcDhcpProfileStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcDhcpProfileRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcDhcpProfileRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcDhcpProfile, d.Id(), err)
+	}
+
+	dhcpProfile, err := readCciProjectEntity[vcfatypes.DhcpProfile](tmClient, vpcDhcpProfileEntity, projectName, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			log.Printf("[DEBUG] %s %s in Project %s not found, removing from state", labelVcfaVpcDhcpProfile, name, projectName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelVcfaVpcDhcpProfile, err)
+	}
+
+	if err := setVpcDhcpProfileData(d, projectName, name, dhcpProfile); err != nil {
+		return diag.Errorf("error setting %s data: %s", labelVcfaVpcDhcpProfile, err)
+	}
+
+	return nil
+}
+
+func resourceVcfaVpcDhcpProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcDhcpProfile, d.Id(), err)
+	}
+
+	if err := deleteCciProjectEntity(tmClient, vpcDhcpProfileEntity, projectName, name, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error deleting %s: %s", labelVcfaVpcDhcpProfile, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcDhcpProfile, name, "deleted")
+	}
+
+	err = waitForCciProjectEntityDeleted(ctx, vpcDhcpProfileEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutDelete),
+		vpcDhcpProfileStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVcfaVpcDhcpProfileImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	projectName, name, ok := splitOrgScopedImportId(d)
+	if !ok {
+		return nil, fmt.Errorf("expected import ID to be [<org>%s]<project_name>%s<dhcp_profile_name>", ImportSeparator, ImportSeparator)
+	}
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readCciProjectEntity[vcfatypes.DhcpProfile](tmClient, vpcDhcpProfileEntity, projectName, name); err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", labelVcfaVpcDhcpProfile, err)
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func vpcDhcpProfileStatusFunc(tmClient *VCDClient, projectName, name string) func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+	return func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+		dhcpProfile, err := readCciProjectEntity[vcfatypes.DhcpProfile](tmClient, vpcDhcpProfileEntity, projectName, name)
+		if err != nil {
+			return "", nil, err
+		}
+		return dhcpProfile.Status.Phase, dhcpProfile.Status.Conditions, nil
+	}
+}
+
+func vpcDhcpProfileFromResourceData(d *schema.ResourceData, projectName, name string) vcfatypes.DhcpProfile {
+	return vcfatypes.DhcpProfile{
+		TypeMeta: v1.TypeMeta{
+			Kind:       vcfatypes.DhcpProfileKind,
+			APIVersion: vcfatypes.VpcAPI + "/" + vcfatypes.VpcVersion,
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: projectName,
+		},
+		Spec: vcfatypes.DhcpProfileSpec{
+			RegionName:      d.Get("region_name").(string),
+			Description:     d.Get("description").(string),
+			Mode:            d.Get("mode").(string),
+			ServerAddresses: convertTypeListToSliceOfStrings(d.Get("server_addresses").([]interface{})),
+			LeaseTime:       d.Get("lease_time").(int),
+			DnsServers:      convertTypeListToSliceOfStrings(d.Get("dns_servers").([]interface{})),
+		},
+	}
+}
+
+func setVpcDhcpProfileData(d *schema.ResourceData, projectName, name string, dhcpProfile vcfatypes.DhcpProfile) error {
+	d.SetId(buildResourceId(projectName, name))
+	dSet(d, "name", name)
+	dSet(d, "project_name", projectName)
+	dSet(d, "region_name", dhcpProfile.Spec.RegionName)
+	dSet(d, "description", dhcpProfile.Spec.Description)
+	dSet(d, "mode", dhcpProfile.Spec.Mode)
+	dSet(d, "lease_time", dhcpProfile.Spec.LeaseTime)
+	dSet(d, "phase", dhcpProfile.Status.Phase)
+	dSet(d, "ready", isCciConditionReady(dhcpProfile.Status.Conditions))
+
+	if err := d.Set("server_addresses", dhcpProfile.Spec.ServerAddresses); err != nil {
+		return fmt.Errorf("error setting 'server_addresses': %s", err)
+	}
+	if err := d.Set("dns_servers", dhcpProfile.Spec.DnsServers); err != nil {
+		return fmt.Errorf("error setting 'dns_servers': %s", err)
+	}
+	if err := d.Set("conditions", flattenCciConditions(dhcpProfile.Status.Conditions)); err != nil {
+		return fmt.Errorf("error setting 'conditions': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,327 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const labelVcfaVpcDnsService = "VPC DNS Service"
+
+var vpcDnsServiceEntity = cciProjectEntity{label: labelVcfaVpcDnsService, urlTemplate: vcfatypes.DnsServicesURL}
+
+var vpcDnsServiceConditionalForwarderSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"domain_names": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "Domain names whose queries are forwarded to 'upstream_servers'",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"upstream_servers": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "IP addresses of the DNS servers that resolve the queries of 'domain_names'",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	},
+}
+
+func resourceVcfaVpcDnsService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaVpcDnsServiceCreate,
+		ReadContext:   resourceVcfaVpcDnsServiceRead,
+		UpdateContext: resourceVcfaVpcDnsServiceUpdate,
+		DeleteContext: resourceVcfaVpcDnsServiceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaVpcDnsServiceImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Names cannot be changed
+				Description: fmt.Sprintf("Name of the %s", labelVcfaVpcDnsService),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(rfc1123LabelNameRegex, "Name must match RFC 1123 Label name (lower case alphabet, 0-9 and hyphen -)"),
+				),
+			},
+			"org": orgScopedSchema(labelVcfaVpcDnsService),
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelVcfaVpcDnsService),
+			},
+			"vpc_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("Name of the %s that the %s serves", labelVcfaVpc, labelVcfaVpcDnsService),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Description of the %s", labelVcfaVpcDnsService),
+			},
+			"upstream_servers": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "IP addresses of the DNS servers that queries are forwarded to by default",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+			"conditional_forwarder": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Forwards the queries of specific domains to other DNS servers",
+				Elem:        vpcDnsServiceConditionalForwarderSchema,
+			},
+			"listener_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("IP address where the %s listens for queries", labelVcfaVpcDnsService),
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Phase of the %s", labelVcfaVpcDnsService),
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is in a ready status or not", labelVcfaVpcDnsService),
+			},
+			"conditions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Detailed conditions tracking %s health and lifecycle events", labelVcfaVpcDnsService),
+				Elem:        supervisorNamespaceConditionsSchema,
+			},
+		},
+	}
+}
+
+func resourceVcfaVpcDnsServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName := d.Get("project_name").(string)
+	name := d.Get("name").(string)
+
+	dnsService := vpcDnsServiceFromResourceData(d, projectName, name)
+	if err := createCciProjectEntity(tmClient, vpcDnsServiceEntity, projectName, &dnsService, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error creating %s: %s", labelVcfaVpcDnsService, projectAccessError(tmClient, projectName, err))
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcDnsService, name, "created")
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	err = waitForCciProjectEntityReady(ctx, vpcDnsServiceEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutCreate),
+		vpcDnsServiceStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcDnsServiceRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcDnsServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcDnsService, d.Id(), err)
+	}
+
+	// The latest resource version is required to update the object
+	dnsService, err := readCciProjectEntity[vcfatypes.DnsService](tmClient, vpcDnsServiceEntity, projectName, name)
+	if err != nil {
+		return diag.Errorf("error reading %s: %s", labelVcfaVpcDnsService, err)
+	}
+	updatedDnsService := vpcDnsServiceFromResourceData(d, projectName, name)
+	updatedDnsService.ResourceVersion = dnsService.ResourceVersion
+
+	if err = updateCciProjectEntity(tmClient, vpcDnsServiceEntity, projectName, name, &updatedDnsService, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error updating %s: %s", labelVcfaVpcDnsService, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcDnsService, name, "updated")
+	}
+
+	err = waitForCciProjectEntityReady(ctx, vpcDnsServiceEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutUpdate),
+		vpcDnsServiceStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcDnsServiceRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcDnsServiceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcDnsService, d.Id(), err)
+	}
+
+	dnsService, err := readCciProjectEntity[vcfatypes.DnsService](tmClient, vpcDnsServiceEntity, projectName, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			log.Printf("[DEBUG] %s %s in Project %s not found, removing from state", labelVcfaVpcDnsService, name, projectName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelVcfaVpcDnsService, err)
+	}
+
+	if err := setVpcDnsServiceData(d, projectName, name, dnsService); err != nil {
+		return diag.Errorf("error setting %s data: %s", labelVcfaVpcDnsService, err)
+	}
+
+	return nil
+}
+
+func resourceVcfaVpcDnsServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcDnsService, d.Id(), err)
+	}
+
+	if err := deleteCciProjectEntity(tmClient, vpcDnsServiceEntity, projectName, name, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error deleting %s: %s", labelVcfaVpcDnsService, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcDnsService, name, "deleted")
+	}
+
+	err = waitForCciProjectEntityDeleted(ctx, vpcDnsServiceEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutDelete),
+		vpcDnsServiceStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVcfaVpcDnsServiceImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	projectName, name, ok := splitOrgScopedImportId(d)
+	if !ok {
+		return nil, fmt.Errorf("expected import ID to be [<org>%s]<project_name>%s<dns_service_name>", ImportSeparator, ImportSeparator)
+	}
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readCciProjectEntity[vcfatypes.DnsService](tmClient, vpcDnsServiceEntity, projectName, name); err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", labelVcfaVpcDnsService, err)
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func vpcDnsServiceStatusFunc(tmClient *VCDClient, projectName, name string) func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+	return func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+		dnsService, err := readCciProjectEntity[vcfatypes.DnsService](tmClient, vpcDnsServiceEntity, projectName, name)
+		if err != nil {
+			return "", nil, err
+		}
+		return dnsService.Status.Phase, dnsService.Status.Conditions, nil
+	}
+}
+
+func vpcDnsServiceFromResourceData(d *schema.ResourceData, projectName, name string) vcfatypes.DnsService {
+	var conditionalForwarders []vcfatypes.DnsConditionalForwarder
+	for _, forwarder := range d.Get("conditional_forwarder").([]interface{}) {
+		forwarderMap := forwarder.(map[string]interface{})
+		conditionalForwarders = append(conditionalForwarders, vcfatypes.DnsConditionalForwarder{
+			DomainNames:     convertTypeListToSliceOfStrings(forwarderMap["domain_names"].([]interface{})),
+			UpstreamServers: convertTypeListToSliceOfStrings(forwarderMap["upstream_servers"].([]interface{})),
+		})
+	}
+
+	return vcfatypes.DnsService{
+		TypeMeta: v1.TypeMeta{
+			Kind:       vcfatypes.DnsServiceKind,
+			APIVersion: vcfatypes.VpcAPI + "/" + vcfatypes.VpcVersion,
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: projectName,
+		},
+		Spec: vcfatypes.DnsServiceSpec{
+			VpcName:               d.Get("vpc_name").(string),
+			Description:           d.Get("description").(string),
+			UpstreamServers:       convertTypeListToSliceOfStrings(d.Get("upstream_servers").([]interface{})),
+			ConditionalForwarders: conditionalForwarders,
+		},
+	}
+}
+
+func setVpcDnsServiceData(d *schema.ResourceData, projectName, name string, dnsService vcfatypes.DnsService) error {
+	d.SetId(buildResourceId(projectName, name))
+	dSet(d, "name", name)
+	dSet(d, "project_name", projectName)
+	dSet(d, "vpc_name", dnsService.Spec.VpcName)
+	dSet(d, "description", dnsService.Spec.Description)
+	dSet(d, "listener_ip", dnsService.Status.ListenerIP)
+	dSet(d, "phase", dnsService.Status.Phase)
+	dSet(d, "ready", isCciConditionReady(dnsService.Status.Conditions))
+
+	if err := d.Set("upstream_servers", dnsService.Spec.UpstreamServers); err != nil {
+		return fmt.Errorf("error setting 'upstream_servers': %s", err)
+	}
+
+	conditionalForwarders := make([]interface{}, 0, len(dnsService.Spec.ConditionalForwarders))
+	for _, forwarder := range dnsService.Spec.ConditionalForwarders {
+		conditionalForwarders = append(conditionalForwarders, map[string]interface{}{
+			"domain_names":     forwarder.DomainNames,
+			"upstream_servers": forwarder.UpstreamServers,
+		})
+	}
+	if err := d.Set("conditional_forwarder", conditionalForwarders); err != nil {
+		return fmt.Errorf("error setting 'conditional_forwarder': %s", err)
+	}
+	if err := d.Set("conditions", flattenCciConditions(dnsService.Status.Conditions)); err != nil {
+		return fmt.Errorf("error setting 'conditions': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,393 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const labelVcfaVpcSubnet = "VPC Subnet"
+
+var vpcSubnetEntity = cciProjectEntity{label: labelVcfaVpcSubnet, urlTemplate: vcfatypes.SubnetsURL}
+
+var vpcSubnetDhcpRangeSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"start_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+			Description:  "First IP address of the range",
+		},
+		"end_address": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPAddress,
+			Description:  "Last IP address of the range",
+		},
+	},
+}
+
+func resourceVcfaVpcSubnet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVcfaVpcSubnetCreate,
+		ReadContext:   resourceVcfaVpcSubnetRead,
+		UpdateContext: resourceVcfaVpcSubnetUpdate,
+		DeleteContext: resourceVcfaVpcSubnetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVcfaVpcSubnetImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Names cannot be changed
+				Description: fmt.Sprintf("Name of the %s", labelVcfaVpcSubnet),
+				ValidateDiagFunc: validation.ToDiagFunc(
+					validation.StringMatch(rfc1123LabelNameRegex, "Name must match RFC 1123 Label name (lower case alphabet, 0-9 and hyphen -)"),
+				),
+			},
+			"org": orgScopedSchema(labelVcfaVpcSubnet),
+			"project_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("The name of the Project the %s belongs to", labelVcfaVpcSubnet),
+			},
+			"vpc_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // Update not supported
+				Description: fmt.Sprintf("Name of the %s that the %s belongs to", labelVcfaVpc, labelVcfaVpcSubnet),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: fmt.Sprintf("Description of the %s", labelVcfaVpcSubnet),
+			},
+			"access_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true, // Update not supported
+				Default:      "Private",
+				ValidateFunc: validation.StringInSlice([]string{"Private", "Public", "PrivateTGW"}, false),
+				Description:  fmt.Sprintf("Access mode of the %s, either 'Private', 'Public' or 'PrivateTGW'", labelVcfaVpcSubnet),
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true, // Update not supported
+				ValidateFunc: validation.IsCIDR,
+				Description:  fmt.Sprintf("CIDR block of the %s. For 'Private' Subnets, it must be within the private IPs of the %s", labelVcfaVpcSubnet, labelVcfaVpc),
+			},
+			"gateway_address": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true, // Update not supported
+				ValidateFunc: validation.IsIPAddress,
+				Description:  fmt.Sprintf("IP address of the %s gateway. The first address of 'cidr' is used if not set", labelVcfaVpcSubnet),
+			},
+			"dhcp_range": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: fmt.Sprintf("IP address ranges within 'cidr' that DHCP serves to the %s workloads", labelVcfaVpcSubnet),
+				Elem:        vpcSubnetDhcpRangeSchema,
+			},
+			"phase": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("Phase of the %s", labelVcfaVpcSubnet),
+			},
+			"ready": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: fmt.Sprintf("Whether the %s is in a ready status or not", labelVcfaVpcSubnet),
+			},
+			"conditions": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: fmt.Sprintf("Detailed conditions tracking %s health and lifecycle events", labelVcfaVpcSubnet),
+				Elem:        supervisorNamespaceConditionsSchema,
+			},
+		},
+		CustomizeDiff: validateVpcSubnetAddressesDiff,
+	}
+}
+
+// validateVpcSubnetAddressesDiff checks that the gateway and the DHCP ranges are within the Subnet CIDR block,
+// so that errors are reported during plan
+func validateVpcSubnetAddressesDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("cidr") || !d.NewValueKnown("gateway_address") || !d.NewValueKnown("dhcp_range") {
+		return nil
+	}
+	var dhcpRanges []vcfatypes.SubnetDhcpRange
+	for _, dhcpRange := range d.Get("dhcp_range").([]interface{}) {
+		dhcpRangeMap := dhcpRange.(map[string]interface{})
+		dhcpRanges = append(dhcpRanges, vcfatypes.SubnetDhcpRange{
+			StartAddress: dhcpRangeMap["start_address"].(string),
+			EndAddress:   dhcpRangeMap["end_address"].(string),
+		})
+	}
+	return validateVpcSubnetAddresses(d.Get("cidr").(string), d.Get("gateway_address").(string), dhcpRanges)
+}
+
+// validateVpcSubnetAddresses returns an error if the gateway address or any of the DHCP ranges are not within the
+// given CIDR block, if a DHCP range is reversed, or if the gateway address is within a DHCP range
+func validateVpcSubnetAddresses(cidr, gatewayAddress string, dhcpRanges []vcfatypes.SubnetDhcpRange) error {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return fmt.Errorf("invalid 'cidr' '%s': %s", cidr, err)
+	}
+	prefix = prefix.Masked()
+
+	var gateway netip.Addr
+	if gatewayAddress != "" {
+		gateway, err = netip.ParseAddr(gatewayAddress)
+		if err != nil {
+			return fmt.Errorf("invalid 'gateway_address' '%s': %s", gatewayAddress, err)
+		}
+		if !prefix.Contains(gateway) {
+			return fmt.Errorf("'gateway_address' '%s' is not within 'cidr' '%s'", gatewayAddress, cidr)
+		}
+	}
+
+	for _, dhcpRange := range dhcpRanges {
+		start, err := netip.ParseAddr(dhcpRange.StartAddress)
+		if err != nil {
+			return fmt.Errorf("invalid DHCP range start address '%s': %s", dhcpRange.StartAddress, err)
+		}
+		end, err := netip.ParseAddr(dhcpRange.EndAddress)
+		if err != nil {
+			return fmt.Errorf("invalid DHCP range end address '%s': %s", dhcpRange.EndAddress, err)
+		}
+		if !prefix.Contains(start) || !prefix.Contains(end) {
+			return fmt.Errorf("DHCP range '%s-%s' is not within 'cidr' '%s'", dhcpRange.StartAddress, dhcpRange.EndAddress, cidr)
+		}
+		if end.Less(start) {
+			return fmt.Errorf("DHCP range '%s-%s' ends before it starts", dhcpRange.StartAddress, dhcpRange.EndAddress)
+		}
+		if gateway.IsValid() && !gateway.Less(start) && !end.Less(gateway) {
+			return fmt.Errorf("'gateway_address' '%s' is within DHCP range '%s-%s'", gatewayAddress, dhcpRange.StartAddress, dhcpRange.EndAddress)
+		}
+	}
+	return nil
+}
+
+func resourceVcfaVpcSubnetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName := d.Get("project_name").(string)
+	name := d.Get("name").(string)
+
+	subnet := vpcSubnetFromResourceData(d, projectName, name)
+	if err := createCciProjectEntity(tmClient, vpcSubnetEntity, projectName, &subnet, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error creating %s: %s", labelVcfaVpcSubnet, projectAccessError(tmClient, projectName, err))
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcSubnet, name, "created")
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	err = waitForCciProjectEntityReady(ctx, vpcSubnetEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutCreate),
+		vpcSubnetStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcSubnetRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcSubnet, d.Id(), err)
+	}
+
+	// The latest resource version is required to update the object
+	subnet, err := readCciProjectEntity[vcfatypes.Subnet](tmClient, vpcSubnetEntity, projectName, name)
+	if err != nil {
+		return diag.Errorf("error reading %s: %s", labelVcfaVpcSubnet, err)
+	}
+	updatedSubnet := vpcSubnetFromResourceData(d, projectName, name)
+	updatedSubnet.ResourceVersion = subnet.ResourceVersion
+
+	if err = updateCciProjectEntity(tmClient, vpcSubnetEntity, projectName, name, &updatedSubnet, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error updating %s: %s", labelVcfaVpcSubnet, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcSubnet, name, "updated")
+	}
+
+	err = waitForCciProjectEntityReady(ctx, vpcSubnetEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutUpdate),
+		vpcSubnetStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVcfaVpcSubnetRead(ctx, d, meta)
+}
+
+func resourceVcfaVpcSubnetRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcSubnet, d.Id(), err)
+	}
+
+	subnet, err := readCciProjectEntity[vcfatypes.Subnet](tmClient, vpcSubnetEntity, projectName, name)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			log.Printf("[DEBUG] %s %s in Project %s not found, removing from state", labelVcfaVpcSubnet, name, projectName)
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("error reading %s: %s", labelVcfaVpcSubnet, err)
+	}
+
+	if err := setVpcSubnetData(d, projectName, name, subnet); err != nil {
+		return diag.Errorf("error setting %s data: %s", labelVcfaVpcSubnet, err)
+	}
+
+	return nil
+}
+
+func resourceVcfaVpcSubnetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	projectName, name, err := parseResourceId(d.Id())
+	if err != nil {
+		return diag.Errorf("error parsing %s resource id %s: %s", labelVcfaVpcSubnet, d.Id(), err)
+	}
+
+	if err := deleteCciProjectEntity(tmClient, vpcSubnetEntity, projectName, name, dryRunParams(meta)); err != nil {
+		return diag.Errorf("error deleting %s: %s", labelVcfaVpcSubnet, err)
+	}
+	if isDryRun(meta) {
+		return dryRunDiagnostics(d, labelVcfaVpcSubnet, name, "deleted")
+	}
+
+	err = waitForCciProjectEntityDeleted(ctx, vpcSubnetEntity, projectName, name, operationTimeout(d, meta, schema.TimeoutDelete),
+		vpcSubnetStatusFunc(tmClient, projectName, name))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceVcfaVpcSubnetImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	projectName, name, ok := splitOrgScopedImportId(d)
+	if !ok {
+		return nil, fmt.Errorf("expected import ID to be [<org>%s]<project_name>%s<subnet_name>", ImportSeparator, ImportSeparator)
+	}
+	tmClient, err := getOrgScopedClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := readCciProjectEntity[vcfatypes.Subnet](tmClient, vpcSubnetEntity, projectName, name); err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", labelVcfaVpcSubnet, err)
+	}
+
+	d.SetId(buildResourceId(projectName, name))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func vpcSubnetStatusFunc(tmClient *VCDClient, projectName, name string) func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+	return func() (string, []ccitypes.SupervisorNamespaceStatusConditions, error) {
+		subnet, err := readCciProjectEntity[vcfatypes.Subnet](tmClient, vpcSubnetEntity, projectName, name)
+		if err != nil {
+			return "", nil, err
+		}
+		return subnet.Status.Phase, subnet.Status.Conditions, nil
+	}
+}
+
+func vpcSubnetFromResourceData(d *schema.ResourceData, projectName, name string) vcfatypes.Subnet {
+	var dhcpRanges []vcfatypes.SubnetDhcpRange
+	for _, dhcpRange := range d.Get("dhcp_range").([]interface{}) {
+		dhcpRangeMap := dhcpRange.(map[string]interface{})
+		dhcpRanges = append(dhcpRanges, vcfatypes.SubnetDhcpRange{
+			StartAddress: dhcpRangeMap["start_address"].(string),
+			EndAddress:   dhcpRangeMap["end_address"].(string),
+		})
+	}
+
+	return vcfatypes.Subnet{
+		TypeMeta: v1.TypeMeta{
+			Kind:       vcfatypes.SubnetKind,
+			APIVersion: vcfatypes.VpcAPI + "/" + vcfatypes.VpcVersion,
+		},
+		ObjectMeta: v1.ObjectMeta{
+			Name:      name,
+			Namespace: projectName,
+		},
+		Spec: vcfatypes.SubnetSpec{
+			VpcName:        d.Get("vpc_name").(string),
+			Description:    d.Get("description").(string),
+			AccessMode:     d.Get("access_mode").(string),
+			IPAddresses:    []string{d.Get("cidr").(string)},
+			GatewayAddress: d.Get("gateway_address").(string),
+			DhcpRanges:     dhcpRanges,
+		},
+	}
+}
+
+func setVpcSubnetData(d *schema.ResourceData, projectName, name string, subnet vcfatypes.Subnet) error {
+	d.SetId(buildResourceId(projectName, name))
+	dSet(d, "name", name)
+	dSet(d, "project_name", projectName)
+	dSet(d, "vpc_name", subnet.Spec.VpcName)
+	dSet(d, "description", subnet.Spec.Description)
+	dSet(d, "access_mode", subnet.Spec.AccessMode)
+	dSet(d, "gateway_address", subnet.Spec.GatewayAddress)
+	dSet(d, "phase", subnet.Status.Phase)
+	dSet(d, "ready", isCciConditionReady(subnet.Status.Conditions))
+	if len(subnet.Spec.IPAddresses) > 0 {
+		dSet(d, "cidr", subnet.Spec.IPAddresses[0])
+	}
+
+	dhcpRanges := make([]interface{}, 0, len(subnet.Spec.DhcpRanges))
+	for _, dhcpRange := range subnet.Spec.DhcpRanges {
+		dhcpRanges = append(dhcpRanges, map[string]interface{}{
+			"start_address": dhcpRange.StartAddress,
+			"end_address":   dhcpRange.EndAddress,
+		})
+	}
+	if err := d.Set("dhcp_range", dhcpRanges); err != nil {
+		return fmt.Errorf("error setting 'dhcp_range': %s", err)
+	}
+	if err := d.Set("conditions", flattenCciConditions(subnet.Status.Conditions)); err != nil {
+		return fmt.Errorf("error setting 'conditions': %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/vmware/terraform-provider-vcfa/internal/vcfatypes"
+)
+
+// TestValidateVpcSubnetAddresses checks that the gateway and DHCP ranges of a VPC Subnet are validated against its CIDR block
+func TestValidateVpcSubnetAddresses(t *testing.T) {
+	tests := []struct {
+		name       string
+		cidr       string
+		gateway    string
+		dhcpRanges []vcfatypes.SubnetDhcpRange
+		wantErr    bool
+	}{
+		{
+			name: "OnlyCidr",
+			cidr: "172.16.0.0/24",
+		},
+		{
+			name:       "Valid",
+			cidr:       "172.16.0.0/24",
+			gateway:    "172.16.0.1",
+			dhcpRanges: []vcfatypes.SubnetDhcpRange{{StartAddress: "172.16.0.100", EndAddress: "172.16.0.200"}},
+		},
+		{
+			name:    "GatewayOutsideCidr",
+			cidr:    "172.16.0.0/24",
+			gateway: "172.16.1.1",
+			wantErr: true,
+		},
+		{
+			name:       "RangeOutsideCidr",
+			cidr:       "172.16.0.0/24",
+			dhcpRanges: []vcfatypes.SubnetDhcpRange{{StartAddress: "172.16.0.100", EndAddress: "172.16.1.10"}},
+			wantErr:    true,
+		},
+		{
+			name:       "ReversedRange",
+			cidr:       "172.16.0.0/24",
+			dhcpRanges: []vcfatypes.SubnetDhcpRange{{StartAddress: "172.16.0.200", EndAddress: "172.16.0.100"}},
+			wantErr:    true,
+		},
+		{
+			name:       "GatewayInRange",
+			cidr:       "172.16.0.0/24",
+			gateway:    "172.16.0.150",
+			dhcpRanges: []vcfatypes.SubnetDhcpRange{{StartAddress: "172.16.0.100", EndAddress: "172.16.0.200"}},
+			wantErr:    true,
+		},
+		{
+			name:    "InvalidCidr",
+			cidr:    "172.16.0.0",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVpcSubnetAddresses(tt.cidr, tt.gateway, tt.dhcpRanges)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateVpcSubnetAddresses() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
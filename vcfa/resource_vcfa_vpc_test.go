@@ -0,0 +1,386 @@
+//go:build api || cci || ALL || functional
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVcfaVpc(t *testing.T) {
+	preTestChecks(t)
+	defer postTestChecks(t)
+	skipIfNotSysAdmin(t)
+
+	nsxManagerHcl, nsxManagerHclRef := getNsxManagerHcl(t)
+	vCenterHcl, vCenterHclRef := getVCenterHcl(t, nsxManagerHclRef)
+	regionHcl, regionHclRef := getRegionHcl(t, vCenterHclRef, nsxManagerHclRef)
+	ipSpaceHcl, ipSpaceHclRef := getIpSpaceHcl(t, regionHclRef, "1", "1")
+	providerGatewayHcl, providerGatewayHclRef := getProviderGatewayHcl(t, regionHclRef, ipSpaceHclRef)
+	edgeClusterHcl, edgeClusterHclRef := getEdgeClusterHcl(t, nsxManagerHclRef, regionHclRef)
+
+	var params = StringMap{
+		"Testname":           t.Name(),
+		"SupervisorName":     testConfig.Tm.VcenterSupervisor,
+		"SupervisorZoneName": testConfig.Tm.VcenterSupervisorZone,
+
+		"StorageClass": testConfig.Tm.StorageClass,
+		"ProjectName":  "tf-project-vpc",
+
+		"OrgName":     "tf-org-vpc",
+		"OrgUser":     "tflocal",
+		"OrgPassword": "long-change-ME1",
+
+		"RegionName":    testConfig.Tm.Region,
+		"RegionVmClass": "best-effort-2xlarge",
+
+		"RegionId":          fmt.Sprintf("%s.id", regionHclRef),
+		"ProviderGatewayId": fmt.Sprintf("%s.id", providerGatewayHclRef),
+		"EdgeClusterId":     fmt.Sprintf("%s.id", edgeClusterHclRef),
+
+		"VpcName":            "tf-test-vpc",
+		"VpcDescription":     "VPC created by Terraform",
+		"VpcDescriptionUpd":  "VPC updated by Terraform",
+		"VpcPrivateCidr":     "172.16.0.0/24",
+		"VpcPrivateCidrUpd":  "172.16.1.0/24",
+		"NamespaceClassName": "small",
+
+		"DhcpProfileName":  "tf-test-dhcp-profile",
+		"DnsServiceName":   "tf-test-dns-service",
+		"DnsUpstream":      "8.8.8.8",
+		"DnsUpstreamUpd":   "1.1.1.1",
+		"DhcpLeaseTime":    "3600",
+		"DhcpLeaseTimeUpd": "7200",
+		"SubnetName":       "tf-test-subnet",
+		"SubnetCidr":       "172.16.0.0/26",
+
+		"Tags": "tm org regionQuota",
+	}
+	testParamsNotEmpty(t, params)
+
+	skipBinaryTest := "# skip-binary-test: prerequisite buildup for acceptance tests"
+	configTextPrerequisites := vCenterHcl + nsxManagerHcl + regionHcl + ipSpaceHcl + providerGatewayHcl + edgeClusterHcl + skipBinaryTest
+
+	configText1 := templateFill(configTextPrerequisites+testAccVcfaSupervisorNamespaceStep1, params)
+	params["FuncName"] = t.Name() + "-step2"
+	configText2 := templateFill(configTextPrerequisites+testAccVcfaVpcStep2, params)
+	params["FuncName"] = t.Name() + "-step3"
+	configText3 := templateFill(configTextPrerequisites+testAccVcfaVpcStep3Update, params)
+	params["FuncName"] = t.Name() + "-step4"
+	configText4 := templateFill(configTextPrerequisites+testAccVcfaVpcStep4DS, params)
+
+	debugPrintf("#[DEBUG] CONFIGURATION step1: %s\n", configText1)
+	debugPrintf("#[DEBUG] CONFIGURATION step2: %s\n", configText2)
+	debugPrintf("#[DEBUG] CONFIGURATION step3: %s\n", configText3)
+	debugPrintf("#[DEBUG] CONFIGURATION step4: %s\n", configText4)
+
+	if vcfaShortTest {
+		t.Skip(acceptanceTestsSkipped)
+		return
+	}
+
+	// VPCs are created by a tenant user, which is created in the first step
+	multipleFactories := func() map[string]func() (*schema.Provider, error) {
+		return map[string]func() (*schema.Provider, error){
+			"vcfa": func() (*schema.Provider, error) {
+				return testAccProvider, nil
+			},
+			"vcfatenant": func() (*schema.Provider, error) {
+				return testOrgProvider(params["OrgName"].(string), params["OrgUser"].(string), params["OrgPassword"].(string)), nil
+			},
+		}
+	}
+	defer cachedVCDClients.reset()
+
+	resourceName := "vcfa_vpc.test"
+	dhcpProfileName := "vcfa_vpc_dhcp_profile.test"
+	dnsServiceName := "vcfa_vpc_dns_service.test"
+	subnetName := "vcfa_vpc_subnet.test"
+	resource.Test(t, resource.TestCase{
+		Steps: []resource.TestStep{
+			{
+				ProviderFactories: testAccProviders,
+				Config:            configText1,
+			},
+			{
+				ProviderFactories: multipleFactories(),
+				PreConfig: func() {
+					time.Sleep(70 * time.Second) // Give time for Namespace Classes to be allocated after the Organization is created
+					createProject(t, params["OrgName"].(string), params["OrgUser"].(string), params["OrgPassword"].(string), params["ProjectName"].(string))
+				},
+				Config: configText2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s:%s", params["ProjectName"], params["VpcName"])),
+					resource.TestCheckResourceAttr(resourceName, "name", params["VpcName"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "region_name", params["RegionName"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "description", params["VpcDescription"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "private_ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "private_ips.*", params["VpcPrivateCidr"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "external_connectivity_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_snat_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ready", "true"),
+					resource.TestCheckResourceAttrPair("vcfa_supervisor_namespace.test", "vpc_name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "dhcp_profile_name", dhcpProfileName, "name"),
+
+					resource.TestCheckResourceAttr(dhcpProfileName, "id", fmt.Sprintf("%s:%s", params["ProjectName"], params["DhcpProfileName"])),
+					resource.TestCheckResourceAttr(dhcpProfileName, "mode", "SERVER"),
+					resource.TestCheckResourceAttr(dhcpProfileName, "lease_time", params["DhcpLeaseTime"].(string)),
+					resource.TestCheckResourceAttr(dhcpProfileName, "dns_servers.#", "1"),
+					resource.TestCheckResourceAttr(dhcpProfileName, "ready", "true"),
+
+					resource.TestCheckResourceAttr(dnsServiceName, "id", fmt.Sprintf("%s:%s", params["ProjectName"], params["DnsServiceName"])),
+					resource.TestCheckResourceAttrPair(dnsServiceName, "vpc_name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dnsServiceName, "upstream_servers.#", "1"),
+					resource.TestCheckResourceAttr(dnsServiceName, "upstream_servers.0", params["DnsUpstream"].(string)),
+					resource.TestCheckResourceAttr(dnsServiceName, "conditional_forwarder.#", "0"),
+					resource.TestCheckResourceAttrSet(dnsServiceName, "listener_ip"),
+					resource.TestCheckResourceAttr(dnsServiceName, "ready", "true"),
+
+					resource.TestCheckResourceAttr(subnetName, "id", fmt.Sprintf("%s:%s", params["ProjectName"], params["SubnetName"])),
+					resource.TestCheckResourceAttrPair(subnetName, "vpc_name", resourceName, "name"),
+					resource.TestCheckResourceAttr(subnetName, "access_mode", "Private"),
+					resource.TestCheckResourceAttr(subnetName, "cidr", params["SubnetCidr"].(string)),
+					resource.TestCheckResourceAttr(subnetName, "gateway_address", "172.16.0.1"),
+					resource.TestCheckResourceAttr(subnetName, "dhcp_range.#", "1"),
+					resource.TestCheckResourceAttr(subnetName, "ready", "true"),
+				),
+			},
+			{
+				ProviderFactories: multipleFactories(),
+				Config:            configText3,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", params["VpcDescriptionUpd"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "private_ips.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "private_ips.*", params["VpcPrivateCidrUpd"].(string)),
+					resource.TestCheckResourceAttr(resourceName, "default_snat_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ready", "true"),
+					resource.TestCheckResourceAttr(dhcpProfileName, "lease_time", params["DhcpLeaseTimeUpd"].(string)),
+					resource.TestCheckResourceAttr(dnsServiceName, "upstream_servers.0", params["DnsUpstreamUpd"].(string)),
+					resource.TestCheckResourceAttr(dnsServiceName, "conditional_forwarder.#", "1"),
+					resource.TestCheckResourceAttr(dnsServiceName, "conditional_forwarder.0.domain_names.0", "corp.example.com"),
+					resource.TestCheckResourceAttr(subnetName, "dhcp_range.#", "2"),
+					resource.TestCheckResourceAttr(subnetName, "dhcp_range.1.start_address", "172.16.0.50"),
+				),
+			},
+			{
+				ProviderFactories: multipleFactories(),
+				Config:            configText4,
+				Check: resource.ComposeTestCheckFunc(
+					resourceFieldsEqual("data.vcfa_vpc.test", resourceName, nil),
+				),
+			},
+			{
+				ProviderFactories: multipleFactories(),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["ProjectName"].(string) + ImportSeparator + params["VpcName"].(string), nil
+				},
+			},
+			{
+				ProviderFactories: multipleFactories(),
+				ResourceName:      dhcpProfileName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["ProjectName"].(string) + ImportSeparator + params["DhcpProfileName"].(string), nil
+				},
+			},
+			{
+				ProviderFactories: multipleFactories(),
+				ResourceName:      dnsServiceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["ProjectName"].(string) + ImportSeparator + params["DnsServiceName"].(string), nil
+				},
+			},
+			{
+				ProviderFactories: multipleFactories(),
+				ResourceName:      subnetName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return params["ProjectName"].(string) + ImportSeparator + params["SubnetName"].(string), nil
+				},
+			},
+			{
+				// Applying step1 config that will remove the Supervisor Namespace and the VPC
+				ProviderFactories: multipleFactories(),
+				Config:            configText1,
+			},
+			{
+				// VPC already removed, removing project using SDK and leaving for Terraform to teardown
+				PreConfig: func() {
+					removeProject(t, params["OrgName"].(string), params["OrgUser"].(string), params["OrgPassword"].(string), params["ProjectName"].(string))
+				},
+				ProviderFactories: multipleFactories(),
+				Config:            configText1,
+			},
+		},
+	})
+}
+
+// Project must be precreated before
+const testAccVcfaVpcStep2 = testAccVcfaSupervisorNamespaceStep1 + `
+resource "vcfa_vpc" "test" {
+  provider = vcfatenant
+
+  name         = "{{.VpcName}}"
+  project_name = "{{.ProjectName}}"
+  region_name  = "{{.RegionName}}"
+  description  = "{{.VpcDescription}}"
+  private_ips  = ["{{.VpcPrivateCidr}}"]
+
+  dhcp_profile_name = vcfa_vpc_dhcp_profile.test.name
+}
+
+resource "vcfa_vpc_dhcp_profile" "test" {
+  provider = vcfatenant
+
+  name         = "{{.DhcpProfileName}}"
+  project_name = "{{.ProjectName}}"
+  region_name  = "{{.RegionName}}"
+  mode         = "SERVER"
+  lease_time   = {{.DhcpLeaseTime}}
+  dns_servers  = ["{{.DnsUpstream}}"]
+}
+
+resource "vcfa_vpc_dns_service" "test" {
+  provider = vcfatenant
+
+  name             = "{{.DnsServiceName}}"
+  project_name     = "{{.ProjectName}}"
+  vpc_name         = vcfa_vpc.test.name
+  upstream_servers = ["{{.DnsUpstream}}"]
+}
+
+resource "vcfa_vpc_subnet" "test" {
+  provider = vcfatenant
+
+  name            = "{{.SubnetName}}"
+  project_name    = "{{.ProjectName}}"
+  vpc_name        = vcfa_vpc.test.name
+  cidr            = "{{.SubnetCidr}}"
+  gateway_address = "172.16.0.1"
+
+  dhcp_range {
+    start_address = "172.16.0.10"
+    end_address   = "172.16.0.40"
+  }
+}
+
+resource "vcfa_supervisor_namespace" "test" {
+  provider = vcfatenant
+
+  name_prefix  = "terraform-vpc"
+  project_name = "{{.ProjectName}}"
+  class_name   = "{{.NamespaceClassName}}"
+  region_name  = "{{.RegionName}}"
+  vpc_name     = vcfa_vpc.test.name
+
+  zones_initial_class_config_overrides {
+    cpu_limit          = "100M"
+    cpu_reservation    = "1M"
+    memory_limit       = "200Mi"
+    memory_reservation = "2Mi"
+    name               = "{{.SupervisorZoneName}}"
+  }
+}
+`
+
+const testAccVcfaVpcStep3Update = testAccVcfaSupervisorNamespaceStep1 + `
+resource "vcfa_vpc" "test" {
+  provider = vcfatenant
+
+  name                 = "{{.VpcName}}"
+  project_name         = "{{.ProjectName}}"
+  region_name          = "{{.RegionName}}"
+  description          = "{{.VpcDescriptionUpd}}"
+  private_ips          = ["{{.VpcPrivateCidr}}", "{{.VpcPrivateCidrUpd}}"]
+  default_snat_enabled = false
+  dhcp_profile_name    = vcfa_vpc_dhcp_profile.test.name
+}
+
+resource "vcfa_vpc_dhcp_profile" "test" {
+  provider = vcfatenant
+
+  name         = "{{.DhcpProfileName}}"
+  project_name = "{{.ProjectName}}"
+  region_name  = "{{.RegionName}}"
+  mode         = "SERVER"
+  lease_time   = {{.DhcpLeaseTimeUpd}}
+  dns_servers  = ["{{.DnsUpstream}}"]
+}
+
+resource "vcfa_vpc_dns_service" "test" {
+  provider = vcfatenant
+
+  name             = "{{.DnsServiceName}}"
+  project_name     = "{{.ProjectName}}"
+  vpc_name         = vcfa_vpc.test.name
+  upstream_servers = ["{{.DnsUpstreamUpd}}"]
+
+  conditional_forwarder {
+    domain_names     = ["corp.example.com"]
+    upstream_servers = ["{{.DnsUpstream}}"]
+  }
+}
+
+resource "vcfa_vpc_subnet" "test" {
+  provider = vcfatenant
+
+  name            = "{{.SubnetName}}"
+  project_name    = "{{.ProjectName}}"
+  vpc_name        = vcfa_vpc.test.name
+  cidr            = "{{.SubnetCidr}}"
+  gateway_address = "172.16.0.1"
+
+  dhcp_range {
+    start_address = "172.16.0.10"
+    end_address   = "172.16.0.40"
+  }
+
+  dhcp_range {
+    start_address = "172.16.0.50"
+    end_address   = "172.16.0.60"
+  }
+}
+
+resource "vcfa_supervisor_namespace" "test" {
+  provider = vcfatenant
+
+  name_prefix  = "terraform-vpc"
+  project_name = "{{.ProjectName}}"
+  class_name   = "{{.NamespaceClassName}}"
+  region_name  = "{{.RegionName}}"
+  vpc_name     = vcfa_vpc.test.name
+
+  zones_initial_class_config_overrides {
+    cpu_limit          = "100M"
+    cpu_reservation    = "1M"
+    memory_limit       = "200Mi"
+    memory_reservation = "2Mi"
+    name               = "{{.SupervisorZoneName}}"
+  }
+}
+`
+
+const testAccVcfaVpcStep4DS = testAccVcfaVpcStep3Update + `
+data "vcfa_vpc" "test" {
+  provider = vcfatenant
+
+  name         = vcfa_vpc.test.name
+  project_name = vcfa_vpc.test.project_name
+}
+`
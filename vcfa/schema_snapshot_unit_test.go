@@ -0,0 +1,218 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// schemaSnapshotDir contains a golden file per resource and data source with a snapshot of its schema
+const schemaSnapshotDir = "testdata/schema_snapshots"
+
+// TestSchemaSnapshots compares the schema of every resource and data source with its golden file, so any schema
+// change (a type change, an attribute that becomes required or forces replacement...) shows up in the pull request.
+// When a schema change is intended, the golden files are regenerated with 'make schema-snapshots', which sets
+// VCFA_UPDATE_SCHEMA_SNAPSHOTS
+func TestSchemaSnapshots(t *testing.T) {
+	update := os.Getenv("VCFA_UPDATE_SCHEMA_SNAPSHOTS") != ""
+	checkSchemaSnapshots(t, "resources", globalResourceMap, update)
+	checkSchemaSnapshots(t, "data-sources", globalDataSourceMap, update)
+}
+
+func checkSchemaSnapshots(t *testing.T, kind string, resources map[string]*schema.Resource, update bool) {
+	dir := filepath.Join(schemaSnapshotDir, kind)
+	if update {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, resource := range resources {
+		t.Run(kind+"/"+name, func(t *testing.T) {
+			snapshotFile := filepath.Join(dir, name+".txt")
+			got := buildSchemaSnapshot(resource)
+			if update {
+				if err := os.WriteFile(snapshotFile, []byte(got), 0600); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(snapshotFile) // #nosec G304 -- test golden file
+			if err != nil {
+				t.Fatalf("missing schema snapshot %s. Run 'make schema-snapshots' to create it: %s", snapshotFile, err)
+			}
+			if diff := diffSchemaSnapshots(string(want), got); diff != "" {
+				t.Errorf("schema of %s changed. If the change is intended, run 'make schema-snapshots' and commit "+
+					"the updated %s:\n%s", name, snapshotFile, diff)
+			}
+		})
+	}
+
+	// Golden files of removed or renamed resources must be removed too
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".txt")
+		if _, ok := resources[name]; !ok {
+			t.Errorf("schema snapshot %s does not belong to any of the %s. Run 'make schema-snapshots' to remove it",
+				filepath.Join(dir, entry.Name()), kind)
+		}
+	}
+}
+
+// buildSchemaSnapshot returns a text representation of the given resource schema, with one line per attribute
+// (nested attributes use a dotted path), sorted by path
+func buildSchemaSnapshot(resource *schema.Resource) string {
+	lines := []string{
+		fmt.Sprintf("# schema_version: %d", resource.SchemaVersion),
+		fmt.Sprintf("# importable: %t", resource.Importer != nil),
+	}
+	if resource.DeprecationMessage != "" {
+		lines = append(lines, "# deprecated: true")
+	}
+	lines = append(lines, buildSchemaSnapshotLines("", resource.SchemaMap())...)
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func buildSchemaSnapshotLines(prefix string, schemaMap map[string]*schema.Schema) []string {
+	names := make([]string, 0, len(schemaMap))
+	for name := range schemaMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var lines []string
+	for _, name := range names {
+		attribute := schemaMap[name]
+		path := prefix + name
+		lines = append(lines, path+": "+describeSchemaAttribute(attribute))
+		if nested, ok := attribute.Elem.(*schema.Resource); ok {
+			lines = append(lines, buildSchemaSnapshotLines(path+".", nested.SchemaMap())...)
+		}
+	}
+	return lines
+}
+
+// describeSchemaAttribute lists the properties of an attribute that are relevant for compatibility
+func describeSchemaAttribute(attribute *schema.Schema) string {
+	attributeType := attribute.Type.String()
+	switch elem := attribute.Elem.(type) {
+	case *schema.Schema:
+		attributeType += "(" + elem.Type.String() + ")"
+	case *schema.Resource:
+		attributeType += "(block)"
+	}
+	properties := []string{attributeType}
+
+	flags := []struct {
+		set  bool
+		name string
+	}{
+		{attribute.Required, "Required"},
+		{attribute.Optional, "Optional"},
+		{attribute.Computed, "Computed"},
+		{attribute.ForceNew, "ForceNew"},
+		{attribute.Sensitive, "Sensitive"},
+		{attribute.WriteOnly, "WriteOnly"},
+		{attribute.Deprecated != "", "Deprecated"},
+	}
+	for _, flag := range flags {
+		if flag.set {
+			properties = append(properties, flag.name)
+		}
+	}
+	if attribute.Default != nil {
+		properties = append(properties, fmt.Sprintf("Default=%v", attribute.Default))
+	}
+	if attribute.MinItems > 0 {
+		properties = append(properties, fmt.Sprintf("MinItems=%d", attribute.MinItems))
+	}
+	if attribute.MaxItems > 0 {
+		properties = append(properties, fmt.Sprintf("MaxItems=%d", attribute.MaxItems))
+	}
+	constraints := []struct {
+		keys []string
+		name string
+	}{
+		{attribute.ConflictsWith, "ConflictsWith"},
+		{attribute.ExactlyOneOf, "ExactlyOneOf"},
+		{attribute.AtLeastOneOf, "AtLeastOneOf"},
+		{attribute.RequiredWith, "RequiredWith"},
+	}
+	for _, constraint := range constraints {
+		if len(constraint.keys) > 0 {
+			keys := append([]string{}, constraint.keys...)
+			sort.Strings(keys)
+			properties = append(properties, fmt.Sprintf("%s=%s", constraint.name, strings.Join(keys, ",")))
+		}
+	}
+	return strings.Join(properties, " ")
+}
+
+// diffSchemaSnapshots returns the lines removed from and added to the expected snapshot, or an empty string if both
+// snapshots are equal
+func diffSchemaSnapshots(want, got string) string {
+	if want == got {
+		return ""
+	}
+	wantLines := strings.Split(strings.TrimSpace(want), "\n")
+	gotLines := strings.Split(strings.TrimSpace(got), "\n")
+	inWant := make(map[string]bool, len(wantLines))
+	for _, line := range wantLines {
+		inWant[line] = true
+	}
+	inGot := make(map[string]bool, len(gotLines))
+	for _, line := range gotLines {
+		inGot[line] = true
+	}
+
+	var diff []string
+	for _, line := range wantLines {
+		if !inGot[line] {
+			diff = append(diff, "- "+line)
+		}
+	}
+	for _, line := range gotLines {
+		if !inWant[line] {
+			diff = append(diff, "+ "+line)
+		}
+	}
+	return strings.Join(diff, "\n")
+}
+
+// TestDiffSchemaSnapshots checks that schema changes are reported line by line
+func TestDiffSchemaSnapshots(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name":  {Type: schema.TypeString, Required: true},
+			"count": {Type: schema.TypeInt, Optional: true, Default: 1},
+		},
+	}
+	want := buildSchemaSnapshot(resource)
+	if diff := diffSchemaSnapshots(want, buildSchemaSnapshot(resource)); diff != "" {
+		t.Errorf("expected no differences, got:\n%s", diff)
+	}
+
+	resource.Schema["name"].ForceNew = true
+	got := diffSchemaSnapshots(want, buildSchemaSnapshot(resource))
+	expected := "- name: TypeString Required\n+ name: TypeString Required ForceNew"
+	if got != expected {
+		t.Errorf("diffSchemaSnapshots() = %q, want %q", got, expected)
+	}
+}
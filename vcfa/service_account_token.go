@@ -0,0 +1,57 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// authenticateWithServiceAccountToken exchanges the refresh token stored in the given Service Account token file
+// for a bearer token, and persists the rotated refresh token back to the same file, as Service Account refresh
+// tokens can be used only once.
+// The file is locked during the whole exchange, so concurrent provider instances (e.g. parallel pipeline jobs) don't
+// use the same refresh token twice, and it is replaced atomically, so an interruption can't leave it truncated
+func authenticateWithServiceAccountToken(client *govcd.VCDClient, org, tokenFile string) error {
+	unlock, err := lockTokenFile(tokenFile, tokenFileLockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	apiToken, err := client.SetApiTokenFromFile(org, tokenFile)
+	if err != nil {
+		return err
+	}
+
+	err = writeServiceAccountTokenFile(tokenFile, client.Client.UserAgent, apiToken)
+	if err != nil {
+		return fmt.Errorf("failed to save rotated service account token to %s: %s", tokenFile, err)
+	}
+	return nil
+}
+
+// writeServiceAccountTokenFile saves the given refresh token to the Service Account token file, in the same format
+// as the SDK does
+func writeServiceAccountTokenFile(tokenFile, userAgent string, apiToken *types.ApiTokenRefresh) error {
+	if apiToken == nil || apiToken.RefreshToken == "" {
+		return fmt.Errorf("no refresh token was returned")
+	}
+	contents, err := json.MarshalIndent(types.ApiTokenRefresh{
+		RefreshToken: apiToken.RefreshToken,
+		TokenType:    "Service Account",
+		UpdatedBy:    userAgent,
+		UpdatedOn:    time.Now().Format(time.RFC3339),
+	}, " ", " ")
+	if err != nil {
+		return fmt.Errorf("error marshalling token: %s", err)
+	}
+
+	return writeTokenFile(tokenFile, contents)
+}
@@ -0,0 +1,56 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// TestWriteServiceAccountTokenFile checks that the rotated Service Account token replaces the previous one,
+// is readable by the SDK and is only accessible by its owner
+func TestWriteServiceAccountTokenFile(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "sa_token.json")
+	if err := os.WriteFile(tokenFile, []byte(`{"refresh_token":"old-token-with-a-longer-value"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	err := writeServiceAccountTokenFile(tokenFile, "terraform-provider-vcfa", &types.ApiTokenRefresh{RefreshToken: "new-token"})
+	if err != nil {
+		t.Fatalf("writeServiceAccountTokenFile() error = %v", err)
+	}
+
+	info, err := os.Stat(tokenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected permissions 0600, got %o", info.Mode().Perm())
+	}
+	token, err := govcd.GetTokenFromFile(tokenFile)
+	if err != nil {
+		t.Fatalf("could not read the token file back: %s", err)
+	}
+	if token.RefreshToken != "new-token" || token.TokenType != "Service Account" || token.UpdatedBy != "terraform-provider-vcfa" {
+		t.Errorf("unexpected token file contents: %+v", token)
+	}
+	entries, err := os.ReadDir(filepath.Dir(tokenFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only the token file in the directory, got %d entries", len(entries))
+	}
+
+	if err := writeServiceAccountTokenFile(tokenFile, "terraform-provider-vcfa", &types.ApiTokenRefresh{}); err == nil {
+		t.Errorf("writeServiceAccountTokenFile() expected an error for an empty refresh token")
+	}
+}
@@ -0,0 +1,117 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+// MintedSessionToken is the bearer token of a new session of the provider user, opened with a temporary API Token
+// that is only used to mint it. Both must be revoked with RevokeSessionToken once the token is no longer needed
+type MintedSessionToken struct {
+	SessionToken
+	// ApiTokenId is the ID of the temporary API Token
+	ApiTokenId string
+}
+
+// MintSessionToken opens a new session for the user of the provider session and returns its bearer token, so other
+// providers receive a separate credential instead of the provider one. It is used by the 'vcfa_session_token' and
+// 'vcfa_kubeconfig' ephemeral resources
+func MintSessionToken(ctx context.Context, tmClient *VCDClient, tokenName string) (*MintedSessionToken, error) {
+	// System Admin can't create API tokens outside SysOrg,
+	// just as Org admins can't create API tokens in other Orgs
+	org := tmClient.SysOrg
+	if org == "" {
+		org = tmClient.Org
+	}
+
+	token, err := tmClient.CreateToken(org, tokenName)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the temporary %s to mint a session token, which requires a session "+
+			"that is not opened with an API Token: %s", labelVcfaApiToken, err)
+	}
+	bearerToken, err := exchangeApiToken(tmClient, token, org)
+	if err != nil {
+		if deleteErr := token.Delete(); deleteErr != nil {
+			return nil, fmt.Errorf("%s. Also, the temporary %s '%s' could not be deleted: %s", err, labelVcfaApiToken, tokenName, deleteErr)
+		}
+		return nil, err
+	}
+
+	sessionToken, err := parseSessionToken(bearerToken.AccessToken)
+	if err != nil {
+		if revokeErr := RevokeSessionToken(ctx, tmClient, bearerToken.AccessToken, token.Token.ID); revokeErr != nil {
+			return nil, fmt.Errorf("%s. Also, the minted session could not be revoked: %s", err, revokeErr)
+		}
+		return nil, err
+	}
+	if sessionToken.ExpiresAt.IsZero() && bearerToken.ExpiresIn > 0 {
+		sessionToken.ExpiresAt = time.Now().Add(time.Duration(bearerToken.ExpiresIn) * time.Second)
+	}
+	return &MintedSessionToken{SessionToken: *sessionToken, ApiTokenId: token.Token.ID}, nil
+}
+
+// exchangeApiToken retrieves the refresh token of a new API Token and exchanges it for the bearer token of a new session
+func exchangeApiToken(tmClient *VCDClient, token *govcd.Token, org string) (*types.ApiTokenRefresh, error) {
+	apiToken, err := token.GetInitialApiToken()
+	if err != nil {
+		return nil, fmt.Errorf("error getting refresh token from the temporary %s: %s", labelVcfaApiToken, err)
+	}
+	bearerToken, err := tmClient.GetBearerTokenFromApiToken(org, apiToken.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("error minting a session token: %s", err)
+	}
+	return bearerToken, nil
+}
+
+// RevokeSessionToken ends the session of a token minted by MintSessionToken and deletes its temporary API Token.
+// Sessions and API Tokens that are already gone are ignored
+func RevokeSessionToken(ctx context.Context, tmClient *VCDClient, bearerToken, apiTokenId string) error {
+	if err := logoutSession(ctx, tmClient, bearerToken); err != nil {
+		return fmt.Errorf("error ending the minted session: %s", err)
+	}
+
+	token, err := tmClient.GetTokenById(apiTokenId)
+	if err != nil {
+		if govcd.ContainsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error retrieving the temporary %s '%s': %s", labelVcfaApiToken, apiTokenId, err)
+	}
+	if err := token.Delete(); err != nil && !govcd.ContainsNotFound(err) {
+		return fmt.Errorf("error deleting the temporary %s '%s': %s", labelVcfaApiToken, apiTokenId, err)
+	}
+	return nil
+}
+
+// logoutSession ends the session of the given bearer token, which is not the one of the provider client
+func logoutSession(ctx context.Context, tmClient *VCDClient, bearerToken string) error {
+	sessionUrl := tmClient.Client.VCDHREF
+	sessionUrl.Path = strings.TrimSuffix(sessionUrl.Path, "/api") + "/cloudapi/1.0.0/sessions/current"
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, sessionUrl.String(), nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Accept", "application/json;version="+tmClient.Client.APIVersion)
+	request.Header.Set("Authorization", "Bearer "+bearerToken)
+	response, err := tmClient.Client.Http.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = response.Body.Close() }()
+	// An expired or already closed session is rejected as unauthorized
+	if response.StatusCode >= 300 && response.StatusCode != http.StatusUnauthorized && response.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("unexpected status %s", response.Status)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// TestLogoutSession checks that the minted sessions are ended with their own token, and that sessions that are
+// already gone are ignored
+func TestLogoutSession(t *testing.T) {
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/cloudapi/1.0.0/sessions/current" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer minted-token" {
+			t.Errorf("expected the minted token to be used, got '%s'", r.Header.Get("Authorization"))
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	serverUrl, err := url.Parse(server.URL + "/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := govcd.NewVCDClient(*serverUrl, true)
+	client.Client.VCDToken = "provider-token"
+	tmClient := &VCDClient{VCDClient: client}
+
+	for _, status = range []int{http.StatusNoContent, http.StatusUnauthorized, http.StatusNotFound} {
+		if err := logoutSession(context.Background(), tmClient, "minted-token"); err != nil {
+			t.Errorf("unexpected error for status %d: %s", status, err)
+		}
+	}
+	status = http.StatusInternalServerError
+	if err := logoutSession(context.Background(), tmClient, "minted-token"); err == nil {
+		t.Errorf("expected an error for status %d", status)
+	}
+}
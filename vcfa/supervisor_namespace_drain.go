@@ -0,0 +1,122 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// supervisorNamespaceDeleteStrategyForce deletes the Supervisor Namespace straight away, with all its workloads
+	supervisorNamespaceDeleteStrategyForce = "force"
+	// supervisorNamespaceDeleteStrategyDrain waits for the workloads to be gone before deleting the Supervisor Namespace
+	supervisorNamespaceDeleteStrategyDrain = "drain"
+	// supervisorNamespaceDeleteStrategyFail refuses to delete the Supervisor Namespace while it has workloads
+	supervisorNamespaceDeleteStrategyFail = "fail"
+)
+
+// supervisorNamespaceDrainPollInterval is the time between two checks of the workloads while draining a Supervisor
+// Namespace
+const supervisorNamespaceDrainPollInterval = 10 * time.Second
+
+// supervisorNamespaceWorkloadKind is a kind of workload that can run in a Supervisor Namespace. 'pathTemplate' must
+// contain a single '%s' placeholder for the namespace name
+type supervisorNamespaceWorkloadKind struct {
+	kind         string
+	pathTemplate string
+}
+
+// supervisorNamespaceWorkloadKinds are the workloads that prevent a Supervisor Namespace from being drained. APIs that
+// the Supervisor doesn't serve are skipped
+var supervisorNamespaceWorkloadKinds = []supervisorNamespaceWorkloadKind{
+	{kind: "Cluster", pathTemplate: "/apis/cluster.x-k8s.io/v1beta1/namespaces/%s/clusters"},
+	{kind: "VirtualMachine", pathTemplate: "/apis/vmoperator.vmware.com/v1alpha3/namespaces/%s/virtualmachines"},
+	{kind: "Pod", pathTemplate: "/api/v1/namespaces/%s/pods"},
+}
+
+// listSupervisorNamespaceWorkloads returns the workloads of the given namespace as "<kind> '<name>'", sorted. 'get'
+// retrieves the raw response of a path of the namespace endpoint
+func listSupervisorNamespaceWorkloads(ctx context.Context, namespace string, get func(context.Context, string) ([]byte, error)) ([]string, error) {
+	var workloads []string
+	for _, kind := range supervisorNamespaceWorkloadKinds {
+		raw, err := get(ctx, fmt.Sprintf(kind.pathTemplate, namespace))
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Printf("[DEBUG] %s API is not available in %s %s, skipping it", kind.kind, labelSupervisorNamespace, namespace)
+				continue
+			}
+			return nil, fmt.Errorf("error listing %ss: %s", kind.kind, err)
+		}
+		var list v1.PartialObjectMetadataList
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, fmt.Errorf("error decoding %ss: %s", kind.kind, err)
+		}
+		for _, item := range list.Items {
+			workloads = append(workloads, fmt.Sprintf("%s '%s'", kind.kind, item.Name))
+		}
+	}
+	sort.Strings(workloads)
+	return workloads, nil
+}
+
+// waitForSupervisorNamespaceDrained waits until 'listWorkloads' returns no workloads, checking them every
+// 'pollInterval'. With a zero timeout, the workloads are checked only once. The error lists the remaining workloads
+func waitForSupervisorNamespaceDrained(ctx context.Context, name string, timeout, pollInterval time.Duration, listWorkloads func() ([]string, error)) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		workloads, err := listWorkloads()
+		if err != nil {
+			return fmt.Errorf("error checking the workloads of %s %s: %s", labelSupervisorNamespace, name, err)
+		}
+		if len(workloads) == 0 {
+			return nil
+		}
+		if timeout == 0 {
+			return fmt.Errorf("%s %s was not deleted because it has %d workload(s): %s. Delete them first, or use the '%s' delete strategy",
+				labelSupervisorNamespace, name, len(workloads), strings.Join(workloads, ", "), supervisorNamespaceDeleteStrategyForce)
+		}
+		if time.Now().Add(pollInterval).After(deadline) {
+			return fmt.Errorf("%s %s was not deleted because it still has %d workload(s) after waiting %s: %s. Delete them first, or use the '%s' delete strategy",
+				labelSupervisorNamespace, name, len(workloads), timeout, strings.Join(workloads, ", "), supervisorNamespaceDeleteStrategyForce)
+		}
+		log.Printf("[DEBUG] waiting for %d workload(s) of %s %s to terminate: %s", len(workloads), labelSupervisorNamespace, name, strings.Join(workloads, ", "))
+		if err := sleepWithContext(ctx, pollInterval); err != nil {
+			return fmt.Errorf("error waiting for the workloads of %s %s to terminate: %s", labelSupervisorNamespace, name, err)
+		}
+	}
+}
+
+// drainSupervisorNamespace applies the 'drain' and 'fail' delete strategies, returning an error if the Supervisor
+// Namespace still has workloads. The 'force' strategy doesn't check anything
+func drainSupervisorNamespace(ctx context.Context, tmClient *VCDClient, projectName, name, strategy string, timeout time.Duration) error {
+	if strategy == "" || strategy == supervisorNamespaceDeleteStrategyForce {
+		return nil
+	}
+	client, err := getSupervisorNamespaceKubernetesClientByName(ctx, tmClient, projectName, name)
+	if err != nil {
+		return fmt.Errorf("error connecting to %s %s to check its workloads, use the '%s' delete strategy to delete it anyway: %s",
+			labelSupervisorNamespace, name, supervisorNamespaceDeleteStrategyForce, err)
+	}
+	restClient := client.Discovery().RESTClient()
+	get := func(ctx context.Context, path string) ([]byte, error) {
+		return restClient.Get().AbsPath(path).DoRaw(ctx)
+	}
+
+	if strategy == supervisorNamespaceDeleteStrategyFail {
+		timeout = 0
+	}
+	return waitForSupervisorNamespaceDrained(ctx, name, timeout, supervisorNamespaceDrainPollInterval, func() ([]string, error) {
+		return listSupervisorNamespaceWorkloads(ctx, name, get)
+	})
+}
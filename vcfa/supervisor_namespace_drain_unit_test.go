@@ -0,0 +1,94 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TestListSupervisorNamespaceWorkloads checks that the workloads of every kind are listed, skipping the APIs that
+// are not served
+func TestListSupervisorNamespaceWorkloads(t *testing.T) {
+	responses := map[string]string{
+		"/api/v1/namespaces/ns1/pods":                                         `{"items":[{"metadata":{"name":"pod2"}},{"metadata":{"name":"pod1"}}]}`,
+		"/apis/vmoperator.vmware.com/v1alpha3/namespaces/ns1/virtualmachines": `{"items":[{"metadata":{"name":"vm1"}}]}`,
+	}
+	get := func(_ context.Context, path string) ([]byte, error) {
+		if response, ok := responses[path]; ok {
+			return []byte(response), nil
+		}
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, path)
+	}
+
+	workloads, err := listSupervisorNamespaceWorkloads(context.Background(), "ns1", get)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []string{"Pod 'pod1'", "Pod 'pod2'", "VirtualMachine 'vm1'"}
+	if !reflect.DeepEqual(workloads, expected) {
+		t.Errorf("expected %v, got %v", expected, workloads)
+	}
+
+	_, err = listSupervisorNamespaceWorkloads(context.Background(), "ns1", func(context.Context, string) ([]byte, error) {
+		return nil, fmt.Errorf("connection refused")
+	})
+	if err == nil {
+		t.Errorf("expected errors other than 'not found' to be returned")
+	}
+}
+
+// TestWaitForSupervisorNamespaceDrained checks the waits of the 'drain' and 'fail' delete strategies
+func TestWaitForSupervisorNamespaceDrained(t *testing.T) {
+	// The workloads terminate after a few checks
+	checks := 0
+	err := waitForSupervisorNamespaceDrained(context.Background(), "ns1", time.Second, time.Millisecond, func() ([]string, error) {
+		checks++
+		if checks < 3 {
+			return []string{"Pod 'pod1'"}, nil
+		}
+		return nil, nil
+	})
+	if err != nil || checks != 3 {
+		t.Errorf("expected the drain to succeed after 3 checks, got %d checks and %v", checks, err)
+	}
+
+	// Without a timeout, a single check is done
+	checks = 0
+	err = waitForSupervisorNamespaceDrained(context.Background(), "ns1", 0, time.Millisecond, func() ([]string, error) {
+		checks++
+		return []string{"Pod 'pod1'"}, nil
+	})
+	if err == nil || checks != 1 || !strings.Contains(err.Error(), "Pod 'pod1'") {
+		t.Errorf("expected a single check listing the workloads, got %d checks and %v", checks, err)
+	}
+
+	// The workloads don't terminate in time
+	err = waitForSupervisorNamespaceDrained(context.Background(), "ns1", 20*time.Millisecond, 5*time.Millisecond, func() ([]string, error) {
+		return []string{"VirtualMachine 'vm1'"}, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "still has 1 workload(s)") {
+		t.Errorf("expected a timeout listing the workloads, got %v", err)
+	}
+
+	// The operation is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = waitForSupervisorNamespaceDrained(ctx, "ns1", time.Hour, time.Minute, func() ([]string, error) {
+		return []string{"Pod 'pod1'"}, nil
+	})
+	if !isContextCancellation(err) {
+		t.Errorf("expected the wait to be cancelled, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VCFA has no expiration for Supervisor Namespaces, so the TTL is kept in their metadata, where it can be found by
+// reapers. Label values can't contain the ':' of the timestamps, so only the flag to select them is a label
+const (
+	// supervisorNamespaceEphemeralLabel marks the Supervisor Namespaces that have a TTL
+	supervisorNamespaceEphemeralLabel = "terraform.vcfa.vmware.com/ephemeral"
+	// supervisorNamespaceTtlAnnotation is the annotation with the TTL of the Supervisor Namespace, as set in 'ttl'
+	supervisorNamespaceTtlAnnotation = "terraform.vcfa.vmware.com/ttl"
+	// supervisorNamespaceExpiresAtAnnotation is the annotation with the RFC 3339 time when the Supervisor Namespace expires
+	supervisorNamespaceExpiresAtAnnotation = "terraform.vcfa.vmware.com/expires-at"
+)
+
+// parseSupervisorNamespaceTtl parses the 'ttl' value of a Supervisor Namespace. An empty value means no expiration
+func parseSupervisorNamespaceTtl(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid 'ttl' value '%s': %s", value, err)
+	}
+	if ttl <= 0 {
+		return 0, fmt.Errorf("invalid 'ttl' value '%s': must be a positive duration", value)
+	}
+	return ttl, nil
+}
+
+// setSupervisorNamespaceExpiration sets the expiration labels and annotations of a Supervisor Namespace created at the
+// given time, or removes them if the TTL is empty. The rest of the labels and annotations are kept
+func setSupervisorNamespaceExpiration(objectMeta *v1.ObjectMeta, ttlValue string, creationTime time.Time) error {
+	ttl, err := parseSupervisorNamespaceTtl(ttlValue)
+	if err != nil {
+		return err
+	}
+	if ttl == 0 {
+		delete(objectMeta.Labels, supervisorNamespaceEphemeralLabel)
+		delete(objectMeta.Annotations, supervisorNamespaceTtlAnnotation)
+		delete(objectMeta.Annotations, supervisorNamespaceExpiresAtAnnotation)
+		return nil
+	}
+
+	if objectMeta.Labels == nil {
+		objectMeta.Labels = map[string]string{}
+	}
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = map[string]string{}
+	}
+	objectMeta.Labels[supervisorNamespaceEphemeralLabel] = "true"
+	objectMeta.Annotations[supervisorNamespaceTtlAnnotation] = ttlValue
+	objectMeta.Annotations[supervisorNamespaceExpiresAtAnnotation] = creationTime.Add(ttl).UTC().Format(time.RFC3339)
+	return nil
+}
+
+// isSupervisorNamespaceExpired returns whether the given expiration time, in RFC 3339 format, is already past.
+// Supervisor Namespaces without a valid expiration time never expire
+func isSupervisorNamespaceExpired(expiresAt string, now time.Time) bool {
+	if expiresAt == "" {
+		return false
+	}
+	expiration, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return false
+	}
+	return !now.Before(expiration)
+}
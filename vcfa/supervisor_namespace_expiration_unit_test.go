@@ -0,0 +1,109 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetSupervisorNamespaceExpiration(t *testing.T) {
+	creationTime := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		objectMeta      v1.ObjectMeta
+		ttl             string
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+		wantErr         bool
+	}{
+		{
+			name:       "SetOnNewNamespace",
+			objectMeta: v1.ObjectMeta{Labels: map[string]string{supervisorNamespaceRequestIdLabel: "request"}},
+			ttl:        "72h",
+			wantLabels: map[string]string{supervisorNamespaceRequestIdLabel: "request", supervisorNamespaceEphemeralLabel: "true"},
+			wantAnnotations: map[string]string{
+				supervisorNamespaceTtlAnnotation:       "72h",
+				supervisorNamespaceExpiresAtAnnotation: "2026-10-19T12:00:00Z",
+			},
+		},
+		{
+			name: "Extended",
+			objectMeta: v1.ObjectMeta{
+				Labels: map[string]string{supervisorNamespaceEphemeralLabel: "true"},
+				Annotations: map[string]string{
+					"owner":                                "dev-team",
+					supervisorNamespaceTtlAnnotation:       "72h",
+					supervisorNamespaceExpiresAtAnnotation: "2026-10-19T12:00:00Z",
+				},
+			},
+			ttl:        "168h",
+			wantLabels: map[string]string{supervisorNamespaceEphemeralLabel: "true"},
+			wantAnnotations: map[string]string{
+				"owner":                                "dev-team",
+				supervisorNamespaceTtlAnnotation:       "168h",
+				supervisorNamespaceExpiresAtAnnotation: "2026-10-23T12:00:00Z",
+			},
+		},
+		{
+			name: "Removed",
+			objectMeta: v1.ObjectMeta{
+				Labels: map[string]string{supervisorNamespaceEphemeralLabel: "true", "app": "demo"},
+				Annotations: map[string]string{
+					supervisorNamespaceTtlAnnotation:       "72h",
+					supervisorNamespaceExpiresAtAnnotation: "2026-10-19T12:00:00Z",
+				},
+			},
+			ttl:             "",
+			wantLabels:      map[string]string{"app": "demo"},
+			wantAnnotations: map[string]string{},
+		},
+		{name: "NoTtl", ttl: ""},
+		{name: "Invalid", ttl: "three days", wantErr: true},
+		{name: "Negative", ttl: "-1h", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := setSupervisorNamespaceExpiration(&tt.objectMeta, tt.ttl, creationTime)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setSupervisorNamespaceExpiration() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(tt.objectMeta.Labels, tt.wantLabels) {
+				t.Errorf("got labels %v, want %v", tt.objectMeta.Labels, tt.wantLabels)
+			}
+			if !reflect.DeepEqual(tt.objectMeta.Annotations, tt.wantAnnotations) {
+				t.Errorf("got annotations %v, want %v", tt.objectMeta.Annotations, tt.wantAnnotations)
+			}
+		})
+	}
+}
+
+func TestIsSupervisorNamespaceExpired(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expiresAt string
+		want      bool
+	}{
+		{expiresAt: "", want: false},
+		{expiresAt: "not-a-time", want: false},
+		{expiresAt: "2026-10-16T13:00:00Z", want: false},
+		{expiresAt: "2026-10-16T12:00:00Z", want: true},
+		{expiresAt: "2026-10-15T12:00:00+02:00", want: true},
+	}
+	for _, tt := range tests {
+		if got := isSupervisorNamespaceExpired(tt.expiresAt, now); got != tt.want {
+			t.Errorf("isSupervisorNamespaceExpired(%q) = %t, want %t", tt.expiresAt, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/vmware/go-vcloud-director/v3/ccitypes"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// storageClassQuotaSuffix is the suffix of the ResourceQuota entries that limit the storage requested from a
+	// given Storage Class: '<storage class>.storageclass.storage.k8s.io/requests.storage'
+	storageClassQuotaSuffix = ".storageclass.storage.k8s.io/requests.storage"
+	// defaultStorageClassAnnotation marks the Storage Class used by the volumes that don't request any
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+)
+
+var supervisorNamespaceStorageClassUsageSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the Storage Class",
+		},
+		"limit": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Limit (format: `<number><unit>`, where `<unit>` can be `Mi`, `Gi`, or `Ti`)",
+		},
+		"used": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Storage requested by the volumes of the Storage Class. Empty if the usage could not be retrieved",
+		},
+	},
+}
+
+// supervisorNamespaceUsage contains the consumption of a Supervisor Namespace, as reported by the ResourceQuotas of
+// its Kubernetes namespace
+type supervisorNamespaceUsage struct {
+	storageClasses map[string]string
+	cpu            string
+	memory         string
+}
+
+// setSupervisorNamespaceUsage sets the consumption attributes of the given Supervisor Namespace, and must be called
+// after setSupervisorNamespaceData. The CCI status only
+// reports the limits, so the usage is read from the Kubernetes namespace. As it is informational, any error reaching
+// the namespace endpoint is logged and leaves the usage empty instead of failing the read
+func setSupervisorNamespaceUsage(ctx context.Context, tmClient *VCDClient, d *schema.ResourceData, supervisorNamespace ccitypes.SupervisorNamespace) error {
+	var statusStorageClasses []ccitypes.SupervisorNamespaceStatusStorageClasses
+	if supervisorNamespace.Status != nil {
+		statusStorageClasses = supervisorNamespace.Status.StorageClasses
+	}
+	storageClassNames := make([]string, 0, len(statusStorageClasses))
+	for _, storageClass := range statusStorageClasses {
+		storageClassNames = append(storageClassNames, storageClass.Name)
+	}
+
+	usage := supervisorNamespaceUsage{}
+	defaultStorageClasses := map[string]bool{}
+	client, err := getSupervisorNamespaceKubernetesClient(tmClient, supervisorNamespace)
+	if err == nil {
+		namespace := d.Get("kubernetes_namespace").(string)
+		quotas, quotaErr := client.CoreV1().ResourceQuotas(namespace).List(ctx, v1.ListOptions{})
+		switch {
+		case quotaErr == nil:
+			usage = getSupervisorNamespaceUsageFromQuotas(quotas.Items)
+		case isMissingEndpointError(quotaErr):
+			warnOptionalEndpointMissing(fmt.Sprintf("The quota usage of %ss", labelSupervisorNamespace),
+				fmt.Sprintf("The usage attributes of %s %s are empty. %s", labelSupervisorNamespace, namespace, quotaErr))
+		default:
+			log.Printf("[WARN] unable to retrieve the quota usage of %s %s: %s", labelSupervisorNamespace, namespace, quotaErr)
+		}
+		// Listing Storage Classes is cluster scoped, and may be forbidden to Organization users
+		storageClasses, scErr := client.StorageV1().StorageClasses().List(ctx, v1.ListOptions{})
+		if scErr != nil {
+			log.Printf("[WARN] unable to retrieve the Storage Classes of %s %s: %s", labelSupervisorNamespace, namespace, scErr)
+		} else {
+			defaultStorageClasses = getDefaultStorageClasses(storageClasses.Items)
+		}
+	} else {
+		log.Printf("[WARN] unable to retrieve the usage of %s %s: %s", labelSupervisorNamespace, d.Get("name").(string), err)
+	}
+
+	storageClassUsage := make([]interface{}, 0, len(statusStorageClasses))
+	for _, storageClass := range statusStorageClasses {
+		storageClassUsage = append(storageClassUsage, map[string]interface{}{
+			"name":  storageClass.Name,
+			"limit": storageClass.Limit,
+			"used":  usage.storageClasses[storageClass.Name],
+		})
+	}
+	if err := d.Set("storage_class_usage", storageClassUsage); err != nil {
+		return fmt.Errorf("error setting storage_class_usage: %s", err)
+	}
+	dSet(d, "default_storage_class", getSupervisorNamespaceDefaultStorageClass(storageClassNames, defaultStorageClasses))
+	dSet(d, "cpu_used", usage.cpu)
+	dSet(d, "memory_used", usage.memory)
+	return nil
+}
+
+// getSupervisorNamespaceKubernetesClient returns a Kubernetes client for the namespace endpoint of the given
+// Supervisor Namespace, authenticated with the session token of the provider
+func getSupervisorNamespaceKubernetesClient(tmClient *VCDClient, supervisorNamespace ccitypes.SupervisorNamespace) (kubernetes.Interface, error) {
+	if supervisorNamespace.Status == nil || supervisorNamespace.Status.NamespaceEndpointURL == "" {
+		return nil, fmt.Errorf("the namespace endpoint URL is not available yet")
+	}
+	config := &rest.Config{
+		Host:        supervisorNamespace.Status.NamespaceEndpointURL,
+		BearerToken: tmClient.Client.VCDToken,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: tmClient.InsecureFlag,
+		},
+	}
+	// Kubernetes clients reject a CA together with the insecure flag
+	if tmClient.CaCertificate != "" && !tmClient.InsecureFlag {
+		config.TLSClientConfig.CAData = []byte(tmClient.CaCertificate)
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// getSupervisorNamespaceUsageFromQuotas extracts the storage used by each Storage Class and the CPU and memory
+// requested in the namespace from its ResourceQuotas
+func getSupervisorNamespaceUsageFromQuotas(quotas []corev1.ResourceQuota) supervisorNamespaceUsage {
+	maxUsed := getQuotaUsedQuantities(quotas)
+
+	usage := supervisorNamespaceUsage{storageClasses: map[string]string{}}
+	for resourceName, quantity := range maxUsed {
+		switch {
+		case strings.HasSuffix(string(resourceName), storageClassQuotaSuffix):
+			usage.storageClasses[strings.TrimSuffix(string(resourceName), storageClassQuotaSuffix)] = quantity.String()
+		case resourceName == corev1.ResourceRequestsCPU:
+			usage.cpu = quantity.String()
+		case resourceName == corev1.ResourceRequestsMemory:
+			usage.memory = quantity.String()
+		}
+	}
+	return usage
+}
+
+// getQuotaUsedQuantities returns the usage of every resource tracked by the given ResourceQuotas. When several quotas
+// track the same resource, the highest usage is kept, as all of them observe the same workloads
+func getQuotaUsedQuantities(quotas []corev1.ResourceQuota) map[corev1.ResourceName]resource.Quantity {
+	maxUsed := map[corev1.ResourceName]resource.Quantity{}
+	for _, quota := range quotas {
+		for resourceName, quantity := range quota.Status.Used {
+			if previous, ok := maxUsed[resourceName]; ok && previous.Cmp(quantity) >= 0 {
+				continue
+			}
+			maxUsed[resourceName] = quantity
+		}
+	}
+	return maxUsed
+}
+
+// getQuotaHardQuantities returns the limit of every resource enforced by the given ResourceQuotas. When several quotas
+// limit the same resource, the lowest limit is kept, as it is the one that is enforced
+func getQuotaHardQuantities(quotas []corev1.ResourceQuota) map[corev1.ResourceName]resource.Quantity {
+	minHard := map[corev1.ResourceName]resource.Quantity{}
+	for _, quota := range quotas {
+		for resourceName, quantity := range quota.Status.Hard {
+			if previous, ok := minHard[resourceName]; ok && previous.Cmp(quantity) <= 0 {
+				continue
+			}
+			minHard[resourceName] = quantity
+		}
+	}
+	return minHard
+}
+
+// getDefaultStorageClasses returns the names of the Storage Classes annotated as default
+func getDefaultStorageClasses(storageClasses []storagev1.StorageClass) map[string]bool {
+	defaults := map[string]bool{}
+	for _, storageClass := range storageClasses {
+		if strings.EqualFold(storageClass.Annotations[defaultStorageClassAnnotation], "true") {
+			defaults[storageClass.Name] = true
+		}
+	}
+	return defaults
+}
+
+// getSupervisorNamespaceDefaultStorageClass returns the Storage Class of the Supervisor Namespace that is annotated as
+// default. When there is no such annotation and the Supervisor Namespace has a single Storage Class, that one is used
+// by all the volumes
+func getSupervisorNamespaceDefaultStorageClass(storageClassNames []string, defaults map[string]bool) string {
+	sorted := append([]string{}, storageClassNames...)
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		if defaults[name] {
+			return name
+		}
+	}
+	if len(storageClassNames) == 1 {
+		return storageClassNames[0]
+	}
+	return ""
+}
@@ -0,0 +1,86 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestGetSupervisorNamespaceUsageFromQuotas checks that the usage of every Storage Class, CPU and memory is extracted
+// from the ResourceQuotas, keeping the highest one when several quotas track the same resource
+func TestGetSupervisorNamespaceUsageFromQuotas(t *testing.T) {
+	newQuota := func(used map[string]string) corev1.ResourceQuota {
+		quota := corev1.ResourceQuota{Status: corev1.ResourceQuotaStatus{Used: corev1.ResourceList{}}}
+		for name, quantity := range used {
+			quota.Status.Used[corev1.ResourceName(name)] = resource.MustParse(quantity)
+		}
+		return quota
+	}
+
+	usage := getSupervisorNamespaceUsageFromQuotas([]corev1.ResourceQuota{
+		newQuota(map[string]string{
+			"gold.storageclass.storage.k8s.io/requests.storage":   "10Gi",
+			"silver.storageclass.storage.k8s.io/requests.storage": "0",
+			"requests.storage": "10Gi",
+			"requests.cpu":     "1500m",
+			"requests.memory":  "2Gi",
+		}),
+		newQuota(map[string]string{
+			"gold.storageclass.storage.k8s.io/requests.storage": "12Gi",
+			"requests.cpu": "1",
+		}),
+	})
+	expectedStorageClasses := map[string]string{"gold": "12Gi", "silver": "0"}
+	if !reflect.DeepEqual(usage.storageClasses, expectedStorageClasses) {
+		t.Errorf("expected storage class usage %v, got %v", expectedStorageClasses, usage.storageClasses)
+	}
+	if usage.cpu != "1500m" {
+		t.Errorf("expected CPU usage '1500m', got '%s'", usage.cpu)
+	}
+	if usage.memory != "2Gi" {
+		t.Errorf("expected memory usage '2Gi', got '%s'", usage.memory)
+	}
+
+	usage = getSupervisorNamespaceUsageFromQuotas(nil)
+	if len(usage.storageClasses) != 0 || usage.cpu != "" || usage.memory != "" {
+		t.Errorf("expected no usage without quotas, got %+v", usage)
+	}
+}
+
+func TestGetSupervisorNamespaceDefaultStorageClass(t *testing.T) {
+	defaults := getDefaultStorageClasses([]storagev1.StorageClass{
+		{ObjectMeta: v1.ObjectMeta{Name: "gold", Annotations: map[string]string{defaultStorageClassAnnotation: "true"}}},
+		{ObjectMeta: v1.ObjectMeta{Name: "silver", Annotations: map[string]string{defaultStorageClassAnnotation: "false"}}},
+		{ObjectMeta: v1.ObjectMeta{Name: "bronze"}},
+	})
+
+	tests := []struct {
+		name              string
+		storageClassNames []string
+		defaults          map[string]bool
+		expected          string
+	}{
+		{name: "annotated", storageClassNames: []string{"silver", "gold"}, defaults: defaults, expected: "gold"},
+		{name: "annotated not in namespace", storageClassNames: []string{"silver", "bronze"}, defaults: defaults, expected: ""},
+		{name: "single storage class", storageClassNames: []string{"bronze"}, defaults: defaults, expected: "bronze"},
+		{name: "no annotations available", storageClassNames: []string{"silver", "gold"}, defaults: map[string]bool{}, expected: ""},
+		{name: "no storage classes", defaults: defaults, expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getSupervisorNamespaceDefaultStorageClass(tt.storageClassNames, tt.defaults); got != tt.expected {
+				t.Errorf("expected '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+}
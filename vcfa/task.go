@@ -0,0 +1,93 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const labelVcfaTask = "Task"
+
+// taskPendingStatuses are the statuses of the tasks that have not finished yet
+var taskPendingStatuses = []string{"queued", "preRunning", "running", "postRunning"}
+
+// taskFinalStatuses are the statuses of the finished tasks, regardless of their outcome
+var taskFinalStatuses = []string{"success", "error", "aborted"}
+
+// taskPollInterval is the time between the refreshes of a task that is being waited for
+const taskPollInterval = 3 * time.Second
+
+// getTask retrieves the task with the given ID, which can be a URN or a UUID
+func getTask(tmClient *VCDClient, taskId string) (*govcd.Task, error) {
+	task, err := tmClient.VCDClient.Client.GetTaskById(taskId)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving %s '%s': %s", labelVcfaTask, taskId, err)
+	}
+	return task, nil
+}
+
+// waitForTask refreshes the task with the given ID until it finishes, and returns its last version. Unlike
+// govcd.Task.WaitTaskCompletion, it stops when the context is cancelled or the timeout elapses, and a task that
+// finishes with an error is not an error of the wait, so the callers can inspect it
+func waitForTask(ctx context.Context, tmClient *VCDClient, taskId string, timeout time.Duration) (*govcd.Task, error) {
+	stateChangeFunc := retry.StateChangeConf{
+		Pending: taskPendingStatuses,
+		Target:  taskFinalStatuses,
+		Refresh: func() (any, string, error) {
+			task, err := getTask(tmClient, taskId)
+			if err != nil {
+				return nil, "", err
+			}
+			log.Printf("[DEBUG] %s '%s' is '%s' (%d%%)", labelVcfaTask, taskId, task.Task.Status, task.Task.Progress)
+			return task, task.Task.Status, nil
+		},
+		Timeout:    timeout,
+		MinTimeout: taskPollInterval,
+	}
+	result, err := stateChangeFunc.WaitForStateContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for %s '%s' to finish: %s", labelVcfaTask, taskId, err)
+	}
+	return result.(*govcd.Task), nil
+}
+
+// isTaskFinished returns true if the task has finished, regardless of its outcome
+func isTaskFinished(task *types.Task) bool {
+	return contains(taskFinalStatuses, task.Status)
+}
+
+// taskErrorMessage returns the error of a failed task, with its codes, or an empty string if the task has no error
+func taskErrorMessage(task *types.Task) string {
+	if task.Error == nil {
+		return ""
+	}
+	return fmt.Sprintf("[%d:%s] - %s", task.Error.MajorErrorCode, task.Error.MinorErrorCode, task.Error.Message)
+}
+
+// waitForTaskCompletion refreshes the given task until it finishes, like govcd.Task.WaitTaskCompletion, but it stops
+// as soon as the context is cancelled. The task keeps its last refreshed state, so the callers can inspect it
+func waitForTaskCompletion(ctx context.Context, task *govcd.Task) error {
+	for {
+		if err := task.Refresh(); err != nil {
+			return fmt.Errorf("error retrieving %s: %s", labelVcfaTask, err)
+		}
+		if isTaskFinished(task.Task) {
+			if task.Task.Status != "success" {
+				return fmt.Errorf("task did not complete successfully: %s", taskErrorMessage(task.Task))
+			}
+			return nil
+		}
+		if err := sleepWithContext(ctx, taskPollInterval); err != nil {
+			return fmt.Errorf("error waiting for %s '%s' to finish: %s", labelVcfaTask, task.Task.ID, err)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+	"github.com/vmware/go-vcloud-director/v3/types/v56"
+)
+
+const testTaskUuid = "0f2a9a2c-4c9b-4d7e-9f3b-1a2b3c4d5e6f"
+
+// newTaskTestServer returns a VCFA client connected to a server that serves a task which is running for the given
+// number of refreshes, and then finishes with the given status
+func newTaskTestServer(t *testing.T, runningRefreshes int, finalStatus string) (*VCDClient, *int) {
+	refreshes := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/api/task/"+testTaskUuid) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		refreshes++
+		status, progress, taskError := "running", 50, ""
+		if refreshes > runningRefreshes {
+			status, progress = finalStatus, 100
+			if finalStatus == "error" {
+				taskError = `<Error majorErrorCode="400" minorErrorCode="BAD_REQUEST" message="invalid spec"/>`
+			}
+		}
+		w.Header().Set("Content-Type", types.MimeTask)
+		_, _ = fmt.Fprintf(w, `<Task xmlns="http://www.vmware.com/vcloud/v1.5" href="%s%s" id="urn:vcloud:task:%s" name="task" `+
+			`status="%s" operationName="syncLibrary"><Owner href="" id="urn:vcloud:contentLibrary:1" name="library" `+
+			`type="application/json"/>%s<Progress>%d</Progress></Task>`,
+			"http://"+r.Host, r.URL.Path, testTaskUuid, status, taskError, progress)
+	}))
+	t.Cleanup(server.Close)
+
+	serverUrl, err := url.Parse(server.URL + "/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &VCDClient{VCDClient: govcd.NewVCDClient(*serverUrl, true)}, &refreshes
+}
+
+// TestWaitForTask checks that tasks are refreshed until they finish, and that failed tasks are returned
+func TestWaitForTask(t *testing.T) {
+	tests := []struct {
+		name          string
+		finalStatus   string
+		wantErrorText string
+	}{
+		{name: "Success", finalStatus: "success"},
+		{name: "Error", finalStatus: "error", wantErrorText: "[400:BAD_REQUEST] - invalid spec"},
+		{name: "Aborted", finalStatus: "aborted"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmClient, refreshes := newTaskTestServer(t, 1, tt.finalStatus)
+			task, err := waitForTask(context.Background(), tmClient, "urn:vcloud:task:"+testTaskUuid, time.Minute)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if task.Task.Status != tt.finalStatus || !isTaskFinished(task.Task) {
+				t.Errorf("expected finished task with status '%s', got '%s'", tt.finalStatus, task.Task.Status)
+			}
+			if *refreshes != 2 {
+				t.Errorf("expected 2 refreshes, got %d", *refreshes)
+			}
+			if got := taskErrorMessage(task.Task); got != tt.wantErrorText {
+				t.Errorf("expected error message '%s', got '%s'", tt.wantErrorText, got)
+			}
+		})
+	}
+}
+
+// TestWaitForTaskTimeout checks that waiting for a task stops when the timeout elapses or the context is cancelled
+func TestWaitForTaskTimeout(t *testing.T) {
+	tmClient, _ := newTaskTestServer(t, 1000, "success")
+	if _, err := waitForTask(context.Background(), tmClient, testTaskUuid, time.Second); err == nil {
+		t.Errorf("expected a timeout error")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := waitForTask(ctx, tmClient, testTaskUuid, time.Minute); err == nil {
+		t.Errorf("expected a cancellation error")
+	}
+}
+
+// TestGetTaskNotFound checks that missing tasks are reported
+func TestGetTaskNotFound(t *testing.T) {
+	tmClient, _ := newTaskTestServer(t, 0, "success")
+	if _, err := getTask(tmClient, "urn:vcloud:task:00000000-0000-0000-0000-000000000000"); err == nil {
+		t.Errorf("expected an error for a missing task")
+	}
+}
@@ -28,6 +28,11 @@ func (c *testCachedFieldValue) testCheckCachedResourceFieldValue(res, field stri
 	return c.TestCheckCachedResourceFieldValue(res, field)
 }
 
+// testCheckCachedResourceFieldValueChanged delegates to the shared implementation.
+func (c *testCachedFieldValue) testCheckCachedResourceFieldValueChanged(res, field string) resource.TestCheckFunc {
+	return c.TestCheckCachedResourceFieldValueChanged(res, field)
+}
+
 // testCheckCachedResourceFieldValuePattern delegates to the shared implementation.
 func (c *testCachedFieldValue) testCheckCachedResourceFieldValuePattern(res, field, pattern string) resource.TestCheckFunc {
 	return c.TestCheckCachedResourceFieldValuePattern(res, field, pattern)
@@ -0,0 +1,118 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// operationTimeout returns the timeout to use for the given operation (schema.TimeoutCreate, schema.TimeoutUpdate, ...).
+// The provider 'default_operation_timeout' can only extend the timeout of the resource, which is either the one set
+// in the 'timeouts' block or the resource default, so that slow environments can globally wait longer.
+func operationTimeout(d *schema.ResourceData, meta interface{}, key string) time.Duration {
+	timeout := d.Timeout(key)
+	if container, ok := meta.(ClientContainer); ok && container.defaultOperationTimeout > timeout {
+		return container.defaultOperationTimeout
+	}
+	return timeout
+}
+
+// applyOperationTimeouts bounds every operation of a resource or data source by the timeout returned by
+// operationTimeout. The SDK bounds the context of the '*Context' operations by the timeout of the resource alone, which
+// would cancel the waits and requests before the provider 'default_operation_timeout', so they are turned into
+// '*WithoutTimeout' operations that set the deadline themselves
+func applyOperationTimeouts(resource *schema.Resource) {
+	withTimeout := func(key string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			ctx, cancel := context.WithTimeout(ctx, operationTimeout(d, meta, key))
+			defer cancel()
+			return f(ctx, d, meta)
+		}
+	}
+	if resource.CreateContext != nil {
+		resource.CreateWithoutTimeout = withTimeout(schema.TimeoutCreate, resource.CreateContext)
+		resource.CreateContext = nil
+	}
+	if resource.ReadContext != nil {
+		resource.ReadWithoutTimeout = withTimeout(schema.TimeoutRead, resource.ReadContext)
+		resource.ReadContext = nil
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateWithoutTimeout = withTimeout(schema.TimeoutUpdate, resource.UpdateContext)
+		resource.UpdateContext = nil
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteWithoutTimeout = withTimeout(schema.TimeoutDelete, resource.DeleteContext)
+		resource.DeleteContext = nil
+	}
+}
+
+// parseDefaultOperationTimeout parses the provider 'default_operation_timeout' value. An empty value means that
+// there is no provider default
+func parseDefaultOperationTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid 'default_operation_timeout' value '%s': %s", value, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid 'default_operation_timeout' value '%s': must be a positive duration", value)
+	}
+	return timeout, nil
+}
+
+// parseDeletionGracePeriod parses the provider 'deletion_grace_period' value. An empty value means that there is
+// no grace period, so the force or fallback behaviors of the deletions are used straight away
+func parseDeletionGracePeriod(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	gracePeriod, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid 'deletion_grace_period' value '%s': %s", value, err)
+	}
+	if gracePeriod < 0 {
+		return 0, fmt.Errorf("invalid 'deletion_grace_period' value '%s': must not be negative", value)
+	}
+	return gracePeriod, nil
+}
+
+// deletionGracePeriod returns the time that the deletions wait for a regular deletion to succeed, before falling
+// back to forced deletions or re-issuing the deletion requests
+func deletionGracePeriod(meta interface{}) time.Duration {
+	if container, ok := meta.(ClientContainer); ok {
+		return container.deletionGracePeriod
+	}
+	return 0
+}
+
+// retryDuringGracePeriod runs 'operation' every 'interval' until it succeeds or 'gracePeriod' elapses, in which case
+// the last error is returned
+func retryDuringGracePeriod(ctx context.Context, gracePeriod, interval time.Duration, operation func() error) error {
+	deadline := time.Now().Add(gracePeriod)
+	for {
+		err := operation()
+		if err == nil {
+			return nil
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return err
+		}
+		log.Printf("[DEBUG] operation failed during the deletion grace period, retrying in %s: %s", interval, err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// tokenCacheMinValidity is the minimum validity that a cached session token must have left to be reused, so it
+// doesn't expire at the beginning of the Terraform run
+const tokenCacheMinValidity = 5 * time.Minute
+
+// cachedSessionToken is a session token stored in the token cache file
+type cachedSessionToken struct {
+	AuthHeader string `json:"auth_header"`
+	Token      string `json:"token"`
+	ExpiresOn  string `json:"expires_on"`
+}
+
+// tokenCache is the content of the token cache file. Tokens are indexed by a checksum of the provider connection
+// settings, so several provider configurations can share the same file
+type tokenCache map[string]cachedSessionToken
+
+// tokenCacheKey returns the key of the session token of the given configuration in the token cache file. Only the
+// settings that are not secret are used, which are the URL, the organization, the user and the authentication method,
+// as a checksum that includes the credentials could be brute-forced by anyone who can read the file
+func tokenCacheKey(c *Config) string {
+	authMethod := "password"
+	switch {
+	case c.ServiceAccountTokenFile != "":
+		authMethod = "service_account_token_file:" + c.ServiceAccountTokenFile
+	case c.ApiTokenFile != "":
+		authMethod = "api_token_file:" + c.ApiTokenFile
+	case c.ApiToken != "":
+		authMethod = "api_token"
+	}
+	rawData := strings.Join([]string{c.Href, c.SysOrg, c.User, authMethod}, "#")
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(rawData)))
+}
+
+// authenticateWithTokenCache reuses the session token cached in 'cacheFile' for the given key, if it is still valid
+// and VCFA accepts it. Otherwise, it runs the given 'authenticate' function and caches the new session token, so next
+// Terraform runs don't need to log in again.
+// The cache file is locked during the whole process, so parallel runs wait for a single login instead of all of them
+// logging in at the same time
+func authenticateWithTokenCache(client *govcd.VCDClient, cacheFile, cacheKey, org string, authenticate func() error) error {
+	unlock, err := lockTokenFile(cacheFile, tokenFileLockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	cache, err := readTokenCache(cacheFile)
+	if err != nil {
+		// A broken cache must not prevent the authentication, it is overwritten with the new token
+		log.Printf("[WARN] ignoring token cache file %s: %s", cacheFile, err)
+		cache = tokenCache{}
+	}
+
+	if cached, ok := cache[cacheKey]; ok && isCachedSessionTokenValid(cached, time.Now()) {
+		err = client.SetToken(org, cached.AuthHeader, cached.Token)
+		if err == nil {
+			log.Printf("[DEBUG] reusing cached session token from %s", cacheFile)
+			return nil
+		}
+		log.Printf("[DEBUG] cached session token from %s was rejected, authenticating again: %s", cacheFile, err)
+		client.Client.VCDToken = ""
+		client.Client.VCDAuthHeader = ""
+		client.Client.UsingBearerToken = false
+		client.Client.UsingAccessToken = false
+	}
+
+	if err := authenticate(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	cache[cacheKey] = cachedSessionToken{
+		AuthHeader: client.Client.VCDAuthHeader,
+		Token:      client.Client.VCDToken,
+		ExpiresOn:  getSessionTokenExpiration(client.Client.VCDToken, now).Format(time.RFC3339),
+	}
+	for key, cached := range cache {
+		if !isCachedSessionTokenValid(cached, now) {
+			delete(cache, key)
+		}
+	}
+	if err := writeTokenCache(cacheFile, cache); err != nil {
+		// The session is already established, so failing to cache it only means that next run logs in again
+		log.Printf("[WARN] could not save the session token to cache file %s: %s", cacheFile, err)
+	}
+	return nil
+}
+
+// readTokenCache reads the given token cache file. A missing file is an empty cache
+func readTokenCache(cacheFile string) (tokenCache, error) {
+	contents, err := os.ReadFile(cacheFile) // #nosec G304 -- the file is set in the provider configuration
+	if errors.Is(err, os.ErrNotExist) {
+		return tokenCache{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache := tokenCache{}
+	if err := json.Unmarshal(contents, &cache); err != nil {
+		return nil, fmt.Errorf("error parsing token cache: %s", err)
+	}
+	return cache, nil
+}
+
+// writeTokenCache replaces the given token cache file with the given tokens
+func writeTokenCache(cacheFile string, cache tokenCache) error {
+	contents, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling token cache: %s", err)
+	}
+	return writeTokenFile(cacheFile, contents)
+}
+
+// isCachedSessionTokenValid returns whether the given cached token has at least 'tokenCacheMinValidity' left
+func isCachedSessionTokenValid(cached cachedSessionToken, now time.Time) bool {
+	if cached.Token == "" {
+		return false
+	}
+	expiresOn, err := time.Parse(time.RFC3339, cached.ExpiresOn)
+	if err != nil {
+		return false
+	}
+	return expiresOn.Sub(now) > tokenCacheMinValidity
+}
+
+// getSessionTokenExpiration returns the expiration time of the given session token, taken from its JWT 'exp' claim.
+// If the token is not a JWT or it doesn't have an expiration, it is considered valid for 'maxConnectionValidity',
+// like the connections cached in memory
+func getSessionTokenExpiration(token string, now time.Time) time.Time {
+	parsedToken, _, err := new(jwt.Parser).ParseUnverified(token, jwt.MapClaims{})
+	if err == nil {
+		expiration, err := parsedToken.Claims.GetExpirationTime()
+		if err == nil && expiration != nil {
+			return expiration.Time
+		}
+	}
+	return now.Add(maxConnectionValidity)
+}
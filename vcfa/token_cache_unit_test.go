@@ -0,0 +1,150 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/vmware/go-vcloud-director/v3/govcd"
+)
+
+// TestGetSessionTokenExpiration checks that the expiration of session tokens is taken from their JWT claims
+func TestGetSessionTokenExpiration(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	expiration := now.Add(8 * time.Hour)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"exp": expiration.Unix()}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := getSessionTokenExpiration(token, now); !got.Equal(expiration) {
+		t.Errorf("getSessionTokenExpiration() = %s, want %s", got, expiration)
+	}
+	if got := getSessionTokenExpiration("not-a-jwt", now); !got.Equal(now.Add(maxConnectionValidity)) {
+		t.Errorf("getSessionTokenExpiration() = %s, want %s", got, now.Add(maxConnectionValidity))
+	}
+}
+
+// TestIsCachedSessionTokenValid checks that only cached tokens with enough validity left are reused
+func TestIsCachedSessionTokenValid(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		cached cachedSessionToken
+		want   bool
+	}{
+		{name: "Valid", cached: cachedSessionToken{Token: "token", ExpiresOn: now.Add(time.Hour).Format(time.RFC3339)}, want: true},
+		{name: "AboutToExpire", cached: cachedSessionToken{Token: "token", ExpiresOn: now.Add(time.Minute).Format(time.RFC3339)}},
+		{name: "Expired", cached: cachedSessionToken{Token: "token", ExpiresOn: now.Add(-time.Hour).Format(time.RFC3339)}},
+		{name: "NoToken", cached: cachedSessionToken{ExpiresOn: now.Add(time.Hour).Format(time.RFC3339)}},
+		{name: "InvalidExpiration", cached: cachedSessionToken{Token: "token", ExpiresOn: "tomorrow"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isCachedSessionTokenValid(tt.cached, now); got != tt.want {
+				t.Errorf("isCachedSessionTokenValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestAuthenticateWithTokenCache checks that new session tokens are cached, and that tokens rejected by VCFA are
+// replaced by a new login
+func TestAuthenticateWithTokenCache(t *testing.T) {
+	cacheFile := filepath.Join(t.TempDir(), "token_cache.json")
+	// Nothing listens on this address, so the cached token is always rejected
+	client := govcd.NewVCDClient(url.URL{Scheme: "https", Host: "127.0.0.1:1", Path: "/api"}, true)
+
+	logins := 0
+	authenticate := func() error {
+		logins++
+		client.Client.VCDAuthHeader = govcd.BearerTokenHeader
+		client.Client.VCDToken = "new-token"
+		return nil
+	}
+
+	err := writeTokenCache(cacheFile, tokenCache{
+		"connection1": {Token: "rejected-token", AuthHeader: govcd.BearerTokenHeader, ExpiresOn: time.Now().Add(time.Hour).Format(time.RFC3339)},
+		"connection2": {Token: "expired-token", AuthHeader: govcd.BearerTokenHeader, ExpiresOn: time.Now().Add(-time.Hour).Format(time.RFC3339)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticateWithTokenCache(client, cacheFile, "connection1", "System", authenticate); err != nil {
+		t.Fatalf("authenticateWithTokenCache() error = %v", err)
+	}
+	if logins != 1 {
+		t.Errorf("expected 1 login after the cached token was rejected, got %d", logins)
+	}
+
+	cache, err := readTokenCache(cacheFile)
+	if err != nil {
+		t.Fatalf("readTokenCache() error = %v", err)
+	}
+	if len(cache) != 1 || cache["connection1"].Token != "new-token" {
+		t.Errorf("expected only the new token to be cached, got %+v", cache)
+	}
+	info, err := os.Stat(cacheFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected permissions 0600, got %o", info.Mode().Perm())
+	}
+	if _, err := os.Stat(cacheFile + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("expected the lock file to be removed, got %v", err)
+	}
+
+	// A broken cache file doesn't prevent the authentication
+	if err := os.WriteFile(cacheFile, []byte("{broken"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := authenticateWithTokenCache(client, cacheFile, "connection1", "System", authenticate); err != nil {
+		t.Fatalf("authenticateWithTokenCache() error = %v", err)
+	}
+	if logins != 2 {
+		t.Errorf("expected 2 logins after the cache was broken, got %d", logins)
+	}
+}
+
+// TestTokenCacheKey checks that the key of the cached session tokens depends on the connection settings, but not on
+// the credentials
+func TestTokenCacheKey(t *testing.T) {
+	base := Config{Href: "https://vcfa.example.com/api", SysOrg: "System", User: "admin", Password: "secret1"}
+	key := tokenCacheKey(&base)
+
+	samePassword := base
+	samePassword.Password = "secret2"
+	if got := tokenCacheKey(&samePassword); got != key {
+		t.Errorf("expected the key not to depend on the password, got %s and %s", key, got)
+	}
+	sameApiToken := Config{Href: base.Href, SysOrg: base.SysOrg, ApiToken: "token1"}
+	otherApiToken := sameApiToken
+	otherApiToken.ApiToken = "token2"
+	if tokenCacheKey(&sameApiToken) != tokenCacheKey(&otherApiToken) {
+		t.Errorf("expected the key not to depend on the API token")
+	}
+
+	for name, change := range map[string]func(c *Config){
+		"Url":                     func(c *Config) { c.Href = "https://other.example.com/api" },
+		"Org":                     func(c *Config) { c.SysOrg = "org1" },
+		"User":                    func(c *Config) { c.User = "user1" },
+		"ApiToken":                func(c *Config) { c.ApiToken = "token1" },
+		"ApiTokenFile":            func(c *Config) { c.ApiTokenFile = "token.json" },
+		"ServiceAccountTokenFile": func(c *Config) { c.ServiceAccountTokenFile = "sa.json" },
+	} {
+		other := base
+		change(&other)
+		if tokenCacheKey(&other) == key {
+			t.Errorf("expected a different key when the %s changes", name)
+		}
+	}
+}
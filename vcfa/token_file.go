@@ -0,0 +1,95 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// tokenFileLockTimeout is the maximum time to wait for other processes using the same token file to release it
+	tokenFileLockTimeout = 30 * time.Second
+	// tokenFileStaleLock is the age after which a lock file is considered abandoned, for example by a process that
+	// was killed in the middle of the authentication
+	tokenFileStaleLock = 2 * time.Minute
+)
+
+// tokenFileLockRetryDelay is the time to wait between attempts to acquire a token file lock.
+// It is a variable so unit tests can reduce it
+var tokenFileLockRetryDelay = 200 * time.Millisecond
+
+// lockTokenFile acquires an exclusive lock on the given token file, by creating a '<file>.lock' file next to it, so
+// several Terraform runs sharing the same file don't use or overwrite it concurrently. Locks older than
+// 'tokenFileStaleLock' are removed. Returns a function that releases the lock
+func lockTokenFile(tokenFile string, timeout time.Duration) (func(), error) {
+	lockFile := tokenFile + ".lock"
+	deadline := time.Now().Add(timeout)
+	for {
+		f, err := os.OpenFile(lockFile, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			_, _ = fmt.Fprintf(f, "%d\n", os.Getpid())
+			_ = f.Close()
+			return func() {
+				if err := os.Remove(lockFile); err != nil {
+					log.Printf("[WARN] could not remove lock file %s: %s", lockFile, err)
+				}
+			}, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("error creating lock file %s: %s", lockFile, err)
+		}
+
+		if info, statErr := os.Stat(lockFile); statErr == nil && time.Since(info.ModTime()) > tokenFileStaleLock {
+			log.Printf("[WARN] removing stale lock file %s", lockFile)
+			_ = os.Remove(lockFile)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s waiting for lock file %s. If no other process is using the "+
+				"token file, remove the lock file", timeout, lockFile)
+		}
+		time.Sleep(tokenFileLockRetryDelay)
+	}
+}
+
+// writeTokenFile replaces the contents of the given token file. The contents are written to a temporary file with
+// 0600 permissions in the same directory, which then replaces the original one, so the file is never left partially
+// written nor readable by other users
+func writeTokenFile(tokenFile string, contents []byte) error {
+	// When the token file is a symbolic link, its target is replaced, so the link is preserved
+	if resolved, err := filepath.EvalSymlinks(tokenFile); err == nil {
+		tokenFile = resolved
+	}
+	tmpFile, err := os.CreateTemp(filepath.Dir(tokenFile), filepath.Base(tokenFile)+".tmp*")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file: %s", err)
+	}
+	tmpName := tmpFile.Name()
+	// On success, the temporary file is renamed, so this removal is a no-op
+	defer func() { _ = os.Remove(tmpName) }()
+
+	err = tmpFile.Chmod(0600)
+	if err == nil {
+		_, err = tmpFile.Write(contents)
+	}
+	if err == nil {
+		err = tmpFile.Sync()
+	}
+	closeErr := tmpFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error writing temporary file %s: %s", tmpName, err)
+	}
+
+	return os.Rename(tmpName, tokenFile)
+}
@@ -0,0 +1,47 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestLockTokenFile checks that a token file can't be locked twice, and that stale locks are discarded
+func TestLockTokenFile(t *testing.T) {
+	defer func(delay time.Duration) { tokenFileLockRetryDelay = delay }(tokenFileLockRetryDelay)
+	tokenFileLockRetryDelay = 10 * time.Millisecond
+
+	tokenFile := filepath.Join(t.TempDir(), "token.json")
+	unlock, err := lockTokenFile(tokenFile, time.Second)
+	if err != nil {
+		t.Fatalf("lockTokenFile() error = %v", err)
+	}
+	if _, err := lockTokenFile(tokenFile, 50*time.Millisecond); err == nil {
+		t.Fatalf("lockTokenFile() expected an error for an already locked file")
+	}
+	unlock()
+	if _, err := os.Stat(tokenFile + ".lock"); !os.IsNotExist(err) {
+		t.Errorf("expected the lock file to be removed, got %v", err)
+	}
+
+	// This lock is never released, as if its process was killed
+	if _, err := lockTokenFile(tokenFile, time.Second); err != nil {
+		t.Fatalf("lockTokenFile() error = %v", err)
+	}
+	staleTime := time.Now().Add(-2 * tokenFileStaleLock)
+	if err := os.Chtimes(tokenFile+".lock", staleTime, staleTime); err != nil {
+		t.Fatal(err)
+	}
+	unlockStale, err := lockTokenFile(tokenFile, 50*time.Millisecond)
+	if err != nil {
+		t.Fatalf("lockTokenFile() expected a stale lock to be discarded, got %v", err)
+	}
+	unlockStale()
+}
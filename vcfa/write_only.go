@@ -0,0 +1,94 @@
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// writeOnlySecretSchema returns the schema of '<argument>_wo', the write-only alternative of the secret argument at
+// the given path, like 'password' or 'custom_settings.0.password'. Its value is sent to VCFA, but Terraform never
+// stores it in the plan or the state. As Terraform can't detect its changes, it is updated when the version
+// argument returned by writeOnlySecretVersionSchema changes
+func writeOnlySecretSchema(path, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		WriteOnly:     true,
+		Sensitive:     true,
+		ConflictsWith: []string{path},
+		RequiredWith:  []string{path + "_wo_version"},
+		Description: fmt.Sprintf("%s. Write-only alternative to '%s' that is never stored in the plan or the state, "+
+			"which requires Terraform 1.11+", description, lastSchemaPathElement(path)),
+	}
+}
+
+// writeOnlySecretVersionSchema returns the schema of '<argument>_wo_version', which must be changed to send a new
+// value of the write-only argument '<argument>_wo'
+func writeOnlySecretVersionSchema(path string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{path + "_wo"},
+		ValidateFunc: validation.IntAtLeast(1),
+		Description:  fmt.Sprintf("Version of '%s_wo', starting at 1. Changing it sends the new value to VCFA", lastSchemaPathElement(path)),
+	}
+}
+
+// getSecretArgument returns the value of the secret argument at the given path or, when it is set, the value of its
+// write-only alternative '<path>_wo'. Write-only values are only in the configuration, so they can only be retrieved
+// in Create and Update operations
+func getSecretArgument(d *schema.ResourceData, path string) string {
+	if value := getWriteOnlyString(d, path+"_wo"); value != "" {
+		return value
+	}
+	return d.Get(path).(string)
+}
+
+// hasSecretArgumentChange returns true if the secret argument at the given path, or the version of its write-only
+// alternative, has changed
+func hasSecretArgumentChange(d *schema.ResourceData, path string) bool {
+	return d.HasChanges(path, path+"_wo_version")
+}
+
+// isWriteOnlySecretSet returns true if the write-only alternative '<path>_wo' of a secret argument is set
+func isWriteOnlySecretSet(d *schema.ResourceData, path string) bool {
+	return getWriteOnlyString(d, path+"_wo") != ""
+}
+
+// getWriteOnlyString returns the value of the write-only string argument at the given path of the configuration, or an
+// empty string if it is not set
+func getWriteOnlyString(d *schema.ResourceData, path string) string {
+	value, diags := d.GetRawConfigAt(schemaPathToCtyPath(path))
+	if diags.HasError() || value.IsNull() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return ""
+	}
+	return value.AsString()
+}
+
+// schemaPathToCtyPath converts a path like 'custom_settings.0.password' to a cty.Path
+func schemaPathToCtyPath(path string) cty.Path {
+	var ctyPath cty.Path
+	for _, element := range strings.Split(path, ".") {
+		if index, err := strconv.Atoi(element); err == nil {
+			ctyPath = ctyPath.IndexInt(index)
+			continue
+		}
+		ctyPath = ctyPath.GetAttr(element)
+	}
+	return ctyPath
+}
+
+// lastSchemaPathElement returns the name of the argument at the given path, like 'password' for
+// 'custom_settings.0.password'
+func lastSchemaPathElement(path string) string {
+	return path[strings.LastIndex(path, ".")+1:]
+}
@@ -0,0 +1,43 @@
+//go:build unit || ALL
+
+// © Broadcom. All Rights Reserved.
+// The term "Broadcom" refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: MPL-2.0
+
+package vcfa
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+// TestSchemaPathToCtyPath checks the conversion of the paths of the secret arguments
+func TestSchemaPathToCtyPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		want     cty.Path
+		wantName string
+	}{
+		{
+			path:     "password",
+			want:     cty.GetAttrPath("password"),
+			wantName: "password",
+		},
+		{
+			path:     "custom_settings.0.password_wo",
+			want:     cty.GetAttrPath("custom_settings").IndexInt(0).GetAttr("password_wo"),
+			wantName: "password_wo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := schemaPathToCtyPath(tt.path); !got.Equals(tt.want) {
+				t.Errorf("expected path %#v, got %#v", tt.want, got)
+			}
+			if got := lastSchemaPathElement(tt.path); got != tt.wantName {
+				t.Errorf("expected name '%s', got '%s'", tt.wantName, got)
+			}
+		})
+	}
+}